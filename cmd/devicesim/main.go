@@ -0,0 +1,139 @@
+// Command devicesim fires signed messages of virtual smart card readers at the MQTT broker
+// and reports the acknowledgments of the server. The devices must be created on the server first,
+// their names and secrets are given with -device.
+//
+//	go run ./cmd/devicesim -device gate_a:<secret> -modes presence -uids 04A1B2C3,04D4E5F6 -rate 2 -duration 1m
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+	"github.com/adiubaidah/syafiiyah-main/pkg/devicesim"
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+)
+
+// deviceFlags collects repeated -device name:secret flags
+type deviceFlags []string
+
+func (d *deviceFlags) String() string {
+	return strings.Join(*d, ",")
+}
+
+func (d *deviceFlags) Set(value string) error {
+	if !strings.Contains(value, ":") {
+		return errors.New("device must be name:secret")
+	}
+	*d = append(*d, value)
+	return nil
+}
+
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func main() {
+	var devices deviceFlags
+	flag.Var(&devices, "device", "virtual device as name:secret, repeatable")
+	brokerURL := flag.String("broker", "tcp://localhost:1883", "MQTT broker URL")
+	modes := flag.String("modes", "presence", "comma separated modes used in turn for taps: record, presence, permission")
+	uids := flag.String("uids", "", "comma separated card UIDs tapped in turn")
+	rate := flag.Float64("rate", 1, "taps per second of each device")
+	count := flag.Int("count", 0, "taps of each device, 0 means until -duration or interrupted")
+	duration := flag.Duration("duration", 0, "how long the load runs, 0 means until -count or interrupted")
+	ping := flag.Duration("ping", 0, "heartbeat interval, 0 disables heartbeats")
+	firmware := flag.String("firmware", "devicesim", "firmware version reported in heartbeats")
+	timeout := flag.Duration("timeout", 5*time.Second, "acknowledgment timeout")
+	ackVersion := flag.Int("ack-version", 0, "acknowledgment format asked for taps, 0 is the legacy format")
+	verbose := flag.Bool("v", false, "print every acknowledgment")
+	flag.Parse()
+
+	if len(devices) == 0 {
+		log.Fatal("at least one -device is required")
+	}
+
+	load := devicesim.Load{
+		UIDs:            splitList(*uids),
+		Rate:            *rate,
+		Count:           *count,
+		Duration:        *duration,
+		PingInterval:    *ping,
+		FirmwareVersion: *firmware,
+	}
+	for _, mode := range splitList(*modes) {
+		load.Modes = append(load.Modes, repo.DeviceModeType(mode))
+	}
+	if len(load.UIDs) == 0 && load.PingInterval == 0 {
+		log.Fatal("nothing to send, give -uids or -ping")
+	}
+
+	client := mqtt.NewClient(mqtt.NewClientOptions().
+		AddBroker(*brokerURL).
+		SetClientID(fmt.Sprintf("devicesim-%d", os.Getpid())))
+	token := client.Connect()
+	if !token.WaitTimeout(*timeout) {
+		log.Fatalf("timeout connecting to %s", *brokerURL)
+	}
+	if err := token.Error(); err != nil {
+		log.Fatalf("connecting to %s: %v", *brokerURL, err)
+	}
+	defer client.Disconnect(250)
+
+	simulator := devicesim.New(client, *timeout)
+	registeredModes := append([]repo.DeviceModeType{}, load.Modes...)
+	if load.PingInterval > 0 {
+		registeredModes = append(registeredModes, repo.DeviceModeTypePing)
+	}
+	for _, device := range devices {
+		name, secret, _ := strings.Cut(device, ":")
+		registered, err := simulator.Register(name, secret, registeredModes...)
+		if err != nil {
+			log.Fatalf("registering device %s: %v", name, err)
+		}
+		registered.AckVersion = *ackVersion
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	report := simulator.Run(ctx, load, func(result devicesim.Result) {
+		if !*verbose {
+			return
+		}
+		if result.Err != nil {
+			log.Printf("%s %s %s: %v", result.Device, result.Mode, result.Uid, result.Err)
+			return
+		}
+		if result.Ack.Version > 0 {
+			log.Printf("%s %s %s: %d %s %q %s %s %s (%s)", result.Device, result.Mode, result.Uid, result.Ack.Code,
+				result.Ack.Result, result.Ack.Display, result.Ack.Name, result.Ack.Icon, result.Ack.Signal, result.Ack.Latency)
+			return
+		}
+		log.Printf("%s %s %s: %d %s %s (%s)", result.Device, result.Mode, result.Uid,
+			result.Ack.Code, result.Ack.Message, result.Ack.Data, result.Ack.Latency)
+	})
+
+	fmt.Printf("sent %d, acknowledged %d, timed out %d, failed %d\n", report.Sent, report.Acked, report.TimedOut, report.Failed)
+	fmt.Printf("latency avg %s, max %s\n", report.AvgLatency, report.MaxLatency)
+	for mode, sent := range report.Modes {
+		fmt.Printf("mode %s: %d\n", mode, sent)
+	}
+	for code, acked := range report.Codes {
+		fmt.Printf("code %d: %d\n", code, acked)
+	}
+}
@@ -0,0 +1,4 @@
+ALTER TABLE "device"
+DROP COLUMN IF EXISTS "ip_address",
+DROP COLUMN IF EXISTS "firmware_version",
+DROP COLUMN IF EXISTS "last_seen_at";
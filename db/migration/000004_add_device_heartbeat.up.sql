@@ -0,0 +1,6 @@
+ALTER TABLE "device"
+ADD COLUMN "last_seen_at" timestamptz,
+ADD COLUMN "firmware_version" varchar(50),
+ADD COLUMN "ip_address" varchar(45);
+
+COMMENT ON COLUMN "device"."last_seen_at" IS 'Waktu terakhir device mengirim ping';
@@ -0,0 +1,17 @@
+DROP TABLE IF EXISTS "device_batch_tap";
+
+DELETE FROM "device_mode" WHERE "mode" = 'batch';
+
+ALTER TYPE "device_mode_type" RENAME TO "device_mode_type_old";
+
+CREATE TYPE "device_mode_type" AS ENUM (
+  'record',
+  'presence',
+  'permission',
+  'ping'
+);
+
+ALTER TABLE "device_mode"
+ALTER COLUMN "mode" TYPE "device_mode_type" USING "mode"::text::"device_mode_type";
+
+DROP TYPE "device_mode_type_old";
@@ -0,0 +1,20 @@
+ALTER TYPE "device_mode_type" ADD VALUE 'batch';
+
+CREATE TABLE "device_batch_tap" (
+  "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  "device_id" int NOT NULL,
+  "tap_id" varchar(64) NOT NULL,
+  "uid" varchar(20) NOT NULL,
+  "tapped_at" timestamptz NOT NULL,
+  "code" int NOT NULL,
+  "message" text NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX ON "device_batch_tap" ("device_id", "tap_id");
+
+COMMENT ON COLUMN "device_batch_tap"."tap_id" IS 'ID unik tap dari device, agar upload ulang tidak tercatat dua kali';
+
+COMMENT ON COLUMN "device_batch_tap"."tapped_at" IS 'Waktu tap menurut device, bukan waktu diterima server';
+
+ALTER TABLE "device_batch_tap" ADD FOREIGN KEY ("device_id") REFERENCES "device" ("id") ON DELETE CASCADE;
@@ -0,0 +1,11 @@
+ALTER TABLE "device"
+ADD COLUMN "secret" varchar(64);
+
+UPDATE "device"
+SET "secret" = encode(sha256((random()::text || clock_timestamp()::text)::bytea), 'hex')
+WHERE "secret" IS NULL;
+
+ALTER TABLE "device"
+ALTER COLUMN "secret" SET NOT NULL;
+
+COMMENT ON COLUMN "device"."secret" IS 'Kunci HMAC untuk menandatangani payload dari device';
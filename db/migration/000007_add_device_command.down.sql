@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "device_command";
+
+DROP TYPE IF EXISTS "device_command_status";
+
+DROP TYPE IF EXISTS "device_command_type";
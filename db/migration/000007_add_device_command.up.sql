@@ -0,0 +1,38 @@
+CREATE TYPE "device_command_type" AS ENUM (
+  'switch_mode',
+  'reboot',
+  'beep',
+  'display_message'
+);
+
+CREATE TYPE "device_command_status" AS ENUM (
+  'pending',
+  'sent',
+  'acknowledged',
+  'failed'
+);
+
+CREATE TABLE "device_command" (
+  "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  "device_id" int NOT NULL,
+  "type" device_command_type NOT NULL,
+  "mode" device_mode_type,
+  "message" varchar(100),
+  "expires_at" timestamptz,
+  "status" device_command_status NOT NULL DEFAULT 'pending',
+  "error" text,
+  "created_by" int,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "sent_at" timestamptz,
+  "acknowledged_at" timestamptz
+);
+
+CREATE INDEX ON "device_command" ("device_id", "created_at");
+
+COMMENT ON COLUMN "device_command"."mode" IS 'Mode sementara untuk perintah switch_mode';
+
+COMMENT ON COLUMN "device_command"."expires_at" IS 'Batas waktu mode sementara, setelahnya device kembali ke mode semula';
+
+ALTER TABLE "device_command" ADD FOREIGN KEY ("device_id") REFERENCES "device" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "device_command" ADD FOREIGN KEY ("created_by") REFERENCES "user" ("id") ON DELETE SET NULL;
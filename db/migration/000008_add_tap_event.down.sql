@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "tap_event";
@@ -0,0 +1,33 @@
+CREATE TABLE "tap_event" (
+  "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  "device_id" int,
+  "mode" device_mode_type NOT NULL,
+  "uid" varchar(255),
+  "santri_id" int,
+  "employee_id" int,
+  "code" int NOT NULL,
+  "message" text NOT NULL,
+  "latency_ms" int NOT NULL,
+  "tapped_at" timestamptz NOT NULL DEFAULT (now()),
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "tap_event" ("tapped_at");
+
+CREATE INDEX ON "tap_event" ("uid");
+
+CREATE INDEX ON "tap_event" ("santri_id", "tapped_at");
+
+CREATE INDEX ON "tap_event" ("employee_id", "tapped_at");
+
+COMMENT ON COLUMN "tap_event"."code" IS 'Kode respon yang dikirim ke device, >= 300 berarti tap ditolak';
+
+COMMENT ON COLUMN "tap_event"."latency_ms" IS 'Lama pemrosesan tap di server';
+
+COMMENT ON COLUMN "tap_event"."tapped_at" IS 'Waktu tap di device, untuk mode batch bisa lebih awal dari created_at';
+
+ALTER TABLE "tap_event" ADD FOREIGN KEY ("device_id") REFERENCES "device" ("id") ON DELETE SET NULL;
+
+ALTER TABLE "tap_event" ADD FOREIGN KEY ("santri_id") REFERENCES "santri" ("id") ON DELETE SET NULL;
+
+ALTER TABLE "tap_event" ADD FOREIGN KEY ("employee_id") REFERENCES "employee" ("id") ON DELETE SET NULL;
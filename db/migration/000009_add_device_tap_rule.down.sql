@@ -0,0 +1,9 @@
+ALTER TABLE "tap_event" DROP COLUMN IF EXISTS "gate_direction";
+
+ALTER TABLE "device" DROP COLUMN IF EXISTS "anti_passback";
+
+ALTER TABLE "device" DROP COLUMN IF EXISTS "gate_direction";
+
+ALTER TABLE "device" DROP COLUMN IF EXISTS "debounce_seconds";
+
+DROP TYPE IF EXISTS "gate_direction_type";
@@ -0,0 +1,22 @@
+CREATE TYPE "gate_direction_type" AS ENUM (
+  'entry',
+  'exit'
+);
+
+ALTER TABLE "device" ADD COLUMN "debounce_seconds" int;
+
+ALTER TABLE "device" ADD COLUMN "gate_direction" gate_direction_type;
+
+ALTER TABLE "device" ADD COLUMN "anti_passback" boolean NOT NULL DEFAULT false;
+
+ALTER TABLE "tap_event" ADD COLUMN "gate_direction" gate_direction_type;
+
+CREATE INDEX ON "tap_event" ("uid", "tapped_at") WHERE "gate_direction" IS NOT NULL;
+
+COMMENT ON COLUMN "device"."debounce_seconds" IS 'Jendela debounce tap berulang dari kartu yang sama, NULL berarti memakai default server dan 0 berarti nonaktif';
+
+COMMENT ON COLUMN "device"."gate_direction" IS 'Arah gerbang untuk device yang dipasang di gerbang, NULL berarti bukan gerbang';
+
+COMMENT ON COLUMN "device"."anti_passback" IS 'Tolak kartu yang masuk dua kali tanpa keluar, atau keluar dua kali tanpa masuk';
+
+COMMENT ON COLUMN "tap_event"."gate_direction" IS 'Arah gerbang device saat tap terjadi';
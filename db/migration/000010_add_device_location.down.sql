@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "device_allow_rule";
+
+ALTER TABLE "device" DROP COLUMN IF EXISTS "location_id";
+
+DROP TABLE IF EXISTS "location";
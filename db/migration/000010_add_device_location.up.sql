@@ -0,0 +1,36 @@
+CREATE TABLE "location" (
+  "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  "name" varchar(100) UNIQUE NOT NULL,
+  "description" text
+);
+
+ALTER TABLE "device" ADD COLUMN "location_id" int;
+
+ALTER TABLE "device" ADD FOREIGN KEY ("location_id") REFERENCES "location" ("id") ON DELETE SET NULL;
+
+CREATE TABLE "device_allow_rule" (
+  "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  "device_id" int NOT NULL,
+  "owner_role" role_type,
+  "gender" gender_type,
+  "occupation_id" int,
+  "schedule_id" int
+);
+
+CREATE INDEX ON "device_allow_rule" ("device_id");
+
+COMMENT ON COLUMN "location"."name" IS 'ex: Masjid Putra, Asrama Putri';
+
+COMMENT ON COLUMN "device"."location_id" IS 'Lokasi tempat device dipasang';
+
+COMMENT ON TABLE "device_allow_rule" IS 'Kartu diterima device jika cocok dengan salah satu aturan, device tanpa aturan menerima semua kartu';
+
+COMMENT ON COLUMN "device_allow_rule"."owner_role" IS 'santri atau employee, NULL berarti semua pemilik';
+
+COMMENT ON COLUMN "device_allow_rule"."gender" IS 'NULL berarti semua gender';
+
+COMMENT ON COLUMN "device_allow_rule"."occupation_id" IS 'Id santri_occupation atau employee_occupation sesuai owner_role, NULL berarti semua';
+
+COMMENT ON COLUMN "device_allow_rule"."schedule_id" IS 'Id santri_schedule atau employee_schedule sesuai owner_role, NULL berarti semua jadwal';
+
+ALTER TABLE "device_allow_rule" ADD FOREIGN KEY ("device_id") REFERENCES "device" ("id") ON DELETE CASCADE;
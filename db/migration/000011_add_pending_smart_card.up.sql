@@ -0,0 +1,16 @@
+CREATE TABLE "pending_smart_card" (
+  "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  "uid" varchar(20) UNIQUE NOT NULL,
+  "device_id" int,
+  "tap_count" int NOT NULL DEFAULT 1,
+  "first_tapped_at" timestamptz NOT NULL DEFAULT 'now()',
+  "last_tapped_at" timestamptz NOT NULL DEFAULT 'now()'
+);
+
+CREATE INDEX ON "pending_smart_card" ("last_tapped_at");
+
+COMMENT ON TABLE "pending_smart_card" IS 'Kartu yang belum terdaftar tetapi ditap di device, admin bisa mengklaimnya menjadi smart_card';
+
+COMMENT ON COLUMN "pending_smart_card"."device_id" IS 'Device tempat kartu terakhir ditap';
+
+ALTER TABLE "pending_smart_card" ADD FOREIGN KEY ("device_id") REFERENCES "device" ("id") ON DELETE SET NULL;
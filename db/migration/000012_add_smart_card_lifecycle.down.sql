@@ -0,0 +1,13 @@
+DROP TABLE IF EXISTS "smart_card_assignment";
+
+ALTER TABLE "smart_card" DROP COLUMN IF EXISTS "is_active";
+
+ALTER TABLE "smart_card" ADD COLUMN "is_active" boolean NOT NULL DEFAULT false;
+
+UPDATE "smart_card" SET "is_active" = "state" = 'active';
+
+ALTER TABLE "smart_card" DROP COLUMN IF EXISTS "replaced_by_id";
+
+ALTER TABLE "smart_card" DROP COLUMN IF EXISTS "state";
+
+DROP TYPE IF EXISTS "smart_card_state";
@@ -0,0 +1,60 @@
+CREATE TYPE "smart_card_state" AS ENUM (
+  'active',
+  'suspended',
+  'lost',
+  'retired'
+);
+
+ALTER TABLE "smart_card" ADD COLUMN "state" smart_card_state NOT NULL DEFAULT 'active';
+
+ALTER TABLE "smart_card" ADD COLUMN "replaced_by_id" int;
+
+UPDATE "smart_card" SET "state" = 'suspended' WHERE NOT "is_active";
+
+ALTER TABLE "smart_card" DROP COLUMN "is_active";
+
+ALTER TABLE "smart_card" ADD COLUMN "is_active" boolean GENERATED ALWAYS AS ("state" = 'active') STORED;
+
+CREATE TABLE "smart_card_assignment" (
+  "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  "smart_card_id" int NOT NULL,
+  "santri_id" int,
+  "employee_id" int,
+  "valid_from" timestamptz NOT NULL DEFAULT 'now()',
+  "valid_until" timestamptz
+);
+
+CREATE INDEX ON "smart_card_assignment" ("santri_id");
+
+CREATE INDEX ON "smart_card_assignment" ("employee_id");
+
+CREATE UNIQUE INDEX ON "smart_card_assignment" ("smart_card_id") WHERE "valid_until" IS NULL;
+
+INSERT INTO
+  "smart_card_assignment" ("smart_card_id", "santri_id", "employee_id", "valid_from")
+SELECT
+  "id",
+  "santri_id",
+  "employee_id",
+  "created_at"
+FROM
+  "smart_card"
+WHERE
+  "santri_id" IS NOT NULL
+  OR "employee_id" IS NOT NULL;
+
+COMMENT ON COLUMN "smart_card"."state" IS 'suspended bisa diaktifkan lagi, lost dan retired melepas pemilik kartu';
+
+COMMENT ON COLUMN "smart_card"."replaced_by_id" IS 'Kartu pengganti jika kartu ini diganti';
+
+COMMENT ON COLUMN "smart_card"."is_active" IS 'Sama dengan state active';
+
+COMMENT ON TABLE "smart_card_assignment" IS 'Riwayat pemilik kartu, valid_until NULL berarti masih dipegang';
+
+ALTER TABLE "smart_card" ADD FOREIGN KEY ("replaced_by_id") REFERENCES "smart_card" ("id") ON DELETE SET NULL;
+
+ALTER TABLE "smart_card_assignment" ADD FOREIGN KEY ("smart_card_id") REFERENCES "smart_card" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "smart_card_assignment" ADD FOREIGN KEY ("santri_id") REFERENCES "santri" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "smart_card_assignment" ADD FOREIGN KEY ("employee_id") REFERENCES "employee" ("id") ON DELETE CASCADE;
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "enrollment_session_item";
+
+DROP TABLE IF EXISTS "enrollment_session";
+
+DROP TYPE IF EXISTS "enrollment_session_status";
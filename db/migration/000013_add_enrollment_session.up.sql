@@ -0,0 +1,57 @@
+CREATE TYPE "enrollment_session_status" AS ENUM (
+  'open',
+  'completed',
+  'cancelled',
+  'expired'
+);
+
+CREATE TABLE "enrollment_session" (
+  "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  "device_id" int NOT NULL,
+  "status" enrollment_session_status NOT NULL DEFAULT 'open',
+  "expires_at" timestamptz NOT NULL,
+  "created_by" int,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "closed_at" timestamptz
+);
+
+CREATE TABLE "enrollment_session_item" (
+  "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  "session_id" int NOT NULL,
+  "position" int NOT NULL,
+  "santri_id" int,
+  "employee_id" int,
+  "smart_card_id" int,
+  "enrolled_at" timestamptz
+);
+
+CREATE UNIQUE INDEX ON "enrollment_session" ("device_id") WHERE "status" = 'open';
+
+CREATE UNIQUE INDEX ON "enrollment_session_item" ("session_id", "position");
+
+COMMENT ON TABLE "enrollment_session" IS 'Sesi pendaftaran kartu, setiap tap di device mendaftarkan kartu untuk pemilik berikutnya';
+
+COMMENT ON COLUMN "enrollment_session"."expires_at" IS 'Sesi yang lewat batas waktu tidak menerima tap lagi';
+
+COMMENT ON TABLE "enrollment_session_item" IS 'Pemilik yang menunggu kartu, dilayani sesuai urutan position';
+
+COMMENT ON COLUMN "enrollment_session_item"."smart_card_id" IS 'Kartu yang didaftarkan, kosong selama belum ditap';
+
+ALTER TABLE "enrollment_session" ADD FOREIGN KEY ("device_id") REFERENCES "device" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "enrollment_session" ADD FOREIGN KEY ("created_by") REFERENCES "user" ("id") ON DELETE SET NULL;
+
+ALTER TABLE "enrollment_session_item" ADD FOREIGN KEY ("session_id") REFERENCES "enrollment_session" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "enrollment_session_item" ADD FOREIGN KEY ("santri_id") REFERENCES "santri" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "enrollment_session_item" ADD FOREIGN KEY ("employee_id") REFERENCES "employee" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "enrollment_session_item" ADD FOREIGN KEY ("smart_card_id") REFERENCES "smart_card" ("id") ON DELETE SET NULL;
+
+ALTER TABLE "enrollment_session_item"
+ADD CONSTRAINT check_enrollment_santri_or_employee
+CHECK (
+  (santri_id IS NOT NULL AND employee_id IS NULL) OR
+  (santri_id IS NULL AND employee_id IS NOT NULL)
+);
@@ -0,0 +1,5 @@
+ALTER TABLE "smart_card" DROP CONSTRAINT IF EXISTS check_smart_card_validity;
+
+ALTER TABLE "smart_card" DROP COLUMN IF EXISTS "valid_until";
+
+ALTER TABLE "smart_card" DROP COLUMN IF EXISTS "valid_from";
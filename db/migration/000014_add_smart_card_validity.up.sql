@@ -0,0 +1,13 @@
+ALTER TABLE "smart_card" ADD COLUMN "valid_from" timestamptz;
+
+ALTER TABLE "smart_card" ADD COLUMN "valid_until" timestamptz;
+
+ALTER TABLE "smart_card"
+ADD CONSTRAINT check_smart_card_validity
+CHECK ("valid_from" IS NULL OR "valid_until" IS NULL OR "valid_from" < "valid_until");
+
+CREATE INDEX ON "smart_card" ("valid_until") WHERE "state" = 'active';
+
+COMMENT ON COLUMN "smart_card"."valid_from" IS 'Kartu belum bisa dipakai sebelum waktu ini, kosong berarti tanpa batas';
+
+COMMENT ON COLUMN "smart_card"."valid_until" IS 'Kartu tidak bisa dipakai setelah waktu ini dan ditangguhkan oleh job, kosong berarti tanpa batas';
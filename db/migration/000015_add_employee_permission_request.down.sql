@@ -0,0 +1,13 @@
+ALTER TABLE "employee_permission" DROP COLUMN IF EXISTS "review_note";
+
+ALTER TABLE "employee_permission" DROP COLUMN IF EXISTS "reviewed_at";
+
+ALTER TABLE "employee_permission" DROP COLUMN IF EXISTS "reviewed_by";
+
+ALTER TABLE "employee_permission" DROP COLUMN IF EXISTS "created_at";
+
+ALTER TABLE "employee_permission" DROP COLUMN IF EXISTS "created_by";
+
+ALTER TABLE "employee_permission" DROP COLUMN IF EXISTS "status";
+
+DROP TYPE IF EXISTS "employee_permission_status";
@@ -0,0 +1,29 @@
+CREATE TYPE "employee_permission_status" AS ENUM (
+  'pending',
+  'approved',
+  'rejected'
+);
+
+ALTER TABLE "employee_permission" ADD COLUMN "status" employee_permission_status NOT NULL DEFAULT 'approved';
+
+ALTER TABLE "employee_permission" ADD COLUMN "created_by" int;
+
+ALTER TABLE "employee_permission" ADD COLUMN "created_at" timestamptz NOT NULL DEFAULT (now());
+
+ALTER TABLE "employee_permission" ADD COLUMN "reviewed_by" int;
+
+ALTER TABLE "employee_permission" ADD COLUMN "reviewed_at" timestamptz;
+
+ALTER TABLE "employee_permission" ADD COLUMN "review_note" varchar(255);
+
+CREATE INDEX ON "employee_permission" ("created_at") WHERE "status" = 'pending';
+
+COMMENT ON COLUMN "employee_permission"."status" IS 'Izin yang diajukan sendiri oleh pegawai menunggu persetujuan admin, izin dari admin langsung disetujui';
+
+COMMENT ON COLUMN "employee_permission"."created_by" IS 'User yang mencatat atau mengajukan izin';
+
+COMMENT ON COLUMN "employee_permission"."reviewed_by" IS 'Admin yang menyetujui atau menolak pengajuan';
+
+ALTER TABLE "employee_permission" ADD FOREIGN KEY ("created_by") REFERENCES "user" ("id") ON DELETE SET NULL;
+
+ALTER TABLE "employee_permission" ADD FOREIGN KEY ("reviewed_by") REFERENCES "user" ("id") ON DELETE SET NULL;
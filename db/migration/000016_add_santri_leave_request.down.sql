@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "santri_leave_request_history";
+
+DROP TABLE IF EXISTS "santri_leave_request";
+
+DROP TYPE IF EXISTS "leave_request_status";
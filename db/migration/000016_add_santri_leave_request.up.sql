@@ -0,0 +1,65 @@
+CREATE TYPE "leave_request_status" AS ENUM (
+  'pending',
+  'approved',
+  'rejected',
+  'cancelled',
+  'completed'
+);
+
+CREATE TABLE "santri_leave_request" (
+  "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  "santri_id" int NOT NULL,
+  "parent_id" int NOT NULL,
+  "type" permission_type NOT NULL,
+  "reason" varchar(255) NOT NULL,
+  "start_leave" timestamptz NOT NULL,
+  "end_leave" timestamptz NOT NULL,
+  "attachment" varchar(255),
+  "status" leave_request_status NOT NULL DEFAULT 'pending',
+  "review_comment" varchar(255),
+  "santri_permission_id" int,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "santri_leave_request_history" (
+  "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  "leave_request_id" int NOT NULL,
+  "from_status" leave_request_status,
+  "to_status" leave_request_status NOT NULL,
+  "actor_id" int,
+  "comment" varchar(255),
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "santri_leave_request" ("parent_id");
+
+CREATE INDEX ON "santri_leave_request" ("santri_id");
+
+CREATE INDEX ON "santri_leave_request" ("created_at") WHERE "status" = 'pending';
+
+CREATE INDEX ON "santri_leave_request_history" ("leave_request_id");
+
+COMMENT ON TABLE "santri_leave_request" IS 'Pengajuan izin santri oleh wali, izin santri dibuat saat pengajuan disetujui admin';
+
+COMMENT ON COLUMN "santri_leave_request"."attachment" IS 'Lampiran pengajuan, misalnya surat dokter';
+
+COMMENT ON COLUMN "santri_leave_request"."santri_permission_id" IS 'Izin santri yang dibuat saat pengajuan disetujui';
+
+COMMENT ON TABLE "santri_leave_request_history" IS 'Riwayat perubahan status pengajuan beserta user yang melakukannya';
+
+COMMENT ON COLUMN "santri_leave_request_history"."from_status" IS 'Kosong saat pengajuan dibuat';
+
+ALTER TABLE "santri_leave_request" ADD FOREIGN KEY ("santri_id") REFERENCES "santri" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "santri_leave_request" ADD FOREIGN KEY ("parent_id") REFERENCES "parent" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "santri_leave_request" ADD FOREIGN KEY ("santri_permission_id") REFERENCES "santri_permission" ("id") ON DELETE SET NULL;
+
+ALTER TABLE "santri_leave_request_history" ADD FOREIGN KEY ("leave_request_id") REFERENCES "santri_leave_request" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "santri_leave_request_history" ADD FOREIGN KEY ("actor_id") REFERENCES "user" ("id") ON DELETE SET NULL;
+
+ALTER TABLE "santri_leave_request"
+ADD CONSTRAINT check_leave_end_after_start
+CHECK ("end_leave" > "start_leave");
@@ -0,0 +1 @@
+ALTER TABLE "santri_permission" DROP COLUMN IF EXISTS "left_at";
@@ -0,0 +1,3 @@
+ALTER TABLE "santri_permission" ADD COLUMN "left_at" timestamptz;
+
+COMMENT ON COLUMN "santri_permission"."left_at" IS 'Waktu santri keluar, tap berikutnya pada izin tanpa end_permission berarti santri kembali';
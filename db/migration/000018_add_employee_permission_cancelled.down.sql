@@ -0,0 +1,24 @@
+UPDATE "employee_permission" SET "status" = 'rejected' WHERE "status" = 'cancelled';
+
+COMMENT ON COLUMN "employee_permission"."status" IS 'Izin yang diajukan sendiri oleh pegawai menunggu persetujuan admin, izin dari admin langsung disetujui';
+
+DROP INDEX IF EXISTS "employee_permission_created_at_idx";
+
+ALTER TABLE "employee_permission" ALTER COLUMN "status" DROP DEFAULT;
+
+ALTER TYPE "employee_permission_status" RENAME TO "employee_permission_status_old";
+
+CREATE TYPE "employee_permission_status" AS ENUM (
+  'pending',
+  'approved',
+  'rejected'
+);
+
+ALTER TABLE "employee_permission"
+ALTER COLUMN "status" TYPE "employee_permission_status" USING "status"::text::"employee_permission_status";
+
+ALTER TABLE "employee_permission" ALTER COLUMN "status" SET DEFAULT 'approved';
+
+DROP TYPE "employee_permission_status_old";
+
+CREATE INDEX ON "employee_permission" ("created_at") WHERE "status" = 'pending';
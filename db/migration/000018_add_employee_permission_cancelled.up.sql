@@ -0,0 +1,3 @@
+ALTER TYPE "employee_permission_status" ADD VALUE 'cancelled';
+
+COMMENT ON COLUMN "employee_permission"."status" IS 'Izin yang diajukan sendiri oleh pegawai menunggu persetujuan admin, izin dari admin langsung disetujui. Pengajuan yang masih menunggu bisa dibatalkan pegawai';
@@ -0,0 +1 @@
+ALTER TABLE "smart_card" DROP COLUMN IF EXISTS "expired_at";
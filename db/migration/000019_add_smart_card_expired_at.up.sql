@@ -0,0 +1,3 @@
+ALTER TABLE "smart_card" ADD COLUMN "expired_at" timestamptz;
+
+COMMENT ON COLUMN "smart_card"."expired_at" IS 'Waktu kartu ditangguhkan oleh job karena valid_until terlewati, kosong jika kartu ditangguhkan karena hal lain';
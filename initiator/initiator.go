@@ -2,6 +2,9 @@ package initiator
 
 import (
 	"context"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/adiubaidah/syafiiyah-main/internal/api/handler"
@@ -12,8 +15,10 @@ import (
 	pb "github.com/adiubaidah/syafiiyah-main/internal/protobuf"
 	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
 	"github.com/adiubaidah/syafiiyah-main/internal/usecase"
+	"github.com/adiubaidah/syafiiyah-main/internal/worker"
 	"github.com/adiubaidah/syafiiyah-main/pkg/config"
 	"github.com/adiubaidah/syafiiyah-main/pkg/token"
+	"github.com/adiubaidah/syafiiyah-main/platform/live"
 	"github.com/adiubaidah/syafiiyah-main/platform/mqtt"
 	"github.com/adiubaidah/syafiiyah-main/platform/routers"
 	storage "github.com/adiubaidah/syafiiyah-main/platform/storage"
@@ -95,6 +100,15 @@ func Init() {
 		logger.Fatalf("%s cannot create token maker", err.Error())
 	}
 
+	// QR credentials are disabled until QR_TOKEN_SYMMETRIC_KEY is set
+	var qrMaker *token.QRMaker
+	if env.QRTokenSymmetricKey != "" {
+		qrMaker, err = token.NewQRMaker(env.QRTokenSymmetricKey)
+		if err != nil {
+			logger.Fatalf("%s cannot create QR credential maker", err.Error())
+		}
+	}
+
 	sessionUseCase := usecase.NewSessionUseCase(redisClient)
 
 	middle := middleware.NewMiddleware(logger, tokenMaker)
@@ -140,9 +154,9 @@ func Init() {
 	santriPresenceUseCase := usecase.NewSantriPresenceUseCase(store)
 	santriPresenceHandler := handler.NewSantriPresenceHandler(logger, santriPresenceUseCase)
 	santriPresenceRouter := router.SantriPresenceRouter(santriPresenceHandler)
+	santriAlphaWorker := worker.NewSantriAlphaWorker(logger, santriScheduleService, santriPresenceUseCase)
 
-	// employeeScheduleService := pb.NewEmployeeScheduleServiceClient(scheduleServiceConn)
-	// employeeScheduleHandler := handler.NewEmployee(logger, employeeScheduleService)
+	employeeScheduleService := pb.NewEmployeeScheduleServiceClient(scheduleServiceConn)
 
 	employeeOccupationUseCase := usecase.NewEmployeeOccupationUseCase(store)
 	employeeOccupationHandler := handler.NewEmployeeOccupationHandler(logger, employeeOccupationUseCase)
@@ -156,6 +170,8 @@ func Init() {
 	})
 	employeeRouter := router.EmployeeRouter(middle, employeeHandler)
 
+	employeePresenceUseCase := usecase.NewEmployeePresenceUseCase(store)
+
 	profileHandler := handler.NewProfileHandler(&handler.ProfileHandler{
 		Logger:          logger,
 		EmployeeUseCase: employeeUseCase,
@@ -165,26 +181,77 @@ func Init() {
 
 	smartCardUseCase := usecase.NewSmartCardUseCase(store)
 	smartCardHandler := handler.NewSmartCardHandler(logger, smartCardUseCase)
-	smartCardRouter := router.SmartCardRouter(smartCardHandler)
+	smartCardRouter := router.SmartCardRouter(middle, smartCardHandler)
+	smartCardExpiryWorker := worker.NewSmartCardExpiryWorker(logger, smartCardUseCase, env.CardExpiryInterval)
 
-	deviceUseCase := usecase.NewDeviceUseCase(store)
+	deviceUseCase := usecase.NewDeviceUseCase(store, env.DeviceOfflineAfter)
 	// santriPresenceWorker := worker.NewSantriPresenceWorker(logger, santriPresenceUseCase)
 
-	mqttSantriHandler := mqttHandler.NewSantriMQTTHandler(logger, santriUseCase, santriScheduleService, santriPresenceUseCase)
-	// mqttEmployeeHandler := mqttHandler.NewEmployeeMQTTHandler(logger, employeeUseCase, santriScheduleService, santriPresenceUseCase)
-	mqttBroker := mqtt.NewMQTTBroker(&mqtt.MQTTBrokerConfig{
-		Logger:           logger,
-		DeviceUseCase:    deviceUseCase,
-		SmartCardUseCase: smartCardUseCase,
-		BrokerURL:        env.MQTTBroker,
-		SantriHandler:    mqttSantriHandler,
+	santriPermissionUseCase := usecase.NewSantriPermissionUseCase(store)
+	santriPermissionHandler := handler.NewSantriPermissionHandler(logger, santriPermissionUseCase)
+	santriPermissionRouter := router.SantriPermissionRouter(middle, santriPermissionHandler)
+
+	employeePermissionUseCase := usecase.NewEmployeePermissionUseCase(store)
+	employeePermissionHandler := handler.NewEmployeePermissionHandler(logger, employeePermissionUseCase)
+	employeePermissionRouter := router.EmployeePermissionRouter(middle, employeePermissionHandler)
+
+	santriLeaveRequestUseCase := usecase.NewSantriLeaveRequestUseCase(store)
+	santriLeaveRequestHandler := handler.NewSantriLeaveRequestHandler(logger, storageManager, santriLeaveRequestUseCase)
+	santriLeaveRequestRouter := router.SantriLeaveRequestRouter(middle, santriLeaveRequestHandler)
+
+	tapEventUseCase := usecase.NewTapEventUseCase(store)
+	tapEventHandler := handler.NewTapEventHandler(logger, tapEventUseCase)
+	tapEventRouter := router.TapEventRouter(middle, tapEventHandler)
+
+	locationUseCase := usecase.NewLocationUseCase(store)
+	locationHandler := handler.NewLocationHandler(logger, locationUseCase)
+	locationRouter := router.LocationRouter(middle, locationHandler)
+
+	qrCredentialUseCase := usecase.NewQRCredentialUseCase(store, qrMaker, env.QRTokenDuration)
+	qrCredentialHandler := handler.NewQRCredentialHandler(logger, qrCredentialUseCase)
+	qrCredentialRouter := router.QRCredentialRouter(middle, qrCredentialHandler)
+
+	holidayUseCase := usecase.NewHolidayUseCase(store)
+	holidayHandler := handler.NewHolidayHandler(logger, holidayUseCase)
+	holidayRouter := router.HolidayRouter(middle, holidayHandler)
+
+	liveFeed := live.NewFeed(0)
+	liveHandler := handler.NewLiveHandler(logger, liveFeed)
+	liveRouter := router.LiveRouter(middle, liveHandler)
+
+	mqttSantriHandler := mqttHandler.NewSantriMQTTHandler(logger, santriUseCase, santriScheduleService, santriPresenceUseCase, santriPermissionUseCase)
+	mqttEmployeeHandler := mqttHandler.NewEmployeeMQTTHandler(logger, employeeUseCase, employeeScheduleService, employeePresenceUseCase)
+	mqttBroker, err := mqtt.NewMQTTBroker(&mqtt.MQTTBrokerConfig{
+		Logger:            logger,
+		DeviceUseCase:     deviceUseCase,
+		SmartCardUseCase:  smartCardUseCase,
+		TapEventUseCase:   tapEventUseCase,
+		HolidayUseCase:    holidayUseCase,
+		BrokerURL:         env.MQTTBroker,
+		ClientID:          env.MQTTClientID,
+		InputQoS:          env.MQTTInputQoS,
+		AckQoS:            env.MQTTAckQoS,
+		SignatureMaxAge:   env.MQTTSignatureMaxAge,
+		Workers:           env.MQTTWorkers,
+		QueueSize:         env.MQTTQueueSize,
+		OverflowPolicy:    mqtt.OverflowPolicy(env.MQTTOverflowPolicy),
+		TapDebounceWindow: env.TapDebounceWindow,
+		SantriHandler:     mqttSantriHandler,
+		EmployeeHandler:   mqttEmployeeHandler,
+		LiveFeed:          liveFeed,
+		QRMaker:           qrMaker,
 	})
+	if err != nil {
+		// the http server is not started yet, returning lets the deferred calls close the connections
+		logger.Errorf("Unable to start MQTT broker: %v", err)
+		return
+	}
 	deviceHandler := handler.NewDeviceHandler(&handler.DeviceHandler{
 		Logger:      logger,
 		UseCase:     deviceUseCase,
 		MqttHandler: mqttBroker,
 	})
-	deviceRouter := router.DeviceRouter(deviceHandler)
+	deviceRouter := router.DeviceRouter(middle, deviceHandler)
 
 	var routerList []routers.Route
 	routerList = append(routerList, authRouter...)
@@ -201,8 +268,42 @@ func Init() {
 	routerList = append(routerList, profileRouter...)
 	routerList = append(routerList, smartCardRouter...)
 	routerList = append(routerList, deviceRouter...)
+	routerList = append(routerList, tapEventRouter...)
+	routerList = append(routerList, locationRouter...)
+	routerList = append(routerList, liveRouter...)
+	routerList = append(routerList, qrCredentialRouter...)
+	routerList = append(routerList, holidayRouter...)
+	routerList = append(routerList, santriPermissionRouter...)
+	routerList = append(routerList, employeePermissionRouter...)
+	routerList = append(routerList, santriLeaveRequestRouter...)
+
+	server := routers.NewRouting(env.ServerAddress, routerList, map[string]routers.HealthCheck{
+		"mqtt":            func() any { return mqttBroker.ConnectionState() },
+		"mqtt_dispatcher": func() any { return mqttBroker.DispatcherStats() },
+	})
+	go server.Serve()
+
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+	go smartCardExpiryWorker.Run(workerCtx)
+	go santriAlphaWorker.Run(workerCtx)
+
+	// queued taps are written before the database pool is closed by the deferred calls above
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+	logger.Println("Shutting down...")
+
+	// live streams never finish on their own, they are ended first so the running requests can drain
+	liveFeed.Close()
+	httpCtx, httpCancel := context.WithTimeout(context.Background(), env.HTTPShutdownTimeout)
+	defer httpCancel()
+	if err := server.Shutdown(httpCtx); err != nil {
+		logger.Errorf("Failed to drain http requests: %v", err)
+	}
 
-	server := routers.NewRouting(env.ServerAddress, routerList)
-	server.Serve()
+	ctx, cancel := context.WithTimeout(context.Background(), env.MQTTDrainTimeout)
+	defer cancel()
+	mqttBroker.Shutdown(ctx)
 
 }
@@ -3,6 +3,7 @@ package handler
 import (
 	"strconv"
 
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/exception"
 	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
 	"github.com/adiubaidah/syafiiyah-main/internal/usecase"
 	"github.com/adiubaidah/syafiiyah-main/platform/mqtt"
@@ -33,7 +34,9 @@ func (h *DeviceHandler) CreateDeviceHandler(c *gin.Context) {
 		return
 	}
 
-	h.MqttHandler.RefreshTopics()
+	if err := h.MqttHandler.RefreshTopics(); err != nil {
+		h.Logger.Errorf("Error refreshing device topics: %v", err)
+	}
 
 	c.JSON(200, model.ResponseData[*model.DeviceResponse]{Code: 200, Status: "success", Data: device})
 }
@@ -68,7 +71,9 @@ func (h *DeviceHandler) UpdateDeviceHandler(c *gin.Context) {
 		return
 	}
 
-	h.MqttHandler.RefreshTopics()
+	if err := h.MqttHandler.RefreshTopics(); err != nil {
+		h.Logger.Errorf("Error refreshing device topics: %v", err)
+	}
 
 	c.JSON(200, model.ResponseData[*model.DeviceResponse]{Code: 200, Status: "success", Data: device})
 }
@@ -86,7 +91,190 @@ func (h *DeviceHandler) DeleteDeviceHandler(c *gin.Context) {
 		return
 	}
 
-	h.MqttHandler.RefreshTopics()
+	if err := h.MqttHandler.RefreshTopics(); err != nil {
+		h.Logger.Errorf("Error refreshing device topics: %v", err)
+	}
+
+	c.JSON(200, model.ResponseData[*model.DeviceResponse]{Code: 200, Status: "success", Data: device})
+}
+
+func (h *DeviceHandler) RotateSecretHandler(c *gin.Context) {
+	idParam := c.Param("id")
+	deviceId, err := strconv.Atoi(idParam)
+	if err != nil {
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
+		return
+	}
+
+	device, err := h.UseCase.RotateSecret(c, int32(deviceId))
+	if err != nil {
+		if appErr, ok := err.(*exception.AppError); ok {
+			c.JSON(appErr.Code, model.ResponseMessage{Code: appErr.Code, Status: "error", Message: appErr.Message})
+			return
+		}
+		c.JSON(500, model.ResponseMessage{Code: 500, Status: "error", Message: err.Error()})
+		return
+	}
 
 	c.JSON(200, model.ResponseData[*model.DeviceResponse]{Code: 200, Status: "success", Data: device})
 }
+
+func (h *DeviceHandler) SendCommandHandler(c *gin.Context) {
+	idParam := c.Param("id")
+	deviceId, err := strconv.Atoi(idParam)
+	if err != nil {
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
+		return
+	}
+
+	var request model.CreateDeviceCommandRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
+		return
+	}
+
+	userValue, _ := c.Get("user")
+	user, _ := userValue.(*model.User)
+	var userId int32
+	if user != nil {
+		userId = user.ID
+	}
+
+	_, message, err := h.UseCase.CreateCommand(c, int32(deviceId), userId, &request)
+	if err != nil {
+		if appErr, ok := err.(*exception.AppError); ok {
+			c.JSON(appErr.Code, model.ResponseMessage{Code: appErr.Code, Status: "error", Message: appErr.Message})
+			return
+		}
+		c.JSON(500, model.ResponseMessage{Code: 500, Status: "error", Message: err.Error()})
+		return
+	}
+
+	command, err := h.MqttHandler.SendCommand(c, int32(deviceId), message)
+	if err != nil {
+		h.Logger.Error(err)
+		c.JSON(500, model.ResponseMessage{Code: 500, Status: "error", Message: err.Error()})
+		return
+	}
+
+	c.JSON(200, model.ResponseData[*model.DeviceCommandResponse]{Code: 200, Status: "success", Data: command})
+}
+
+func (h *DeviceHandler) ListCommandsHandler(c *gin.Context) {
+	idParam := c.Param("id")
+	deviceId, err := strconv.Atoi(idParam)
+	if err != nil {
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
+		return
+	}
+
+	var request model.ListDeviceCommandRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
+		return
+	}
+
+	if request.Limit == 0 {
+		request.Limit = 10
+	}
+
+	if request.Page == 0 {
+		request.Page = 1
+	}
+
+	result, err := h.UseCase.ListCommands(c, int32(deviceId), &request)
+	if err != nil {
+		h.Logger.Error(err)
+		c.JSON(500, model.ResponseMessage{Code: 500, Status: "error", Message: err.Error()})
+		return
+	}
+
+	count, err := h.UseCase.CountCommands(c, int32(deviceId), &request)
+	if err != nil {
+		h.Logger.Error(err)
+		c.JSON(500, model.ResponseMessage{Code: 500, Status: "error", Message: err.Error()})
+		return
+	}
+
+	pagination := model.Pagination{
+		CurrentPage:  request.Page,
+		TotalPages:   int32((count + int64(request.Limit) - 1) / int64(request.Limit)),
+		TotalItems:   count,
+		ItemsPerPage: request.Limit,
+	}
+
+	c.JSON(200, model.ResponseData[model.ListDeviceCommandResponse]{Code: 200, Status: "success", Data: model.ListDeviceCommandResponse{Items: *result, Pagination: pagination}})
+}
+
+func (h *DeviceHandler) UpdateTapRuleHandler(c *gin.Context) {
+	idParam := c.Param("id")
+	deviceId, err := strconv.Atoi(idParam)
+	if err != nil {
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
+		return
+	}
+
+	var request model.UpdateDeviceTapRuleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
+		return
+	}
+
+	device, err := h.UseCase.UpdateTapRule(c, int32(deviceId), &request)
+	if err != nil {
+		if appErr, ok := err.(*exception.AppError); ok {
+			c.JSON(appErr.Code, model.ResponseMessage{Code: appErr.Code, Status: "error", Message: appErr.Message})
+			return
+		}
+		c.JSON(500, model.ResponseMessage{Code: 500, Status: "error", Message: err.Error()})
+		return
+	}
+
+	c.JSON(200, model.ResponseData[*model.DeviceTapRuleResponse]{Code: 200, Status: "success", Data: device})
+}
+
+func (h *DeviceHandler) GetAccessHandler(c *gin.Context) {
+	deviceId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
+		return
+	}
+
+	device, err := h.UseCase.GetAccess(c, int32(deviceId))
+	if err != nil {
+		if appErr, ok := err.(*exception.AppError); ok {
+			c.JSON(appErr.Code, model.ResponseMessage{Code: appErr.Code, Status: "error", Message: appErr.Message})
+			return
+		}
+		c.JSON(500, model.ResponseMessage{Code: 500, Status: "error", Message: err.Error()})
+		return
+	}
+
+	c.JSON(200, model.ResponseData[*model.DeviceAccessResponse]{Code: 200, Status: "success", Data: device})
+}
+
+func (h *DeviceHandler) UpdateAccessHandler(c *gin.Context) {
+	deviceId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
+		return
+	}
+
+	var request model.UpdateDeviceAccessRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
+		return
+	}
+
+	device, err := h.UseCase.UpdateAccess(c, int32(deviceId), &request)
+	if err != nil {
+		if appErr, ok := err.(*exception.AppError); ok {
+			c.JSON(appErr.Code, model.ResponseMessage{Code: appErr.Code, Status: "error", Message: appErr.Message})
+			return
+		}
+		c.JSON(500, model.ResponseMessage{Code: 500, Status: "error", Message: err.Error()})
+		return
+	}
+
+	c.JSON(200, model.ResponseData[*model.DeviceAccessResponse]{Code: 200, Status: "success", Data: device})
+}
@@ -0,0 +1,285 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/exception"
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+	"github.com/adiubaidah/syafiiyah-main/internal/usecase"
+)
+
+type EmployeePermissionHandler interface {
+	CreateEmployeePermissionHandler(c *gin.Context)
+	SubmitEmployeePermissionHandler(c *gin.Context)
+	ListEmployeePermissionsHandler(c *gin.Context)
+	ListOwnEmployeePermissionsHandler(c *gin.Context)
+	ListEmployeePermissionQueueHandler(c *gin.Context)
+	GetEmployeePermissionHandler(c *gin.Context)
+	UpdateEmployeePermissionHandler(c *gin.Context)
+	ReviewEmployeePermissionHandler(c *gin.Context)
+	CancelOwnEmployeePermissionHandler(c *gin.Context)
+	DeleteEmployeePermissionHandler(c *gin.Context)
+}
+
+type employeePermissionHandler struct {
+	logger  *logrus.Logger
+	usecase usecase.EmployeePermissionUseCase
+}
+
+func NewEmployeePermissionHandler(logger *logrus.Logger, usecase usecase.EmployeePermissionUseCase) EmployeePermissionHandler {
+	return &employeePermissionHandler{
+		logger:  logger,
+		usecase: usecase,
+	}
+}
+
+func (h *employeePermissionHandler) CreateEmployeePermissionHandler(c *gin.Context) {
+	var request model.CreateEmployeePermissionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		h.logger.Error(err)
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
+		return
+	}
+
+	userValue, _ := c.Get("user")
+	user, _ := userValue.(*model.User)
+	var userId int32
+	if user != nil {
+		userId = user.ID
+	}
+
+	result, err := h.usecase.CreateEmployeePermission(c, userId, &request)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, model.ResponseData[model.EmployeePermissionResponse]{Code: http.StatusCreated, Status: "Created", Data: *result})
+}
+
+func (h *employeePermissionHandler) SubmitEmployeePermissionHandler(c *gin.Context) {
+	var request model.SubmitEmployeePermissionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		h.logger.Error(err)
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
+		return
+	}
+
+	userValue, _ := c.Get("user")
+	user, _ := userValue.(*model.User)
+
+	result, err := h.usecase.SubmitEmployeePermission(c, user, &request)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, model.ResponseData[model.EmployeePermissionResponse]{Code: http.StatusCreated, Status: "Created", Data: *result})
+}
+
+func (h *employeePermissionHandler) ListEmployeePermissionsHandler(c *gin.Context) {
+	request, ok := h.bindListRequest(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.usecase.ListEmployeePermissions(c, request)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	h.listResponse(c, request, result)
+}
+
+func (h *employeePermissionHandler) ListOwnEmployeePermissionsHandler(c *gin.Context) {
+	request, ok := h.bindListRequest(c)
+	if !ok {
+		return
+	}
+
+	userValue, _ := c.Get("user")
+	user, _ := userValue.(*model.User)
+
+	result, err := h.usecase.ListOwnEmployeePermissions(c, user, request)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	h.listResponse(c, request, result)
+}
+
+func (h *employeePermissionHandler) ListEmployeePermissionQueueHandler(c *gin.Context) {
+	request, ok := h.bindListRequest(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.usecase.ListEmployeePermissionQueue(c, request)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	// the queue ignores the other filters, the count has to as well
+	h.listResponse(c, &model.ListEmployeePermissionRequest{
+		Limit:  request.Limit,
+		Page:   request.Page,
+		Status: repo.EmployeePermissionStatusPending,
+	}, result)
+}
+
+func (h *employeePermissionHandler) GetEmployeePermissionHandler(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: "Invalid ID"})
+		return
+	}
+
+	result, err := h.usecase.GetEmployeePermission(c, int32(id))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, model.ResponseData[model.EmployeePermissionResponse]{Code: http.StatusOK, Status: "success", Data: *result})
+}
+
+func (h *employeePermissionHandler) UpdateEmployeePermissionHandler(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: "Invalid ID"})
+		return
+	}
+
+	var request model.UpdateEmployeePermissionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		h.logger.Error(err)
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
+		return
+	}
+
+	result, err := h.usecase.UpdateEmployeePermission(c, &request, int32(id))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, model.ResponseData[model.EmployeePermissionResponse]{Code: http.StatusOK, Status: "success", Data: *result})
+}
+
+func (h *employeePermissionHandler) ReviewEmployeePermissionHandler(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: "Invalid ID"})
+		return
+	}
+
+	var request model.ReviewEmployeePermissionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		h.logger.Error(err)
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
+		return
+	}
+
+	userValue, _ := c.Get("user")
+	user, _ := userValue.(*model.User)
+	var userId int32
+	if user != nil {
+		userId = user.ID
+	}
+
+	result, err := h.usecase.ReviewEmployeePermission(c, userId, &request, int32(id))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, model.ResponseData[model.EmployeePermissionResponse]{Code: http.StatusOK, Status: "success", Data: *result})
+}
+
+func (h *employeePermissionHandler) CancelOwnEmployeePermissionHandler(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: "Invalid ID"})
+		return
+	}
+
+	userValue, _ := c.Get("user")
+	user, _ := userValue.(*model.User)
+
+	result, err := h.usecase.CancelOwnEmployeePermission(c, user, int32(id))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, model.ResponseData[model.EmployeePermissionResponse]{Code: http.StatusOK, Status: "success", Data: *result})
+}
+
+func (h *employeePermissionHandler) DeleteEmployeePermissionHandler(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: "Invalid ID"})
+		return
+	}
+
+	result, err := h.usecase.DeleteEmployeePermission(c, int32(id))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, model.ResponseData[model.EmployeePermissionResponse]{Code: http.StatusOK, Status: "success", Data: *result})
+}
+
+func (h *employeePermissionHandler) bindListRequest(c *gin.Context) (*model.ListEmployeePermissionRequest, bool) {
+	var request model.ListEmployeePermissionRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		h.logger.Error(err)
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
+		return nil, false
+	}
+
+	if request.Limit == 0 {
+		request.Limit = 10
+	}
+
+	if request.Page == 0 {
+		request.Page = 1
+	}
+
+	return &request, true
+}
+
+func (h *employeePermissionHandler) listResponse(c *gin.Context, request *model.ListEmployeePermissionRequest, result *[]model.EmployeePermissionResponse) {
+	count, err := h.usecase.CountEmployeePermissions(c, request)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	pagination := model.Pagination{
+		CurrentPage:  request.Page,
+		TotalPages:   int32((count + int64(request.Limit) - 1) / int64(request.Limit)),
+		TotalItems:   count,
+		ItemsPerPage: request.Limit,
+	}
+
+	c.JSON(http.StatusOK, model.ResponseData[model.ListEmployeePermissionResponse]{Code: http.StatusOK, Status: "success", Data: model.ListEmployeePermissionResponse{Data: *result, Pagination: pagination}})
+}
+
+func (h *employeePermissionHandler) handleError(c *gin.Context, err error) {
+	h.logger.Error(err)
+	if appErr, ok := err.(*exception.AppError); ok {
+		c.JSON(appErr.Code, model.ResponseMessage{Code: appErr.Code, Status: "error", Message: appErr.Message})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, model.ResponseMessage{Code: 500, Status: "error", Message: err.Error()})
+}
@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/exception"
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
+	"github.com/adiubaidah/syafiiyah-main/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+type HolidayHandler struct {
+	logger  *logrus.Logger
+	usecase *usecase.HolidayUseCase
+}
+
+func NewHolidayHandler(logger *logrus.Logger, usecase *usecase.HolidayUseCase) *HolidayHandler {
+	return &HolidayHandler{logger: logger, usecase: usecase}
+}
+
+func (h *HolidayHandler) CreateHolidayHandler(c *gin.Context) {
+	var request model.CreateHolidayRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		h.logger.Error(err)
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
+		return
+	}
+
+	result, err := h.usecase.CreateHoliday(c, &request)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, model.ResponseData[*model.HolidayResponse]{Code: http.StatusCreated, Status: "success", Data: result})
+}
+
+func (h *HolidayHandler) ListHolidaysHandler(c *gin.Context) {
+	var request model.ListHolidayRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		h.logger.Error(err)
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
+		return
+	}
+
+	result, err := h.usecase.ListHolidays(c, &request)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, model.ResponseData[*[]model.HolidayResponse]{Code: http.StatusOK, Status: "success", Data: result})
+}
+
+func (h *HolidayHandler) CalendarHandler(c *gin.Context) {
+	var request model.HolidayCalendarRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		h.logger.Error(err)
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
+		return
+	}
+
+	now := time.Now()
+	if request.Month == 0 {
+		request.Month = int32(now.Month())
+	}
+
+	if request.Year == 0 {
+		request.Year = int32(now.Year())
+	}
+
+	result, err := h.usecase.Calendar(c, &request)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, model.ResponseData[*[]model.HolidayCalendarDay]{Code: http.StatusOK, Status: "success", Data: result})
+}
+
+func (h *HolidayHandler) GetHolidayHandler(c *gin.Context) {
+	holidayId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: "Invalid ID"})
+		return
+	}
+
+	result, err := h.usecase.GetHoliday(c, int32(holidayId))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, model.ResponseData[*model.HolidayResponse]{Code: http.StatusOK, Status: "success", Data: result})
+}
+
+func (h *HolidayHandler) UpdateHolidayHandler(c *gin.Context) {
+	holidayId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: "Invalid ID"})
+		return
+	}
+
+	var request model.UpdateHolidayRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		h.logger.Error(err)
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
+		return
+	}
+
+	result, err := h.usecase.UpdateHoliday(c, &request, int32(holidayId))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, model.ResponseData[*model.HolidayResponse]{Code: http.StatusOK, Status: "success", Data: result})
+}
+
+func (h *HolidayHandler) DeleteHolidayHandler(c *gin.Context) {
+	holidayId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: "Invalid ID"})
+		return
+	}
+
+	result, err := h.usecase.DeleteHoliday(c, int32(holidayId))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, model.ResponseData[*model.HolidayResponse]{Code: http.StatusOK, Status: "success", Data: result})
+}
+
+func (h *HolidayHandler) handleError(c *gin.Context, err error) {
+	h.logger.Error(err)
+	if appErr, ok := err.(*exception.AppError); ok {
+		c.JSON(appErr.Code, model.ResponseMessage{Code: appErr.Code, Status: "error", Message: appErr.Message})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, model.ResponseMessage{Code: 500, Status: "error", Message: err.Error()})
+}
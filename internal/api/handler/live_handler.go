@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
+	"github.com/adiubaidah/syafiiyah-main/platform/live"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// liveKeepAlive is the interval of comments sent to keep proxies from closing an idle stream
+const liveKeepAlive = 15 * time.Second
+
+type LiveHandler struct {
+	logger *logrus.Logger
+	feed   *live.Feed
+}
+
+func NewLiveHandler(logger *logrus.Logger, feed *live.Feed) *LiveHandler {
+	return &LiveHandler{logger: logger, feed: feed}
+}
+
+// StreamHandler streams tap and presence events as server-sent events.
+// A reconnecting EventSource sends the Last-Event-ID header and receives the events it missed,
+// when they are no longer available a reset event tells the client to reload its data.
+func (h *LiveHandler) StreamHandler(c *gin.Context) {
+	var request model.LiveFeedRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		h.logger.Error(err)
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
+		return
+	}
+	if lastEventID := c.GetHeader("Last-Event-ID"); lastEventID != "" {
+		request.LastEventID = lastEventID
+	}
+
+	subscription, missed, reset := h.feed.Subscribe(request.LastEventID)
+	defer h.feed.Unsubscribe(subscription)
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+
+	if reset {
+		fmt.Fprint(c.Writer, "event: reset\ndata: {}\n\n")
+	}
+	for _, event := range missed {
+		h.writeEvent(c.Writer, &request, event)
+	}
+	c.Writer.Flush()
+
+	keepAlive := time.NewTicker(liveKeepAlive)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case <-keepAlive.C:
+			fmt.Fprint(c.Writer, ": keep-alive\n\n")
+		case event, open := <-subscription.Events:
+			if !open {
+				// dropped for being too slow, the browser reconnects and resumes
+				return
+			}
+			h.writeEvent(c.Writer, &request, event)
+		}
+		c.Writer.Flush()
+	}
+}
+
+func (h *LiveHandler) writeEvent(w io.Writer, filter *model.LiveFeedRequest, event *model.LiveEvent) {
+	if !live.Match(filter, event) {
+		return
+	}
+	data, err := json.Marshal(event)
+	if err != nil {
+		h.logger.Error(err)
+		return
+	}
+	fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)
+}
@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/exception"
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
+	"github.com/adiubaidah/syafiiyah-main/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+type LocationHandler struct {
+	logger  *logrus.Logger
+	usecase *usecase.LocationUseCase
+}
+
+func NewLocationHandler(logger *logrus.Logger, usecase *usecase.LocationUseCase) *LocationHandler {
+	return &LocationHandler{logger: logger, usecase: usecase}
+}
+
+func (h *LocationHandler) CreateLocationHandler(c *gin.Context) {
+	var request model.CreateLocationRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		h.logger.Error(err)
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
+		return
+	}
+
+	result, err := h.usecase.CreateLocation(c, &request)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, model.ResponseData[*model.LocationResponse]{Code: http.StatusCreated, Status: "success", Data: result})
+}
+
+func (h *LocationHandler) ListLocationsHandler(c *gin.Context) {
+	result, err := h.usecase.ListLocations(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, model.ResponseData[*[]model.LocationResponse]{Code: http.StatusOK, Status: "success", Data: result})
+}
+
+func (h *LocationHandler) UpdateLocationHandler(c *gin.Context) {
+	locationId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: "Invalid ID"})
+		return
+	}
+
+	var request model.UpdateLocationRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		h.logger.Error(err)
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
+		return
+	}
+
+	result, err := h.usecase.UpdateLocation(c, &request, int32(locationId))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, model.ResponseData[*model.LocationResponse]{Code: http.StatusOK, Status: "success", Data: result})
+}
+
+func (h *LocationHandler) DeleteLocationHandler(c *gin.Context) {
+	locationId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: "Invalid ID"})
+		return
+	}
+
+	result, err := h.usecase.DeleteLocation(c, int32(locationId))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, model.ResponseData[*model.LocationResponse]{Code: http.StatusOK, Status: "success", Data: result})
+}
+
+func (h *LocationHandler) handleError(c *gin.Context, err error) {
+	h.logger.Error(err)
+	if appErr, ok := err.(*exception.AppError); ok {
+		c.JSON(appErr.Code, model.ResponseMessage{Code: appErr.Code, Status: "error", Message: appErr.Message})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, model.ResponseMessage{Code: 500, Status: "error", Message: err.Error()})
+}
@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/exception"
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
+	"github.com/adiubaidah/syafiiyah-main/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+type QRCredentialHandler struct {
+	logger  *logrus.Logger
+	usecase *usecase.QRCredentialUseCase
+}
+
+func NewQRCredentialHandler(logger *logrus.Logger, usecase *usecase.QRCredentialUseCase) *QRCredentialHandler {
+	return &QRCredentialHandler{logger: logger, usecase: usecase}
+}
+
+func (h *QRCredentialHandler) IssueQRCredentialHandler(c *gin.Context) {
+	var request model.QRCredentialRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		h.logger.Error(err)
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
+		return
+	}
+
+	userValue, _ := c.Get("user")
+	user, _ := userValue.(*model.User)
+
+	result, err := h.usecase.Issue(c, user, &request)
+	if err != nil {
+		h.logger.Error(err)
+		if appErr, ok := err.(*exception.AppError); ok {
+			c.JSON(appErr.Code, model.ResponseMessage{Code: appErr.Code, Status: "error", Message: appErr.Message})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, model.ResponseMessage{Code: 500, Status: "error", Message: err.Error()})
+		return
+	}
+
+	// every credential is new, a cached one may already be expired
+	c.Header("Cache-Control", "no-store")
+	c.JSON(200, model.ResponseData[*model.QRCredentialResponse]{Code: 200, Status: "success", Data: result})
+}
@@ -0,0 +1,232 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/exception"
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
+	"github.com/adiubaidah/syafiiyah-main/internal/usecase"
+	"github.com/adiubaidah/syafiiyah-main/pkg/util"
+	"github.com/adiubaidah/syafiiyah-main/platform/storage"
+)
+
+type SantriLeaveRequestHandler interface {
+	SubmitSantriLeaveRequestHandler(c *gin.Context)
+	ListSantriLeaveRequestsHandler(c *gin.Context)
+	GetSantriLeaveRequestHandler(c *gin.Context)
+	ReviewSantriLeaveRequestHandler(c *gin.Context)
+	CancelSantriLeaveRequestHandler(c *gin.Context)
+	CompleteSantriLeaveRequestHandler(c *gin.Context)
+}
+
+type santriLeaveRequestHandler struct {
+	logger  *logrus.Logger
+	storage *storage.StorageManager
+	usecase usecase.SantriLeaveRequestUseCase
+}
+
+func NewSantriLeaveRequestHandler(logger *logrus.Logger, storage *storage.StorageManager, usecase usecase.SantriLeaveRequestUseCase) SantriLeaveRequestHandler {
+	return &santriLeaveRequestHandler{
+		logger:  logger,
+		storage: storage,
+		usecase: usecase,
+	}
+}
+
+func (h *santriLeaveRequestHandler) SubmitSantriLeaveRequestHandler(c *gin.Context) {
+	var request model.SubmitSantriLeaveRequest
+	if err := c.ShouldBind(&request); err != nil {
+		h.logger.Error(err)
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
+		return
+	}
+
+	attachment, err := c.FormFile("attachment")
+	if err != nil {
+		if err != http.ErrMissingFile {
+			h.logger.Error(err)
+			c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
+			return
+		}
+	} else {
+		if err := util.ValidateAttachment(attachment); err != nil {
+			c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
+			return
+		}
+		fileName := fmt.Sprintf("%s%s", uuid.New().String(), util.GetFileExtension(attachment))
+		if request.Attachment, err = h.storage.UploadFile(c, attachment, fileName); err != nil {
+			h.logger.Error(err)
+			c.JSON(500, model.ResponseMessage{Code: 500, Status: "error", Message: "Failed to save attachment"})
+			return
+		}
+	}
+
+	userValue, _ := c.Get("user")
+	user, _ := userValue.(*model.User)
+
+	result, err := h.usecase.SubmitSantriLeaveRequest(c, user, &request)
+	if err != nil {
+		if request.Attachment != "" {
+			h.storage.DeleteFile(context.Background(), request.Attachment)
+		}
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, model.ResponseData[model.SantriLeaveRequestResponse]{Code: http.StatusCreated, Status: "Created", Data: *result})
+}
+
+func (h *santriLeaveRequestHandler) ListSantriLeaveRequestsHandler(c *gin.Context) {
+	var request model.ListSantriLeaveRequestRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		h.logger.Error(err)
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
+		return
+	}
+
+	if request.Limit == 0 {
+		request.Limit = 10
+	}
+
+	if request.Page == 0 {
+		request.Page = 1
+	}
+
+	userValue, _ := c.Get("user")
+	user, _ := userValue.(*model.User)
+
+	result, err := h.usecase.ListSantriLeaveRequests(c, user, &request)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	count, err := h.usecase.CountSantriLeaveRequests(c, user, &request)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	pagination := model.Pagination{
+		CurrentPage:  request.Page,
+		TotalPages:   int32((count + int64(request.Limit) - 1) / int64(request.Limit)),
+		TotalItems:   count,
+		ItemsPerPage: request.Limit,
+	}
+
+	c.JSON(http.StatusOK, model.ResponseData[model.ListSantriLeaveRequestResponse]{Code: http.StatusOK, Status: "success", Data: model.ListSantriLeaveRequestResponse{Data: *result, Pagination: pagination}})
+}
+
+func (h *santriLeaveRequestHandler) GetSantriLeaveRequestHandler(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: "Invalid ID"})
+		return
+	}
+
+	userValue, _ := c.Get("user")
+	user, _ := userValue.(*model.User)
+
+	result, err := h.usecase.GetSantriLeaveRequest(c, user, int32(id))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, model.ResponseData[model.SantriLeaveRequestResponse]{Code: http.StatusOK, Status: "success", Data: *result})
+}
+
+func (h *santriLeaveRequestHandler) ReviewSantriLeaveRequestHandler(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: "Invalid ID"})
+		return
+	}
+
+	var request model.ReviewSantriLeaveRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		h.logger.Error(err)
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
+		return
+	}
+
+	userValue, _ := c.Get("user")
+	user, _ := userValue.(*model.User)
+
+	result, err := h.usecase.ReviewSantriLeaveRequest(c, user, &request, int32(id))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, model.ResponseData[model.SantriLeaveRequestResponse]{Code: http.StatusOK, Status: "success", Data: *result})
+}
+
+func (h *santriLeaveRequestHandler) CancelSantriLeaveRequestHandler(c *gin.Context) {
+	id, request, user, ok := h.bindComment(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.usecase.CancelSantriLeaveRequest(c, user, request, id)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, model.ResponseData[model.SantriLeaveRequestResponse]{Code: http.StatusOK, Status: "success", Data: *result})
+}
+
+func (h *santriLeaveRequestHandler) CompleteSantriLeaveRequestHandler(c *gin.Context) {
+	id, request, user, ok := h.bindComment(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.usecase.CompleteSantriLeaveRequest(c, user, request, id)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, model.ResponseData[model.SantriLeaveRequestResponse]{Code: http.StatusOK, Status: "success", Data: *result})
+}
+
+// bindComment reads the id and the optional comment of a cancel or complete request, the body may be empty
+func (h *santriLeaveRequestHandler) bindComment(c *gin.Context) (int32, *model.SantriLeaveRequestComment, *model.User, bool) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: "Invalid ID"})
+		return 0, nil, nil, false
+	}
+
+	var request model.SantriLeaveRequestComment
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&request); err != nil {
+			h.logger.Error(err)
+			c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
+			return 0, nil, nil, false
+		}
+	}
+
+	userValue, _ := c.Get("user")
+	user, _ := userValue.(*model.User)
+
+	return int32(id), &request, user, true
+}
+
+func (h *santriLeaveRequestHandler) handleError(c *gin.Context, err error) {
+	h.logger.Error(err)
+	if appErr, ok := err.(*exception.AppError); ok {
+		c.JSON(appErr.Code, model.ResponseMessage{Code: appErr.Code, Status: "error", Message: appErr.Message})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, model.ResponseMessage{Code: 500, Status: "error", Message: err.Error()})
+}
@@ -0,0 +1,152 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/exception"
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
+	"github.com/adiubaidah/syafiiyah-main/internal/usecase"
+)
+
+type SantriPermissionHandler interface {
+	CreateSantriPermissionHandler(c *gin.Context)
+	ListSantriPermissionsHandler(c *gin.Context)
+	GetSantriPermissionHandler(c *gin.Context)
+	UpdateSantriPermissionHandler(c *gin.Context)
+	DeleteSantriPermissionHandler(c *gin.Context)
+}
+
+type santriPermissionHandler struct {
+	logger  *logrus.Logger
+	usecase usecase.SantriPermissionUseCase
+}
+
+func NewSantriPermissionHandler(logger *logrus.Logger, usecase usecase.SantriPermissionUseCase) SantriPermissionHandler {
+	return &santriPermissionHandler{
+		logger:  logger,
+		usecase: usecase,
+	}
+}
+
+func (h *santriPermissionHandler) CreateSantriPermissionHandler(c *gin.Context) {
+	var request model.CreateSantriPermissionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		h.logger.Error(err)
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
+		return
+	}
+
+	result, err := h.usecase.CreateSantriPermission(c, &request)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, model.ResponseData[model.SantriPermissionResponse]{Code: http.StatusCreated, Status: "Created", Data: *result})
+}
+
+func (h *santriPermissionHandler) ListSantriPermissionsHandler(c *gin.Context) {
+	var request model.ListSantriPermissionRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		h.logger.Error(err)
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
+		return
+	}
+
+	if request.Limit == 0 {
+		request.Limit = 10
+	}
+
+	if request.Page == 0 {
+		request.Page = 1
+	}
+
+	result, err := h.usecase.ListSantriPermissions(c, &request)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	count, err := h.usecase.CountSantriPermissions(c, &request)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	pagination := model.Pagination{
+		CurrentPage:  request.Page,
+		TotalPages:   int32((count + int64(request.Limit) - 1) / int64(request.Limit)),
+		TotalItems:   count,
+		ItemsPerPage: request.Limit,
+	}
+
+	c.JSON(http.StatusOK, model.ResponseData[model.ListSantriPermissionResponse]{Code: http.StatusOK, Status: "success", Data: model.ListSantriPermissionResponse{Data: *result, Pagination: pagination}})
+}
+
+func (h *santriPermissionHandler) GetSantriPermissionHandler(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: "Invalid ID"})
+		return
+	}
+
+	result, err := h.usecase.GetSantriPermission(c, int32(id))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, model.ResponseData[model.SantriPermissionResponse]{Code: http.StatusOK, Status: "success", Data: *result})
+}
+
+func (h *santriPermissionHandler) UpdateSantriPermissionHandler(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: "Invalid ID"})
+		return
+	}
+
+	var request model.UpdateSantriPermissionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		h.logger.Error(err)
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
+		return
+	}
+
+	result, err := h.usecase.UpdateSantriPermission(c, &request, int32(id))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, model.ResponseData[model.SantriPermissionResponse]{Code: http.StatusOK, Status: "success", Data: *result})
+}
+
+func (h *santriPermissionHandler) DeleteSantriPermissionHandler(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: "Invalid ID"})
+		return
+	}
+
+	result, err := h.usecase.DeleteSantriPermission(c, int32(id))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, model.ResponseData[model.SantriPermissionResponse]{Code: http.StatusOK, Status: "success", Data: *result})
+}
+
+func (h *santriPermissionHandler) handleError(c *gin.Context, err error) {
+	h.logger.Error(err)
+	if appErr, ok := err.(*exception.AppError); ok {
+		c.JSON(appErr.Code, model.ResponseMessage{Code: appErr.Code, Status: "error", Message: appErr.Message})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, model.ResponseMessage{Code: 500, Status: "error", Message: err.Error()})
+}
@@ -1,8 +1,10 @@
 package handler
 
 import (
+	"net/http"
 	"strconv"
 
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/exception"
 	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
 	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
 	"github.com/adiubaidah/syafiiyah-main/internal/usecase"
@@ -89,6 +91,10 @@ func (h *SmartCardHandler) Update(c *gin.Context) {
 	result, err := h.usecase.Update(c, &smartCardRequest, smartCardId)
 	if err != nil {
 		h.logger.Error(err)
+		if appErr, ok := err.(*exception.AppError); ok {
+			c.JSON(appErr.Code, model.ResponseMessage{Code: appErr.Code, Status: "error", Message: appErr.Message})
+			return
+		}
 		c.JSON(500, model.ResponseMessage{Code: 500, Status: "error", Message: err.Error()})
 		return
 	}
@@ -96,6 +102,57 @@ func (h *SmartCardHandler) Update(c *gin.Context) {
 	c.JSON(200, model.ResponseData[model.SmartCardComplete]{Code: 200, Status: "success", Data: *result})
 }
 
+func (h *SmartCardHandler) Replace(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		h.logger.Error(err)
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: "Invalid ID"})
+		return
+	}
+
+	var request model.ReplaceSmartCardRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		h.logger.Error(err)
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
+		return
+	}
+
+	result, err := h.usecase.Replace(c, int32(id), &request)
+	if err != nil {
+		h.logger.Error(err)
+		if appErr, ok := err.(*exception.AppError); ok {
+			c.JSON(appErr.Code, model.ResponseMessage{Code: appErr.Code, Status: "error", Message: appErr.Message})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, model.ResponseMessage{Code: 500, Status: "error", Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, model.ResponseData[model.SmartCardComplete]{Code: http.StatusOK, Status: "success", Data: *result})
+}
+
+func (h *SmartCardHandler) ListAssignments(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		h.logger.Error(err)
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: "Invalid ID"})
+		return
+	}
+
+	result, err := h.usecase.ListAssignments(c, int32(id))
+	if err != nil {
+		h.logger.Error(err)
+		if appErr, ok := err.(*exception.AppError); ok {
+			c.JSON(appErr.Code, model.ResponseMessage{Code: appErr.Code, Status: "error", Message: appErr.Message})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, model.ResponseMessage{Code: 500, Status: "error", Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, model.ResponseData[[]model.SmartCardAssignment]{Code: http.StatusOK, Status: "success", Data: *result})
+}
+
 func (h *SmartCardHandler) Delete(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
@@ -114,3 +171,225 @@ func (h *SmartCardHandler) Delete(c *gin.Context) {
 
 	c.JSON(200, model.ResponseData[model.SmartCard]{Code: 200, Status: "success", Data: *deletedSmartCard})
 }
+
+func (h *SmartCardHandler) ListPending(c *gin.Context) {
+	var request model.ListPendingSmartCardRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		h.logger.Error(err)
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
+		return
+	}
+
+	if request.Limit == 0 {
+		request.Limit = 10
+	}
+
+	if request.Page == 0 {
+		request.Page = 1
+	}
+
+	result, err := h.usecase.ListPending(c, &request)
+	if err != nil {
+		h.logger.Error(err)
+		c.JSON(500, model.ResponseMessage{Code: 500, Status: "error", Message: err.Error()})
+		return
+	}
+
+	count, err := h.usecase.CountPending(c)
+	if err != nil {
+		h.logger.Error(err)
+		c.JSON(500, model.ResponseMessage{Code: 500, Status: "error", Message: err.Error()})
+		return
+	}
+
+	pagination := model.Pagination{
+		CurrentPage:  request.Page,
+		TotalPages:   int32((count + int64(request.Limit) - 1) / int64(request.Limit)),
+		TotalItems:   count,
+		ItemsPerPage: request.Limit,
+	}
+
+	c.JSON(200, model.ResponseData[model.ListPendingSmartCardResponse]{
+		Code:   200,
+		Status: "success",
+		Data: model.ListPendingSmartCardResponse{
+			Items:      *result,
+			Pagination: pagination,
+		},
+	})
+}
+
+func (h *SmartCardHandler) ClaimPending(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		h.logger.Error(err)
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: "Invalid ID"})
+		return
+	}
+
+	var request model.ClaimPendingSmartCardRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		h.logger.Error(err)
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
+		return
+	}
+
+	result, err := h.usecase.ClaimPending(c, int32(id), &request)
+	if err != nil {
+		h.logger.Error(err)
+		if appErr, ok := err.(*exception.AppError); ok {
+			c.JSON(appErr.Code, model.ResponseMessage{Code: appErr.Code, Status: "error", Message: appErr.Message})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, model.ResponseMessage{Code: 500, Status: "error", Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, model.ResponseData[model.SmartCardComplete]{Code: http.StatusCreated, Status: "success", Data: *result})
+}
+
+func (h *SmartCardHandler) DeletePending(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		h.logger.Error(err)
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: "Invalid ID"})
+		return
+	}
+
+	result, err := h.usecase.DeletePending(c, int32(id))
+	if err != nil {
+		h.logger.Error(err)
+		if appErr, ok := err.(*exception.AppError); ok {
+			c.JSON(appErr.Code, model.ResponseMessage{Code: appErr.Code, Status: "error", Message: appErr.Message})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, model.ResponseMessage{Code: 500, Status: "error", Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, model.ResponseData[model.PendingSmartCard]{Code: http.StatusOK, Status: "success", Data: *result})
+}
+
+func (h *SmartCardHandler) OpenEnrollment(c *gin.Context) {
+	var request model.CreateEnrollmentSessionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		h.logger.Error(err)
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
+		return
+	}
+
+	userValue, _ := c.Get("user")
+	user, _ := userValue.(*model.User)
+	var userId int32
+	if user != nil {
+		userId = user.ID
+	}
+
+	result, err := h.usecase.OpenEnrollment(c, userId, &request)
+	if err != nil {
+		h.logger.Error(err)
+		if appErr, ok := err.(*exception.AppError); ok {
+			c.JSON(appErr.Code, model.ResponseMessage{Code: appErr.Code, Status: "error", Message: appErr.Message})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, model.ResponseMessage{Code: 500, Status: "error", Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, model.ResponseData[model.EnrollmentSessionResponse]{Code: http.StatusCreated, Status: "success", Data: *result})
+}
+
+func (h *SmartCardHandler) GetEnrollment(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		h.logger.Error(err)
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: "Invalid ID"})
+		return
+	}
+
+	result, err := h.usecase.GetEnrollment(c, int32(id))
+	if err != nil {
+		h.logger.Error(err)
+		if appErr, ok := err.(*exception.AppError); ok {
+			c.JSON(appErr.Code, model.ResponseMessage{Code: appErr.Code, Status: "error", Message: appErr.Message})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, model.ResponseMessage{Code: 500, Status: "error", Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, model.ResponseData[model.EnrollmentSessionResponse]{Code: http.StatusOK, Status: "success", Data: *result})
+}
+
+func (h *SmartCardHandler) CancelEnrollment(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		h.logger.Error(err)
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: "Invalid ID"})
+		return
+	}
+
+	result, err := h.usecase.CancelEnrollment(c, int32(id))
+	if err != nil {
+		h.logger.Error(err)
+		if appErr, ok := err.(*exception.AppError); ok {
+			c.JSON(appErr.Code, model.ResponseMessage{Code: appErr.Code, Status: "error", Message: appErr.Message})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, model.ResponseMessage{Code: 500, Status: "error", Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, model.ResponseData[model.EnrollmentSessionResponse]{Code: http.StatusOK, Status: "success", Data: *result})
+}
+
+func (h *SmartCardHandler) ListExpiring(c *gin.Context) {
+	var request model.ListExpiringSmartCardRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		h.logger.Error(err)
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
+		return
+	}
+
+	if request.Days == 0 {
+		request.Days = 30
+	}
+
+	if request.Limit == 0 {
+		request.Limit = 10
+	}
+
+	if request.Page == 0 {
+		request.Page = 1
+	}
+
+	result, err := h.usecase.ListExpiring(c, &request)
+	if err != nil {
+		h.logger.Error(err)
+		c.JSON(500, model.ResponseMessage{Code: 500, Status: "error", Message: err.Error()})
+		return
+	}
+
+	count, err := h.usecase.CountExpiring(c, &request)
+	if err != nil {
+		h.logger.Error(err)
+		c.JSON(500, model.ResponseMessage{Code: 500, Status: "error", Message: err.Error()})
+		return
+	}
+
+	pagination := model.Pagination{
+		CurrentPage:  request.Page,
+		TotalPages:   int32((count + int64(request.Limit) - 1) / int64(request.Limit)),
+		TotalItems:   count,
+		ItemsPerPage: request.Limit,
+	}
+
+	c.JSON(200, model.ResponseData[model.ListSmartCardResponse]{
+		Code:   200,
+		Status: "success",
+		Data: model.ListSmartCardResponse{
+			Items:      *result,
+			Pagination: pagination,
+		},
+	})
+}
@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/exception"
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
+	"github.com/adiubaidah/syafiiyah-main/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+type TapEventHandler struct {
+	logger  *logrus.Logger
+	usecase *usecase.TapEventUseCase
+}
+
+func NewTapEventHandler(logger *logrus.Logger, usecase *usecase.TapEventUseCase) *TapEventHandler {
+	return &TapEventHandler{logger: logger, usecase: usecase}
+}
+
+func (h *TapEventHandler) ListTapEventsHandler(c *gin.Context) {
+	var request model.ListTapEventRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		h.logger.Error(err)
+		c.JSON(400, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
+		return
+	}
+
+	if request.Limit == 0 {
+		request.Limit = 10
+	}
+
+	if request.Page == 0 {
+		request.Page = 1
+	}
+
+	result, err := h.usecase.ListTapEvents(c, &request)
+	if err != nil {
+		h.logger.Error(err)
+		if appErr, ok := err.(*exception.AppError); ok {
+			c.JSON(appErr.Code, model.ResponseMessage{Code: appErr.Code, Status: "error", Message: appErr.Message})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, model.ResponseMessage{Code: 500, Status: "error", Message: err.Error()})
+		return
+	}
+
+	count, err := h.usecase.CountTapEvents(c, &request)
+	if err != nil {
+		h.logger.Error(err)
+		c.JSON(http.StatusInternalServerError, model.ResponseMessage{Code: 500, Status: "error", Message: err.Error()})
+		return
+	}
+
+	pagination := model.Pagination{
+		CurrentPage:  request.Page,
+		TotalPages:   int32((count + int64(request.Limit) - 1) / int64(request.Limit)),
+		TotalItems:   count,
+		ItemsPerPage: request.Limit,
+	}
+
+	c.JSON(http.StatusOK, model.ResponseData[model.ListTapEventResponse]{Code: http.StatusOK, Status: "success", Data: model.ListTapEventResponse{Items: *result, Pagination: pagination}})
+}
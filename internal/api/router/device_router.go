@@ -4,35 +4,103 @@ import (
 	"net/http"
 
 	"github.com/adiubaidah/syafiiyah-main/internal/api/handler"
+	"github.com/adiubaidah/syafiiyah-main/internal/api/middleware"
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
 	"github.com/adiubaidah/syafiiyah-main/platform/routers"
 	"github.com/gin-gonic/gin"
 )
 
-func DeviceRouter(handler *handler.DeviceHandler) []routers.Route {
+func DeviceRouter(middle middleware.Middleware, handler *handler.DeviceHandler) []routers.Route {
 	return []routers.Route{
 		{
-			Method:      http.MethodPost,
-			Path:        "/device",
-			Handle:      handler.CreateDeviceHandler,
-			MiddleWares: []gin.HandlerFunc{},
+			Method: http.MethodPost,
+			Path:   "/device",
+			Handle: handler.CreateDeviceHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin),
+			},
 		},
 		{
-			Method:      http.MethodGet,
-			Path:        "/device",
-			Handle:      handler.ListDevicesHandler,
-			MiddleWares: []gin.HandlerFunc{},
+			Method: http.MethodGet,
+			Path:   "/device",
+			Handle: handler.ListDevicesHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin),
+			},
 		},
 		{
-			Method:      http.MethodPut,
-			Path:        "/device/:id",
-			Handle:      handler.UpdateDeviceHandler,
-			MiddleWares: []gin.HandlerFunc{},
+			Method: http.MethodPut,
+			Path:   "/device/:id",
+			Handle: handler.UpdateDeviceHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin),
+			},
 		},
 		{
-			Method:      http.MethodDelete,
-			Path:        "/device/:id",
-			Handle:      handler.DeleteDeviceHandler,
-			MiddleWares: []gin.HandlerFunc{},
+			Method: http.MethodDelete,
+			Path:   "/device/:id",
+			Handle: handler.DeleteDeviceHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin),
+			},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/device/:id/rotate-secret",
+			Handle: handler.RotateSecretHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin),
+			},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/device/:id/command",
+			Handle: handler.SendCommandHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin),
+			},
+		},
+		{
+			Method: http.MethodGet,
+			Path:   "/device/:id/command",
+			Handle: handler.ListCommandsHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin),
+			},
+		},
+		{
+			Method: http.MethodPut,
+			Path:   "/device/:id/tap-rule",
+			Handle: handler.UpdateTapRuleHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin),
+			},
+		},
+		{
+			Method: http.MethodGet,
+			Path:   "/device/:id/access",
+			Handle: handler.GetAccessHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin),
+			},
+		},
+		{
+			Method: http.MethodPut,
+			Path:   "/device/:id/access",
+			Handle: handler.UpdateAccessHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin),
+			},
 		},
 	}
 }
@@ -0,0 +1,103 @@
+package routing
+
+import (
+	"net/http"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/api/handler"
+	"github.com/adiubaidah/syafiiyah-main/internal/api/middleware"
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+	"github.com/adiubaidah/syafiiyah-main/platform/routers"
+	"github.com/gin-gonic/gin"
+)
+
+func EmployeePermissionRouter(middle middleware.Middleware, handler handler.EmployeePermissionHandler) []routers.Route {
+	return []routers.Route{
+		{
+			Method: http.MethodPost,
+			Path:   "/employee-permission",
+			Handle: handler.CreateEmployeePermissionHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin, repo.RoleTypeAdmin),
+			},
+		},
+		{
+			Method: http.MethodGet,
+			Path:   "/employee-permission",
+			Handle: handler.ListEmployeePermissionsHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin, repo.RoleTypeAdmin),
+			},
+		},
+		{
+			Method: http.MethodGet,
+			Path:   "/employee-permission/queue",
+			Handle: handler.ListEmployeePermissionQueueHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin, repo.RoleTypeAdmin),
+			},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/employee-permission/request",
+			Handle: handler.SubmitEmployeePermissionHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+			},
+		},
+		{
+			Method: http.MethodGet,
+			Path:   "/employee-permission/mine",
+			Handle: handler.ListOwnEmployeePermissionsHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+			},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/employee-permission/mine/:id/cancel",
+			Handle: handler.CancelOwnEmployeePermissionHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+			},
+		},
+		{
+			Method: http.MethodGet,
+			Path:   "/employee-permission/:id",
+			Handle: handler.GetEmployeePermissionHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin, repo.RoleTypeAdmin),
+			},
+		},
+		{
+			Method: http.MethodPut,
+			Path:   "/employee-permission/:id",
+			Handle: handler.UpdateEmployeePermissionHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin, repo.RoleTypeAdmin),
+			},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/employee-permission/:id/review",
+			Handle: handler.ReviewEmployeePermissionHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin, repo.RoleTypeAdmin),
+			},
+		},
+		{
+			Method: http.MethodDelete,
+			Path:   "/employee-permission/:id",
+			Handle: handler.DeleteEmployeePermissionHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin, repo.RoleTypeAdmin),
+			},
+		},
+	}
+}
@@ -0,0 +1,67 @@
+package routing
+
+import (
+	"net/http"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/api/handler"
+	"github.com/adiubaidah/syafiiyah-main/internal/api/middleware"
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+	"github.com/adiubaidah/syafiiyah-main/platform/routers"
+	"github.com/gin-gonic/gin"
+)
+
+func HolidayRouter(middle middleware.Middleware, handler *handler.HolidayHandler) []routers.Route {
+	return []routers.Route{
+		{
+			Method: http.MethodPost,
+			Path:   "/holiday",
+			Handle: handler.CreateHolidayHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin, repo.RoleTypeAdmin),
+			},
+		},
+		{
+			Method: http.MethodGet,
+			Path:   "/holiday",
+			Handle: handler.ListHolidaysHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+			},
+		},
+		{
+			Method: http.MethodGet,
+			Path:   "/holiday/calendar",
+			Handle: handler.CalendarHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+			},
+		},
+		{
+			Method: http.MethodGet,
+			Path:   "/holiday/:id",
+			Handle: handler.GetHolidayHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+			},
+		},
+		{
+			Method: http.MethodPut,
+			Path:   "/holiday/:id",
+			Handle: handler.UpdateHolidayHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin, repo.RoleTypeAdmin),
+			},
+		},
+		{
+			Method: http.MethodDelete,
+			Path:   "/holiday/:id",
+			Handle: handler.DeleteHolidayHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin, repo.RoleTypeAdmin),
+			},
+		},
+	}
+}
@@ -0,0 +1,25 @@
+package routing
+
+import (
+	"net/http"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/api/handler"
+	"github.com/adiubaidah/syafiiyah-main/internal/api/middleware"
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+	"github.com/adiubaidah/syafiiyah-main/platform/routers"
+	"github.com/gin-gonic/gin"
+)
+
+func LiveRouter(middle middleware.Middleware, handler *handler.LiveHandler) []routers.Route {
+	return []routers.Route{
+		{
+			Method: http.MethodGet,
+			Path:   "/live",
+			Handle: handler.StreamHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin, repo.RoleTypeAdmin),
+			},
+		},
+	}
+}
@@ -0,0 +1,52 @@
+package routing
+
+import (
+	"net/http"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/api/handler"
+	"github.com/adiubaidah/syafiiyah-main/internal/api/middleware"
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+	"github.com/adiubaidah/syafiiyah-main/platform/routers"
+	"github.com/gin-gonic/gin"
+)
+
+func LocationRouter(middle middleware.Middleware, handler *handler.LocationHandler) []routers.Route {
+	return []routers.Route{
+		{
+			Method: http.MethodPost,
+			Path:   "/location",
+			Handle: handler.CreateLocationHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin),
+			},
+		},
+		{
+			Method: http.MethodGet,
+			Path:   "/location",
+			Handle: handler.ListLocationsHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin, repo.RoleTypeAdmin),
+			},
+		},
+		{
+			Method: http.MethodPut,
+			Path:   "/location/:id",
+			Handle: handler.UpdateLocationHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin),
+			},
+		},
+		{
+			Method: http.MethodDelete,
+			Path:   "/location/:id",
+			Handle: handler.DeleteLocationHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin),
+			},
+		},
+	}
+}
@@ -0,0 +1,23 @@
+package routing
+
+import (
+	"net/http"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/api/handler"
+	"github.com/adiubaidah/syafiiyah-main/internal/api/middleware"
+	"github.com/adiubaidah/syafiiyah-main/platform/routers"
+	"github.com/gin-gonic/gin"
+)
+
+func QRCredentialRouter(middle middleware.Middleware, handler *handler.QRCredentialHandler) []routers.Route {
+	return []routers.Route{
+		{
+			Method: http.MethodGet,
+			Path:   "/qr-credential",
+			Handle: handler.IssueQRCredentialHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+			},
+		},
+	}
+}
@@ -0,0 +1,70 @@
+package routing
+
+import (
+	"net/http"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/api/handler"
+	"github.com/adiubaidah/syafiiyah-main/internal/api/middleware"
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+	"github.com/adiubaidah/syafiiyah-main/platform/routers"
+	"github.com/gin-gonic/gin"
+)
+
+func SantriLeaveRequestRouter(middle middleware.Middleware, handler handler.SantriLeaveRequestHandler) []routers.Route {
+	return []routers.Route{
+		{
+			Method: http.MethodPost,
+			Path:   "/santri-leave-request",
+			Handle: handler.SubmitSantriLeaveRequestHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeParent),
+			},
+		},
+		{
+			Method: http.MethodGet,
+			Path:   "/santri-leave-request",
+			Handle: handler.ListSantriLeaveRequestsHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin, repo.RoleTypeAdmin, repo.RoleTypeParent),
+			},
+		},
+		{
+			Method: http.MethodGet,
+			Path:   "/santri-leave-request/:id",
+			Handle: handler.GetSantriLeaveRequestHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin, repo.RoleTypeAdmin, repo.RoleTypeParent),
+			},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/santri-leave-request/:id/review",
+			Handle: handler.ReviewSantriLeaveRequestHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin, repo.RoleTypeAdmin),
+			},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/santri-leave-request/:id/cancel",
+			Handle: handler.CancelSantriLeaveRequestHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin, repo.RoleTypeAdmin, repo.RoleTypeParent),
+			},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/santri-leave-request/:id/complete",
+			Handle: handler.CompleteSantriLeaveRequestHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin, repo.RoleTypeAdmin),
+			},
+		},
+	}
+}
@@ -0,0 +1,61 @@
+package routing
+
+import (
+	"net/http"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/api/handler"
+	"github.com/adiubaidah/syafiiyah-main/internal/api/middleware"
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+	"github.com/adiubaidah/syafiiyah-main/platform/routers"
+	"github.com/gin-gonic/gin"
+)
+
+func SantriPermissionRouter(middle middleware.Middleware, handler handler.SantriPermissionHandler) []routers.Route {
+	return []routers.Route{
+		{
+			Method: http.MethodPost,
+			Path:   "/santri-permission",
+			Handle: handler.CreateSantriPermissionHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin, repo.RoleTypeAdmin),
+			},
+		},
+		{
+			Method: http.MethodGet,
+			Path:   "/santri-permission",
+			Handle: handler.ListSantriPermissionsHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin, repo.RoleTypeAdmin),
+			},
+		},
+		{
+			Method: http.MethodGet,
+			Path:   "/santri-permission/:id",
+			Handle: handler.GetSantriPermissionHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin, repo.RoleTypeAdmin),
+			},
+		},
+		{
+			Method: http.MethodPut,
+			Path:   "/santri-permission/:id",
+			Handle: handler.UpdateSantriPermissionHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin, repo.RoleTypeAdmin),
+			},
+		},
+		{
+			Method: http.MethodDelete,
+			Path:   "/santri-permission/:id",
+			Handle: handler.DeleteSantriPermissionHandler,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin, repo.RoleTypeAdmin),
+			},
+		},
+	}
+}
@@ -4,10 +4,13 @@ import (
 	"net/http"
 
 	"github.com/adiubaidah/syafiiyah-main/internal/api/handler"
+	"github.com/adiubaidah/syafiiyah-main/internal/api/middleware"
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
 	"github.com/adiubaidah/syafiiyah-main/platform/routers"
+	"github.com/gin-gonic/gin"
 )
 
-func SmartCardRouter(handler *handler.SmartCardHandler) []routers.Route {
+func SmartCardRouter(middle middleware.Middleware, handler *handler.SmartCardHandler) []routers.Route {
 	return []routers.Route{
 		{
 			Method: http.MethodGet,
@@ -24,6 +27,87 @@ func SmartCardRouter(handler *handler.SmartCardHandler) []routers.Route {
 			Path:   "/smart-card/:id",
 			Handle: handler.Delete,
 		},
+		{
+			Method: http.MethodPost,
+			Path:   "/smart-card/:id/replace",
+			Handle: handler.Replace,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin, repo.RoleTypeAdmin),
+			},
+		},
+		{
+			Method: http.MethodGet,
+			Path:   "/smart-card/:id/assignment",
+			Handle: handler.ListAssignments,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin, repo.RoleTypeAdmin),
+			},
+		},
+		{
+			Method: http.MethodGet,
+			Path:   "/smart-card/expiring",
+			Handle: handler.ListExpiring,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin, repo.RoleTypeAdmin),
+			},
+		},
+		{
+			Method: http.MethodGet,
+			Path:   "/smart-card/pending",
+			Handle: handler.ListPending,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin, repo.RoleTypeAdmin),
+			},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/smart-card/pending/:id/claim",
+			Handle: handler.ClaimPending,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin, repo.RoleTypeAdmin),
+			},
+		},
+		{
+			Method: http.MethodDelete,
+			Path:   "/smart-card/pending/:id",
+			Handle: handler.DeletePending,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin, repo.RoleTypeAdmin),
+			},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/smart-card/enrollment",
+			Handle: handler.OpenEnrollment,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin, repo.RoleTypeAdmin),
+			},
+		},
+		{
+			Method: http.MethodGet,
+			Path:   "/smart-card/enrollment/:id",
+			Handle: handler.GetEnrollment,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin, repo.RoleTypeAdmin),
+			},
+		},
+		{
+			Method: http.MethodDelete,
+			Path:   "/smart-card/enrollment/:id",
+			Handle: handler.CancelEnrollment,
+			MiddleWares: []gin.HandlerFunc{
+				middle.Auth(),
+				middle.RequireRoles(repo.RoleTypeSuperadmin, repo.RoleTypeAdmin),
+			},
+		},
 	}
 
 }
@@ -14,6 +14,8 @@ var (
 	ErrNotFound            = pgx.ErrNoRows
 	ErrCodeDatabaseError   = "DATABASE_ERROR"
 	ErrCodeValidation      = "VALIDATION_ERROR"
+	ErrCodeForbidden       = "FORBIDDEN"
+	ErrCodeUnauthorized    = "UNAUTHORIZED"
 )
 
 func NewParseTimeError(field string, err error) *AppError {
@@ -35,3 +37,11 @@ func NewValidationError(message string) *AppError {
 func NewNotFoundError(message string) *AppError {
 	return Wrap(nil, http.StatusNotFound, ErrNotFound.Error(), message)
 }
+
+func NewForbiddenError(message string) *AppError {
+	return Wrap(nil, http.StatusForbidden, ErrCodeForbidden, message)
+}
+
+func NewUnauthorizedError(message string) *AppError {
+	return Wrap(nil, http.StatusUnauthorized, ErrCodeUnauthorized, message)
+}
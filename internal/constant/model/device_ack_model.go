@@ -0,0 +1,65 @@
+package model
+
+// DeviceAckVersion is the newest acknowledgment format known by the server.
+// A device asks for it with ack_version in the message envelope, devices without it receive
+// ResponseMessage and ResponseData as before, so older firmware keeps working.
+const DeviceAckVersion = 1
+
+// DeviceAckDisplayMax is the length of the display line and owner name, two lines of a 16x2 LCD
+const DeviceAckDisplayMax = 32
+
+// TapResult tells the device what happened to the tap, so it does not have to interpret codes or messages
+type TapResult string
+
+const (
+	TapResultAccepted     TapResult = "accepted"
+	TapResultRepeated     TapResult = "repeated"
+	TapResultDuplicate    TapResult = "duplicate"
+	TapResultCardUnknown  TapResult = "card_unknown"
+	TapResultCardInactive TapResult = "card_inactive"
+	TapResultCardExpired  TapResult = "card_expired"
+	TapResultQRInvalid    TapResult = "qr_invalid"
+	TapResultNoOwner      TapResult = "no_owner"
+	TapResultNoSchedule   TapResult = "no_schedule"
+	TapResultHoliday      TapResult = "holiday"
+	TapResultDenied       TapResult = "denied"
+	TapResultPassback     TapResult = "passback"
+	TapResultInvalid      TapResult = "invalid"
+	TapResultUnauthorized TapResult = "unauthorized"
+	TapResultBusy         TapResult = "busy"
+	TapResultError        TapResult = "error"
+)
+
+// DeviceSignal is the buzzer and LED pattern played by the device
+type DeviceSignal string
+
+const (
+	// DeviceSignalOk one short beep, green LED
+	DeviceSignalOk DeviceSignal = "ok"
+	// DeviceSignalWarn two short beeps, yellow LED
+	DeviceSignalWarn DeviceSignal = "warn"
+	// DeviceSignalError one long beep, red LED
+	DeviceSignalError DeviceSignal = "error"
+)
+
+// DeviceIcon is drawn next to the display line
+type DeviceIcon string
+
+const (
+	DeviceIconPresent    DeviceIcon = "present"
+	DeviceIconLate       DeviceIcon = "late"
+	DeviceIconPermission DeviceIcon = "permission"
+	DeviceIconCard       DeviceIcon = "card"
+)
+
+// DeviceAck is the compact acknowledgment of a tap for devices asking for ack_version 1
+type DeviceAck struct {
+	Version int       `json:"v"`
+	Result  TapResult `json:"result"`
+	Code    int       `json:"code"`
+	// Display is a short line in Indonesian, at most DeviceAckDisplayMax characters
+	Display string       `json:"display"`
+	Name    string       `json:"name,omitempty"`
+	Icon    DeviceIcon   `json:"icon,omitempty"`
+	Signal  DeviceSignal `json:"signal"`
+}
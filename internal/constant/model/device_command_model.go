@@ -0,0 +1,57 @@
+package model
+
+import (
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+)
+
+type CreateDeviceCommandRequest struct {
+	Type repo.DeviceCommandType `json:"type" binding:"required,oneof=switch_mode reboot beep display_message"`
+	// Mode is required for switch_mode command
+	Mode repo.DeviceModeType `json:"mode" binding:"omitempty,oneof=record presence permission batch"`
+	// DurationSeconds is how long the switched mode lasts, default 5 minutes
+	DurationSeconds int32 `json:"duration_seconds" binding:"omitempty,gte=10,lte=86400"`
+	// Message is required for display_message command
+	Message string `json:"message" binding:"omitempty,max=100"`
+}
+
+type ListDeviceCommandRequest struct {
+	Status repo.DeviceCommandStatus `form:"status" binding:"omitempty,oneof=pending sent acknowledged failed"`
+	Limit  int32                    `form:"limit" binding:"omitempty,gte=1"`
+	Page   int32                    `form:"page" binding:"omitempty,gte=1"`
+}
+
+type DeviceCommandResponse struct {
+	ID             int32                    `json:"id"`
+	DeviceID       int32                    `json:"device_id"`
+	Type           repo.DeviceCommandType   `json:"type"`
+	Mode           repo.DeviceModeType      `json:"mode,omitempty"`
+	Message        string                   `json:"message,omitempty"`
+	ExpiresAt      string                   `json:"expires_at,omitempty"`
+	Status         repo.DeviceCommandStatus `json:"status"`
+	Error          string                   `json:"error,omitempty"`
+	CreatedAt      string                   `json:"created_at"`
+	SentAt         string                   `json:"sent_at,omitempty"`
+	AcknowledgedAt string                   `json:"acknowledged_at,omitempty"`
+}
+
+type ListDeviceCommandResponse struct {
+	Items      []DeviceCommandResponse `json:"items"`
+	Pagination Pagination              `json:"pagination"`
+}
+
+// DeviceCommandMessage is the payload published to <device>/command
+type DeviceCommandMessage struct {
+	ID      int32                  `json:"id"`
+	Type    repo.DeviceCommandType `json:"type"`
+	Mode    repo.DeviceModeType    `json:"mode,omitempty"`
+	Message string                 `json:"message,omitempty"`
+	// ExpiresAt is unix timestamp (seconds) when the device should return to its configured modes
+	ExpiresAt int64 `json:"expires_at,omitempty"`
+}
+
+// DeviceCommandAckRequest is published by the device to <device>/command/ack after executing a command
+type DeviceCommandAckRequest struct {
+	CommandID int32  `json:"command_id" validate:"required"`
+	Status    string `json:"status" validate:"required,oneof=ok error"`
+	Message   string `json:"message" validate:"omitempty,max=255"`
+}
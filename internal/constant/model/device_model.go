@@ -1,6 +1,10 @@
 package model
 
-import repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+import (
+	"encoding/json"
+
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+)
 
 type CreateDeviceRequest struct {
 	Name  string                `json:"name" binding:"required,min=3,max=50"`
@@ -12,12 +16,80 @@ type UpdateDeviceRequest = CreateDeviceRequest
 type DeviceResponse struct {
 	ID   int32  `json:"id"`
 	Name string `json:"name"`
+	// Secret only returned when the device is created or its secret rotated
+	Secret string `json:"secret,omitempty"`
 }
 
+type DeviceStatus string
+
+const (
+	DeviceStatusOnline  DeviceStatus = "online"
+	DeviceStatusOffline DeviceStatus = "offline"
+)
+
 type DeviceWithModesResponse struct {
-	ID    int32        `json:"id"`
-	Name  string       `json:"name"`
-	Modes []DeviceMode `json:"modes"`
+	ID              int32         `json:"id"`
+	Name            string        `json:"name"`
+	Status          DeviceStatus  `json:"status"`
+	LastSeenAt      string        `json:"last_seen_at"`
+	FirmwareVersion string        `json:"firmware_version"`
+	IPAddress       string        `json:"ip_address"`
+	TapRule         DeviceTapRule `json:"tap_rule"`
+	Location        *IdAndName    `json:"location"`
+	Modes           []DeviceMode  `json:"modes"`
+}
+
+type DeviceTapRule struct {
+	// DebounceSeconds is nil when the server default is used, 0 disables debounce
+	DebounceSeconds *int32                 `json:"debounce_seconds"`
+	GateDirection   repo.GateDirectionType `json:"gate_direction,omitempty"`
+	AntiPassback    bool                   `json:"anti_passback"`
+}
+
+type UpdateDeviceTapRuleRequest struct {
+	DebounceSeconds *int32                 `json:"debounce_seconds" binding:"omitempty,gte=0,lte=3600"`
+	GateDirection   repo.GateDirectionType `json:"gate_direction" binding:"omitempty,oneof=entry exit"`
+	AntiPassback    bool                   `json:"anti_passback"`
+}
+
+type DeviceTapRuleResponse struct {
+	ID      int32         `json:"id"`
+	Name    string        `json:"name"`
+	TapRule DeviceTapRule `json:"tap_rule"`
+}
+
+// DeviceAllowRule matches a card when every filled field matches, occupation and schedule
+// refer to santri or employee tables depending on the owner role
+type DeviceAllowRule struct {
+	OwnerRole    repo.RoleType   `json:"owner_role,omitempty" binding:"omitempty,oneof=santri employee"`
+	Gender       repo.GenderType `json:"gender,omitempty" binding:"omitempty,oneof=male female"`
+	OccupationID int32           `json:"occupation_id,omitempty" binding:"omitempty,gt=0"`
+	ScheduleID   int32           `json:"schedule_id,omitempty" binding:"omitempty,gt=0"`
+}
+
+// DeviceAccess is the location of the device and the cards it accepts, a device without rules accepts every card
+type DeviceAccess struct {
+	Location *IdAndName        `json:"location"`
+	Rules    []DeviceAllowRule `json:"rules"`
+}
+
+type UpdateDeviceAccessRequest struct {
+	LocationID int32             `json:"location_id" binding:"omitempty,gt=0"`
+	Rules      []DeviceAllowRule `json:"rules" binding:"max=50,dive"`
+}
+
+type DeviceAccessResponse struct {
+	ID     int32        `json:"id"`
+	Name   string       `json:"name"`
+	Access DeviceAccess `json:"access"`
+}
+
+// TapSubject is the owner of a tapped card and the schedule its presence is recorded for
+type TapSubject struct {
+	Role         repo.RoleType
+	Gender       repo.GenderType
+	OccupationID int32
+	ScheduleID   int32
 }
 
 type DeviceMode struct {
@@ -25,3 +97,44 @@ type DeviceMode struct {
 	InputTopic          string              `json:"input_topic"`
 	AcknowledgmentTopic string              `json:"acknowledgment_topic"`
 }
+
+type DevicePingRequest struct {
+	FirmwareVersion string `json:"firmware_version" validate:"omitempty,max=50"`
+	IPAddress       string `json:"ip_address" validate:"omitempty,ip"`
+}
+
+type DevicePingResponse struct {
+	ID         int32  `json:"id"`
+	Name       string `json:"name"`
+	ServerTime string `json:"server_time"`
+}
+
+type BatchTap struct {
+	TapID string `json:"tap_id" validate:"required,max=64"`
+	Uid   string `json:"uid" validate:"required"`
+	// TappedAt is unix timestamp (seconds) when the card was tapped on the device
+	TappedAt int64 `json:"tapped_at" validate:"required"`
+}
+
+type BatchTapRequest struct {
+	Taps []BatchTap `json:"taps" validate:"required,min=1,max=100,dive"`
+}
+
+type BatchTapResult struct {
+	TapID     string `json:"tap_id"`
+	Code      int    `json:"code"`
+	Status    string `json:"status"`
+	Message   string `json:"message"`
+	Duplicate bool   `json:"duplicate"`
+}
+
+// SignedDeviceMessage is the envelope of every message published by a device
+type SignedDeviceMessage struct {
+	Payload   json.RawMessage `json:"payload"`
+	Nonce     string          `json:"nonce" validate:"required,max=64"`
+	Timestamp int64           `json:"timestamp" validate:"required"`
+	Signature string          `json:"signature" validate:"required,hexadecimal"`
+	// AckVersion selects the acknowledgment format of taps, see DeviceAckVersion.
+	// It is not signed, it only changes how the device is answered.
+	AckVersion int `json:"ack_version,omitempty"`
+}
@@ -0,0 +1,70 @@
+package model
+
+import (
+	"time"
+
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+)
+
+// CreateEmployeePermissionRequest is a permission recorded by an admin, it is approved right away
+type CreateEmployeePermissionRequest struct {
+	EmployeeID      int32               `json:"employee_id" binding:"required,gte=1"`
+	Type            repo.PermissionType `json:"type" binding:"required,oneof=sick permission"`
+	StartPermission time.Time           `json:"start_permission" binding:"required"`
+	EndPermission   *time.Time          `json:"end_permission"`
+	Excuse          string              `json:"excuse" binding:"required,max=255"`
+}
+
+// SubmitEmployeePermissionRequest is a sick or leave request of the employee of the user,
+// it waits in the queue until an admin reviews it
+type SubmitEmployeePermissionRequest struct {
+	Type            repo.PermissionType `json:"type" binding:"required,oneof=sick permission"`
+	StartPermission time.Time           `json:"start_permission" binding:"required"`
+	EndPermission   *time.Time          `json:"end_permission"`
+	Excuse          string              `json:"excuse" binding:"required,max=255"`
+}
+
+// UpdateEmployeePermissionRequest keeps the current value of the fields that are not given
+type UpdateEmployeePermissionRequest struct {
+	EmployeeID      int32               `json:"employee_id" binding:"omitempty,gte=1"`
+	Type            repo.PermissionType `json:"type" binding:"omitempty,oneof=sick permission"`
+	StartPermission *time.Time          `json:"start_permission"`
+	EndPermission   *time.Time          `json:"end_permission"`
+	Excuse          string              `json:"excuse" binding:"max=255"`
+}
+
+type ReviewEmployeePermissionRequest struct {
+	Status repo.EmployeePermissionStatus `json:"status" binding:"required,oneof=approved rejected"`
+	Note   string                        `json:"note" binding:"max=255"`
+}
+
+type ListEmployeePermissionRequest struct {
+	Q          string                        `form:"q"`
+	Limit      int32                         `form:"limit" binding:"omitempty,gte=1"`
+	Page       int32                         `form:"page" binding:"omitempty,gte=1"`
+	EmployeeID int32                         `form:"employee_id"`
+	Type       repo.PermissionType           `form:"type" binding:"omitempty,oneof=sick permission"`
+	Status     repo.EmployeePermissionStatus `form:"status" binding:"omitempty,oneof=pending approved rejected cancelled"`
+	// From and To are compared with start_permission and end_permission
+	From string `form:"from" binding:"omitempty,datetime=2006-01-02"`
+	To   string `form:"to" binding:"omitempty,datetime=2006-01-02"`
+}
+
+type EmployeePermissionResponse struct {
+	ID              int32                         `json:"id"`
+	Employee        IdAndName                     `json:"employee"`
+	Type            repo.PermissionType           `json:"type"`
+	StartPermission string                        `json:"start_permission"`
+	EndPermission   string                        `json:"end_permission"`
+	Excuse          string                        `json:"excuse"`
+	Status          repo.EmployeePermissionStatus `json:"status"`
+	CreatedAt       string                        `json:"created_at"`
+	ReviewedBy      int32                         `json:"reviewed_by,omitempty"`
+	ReviewedAt      string                        `json:"reviewed_at,omitempty"`
+	ReviewNote      string                        `json:"review_note,omitempty"`
+}
+
+type ListEmployeePermissionResponse struct {
+	Data       []EmployeePermissionResponse `json:"data"`
+	Pagination Pagination                   `json:"pagination"`
+}
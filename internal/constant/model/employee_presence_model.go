@@ -12,6 +12,7 @@ type CreateEmployeePresenceRequest struct {
 	Type                 repo.PresenceType          `json:"type" binding:"presencetype"`
 	EmployeeID           int32                      `json:"employee_id" binding:"required"`
 	Notes                string                     `json:"notes"`
+	CreatedAt            time.Time                  `json:"-"`
 	CreatedBy            repo.PresenceCreatedByType `json:"-"`
 	EmployeePermissionID int32                      `json:"employee_permission_id"`
 }
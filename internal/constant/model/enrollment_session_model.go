@@ -0,0 +1,44 @@
+package model
+
+import (
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+)
+
+// CreateEnrollmentSessionRequest opens an enrollment session on the device,
+// every tap in record mode registers a card for the next owner in Owners
+type CreateEnrollmentSessionRequest struct {
+	DeviceID int32             `json:"device_id" binding:"required,gte=1"`
+	Owners   []EnrollmentOwner `json:"owners" binding:"required,min=1,max=200,dive"`
+	// DurationSeconds is how long the session accepts taps, 15 minutes when it is not given
+	DurationSeconds int32 `json:"duration_seconds" binding:"omitempty,gte=60,lte=86400"`
+}
+
+type EnrollmentOwner struct {
+	OwnerRole repo.RoleType `json:"owner_role" binding:"required,oneof=santri employee"`
+	OwnerID   int32         `json:"owner_id" binding:"required,gte=1"`
+}
+
+type EnrollmentSessionResponse struct {
+	ID        int32                        `json:"id"`
+	Device    IdAndName                    `json:"device"`
+	Status    repo.EnrollmentSessionStatus `json:"status"`
+	ExpiresAt string                       `json:"expires_at"`
+	CreatedAt string                       `json:"created_at"`
+	ClosedAt  string                       `json:"closed_at,omitempty"`
+	Items     []EnrollmentSessionItem      `json:"items"`
+}
+
+type EnrollmentSessionItem struct {
+	Position     int32         `json:"position"`
+	Owner        OwenerDetails `json:"owner"`
+	SmartCardUid string        `json:"smart_card_uid,omitempty"`
+	EnrolledAt   string        `json:"enrolled_at,omitempty"`
+}
+
+// EnrollmentTapResponse is sent to the device after a tap registered a card in the session
+type EnrollmentTapResponse struct {
+	SmartCardComplete
+	SessionID int32 `json:"session_id"`
+	// Completed is true when every owner of the session has a card
+	Completed bool `json:"completed"`
+}
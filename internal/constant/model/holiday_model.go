@@ -1,17 +1,26 @@
 package model
 
 type CreateHolidayRequest struct {
-	Name        string   `json:"name" binding:"required"`
-	Color       string   `json:"color" binding:"omitempty,hexcolor"`
-	Description string   `json:"description"`
-	Dates       []string `json:"dates" binding:"required,dive,datetime=2006-01-02"`
+	Name        string `json:"name" binding:"required,max=100"`
+	Color       string `json:"color" binding:"omitempty,hexcolor"`
+	Description string `json:"description" binding:"max=255"`
+	// Dates and Ranges may be combined, a date given twice is kept once
+	Dates  []string           `json:"dates" binding:"required_without=Ranges,dive,datetime=2006-01-02"`
+	Ranges []HolidayDateRange `json:"ranges" binding:"required_without=Dates,dive"`
+}
+
+// HolidayDateRange is every date from From to To, both included
+type HolidayDateRange struct {
+	From string `json:"from" binding:"required,datetime=2006-01-02"`
+	To   string `json:"to" binding:"required,datetime=2006-01-02"`
 }
 
 type UpdateHolidayRequest = CreateHolidayRequest
 
 type ListHolidayRequest struct {
-	Month int32 `form:"month" binding:"omitempty,min=1,max=12"`
-	Year  int32 `form:"year" binding:"omitempty,min=1"`
+	Q     string `form:"q"`
+	Month int32  `form:"month" binding:"omitempty,min=1,max=12"`
+	Year  int32  `form:"year" binding:"omitempty,min=1"`
 }
 
 type HolidayResponse struct {
@@ -21,3 +30,21 @@ type HolidayResponse struct {
 	Description string   `json:"description"`
 	Dates       []string `json:"dates"`
 }
+
+// HolidayCalendarRequest is the month shown in the calendar, the current one when it is not given
+type HolidayCalendarRequest struct {
+	Month int32 `form:"month" binding:"omitempty,min=1,max=12"`
+	Year  int32 `form:"year" binding:"omitempty,min=1"`
+}
+
+// HolidayCalendarDay is a holiday date of the month with the holidays falling on it
+type HolidayCalendarDay struct {
+	Date     string                `json:"date"`
+	Holidays []HolidayCalendarItem `json:"holidays"`
+}
+
+type HolidayCalendarItem struct {
+	ID    int32  `json:"id"`
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
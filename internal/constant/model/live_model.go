@@ -0,0 +1,30 @@
+package model
+
+type LiveEventType string
+
+const (
+	LiveEventTypeTap      LiveEventType = "tap"
+	LiveEventTypePresence LiveEventType = "presence"
+)
+
+// LiveEvent is streamed on /live for every tap handled by a device.
+// Its type is presence when the tap recorded a presence, Presence then holds the created presence.
+type LiveEvent struct {
+	ID           string            `json:"id"`
+	Type         LiveEventType     `json:"type"`
+	DeviceID     int32             `json:"device_id,omitempty"`
+	ScheduleID   int32             `json:"schedule_id,omitempty"`
+	OccupationID int32             `json:"occupation_id,omitempty"`
+	Owner        *OwenerDetails    `json:"owner,omitempty"`
+	TapEvent     *TapEventResponse `json:"tap_event"`
+	Presence     any               `json:"presence,omitempty"`
+	CreatedAt    string            `json:"created_at"`
+}
+
+type LiveFeedRequest struct {
+	DeviceID     int32 `form:"device_id"`
+	ScheduleID   int32 `form:"schedule_id"`
+	OccupationID int32 `form:"occupation_id"`
+	// LastEventID resumes the stream for clients that can not send the Last-Event-ID header
+	LastEventID string `form:"last_event_id"`
+}
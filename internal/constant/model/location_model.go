@@ -0,0 +1,17 @@
+package model
+
+type CreateLocationRequest struct {
+	Name        string `json:"name" binding:"required,max=100"`
+	Description string `json:"description"`
+}
+
+type UpdateLocationRequest struct {
+	Name        string `json:"name" binding:"omitempty,max=100"`
+	Description string `json:"description"`
+}
+
+type LocationResponse struct {
+	ID          int32  `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
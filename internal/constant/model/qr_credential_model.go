@@ -0,0 +1,20 @@
+package model
+
+import (
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+)
+
+// QRCredentialRequest asks a QR credential for the owner, without owner it is the employee of the user.
+// A parent gets the credential of their santri, admin and superadmin of anyone.
+type QRCredentialRequest struct {
+	OwnerRole repo.RoleType `form:"owner-role" binding:"required_with=OwnerID,omitempty,oneof=santri employee"`
+	OwnerID   int32         `form:"owner-id" binding:"required_with=OwnerRole,omitempty,gte=1"`
+}
+
+// QRCredentialResponse is shown as QR code to a camera device in presence or permission mode.
+// The app asks a new one before ExpiresAt, an expired credential is refused.
+type QRCredentialResponse struct {
+	Token     string        `json:"token"`
+	Owner     OwenerDetails `json:"owner"`
+	ExpiresAt string        `json:"expires_at"`
+}
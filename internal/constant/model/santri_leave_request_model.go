@@ -0,0 +1,68 @@
+package model
+
+import (
+	"time"
+
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+)
+
+// SubmitSantriLeaveRequest is a leave request of a parent for their santri, it is sent as multipart form
+// so an attachment such as a doctor's letter can come along
+type SubmitSantriLeaveRequest struct {
+	SantriID   int32               `form:"santri_id" binding:"required,gte=1"`
+	Type       repo.PermissionType `form:"type" binding:"required,oneof=sick permission"`
+	Reason     string              `form:"reason" binding:"required,max=255"`
+	StartLeave time.Time           `form:"start_leave" binding:"required"`
+	EndLeave   time.Time           `form:"end_leave" binding:"required"`
+	Attachment string              `form:"-"`
+}
+
+type ReviewSantriLeaveRequest struct {
+	Status  repo.LeaveRequestStatus `json:"status" binding:"required,oneof=approved rejected"`
+	Comment string                  `json:"comment" binding:"max=255"`
+}
+
+// SantriLeaveRequestComment comes with cancelling and completing a leave request, it is kept in the history
+type SantriLeaveRequestComment struct {
+	Comment string `json:"comment" binding:"max=255"`
+}
+
+type ListSantriLeaveRequestRequest struct {
+	Q        string                  `form:"q"`
+	Limit    int32                   `form:"limit" binding:"omitempty,gte=1"`
+	Page     int32                   `form:"page" binding:"omitempty,gte=1"`
+	SantriID int32                   `form:"santri_id"`
+	Status   repo.LeaveRequestStatus `form:"status" binding:"omitempty,oneof=pending approved rejected cancelled completed"`
+}
+
+type SantriLeaveRequestResponse struct {
+	ID                 int32                   `json:"id"`
+	Santri             IdAndName               `json:"santri"`
+	Parent             IdAndName               `json:"parent"`
+	Type               repo.PermissionType     `json:"type"`
+	Reason             string                  `json:"reason"`
+	StartLeave         string                  `json:"start_leave"`
+	EndLeave           string                  `json:"end_leave"`
+	Attachment         string                  `json:"attachment,omitempty"`
+	Status             repo.LeaveRequestStatus `json:"status"`
+	ReviewComment      string                  `json:"review_comment,omitempty"`
+	SantriPermissionID int32                   `json:"santri_permission_id,omitempty"`
+	CreatedAt          string                  `json:"created_at"`
+	UpdatedAt          string                  `json:"updated_at"`
+	// History is only filled when a single leave request is requested
+	History []SantriLeaveRequestHistoryResponse `json:"history,omitempty"`
+}
+
+type SantriLeaveRequestHistoryResponse struct {
+	FromStatus    repo.LeaveRequestStatus `json:"from_status,omitempty"`
+	ToStatus      repo.LeaveRequestStatus `json:"to_status"`
+	ActorID       int32                   `json:"actor_id,omitempty"`
+	ActorUsername string                  `json:"actor_username,omitempty"`
+	Comment       string                  `json:"comment,omitempty"`
+	CreatedAt     string                  `json:"created_at"`
+}
+
+type ListSantriLeaveRequestResponse struct {
+	Data       []SantriLeaveRequestResponse `json:"data"`
+	Pagination Pagination                   `json:"pagination"`
+}
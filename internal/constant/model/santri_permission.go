@@ -1 +1,70 @@
 package model
+
+import (
+	"time"
+
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+)
+
+type SantriPermissionTapAction string
+
+const (
+	// SantriPermissionTapCheckIn santri kembali setelah keluar, izin terbuka ditutup
+	SantriPermissionTapCheckIn SantriPermissionTapAction = "check_in"
+	// SantriPermissionTapCheckOut santri keluar dengan izin yang sudah disetujui
+	SantriPermissionTapCheckOut SantriPermissionTapAction = "check_out"
+)
+
+type CreateSantriPermissionRequest struct {
+	SantriID        int32               `json:"santri_id" binding:"required,gte=1"`
+	Type            repo.PermissionType `json:"type" binding:"required,oneof=sick permission"`
+	StartPermission time.Time           `json:"start_permission" binding:"required"`
+	// EndPermission is left empty for a permission closed when the santri taps back in
+	EndPermission *time.Time `json:"end_permission"`
+	Excuse        string     `json:"excuse" binding:"required,max=255"`
+}
+
+// UpdateSantriPermissionRequest keeps the current value of the fields that are not given
+type UpdateSantriPermissionRequest struct {
+	SantriID        int32               `json:"santri_id" binding:"omitempty,gte=1"`
+	Type            repo.PermissionType `json:"type" binding:"omitempty,oneof=sick permission"`
+	StartPermission *time.Time          `json:"start_permission"`
+	EndPermission   *time.Time          `json:"end_permission"`
+	Excuse          string              `json:"excuse" binding:"max=255"`
+}
+
+type ListSantriPermissionRequest struct {
+	Q        string              `form:"q"`
+	Limit    int32               `form:"limit" binding:"omitempty,gte=1"`
+	Page     int32               `form:"page" binding:"omitempty,gte=1"`
+	SantriID int32               `form:"santri_id"`
+	Type     repo.PermissionType `form:"type" binding:"omitempty,oneof=sick permission"`
+	// From and To are compared with start_permission and end_permission
+	From string `form:"from" binding:"omitempty,datetime=2006-01-02"`
+	To   string `form:"to" binding:"omitempty,datetime=2006-01-02"`
+	// Active only lists the permissions running now
+	Active bool `form:"active"`
+}
+
+type SantriPermissionResponse struct {
+	ID              int32               `json:"id"`
+	SantriID        int32               `json:"santri_id"`
+	Santri          IdAndName           `json:"santri"`
+	Type            repo.PermissionType `json:"type"`
+	StartPermission string              `json:"start_permission"`
+	EndPermission   string              `json:"end_permission"`
+	Excuse          string              `json:"excuse"`
+	// LeftAt is when the santri tapped out under the permission, empty while still inside
+	LeftAt string `json:"left_at,omitempty"`
+}
+
+type ListSantriPermissionResponse struct {
+	Data       []SantriPermissionResponse `json:"data"`
+	Pagination Pagination                 `json:"pagination"`
+}
+
+type SantriPermissionTapResponse struct {
+	Action     SantriPermissionTapAction `json:"action"`
+	Santri     IdAndName                 `json:"santri"`
+	Permission SantriPermissionResponse  `json:"permission"`
+}
@@ -13,6 +13,7 @@ type CreateSantriPresenceRequest struct {
 	Type               repo.PresenceType          `json:"type" binding:"presencetype"`
 	SantriID           int32                      `json:"santri_id" binding:"required"`
 	Notes              string                     `json:"notes"`
+	CreatedAt          time.Time                  `json:"-"`
 	CreatedBy          repo.PresenceCreatedByType `json:"-"`
 	SantriPermissionID int32                      `json:"santri_permission_id"`
 }
@@ -1,32 +1,110 @@
 package model
 
 import (
+	"encoding/json"
+	"time"
+
 	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
 )
 
 type SmartCardRequest struct {
-	Uid string `json:"uid" validate:"required"`
+	Uid string `json:"uid" validate:"required_without=QrToken"`
+	// QrToken is sent instead of Uid by a camera device reading the QR credential of the owner,
+	// it is accepted in presence and permission mode
+	QrToken string `json:"qr_token,omitempty" validate:"required_without=Uid"`
 }
 
 type ListSmartCardRequest struct {
-	CardOwner repo.CardOwner `form:"card-owner" binding:"omitempty,oneof=santri employee none all"`
-	IsActive  int            `form:"is-active" binding:"omitempty,oneof=-1 0 1"`
-	Q         string         `form:"q"`
-	Page      int32          `form:"page" binding:"omitempty,gte=1"`
-	Limit     int32          `form:"limit" binding:"omitempty,gte=1"`
+	CardOwner repo.CardOwner      `form:"card-owner" binding:"omitempty,oneof=santri employee none all"`
+	IsActive  int                 `form:"is-active" binding:"omitempty,oneof=-1 0 1"`
+	State     repo.SmartCardState `form:"state" binding:"omitempty,oneof=active suspended lost retired"`
+	Q         string              `form:"q"`
+	Page      int32               `form:"page" binding:"omitempty,gte=1"`
+	Limit     int32               `form:"limit" binding:"omitempty,gte=1"`
 }
 
 type UpdateSmartCardRequest struct {
-	IsActive  bool          `json:"is_active"`
-	OwnerRole repo.RoleType `json:"owner_role" binding:"omitempty,oneof=santri employee admin superadmin"` //parent can't have card
-	OwnerID   int32         `json:"owner_id"`
+	IsActive bool `json:"is_active"`
+	// State takes precedence over IsActive, lost and retired release the owner
+	State     repo.SmartCardState `json:"state" binding:"omitempty,oneof=active suspended lost retired"`
+	OwnerRole repo.RoleType       `json:"owner_role" binding:"omitempty,oneof=santri employee admin superadmin"` //parent can't have card
+	OwnerID   int32               `json:"owner_id"`
+	// ValidFrom and ValidUntil keep their current value when they are not given, null clears them
+	ValidFrom  OptionalTime `json:"valid_from"`
+	ValidUntil OptionalTime `json:"valid_until"`
+}
+
+// OptionalTime tells a JSON field that is not given from one that is null, Set is true when the field is in the body
+type OptionalTime struct {
+	Set  bool
+	Time *time.Time
+}
+
+func (o *OptionalTime) UnmarshalJSON(data []byte) error {
+	o.Set = true
+	if string(data) == "null" {
+		o.Time = nil
+		return nil
+	}
+
+	var value time.Time
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	o.Time = &value
+	return nil
 }
 
 type SmartCard struct {
-	ID        int32  `json:"id"`
-	Uid       string `json:"uid"`
-	CreatedAt string `json:"create_at"`
-	IsActive  bool   `json:"is_active"`
+	ID           int32               `json:"id"`
+	Uid          string              `json:"uid"`
+	CreatedAt    string              `json:"create_at"`
+	IsActive     bool                `json:"is_active"`
+	State        repo.SmartCardState `json:"state"`
+	ReplacedByID int32               `json:"replaced_by_id,omitempty"`
+	ValidFrom    string              `json:"valid_from,omitempty"`
+	ValidUntil   string              `json:"valid_until,omitempty"`
+	// ValidFromTime and ValidUntilTime are the validity window checked on every tap, zero is unbounded
+	ValidFromTime  time.Time `json:"-"`
+	ValidUntilTime time.Time `json:"-"`
+	// ExpiredAtTime is when the expiry job suspended the card, zero when it is suspended for another reason
+	ExpiredAtTime time.Time `json:"-"`
+}
+
+// SuspendedByExpiryAfter reports whether the card was suspended by the expiry job after the time.
+// Such a card was still active then, so a tap buffered at that time is accepted.
+func (s *SmartCard) SuspendedByExpiryAfter(at time.Time) bool {
+	return s.State == repo.SmartCardStateSuspended && !s.ExpiredAtTime.IsZero() && at.Before(s.ExpiredAtTime)
+}
+
+// ValidAt reports whether the time is inside the validity window of the card, its state is not checked
+func (s *SmartCard) ValidAt(at time.Time) bool {
+	if !s.ValidFromTime.IsZero() && at.Before(s.ValidFromTime) {
+		return false
+	}
+	return s.ValidUntilTime.IsZero() || at.Before(s.ValidUntilTime)
+}
+
+type ListExpiringSmartCardRequest struct {
+	// Days is how far ahead to look, 30 when it is not given
+	Days  int32 `form:"days" binding:"omitempty,gte=1,lte=365"`
+	Page  int32 `form:"page" binding:"omitempty,gte=1"`
+	Limit int32 `form:"limit" binding:"omitempty,gte=1"`
+}
+
+// ReplaceSmartCardRequest gives the owner of a card a new one, e.g. when the card is lost
+type ReplaceSmartCardRequest struct {
+	// Uid of the new card, it is registered when it does not exist yet
+	Uid string `json:"uid" binding:"required,max=20"`
+	// Reason is the state the old card is left in
+	Reason repo.SmartCardState `json:"reason" binding:"required,oneof=lost retired"`
+}
+
+type SmartCardAssignment struct {
+	ID         int32         `json:"id"`
+	Owner      OwenerDetails `json:"owner"`
+	ValidFrom  string        `json:"valid_from"`
+	ValidUntil string        `json:"valid_until"`
 }
 
 type ListSmartCardResponse struct {
@@ -40,7 +118,35 @@ type SmartCardComplete struct {
 }
 
 type OwenerDetails struct {
-	ID   int32         `json:"id"`
-	Role repo.RoleType `json:"role"`
-	Name string        `json:"name"`
+	ID           int32         `json:"id"`
+	Role         repo.RoleType `json:"role"`
+	Name         string        `json:"name"`
+	OccupationID int32         `json:"occupation_id,omitempty"`
+}
+
+type ListPendingSmartCardRequest struct {
+	Page  int32 `form:"page" binding:"omitempty,gte=1"`
+	Limit int32 `form:"limit" binding:"omitempty,gte=1"`
+}
+
+type ClaimPendingSmartCardRequest struct {
+	OwnerRole repo.RoleType `json:"owner_role" binding:"required,oneof=santri employee"`
+	OwnerID   int32         `json:"owner_id" binding:"required,gte=1"`
+	// IsActive is true when it is not given
+	IsActive *bool `json:"is_active"`
+}
+
+// PendingSmartCard is an unregistered card tapped on a device, waiting to be claimed
+type PendingSmartCard struct {
+	ID            int32      `json:"id"`
+	Uid           string     `json:"uid"`
+	Device        *IdAndName `json:"device"`
+	TapCount      int32      `json:"tap_count"`
+	FirstTappedAt string     `json:"first_tapped_at"`
+	LastTappedAt  string     `json:"last_tapped_at"`
+}
+
+type ListPendingSmartCardResponse struct {
+	Items      []PendingSmartCard `json:"items"`
+	Pagination Pagination         `json:"pagination"`
 }
@@ -0,0 +1,53 @@
+package model
+
+import (
+	"time"
+
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+)
+
+type CreateTapEventRequest struct {
+	DeviceName string
+	Mode       repo.DeviceModeType
+	Uid        string
+	// Owner is empty when the card has no owner or is unknown
+	Owner   OwenerDetails
+	Code    int
+	Message string
+	Latency time.Duration
+	// TappedAt is nil for live tap, the time the event is saved is used
+	TappedAt      *time.Time
+	GateDirection repo.GateDirectionType
+}
+
+type ListTapEventRequest struct {
+	DeviceID   int32               `form:"device_id"`
+	Mode       repo.DeviceModeType `form:"mode" binding:"omitempty,oneof=record presence permission batch"`
+	Uid        string              `form:"uid"`
+	SantriID   int32               `form:"santri_id"`
+	EmployeeID int32               `form:"employee_id"`
+	Outcome    string              `form:"outcome" binding:"omitempty,oneof=success rejected"`
+	From       string              `form:"from" binding:"omitempty,datetime=2006-01-02"`
+	To         string              `form:"to" binding:"omitempty,datetime=2006-01-02"`
+	Limit      int32               `form:"limit" binding:"omitempty,gte=1"`
+	Page       int32               `form:"page" binding:"omitempty,gte=1"`
+}
+
+type TapEventResponse struct {
+	ID        int32               `json:"id"`
+	Device    *IdAndName          `json:"device"`
+	Mode      repo.DeviceModeType `json:"mode"`
+	Uid       string              `json:"uid"`
+	Santri    *IdAndName          `json:"santri"`
+	Employee  *IdAndName          `json:"employee"`
+	Code      int32               `json:"code"`
+	Message   string              `json:"message"`
+	LatencyMs int32               `json:"latency_ms"`
+	TappedAt  string              `json:"tapped_at"`
+	CreatedAt string              `json:"created_at"`
+}
+
+type ListTapEventResponse struct {
+	Items      []TapEventResponse `json:"items"`
+	Pagination Pagination         `json:"pagination"`
+}
@@ -1,19 +1,29 @@
 -- name: CreateDevice :one
 INSERT INTO
-    "device" ("name")
+    "device" ("name", "secret")
 VALUES
-    (@name) RETURNING *;
+    (@name, @secret) RETURNING *;
 
 -- name: ListDevices :many
 SELECT
     "device"."id" AS "id",
     "device"."name" AS "name",
+    "device"."last_seen_at" AS "last_seen_at",
+    "device"."firmware_version" AS "firmware_version",
+    "device"."ip_address" AS "ip_address",
+    "device"."debounce_seconds" AS "debounce_seconds",
+    "device"."gate_direction" AS "gate_direction",
+    "device"."anti_passback" AS "anti_passback",
+    "device"."location_id" AS "location_id",
+    "location"."name" AS "location_name",
     "device_mode"."id" AS "device_mode.id",
     "device_mode"."mode" AS "device_mode.mode",
     "device_mode"."input_topic" AS "device_mode.input_topic",
     "device_mode"."acknowledgment_topic" AS "device_mode.acknowledgement_topic"
 FROM
     "device"
+LEFT JOIN
+    "location" ON "device"."location_id" = "location"."id"
 LEFT JOIN
     "device_mode" ON "device"."id" = "device_mode"."device_id";
 
@@ -25,6 +35,70 @@ SET
 WHERE
     "id" = @id RETURNING *;
 
+-- name: UpdateDeviceHeartbeat :one
+UPDATE
+    "device"
+SET
+    "last_seen_at" = @last_seen_at,
+    "firmware_version" = COALESCE(sqlc.narg(firmware_version), firmware_version),
+    "ip_address" = COALESCE(sqlc.narg(ip_address), ip_address)
+WHERE
+    "id" = (
+        SELECT
+            "device_id"
+        FROM
+            "device_mode"
+        WHERE
+            "input_topic" = @input_topic
+        LIMIT
+            1
+    ) RETURNING *;
+
+-- name: UpdateDeviceSecret :one
+UPDATE
+    "device"
+SET
+    "secret" = @secret
+WHERE
+    "id" = @id RETURNING *;
+
+-- name: UpdateDeviceTapRule :one
+UPDATE
+    "device"
+SET
+    "debounce_seconds" = sqlc.narg(debounce_seconds),
+    "gate_direction" = sqlc.narg(gate_direction) :: gate_direction_type,
+    "anti_passback" = @anti_passback
+WHERE
+    "id" = @id RETURNING *;
+
+-- name: UpdateDeviceLocation :one
+UPDATE
+    "device"
+SET
+    "location_id" = sqlc.narg(location_id)
+WHERE
+    "id" = @id RETURNING *;
+
+-- name: GetDevice :one
+SELECT
+    *
+FROM
+    "device"
+WHERE
+    "id" = @id;
+
+-- name: GetDeviceByTopicName :one
+SELECT
+    "device".*
+FROM
+    "device"
+    INNER JOIN "device_mode" ON "device"."id" = "device_mode"."device_id"
+WHERE
+    split_part("device_mode"."input_topic", '/', 1) = @topic_name
+LIMIT
+    1;
+
 -- name: DeleteDevice :one
 DELETE FROM
     "device"
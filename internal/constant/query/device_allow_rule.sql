@@ -0,0 +1,33 @@
+-- name: CreateDeviceAllowRules :copyfrom
+INSERT INTO
+    "device_allow_rule" (
+        "device_id",
+        "owner_role",
+        "gender",
+        "occupation_id",
+        "schedule_id"
+    )
+VALUES
+    (
+        @device_id,
+        @owner_role,
+        @gender,
+        @occupation_id,
+        @schedule_id
+    );
+
+-- name: ListDeviceAllowRules :many
+SELECT
+    *
+FROM
+    "device_allow_rule"
+WHERE
+    "device_id" = @device_id
+ORDER BY
+    "id" ASC;
+
+-- name: DeleteDeviceAllowRules :exec
+DELETE FROM
+    "device_allow_rule"
+WHERE
+    "device_id" = @device_id;
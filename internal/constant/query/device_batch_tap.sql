@@ -0,0 +1,38 @@
+-- name: CreateDeviceBatchTap :one
+INSERT INTO
+    "device_batch_tap" (
+        "device_id",
+        "tap_id",
+        "uid",
+        "tapped_at",
+        "code",
+        "message"
+    )
+VALUES
+    (
+        (
+            SELECT
+                "device_id"
+            FROM
+                "device_mode"
+            WHERE
+                "input_topic" = @input_topic
+            LIMIT
+                1
+        ),
+        @tap_id,
+        @uid,
+        @tapped_at,
+        @code,
+        @message
+    ) ON CONFLICT ("device_id", "tap_id") DO NOTHING RETURNING *;
+
+-- name: GetDeviceBatchTap :one
+SELECT
+    "device_batch_tap".*
+FROM
+    "device_batch_tap"
+    INNER JOIN "device_mode" ON "device_mode"."device_id" = "device_batch_tap"."device_id"
+WHERE
+    "device_mode"."input_topic" = @input_topic
+    AND "device_batch_tap"."tap_id" = @tap_id;
@@ -0,0 +1,74 @@
+-- name: CreateDeviceCommand :one
+INSERT INTO
+    "device_command" (
+        "device_id",
+        "type",
+        "mode",
+        "message",
+        "expires_at",
+        "created_by"
+    )
+VALUES
+    (
+        @device_id,
+        @type :: device_command_type,
+        sqlc.narg(mode) :: device_mode_type,
+        sqlc.narg(message),
+        sqlc.narg(expires_at),
+        sqlc.narg(created_by)
+    ) RETURNING *;
+
+-- name: ListDeviceCommands :many
+SELECT
+    *
+FROM
+    "device_command"
+WHERE
+    "device_id" = @device_id
+    AND (
+        sqlc.narg(status) :: device_command_status IS NULL
+        OR "status" = sqlc.narg(status) :: device_command_status
+    )
+ORDER BY
+    "id" DESC
+LIMIT
+    @limit_number OFFSET @offset_number;
+
+-- name: CountDeviceCommands :one
+SELECT
+    COUNT(*)
+FROM
+    "device_command"
+WHERE
+    "device_id" = @device_id
+    AND (
+        sqlc.narg(status) :: device_command_status IS NULL
+        OR "status" = sqlc.narg(status) :: device_command_status
+    );
+
+-- name: ListActiveDeviceModeSwitches :many
+SELECT
+    "device_command".*,
+    "device"."name" AS "device_name"
+FROM
+    "device_command"
+    INNER JOIN "device" ON "device"."id" = "device_command"."device_id"
+WHERE
+    "device_command"."type" = 'switch_mode'
+    AND "device_command"."status" IN ('sent', 'acknowledged')
+    AND "device_command"."expires_at" > now();
+
+-- name: UpdateDeviceCommandStatus :one
+UPDATE
+    "device_command"
+SET
+    "status" = @status :: device_command_status,
+    "error" = COALESCE(sqlc.narg(error), error),
+    "sent_at" = COALESCE(sqlc.narg(sent_at), sent_at),
+    "acknowledged_at" = COALESCE(sqlc.narg(acknowledged_at), acknowledged_at)
+WHERE
+    "id" = @id
+    AND (
+        sqlc.narg(device_id) :: integer IS NULL
+        OR "device_id" = sqlc.narg(device_id) :: integer
+    ) RETURNING *;
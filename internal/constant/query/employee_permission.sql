@@ -5,15 +5,19 @@ INSERT INTO
         start_permission,
         end_permission,
         "type",
-        excuse
+        excuse,
+        "status",
+        created_by
     )
 VALUES
     (
         @employee_id,
         @start_permission,
         sqlc.narg(end_permission),
-        @type :: santri_permission_type,
-        @excuse
+        @type :: permission_type,
+        @excuse,
+        @status :: employee_permission_status,
+        sqlc.narg(created_by)
     ) RETURNING *;
 
 -- name: ListEmployeePermissions :many
@@ -31,8 +35,12 @@ WHERE
         OR "employee_id" = sqlc.narg(employee_id) :: integer
     )
     AND (
-        sqlc.narg(type) :: santri_permission_type IS NULL
-        OR "type" = sqlc.narg(type) :: santri_permission_type
+        sqlc.narg(type) :: permission_type IS NULL
+        OR "type" = sqlc.narg(type) :: permission_type
+    )
+    AND (
+        sqlc.narg(status) :: employee_permission_status IS NULL
+        OR "status" = sqlc.narg(status) :: employee_permission_status
     )
     AND (
         sqlc.narg(from_date) :: timestamptz IS NULL
@@ -42,6 +50,52 @@ WHERE
         sqlc.narg(end_date) :: timestamptz IS NULL
         OR "end_permission" <= sqlc.narg(end_date) :: timestamptz
     )
+ORDER BY
+    "start_permission" DESC
+LIMIT
+    @limit_number OFFSET @offset_number;
+
+-- name: CountEmployeePermissions :one
+SELECT
+    COUNT(*)
+FROM
+    "employee_permission"
+    INNER JOIN "employee" ON "employee_permission"."employee_id" = "employee"."id"
+WHERE
+    (sqlc.narg(q) :: text IS NULL
+    OR "employee"."name" ILIKE '%' || sqlc.narg(q) || '%')
+    AND (
+        sqlc.narg(employee_id) :: integer IS NULL
+        OR "employee_id" = sqlc.narg(employee_id) :: integer
+    )
+    AND (
+        sqlc.narg(type) :: permission_type IS NULL
+        OR "type" = sqlc.narg(type) :: permission_type
+    )
+    AND (
+        sqlc.narg(status) :: employee_permission_status IS NULL
+        OR "status" = sqlc.narg(status) :: employee_permission_status
+    )
+    AND (
+        sqlc.narg(from_date) :: timestamptz IS NULL
+        OR "start_permission" >= sqlc.narg(from_date) :: timestamptz
+    )
+    AND (
+        sqlc.narg(end_date) :: timestamptz IS NULL
+        OR "end_permission" <= sqlc.narg(end_date) :: timestamptz
+    );
+
+-- name: ListPendingEmployeePermissions :many
+SELECT
+    "employee_permission".*,
+    "employee"."name" AS "employee_name"
+FROM
+    "employee_permission"
+    INNER JOIN "employee" ON "employee_permission"."employee_id" = "employee"."id"
+WHERE
+    "status" = 'pending'
+ORDER BY
+    "employee_permission"."created_at"
 LIMIT
     @limit_number OFFSET @offset_number;
 
@@ -62,12 +116,35 @@ SET
     "employee_id" = COALESCE(sqlc.narg(employee_id), employee_id),
     "start_permission" = COALESCE(sqlc.narg(start_permission), start_permission),
     "end_permission" = sqlc.narg(end_permission),
+    "type" = COALESCE(sqlc.narg(type) :: permission_type, "type"),
     "excuse" = COALESCE(sqlc.narg(excuse), excuse)
 WHERE
     "id" = @id RETURNING *;
 
+-- name: ReviewEmployeePermission :one
+UPDATE
+    "employee_permission"
+SET
+    "status" = @status :: employee_permission_status,
+    "reviewed_by" = sqlc.narg(reviewed_by),
+    "reviewed_at" = now(),
+    "review_note" = sqlc.narg(review_note)
+WHERE
+    "id" = @id
+    AND "status" = 'pending' RETURNING *;
+
+-- name: CancelEmployeePermission :one
+UPDATE
+    "employee_permission"
+SET
+    "status" = 'cancelled'
+WHERE
+    "id" = @id
+    AND "employee_id" = @employee_id
+    AND "status" = 'pending' RETURNING *;
+
 -- name: DeleteEmployeePermission :one
 DELETE FROM
     "employee_permission"
 WHERE
-    "id" = @id RETURNING *;
\ No newline at end of file
+    "id" = @id RETURNING *;
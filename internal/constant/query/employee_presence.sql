@@ -6,6 +6,7 @@ INSERT INTO
         "type",
         "employee_id",
         "notes",
+        "created_at",
         "created_by",
         "employee_permission_id"
     )
@@ -16,6 +17,7 @@ VALUES
         @type :: presence_type,
         @employee_id,
         @notes,
+        COALESCE(sqlc.narg(created_at) :: timestamptz, now()),
         @created_by :: presence_created_by_type,
         @employee_permission_id
     ) RETURNING *;
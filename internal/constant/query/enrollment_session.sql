@@ -0,0 +1,113 @@
+-- name: CreateEnrollmentSession :one
+INSERT INTO
+    "enrollment_session" ("device_id", "expires_at", "created_by")
+VALUES
+    (
+        @device_id,
+        @expires_at,
+        sqlc.narg(created_by)
+    ) RETURNING *;
+
+-- name: CreateEnrollmentSessionItems :copyfrom
+INSERT INTO
+    "enrollment_session_item" (
+        "session_id",
+        "position",
+        "santri_id",
+        "employee_id"
+    )
+VALUES
+    (
+        @session_id,
+        @position,
+        @santri_id,
+        @employee_id
+    );
+
+-- name: ExpireEnrollmentSessions :exec
+UPDATE
+    "enrollment_session"
+SET
+    "status" = 'expired',
+    "closed_at" = "expires_at"
+WHERE
+    "device_id" = @device_id
+    AND "status" = 'open'
+    AND "expires_at" <= now();
+
+-- name: GetEnrollmentSession :one
+SELECT
+    "enrollment_session".*,
+    "device"."name" AS "device_name"
+FROM
+    "enrollment_session"
+    INNER JOIN "device" ON "enrollment_session"."device_id" = "device"."id"
+WHERE
+    "enrollment_session"."id" = @id;
+
+-- name: GetOpenEnrollmentSessionByDeviceName :one
+SELECT
+    "enrollment_session".*
+FROM
+    "enrollment_session"
+WHERE
+    "enrollment_session"."status" = 'open'
+    AND "enrollment_session"."expires_at" > now()
+    AND "enrollment_session"."device_id" = (
+        SELECT
+            "device_id"
+        FROM
+            "device_mode"
+        WHERE
+            split_part("input_topic", '/', 1) = @device_name
+        LIMIT
+            1
+    );
+
+-- name: GetNextEnrollmentSessionItem :one
+SELECT
+    *
+FROM
+    "enrollment_session_item"
+WHERE
+    "session_id" = @session_id
+    AND "smart_card_id" IS NULL
+ORDER BY
+    "position"
+LIMIT
+    1 FOR UPDATE;
+
+-- name: ListEnrollmentSessionItems :many
+SELECT
+    "enrollment_session_item".*,
+    "santri"."name" AS "santri_name",
+    "employee"."name" AS "employee_name",
+    "smart_card"."uid" AS "smart_card_uid"
+FROM
+    "enrollment_session_item"
+    LEFT JOIN "santri" ON "enrollment_session_item"."santri_id" = "santri"."id"
+    LEFT JOIN "employee" ON "enrollment_session_item"."employee_id" = "employee"."id"
+    LEFT JOIN "smart_card" ON "enrollment_session_item"."smart_card_id" = "smart_card"."id"
+WHERE
+    "enrollment_session_item"."session_id" = @session_id
+ORDER BY
+    "enrollment_session_item"."position";
+
+-- name: UpdateEnrollmentSessionItemCard :one
+UPDATE
+    "enrollment_session_item"
+SET
+    "smart_card_id" = @smart_card_id,
+    "enrolled_at" = now()
+WHERE
+    "id" = @id RETURNING *;
+
+-- name: CloseEnrollmentSession :one
+UPDATE
+    "enrollment_session"
+SET
+    "status" = @status :: enrollment_session_status,
+    "closed_at" = now()
+WHERE
+    "id" = @id
+    AND "status" = 'open' RETURNING *;
@@ -0,0 +1,75 @@
+-- name: CreateHoliday :one
+INSERT INTO
+    "holiday" ("name", "color", "description")
+VALUES
+    (@name, @color, @description) RETURNING *;
+
+-- name: ListHolidays :many
+SELECT
+    "holiday".*,
+    "holiday_date"."id" AS "holiday_date_id",
+    "holiday_date"."date" AS "holiday_date"
+FROM
+    "holiday"
+    LEFT JOIN "holiday_date" ON "holiday"."id" = "holiday_date"."holiday_id"
+WHERE
+    (
+        sqlc.narg(q)::text IS NULL
+        OR "holiday"."name" ILIKE sqlc.narg(q)
+    )
+    AND
+    (
+        sqlc.narg(month)::integer IS NULL
+        OR EXTRACT(
+            MONTH
+            FROM
+                "holiday_date"."date"
+        ) = CAST(sqlc.narg(month) AS INTEGER)
+    )
+    AND (
+        sqlc.narg(year)::integer IS NULL
+        OR EXTRACT(
+            YEAR
+            FROM
+                "holiday_date"."date"
+        ) = COALESCE(sqlc.narg(year), EXTRACT(YEAR FROM CURRENT_DATE))
+    )
+ORDER BY
+    "holiday_date"."date" ASC;
+
+-- name: GetHoliday :one
+SELECT
+    *
+FROM
+    "holiday"
+WHERE
+    "id" = @id;
+
+-- name: GetHolidayByDate :one
+SELECT
+    "holiday".*
+FROM
+    "holiday"
+    INNER JOIN "holiday_date" ON "holiday"."id" = "holiday_date"."holiday_id"
+WHERE
+    "holiday_date"."date" = @date
+ORDER BY
+    "holiday"."id"
+LIMIT
+    1;
+
+-- name: UpdateHoliday :one
+UPDATE
+    "holiday"
+SET
+    "name" = COALESCE(sqlc.narg(name), "name"),
+    "color" = @color,
+    "description" = @description
+WHERE
+    "id" = @id RETURNING *;
+
+-- name: DeleteHoliday :one
+DELETE FROM
+    "holiday"
+WHERE
+    "id" = @id RETURNING *;
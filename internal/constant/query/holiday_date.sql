@@ -0,0 +1,32 @@
+-- name: CreateHolidayDates :copyfrom
+INSERT INTO
+    "holiday_date" ("date", "holiday_id")
+VALUES
+    (@date, @holiday_id);
+
+-- name: DeleteHolidayDateByHolidayId :exec
+DELETE FROM
+    "holiday_date"
+WHERE
+    "holiday_id" = @holiday_id;
+
+-- name: ListHolidayDatesByHolidayId :many
+SELECT
+    "date"
+FROM
+    "holiday_date"
+WHERE
+    "holiday_id" = @holiday_id
+ORDER BY
+    "date";
+
+-- name: IsHolidayDate :one
+SELECT
+    EXISTS (
+        SELECT
+            1
+        FROM
+            "holiday_date"
+        WHERE
+            "date" = @date
+    ) :: boolean AS "is_holiday";
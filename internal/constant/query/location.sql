@@ -0,0 +1,36 @@
+-- name: CreateLocation :one
+INSERT INTO
+    "location" ("name", "description")
+VALUES
+    (@name, sqlc.narg(description)) RETURNING *;
+
+-- name: ListLocations :many
+SELECT
+    *
+FROM
+    "location"
+ORDER BY
+    "name" ASC;
+
+-- name: GetLocation :one
+SELECT
+    *
+FROM
+    "location"
+WHERE
+    "id" = @id;
+
+-- name: UpdateLocation :one
+UPDATE
+    "location"
+SET
+    "name" = COALESCE(sqlc.narg(name), name),
+    "description" = COALESCE(sqlc.narg(description), description)
+WHERE
+    "id" = @id RETURNING *;
+
+-- name: DeleteLocation :one
+DELETE FROM
+    "location"
+WHERE
+    "id" = @id RETURNING *;
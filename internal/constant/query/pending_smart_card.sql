@@ -0,0 +1,64 @@
+-- name: UpsertPendingSmartCard :one
+INSERT INTO
+    "pending_smart_card" ("uid", "device_id")
+VALUES
+    (
+        @uid,
+        (
+            SELECT
+                "device_id"
+            FROM
+                "device_mode"
+            WHERE
+                split_part("input_topic", '/', 1) = @device_name
+            LIMIT
+                1
+        )
+    ) ON CONFLICT ("uid") DO
+UPDATE
+SET
+    "device_id" = EXCLUDED."device_id",
+    "tap_count" = "pending_smart_card"."tap_count" + 1,
+    "last_tapped_at" = now() RETURNING *;
+
+-- name: ListPendingSmartCards :many
+SELECT
+    "pending_smart_card".*,
+    "device"."name" AS "device_name"
+FROM
+    "pending_smart_card"
+    LEFT JOIN "device" ON "pending_smart_card"."device_id" = "device"."id"
+WHERE
+    NOT EXISTS (
+        SELECT
+            1
+        FROM
+            "smart_card"
+        WHERE
+            "smart_card"."uid" = "pending_smart_card"."uid"
+    )
+ORDER BY
+    "pending_smart_card"."last_tapped_at" DESC
+LIMIT
+    @limit_number OFFSET @offset_number;
+
+-- name: CountPendingSmartCards :one
+SELECT
+    COUNT(*) AS "count"
+FROM
+    "pending_smart_card"
+WHERE
+    NOT EXISTS (
+        SELECT
+            1
+        FROM
+            "smart_card"
+        WHERE
+            "smart_card"."uid" = "pending_smart_card"."uid"
+    );
+
+-- name: DeletePendingSmartCard :one
+DELETE FROM
+    "pending_smart_card"
+WHERE
+    "id" = @id RETURNING *;
@@ -0,0 +1,127 @@
+-- name: CreateSantriLeaveRequest :one
+INSERT INTO
+    "santri_leave_request" (
+        santri_id,
+        parent_id,
+        "type",
+        reason,
+        start_leave,
+        end_leave,
+        attachment
+    )
+VALUES
+    (
+        @santri_id,
+        @parent_id,
+        @type :: permission_type,
+        @reason,
+        @start_leave,
+        @end_leave,
+        sqlc.narg(attachment)
+    ) RETURNING *;
+
+-- name: ListSantriLeaveRequests :many
+SELECT
+    "santri_leave_request".*,
+    "santri"."name" AS "santri_name",
+    "parent"."name" AS "parent_name"
+FROM
+    "santri_leave_request"
+    INNER JOIN "santri" ON "santri_leave_request"."santri_id" = "santri"."id"
+    INNER JOIN "parent" ON "santri_leave_request"."parent_id" = "parent"."id"
+WHERE
+    (sqlc.narg(q) :: text IS NULL
+    OR "santri"."name" ILIKE '%' || sqlc.narg(q) || '%')
+    AND (
+        sqlc.narg(santri_id) :: integer IS NULL
+        OR "santri_leave_request"."santri_id" = sqlc.narg(santri_id) :: integer
+    )
+    AND (
+        sqlc.narg(parent_id) :: integer IS NULL
+        OR "santri_leave_request"."parent_id" = sqlc.narg(parent_id) :: integer
+    )
+    AND (
+        sqlc.narg(status) :: leave_request_status IS NULL
+        OR "santri_leave_request"."status" = sqlc.narg(status) :: leave_request_status
+    )
+ORDER BY
+    "santri_leave_request"."created_at" DESC
+LIMIT
+    @limit_number OFFSET @offset_number;
+
+-- name: CountSantriLeaveRequests :one
+SELECT
+    COUNT(*)
+FROM
+    "santri_leave_request"
+    INNER JOIN "santri" ON "santri_leave_request"."santri_id" = "santri"."id"
+WHERE
+    (sqlc.narg(q) :: text IS NULL
+    OR "santri"."name" ILIKE '%' || sqlc.narg(q) || '%')
+    AND (
+        sqlc.narg(santri_id) :: integer IS NULL
+        OR "santri_leave_request"."santri_id" = sqlc.narg(santri_id) :: integer
+    )
+    AND (
+        sqlc.narg(parent_id) :: integer IS NULL
+        OR "santri_leave_request"."parent_id" = sqlc.narg(parent_id) :: integer
+    )
+    AND (
+        sqlc.narg(status) :: leave_request_status IS NULL
+        OR "santri_leave_request"."status" = sqlc.narg(status) :: leave_request_status
+    );
+
+-- name: GetSantriLeaveRequest :one
+SELECT
+    "santri_leave_request".*,
+    "santri"."name" AS "santri_name",
+    "parent"."name" AS "parent_name"
+FROM
+    "santri_leave_request"
+    INNER JOIN "santri" ON "santri_leave_request"."santri_id" = "santri"."id"
+    INNER JOIN "parent" ON "santri_leave_request"."parent_id" = "parent"."id"
+WHERE
+    "santri_leave_request"."id" = @id;
+
+-- name: UpdateSantriLeaveRequestStatus :one
+UPDATE
+    "santri_leave_request"
+SET
+    "status" = @status :: leave_request_status,
+    "review_comment" = COALESCE(sqlc.narg(review_comment), review_comment),
+    "santri_permission_id" = COALESCE(sqlc.narg(santri_permission_id), santri_permission_id),
+    "updated_at" = now()
+WHERE
+    "id" = @id
+    AND "status" = @from_status :: leave_request_status RETURNING *;
+
+-- name: CreateSantriLeaveRequestHistory :one
+INSERT INTO
+    "santri_leave_request_history" (
+        leave_request_id,
+        from_status,
+        to_status,
+        actor_id,
+        "comment"
+    )
+VALUES
+    (
+        @leave_request_id,
+        sqlc.narg(from_status) :: leave_request_status,
+        @to_status :: leave_request_status,
+        sqlc.narg(actor_id),
+        sqlc.narg(comment)
+    ) RETURNING *;
+
+-- name: ListSantriLeaveRequestHistories :many
+SELECT
+    "santri_leave_request_history".*,
+    "user"."username" AS "actor_username"
+FROM
+    "santri_leave_request_history"
+    LEFT JOIN "user" ON "santri_leave_request_history"."actor_id" = "user"."id"
+WHERE
+    "santri_leave_request_history"."leave_request_id" = @leave_request_id
+ORDER BY
+    "santri_leave_request_history"."created_at",
+    "santri_leave_request_history"."id";
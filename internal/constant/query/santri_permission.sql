@@ -42,9 +42,74 @@ WHERE
         sqlc.narg(end_date) :: timestamptz IS NULL
         OR "end_permission" <= sqlc.narg(end_date) :: timestamptz
     )
+    AND (
+        sqlc.narg(active_at) :: timestamptz IS NULL
+        OR (
+            "start_permission" <= sqlc.narg(active_at) :: timestamptz
+            AND (
+                "end_permission" IS NULL
+                OR "end_permission" >= sqlc.narg(active_at) :: timestamptz
+            )
+        )
+    )
+ORDER BY
+    "start_permission" DESC
 LIMIT
     @limit_number OFFSET @offset_number;
 
+-- name: CountSantriPermissions :one
+SELECT
+    COUNT(*)
+FROM
+    "santri_permission"
+    INNER JOIN "santri" ON "santri_permission"."santri_id" = "santri"."id"
+WHERE
+    (sqlc.narg(q) :: text IS NULL
+    OR "santri"."name" ILIKE '%' || sqlc.narg(q) || '%')
+    AND (
+        sqlc.narg(santri_id) :: integer IS NULL
+        OR "santri_id" = sqlc.narg(santri_id) :: integer
+    )
+    AND (
+        sqlc.narg(type) :: permission_type IS NULL
+        OR "type" = sqlc.narg(type) :: permission_type
+    )
+    AND (
+        sqlc.narg(from_date) :: timestamptz IS NULL
+        OR "start_permission" >= sqlc.narg(from_date) :: timestamptz
+    )
+    AND (
+        sqlc.narg(end_date) :: timestamptz IS NULL
+        OR "end_permission" <= sqlc.narg(end_date) :: timestamptz
+    )
+    AND (
+        sqlc.narg(active_at) :: timestamptz IS NULL
+        OR (
+            "start_permission" <= sqlc.narg(active_at) :: timestamptz
+            AND (
+                "end_permission" IS NULL
+                OR "end_permission" >= sqlc.narg(active_at) :: timestamptz
+            )
+        )
+    );
+
+-- name: GetActiveSantriPermission :one
+SELECT
+    *
+FROM
+    "santri_permission"
+WHERE
+    "santri_id" = @santri_id
+    AND "start_permission" <= @at :: timestamptz
+    AND (
+        "end_permission" IS NULL
+        OR "end_permission" >= @at :: timestamptz
+    )
+ORDER BY
+    "start_permission" DESC
+LIMIT
+    1;
+
 -- name: GetSantriPermission :one
 SELECT
     "santri_permission".*,
@@ -62,6 +127,7 @@ SET
     "santri_id" = COALESCE(sqlc.narg(santri_id), santri_id),
     "start_permission" = COALESCE(sqlc.narg(start_permission), start_permission),
     "end_permission" = sqlc.narg(end_permission),
+    "type" = COALESCE(sqlc.narg(type) :: permission_type, "type"),
     "excuse" = COALESCE(sqlc.narg(excuse), excuse)
 WHERE
     "id" = @id RETURNING *;
@@ -70,4 +136,26 @@ WHERE
 DELETE FROM
     "santri_permission"
 WHERE
-    "id" = @id RETURNING *;
\ No newline at end of file
+    "id" = @id RETURNING *;
+-- name: ListActiveSantriPermissions :many
+SELECT
+    *
+FROM
+    "santri_permission"
+WHERE
+    "start_permission" <= @at :: timestamptz
+    AND (
+        "end_permission" IS NULL
+        OR "end_permission" >= @at :: timestamptz
+    )
+ORDER BY
+    "santri_id",
+    "start_permission" DESC;
+
+-- name: MarkSantriPermissionLeft :one
+UPDATE
+    "santri_permission"
+SET
+    "left_at" = @left_at
+WHERE
+    "id" = @id RETURNING *;
@@ -6,6 +6,7 @@ INSERT INTO
         "type",
         "santri_id",
         "notes",
+        "created_at",
         "created_by",
         "santri_permission_id"
     )
@@ -16,6 +17,7 @@ VALUES
         @type :: presence_type,
         @santri_id,
         @notes,
+        COALESCE(sqlc.narg(created_at) :: timestamptz, now()),
         @created_by :: presence_created_by_type,
         @santri_permission_id
     ) RETURNING *;
@@ -44,6 +46,41 @@ VALUES
         @santri_permission_id
     );
 
+-- name: CreateSantriTapPresence :one
+INSERT INTO
+    "santri_presence" (
+        "schedule_id",
+        "schedule_name",
+        "type",
+        "santri_id",
+        "notes",
+        "created_at",
+        "created_by",
+        "santri_permission_id"
+    )
+VALUES
+    (
+        @schedule_id,
+        @schedule_name,
+        @type :: presence_type,
+        @santri_id,
+        @notes,
+        COALESCE(sqlc.narg(created_at) :: timestamptz, now()),
+        'tap',
+        @santri_permission_id
+    ) ON CONFLICT ON CONSTRAINT unique_santri_schedule_date DO
+UPDATE
+SET
+    "schedule_name" = EXCLUDED."schedule_name",
+    "type" = EXCLUDED."type",
+    "notes" = EXCLUDED."notes",
+    "created_at" = EXCLUDED."created_at",
+    "created_by" = EXCLUDED."created_by",
+    "santri_permission_id" = EXCLUDED."santri_permission_id"
+WHERE
+    "santri_presence"."created_by" = 'system'
+    AND "santri_presence"."type" = 'alpha' RETURNING *;
+
 -- name: ListSantriPresences :many
 SELECT
     "santri_presence".*,
@@ -120,7 +157,8 @@ SELECT
 FROM
     "santri"
 WHERE
-    NOT EXISTS (
+    "santri"."is_active" IS NOT FALSE
+    AND NOT EXISTS (
         SELECT
             1
         FROM
@@ -150,4 +188,40 @@ DELETE FROM
     "santri_presence"
 WHERE
     "id" = @id
-RETURNING *;
\ No newline at end of file
+RETURNING *;
+-- name: ApplySantriPermissionToPresences :many
+UPDATE
+    "santri_presence"
+SET
+    "type" = @type :: presence_type,
+    "santri_permission_id" = @santri_permission_id
+WHERE
+    "santri_id" = @santri_id
+    AND "type" = 'alpha'
+    AND "created_at" >= @start_permission :: timestamptz
+    AND (
+        sqlc.narg(end_permission) :: timestamptz IS NULL
+        OR "created_at" <= sqlc.narg(end_permission) :: timestamptz
+    ) RETURNING *;
+
+-- name: RevertSantriPermissionPresences :many
+UPDATE
+    "santri_presence"
+SET
+    "type" = 'alpha',
+    "santri_permission_id" = NULL
+WHERE
+    "santri_permission_id" = @santri_permission_id
+    AND "type" IN ('permission', 'sick') RETURNING *;
+
+-- name: DetachSantriPermissionPresences :many
+UPDATE
+    "santri_presence"
+SET
+    "type" = CASE
+        WHEN "type" IN ('permission', 'sick') THEN 'alpha'
+        ELSE "type"
+    END,
+    "santri_permission_id" = NULL
+WHERE
+    "santri_permission_id" = @santri_permission_id RETURNING *;
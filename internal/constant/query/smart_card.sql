@@ -1,12 +1,21 @@
 -- name: CreateSmartCard :one
 INSERT INTO
-    smart_card ("uid", "is_active", "santri_id", "employee_id")
+    smart_card (
+        "uid",
+        "state",
+        "santri_id",
+        "employee_id",
+        "valid_from",
+        "valid_until"
+    )
 VALUES
     (
         @uid,
-        @is_active,
+        @state,
         sqlc.narg(santri_id),
-        sqlc.narg(employee_id)
+        sqlc.narg(employee_id),
+        sqlc.narg(valid_from),
+        sqlc.narg(valid_until)
     ) RETURNING *;
 
 -- name: ListSmartCards :many
@@ -29,6 +38,10 @@ WHERE
         sqlc.narg(is_active)::boolean IS NULL
         OR "smart_card"."is_active" = sqlc.narg(is_active)
     )
+    AND (
+        sqlc.narg(state)::smart_card_state IS NULL
+        OR "smart_card"."state" = sqlc.narg(state)
+    )
     AND (
         CASE
             WHEN sqlc.narg(card_owner)::card_owner = 'santri' THEN "smart_card"."santri_id" IS NOT NULL
@@ -62,6 +75,10 @@ WHERE
         sqlc.narg(is_active)::boolean IS NULL
         OR "smart_card"."is_active" = sqlc.narg(is_active)
     )
+    AND (
+        sqlc.narg(state)::smart_card_state IS NULL
+        OR "smart_card"."state" = sqlc.narg(state)
+    )
     AND (
         CASE
             WHEN sqlc.narg(card_owner)::card_owner = 'santri' THEN "smart_card"."santri_id" IS NOT NULL
@@ -77,9 +94,22 @@ UPDATE
     smart_card
 SET
     "uid" = COALESCE(sqlc.narg(uid), uid),
-    "is_active" = COALESCE(sqlc.narg(is_active), is_active),
+    "state" = COALESCE(sqlc.narg(state), state),
     "santri_id" = sqlc.narg(santri_id),
-    "employee_id" = sqlc.narg(employee_id)
+    "employee_id" = sqlc.narg(employee_id),
+    "replaced_by_id" = COALESCE(sqlc.narg(replaced_by_id), replaced_by_id),
+    "valid_from" = CASE
+        WHEN @clear_valid_from::bool THEN NULL
+        ELSE COALESCE(sqlc.narg(valid_from), valid_from)
+    END,
+    "valid_until" = CASE
+        WHEN @clear_valid_until::bool THEN NULL
+        ELSE COALESCE(sqlc.narg(valid_until), valid_until)
+    END,
+    "expired_at" = CASE
+        WHEN COALESCE(sqlc.narg(state), state) = state THEN expired_at
+        ELSE NULL
+    END
 WHERE
     "id" = @id RETURNING *;
 
@@ -87,7 +117,9 @@ WHERE
 SELECT
     "smart_card".*,
     "santri"."name" as "santri_name",
-    "employee"."name" as "employee_name"
+    "santri"."occupation_id" as "santri_occupation_id",
+    "employee"."name" as "employee_name",
+    "employee"."occupation_id" as "employee_occupation_id"
 FROM
     smart_card
     LEFT JOIN "santri" ON "smart_card"."santri_id" = "santri"."id"
@@ -95,8 +127,81 @@ FROM
 WHERE
     "smart_card"."uid" = @uid;
 
+-- name: GetOwnerSmartCard :one
+SELECT
+    *
+FROM
+    smart_card
+WHERE
+    (
+        "santri_id" = sqlc.narg(santri_id)
+        OR "employee_id" = sqlc.narg(employee_id)
+    )
+    AND "state" IN ('active', 'suspended')
+ORDER BY
+    "state" = 'active' DESC,
+    "id" DESC
+LIMIT
+    1;
+
+-- name: GetSmartCardByID :one
+SELECT
+    *
+FROM
+    smart_card
+WHERE
+    "id" = @id FOR UPDATE;
+
 -- name: DeleteSmartCard :one
 DELETE FROM
     smart_card
 WHERE
-    "id" = @id RETURNING *;
\ No newline at end of file
+    "id" = @id RETURNING *;
+
+-- name: ExpireSmartCards :many
+UPDATE
+    smart_card
+SET
+    "state" = 'suspended',
+    "expired_at" = now()
+WHERE
+    "state" = 'active'
+    AND "valid_until" <= now() RETURNING *;
+
+-- name: SuspendSantriSmartCards :many
+UPDATE
+    smart_card
+SET
+    "state" = 'suspended',
+    "expired_at" = NULL
+WHERE
+    "santri_id" = @santri_id
+    AND "state" IN ('active', 'suspended') RETURNING *;
+
+-- name: ListExpiringSmartCards :many
+SELECT
+    "smart_card".*,
+    "santri"."name" AS "santri_name",
+    "employee"."name" AS "employee_name"
+FROM
+    smart_card
+    LEFT JOIN "santri" ON "smart_card"."santri_id" = "santri"."id"
+    LEFT JOIN "employee" ON "smart_card"."employee_id" = "employee"."id"
+WHERE
+    "smart_card"."state" = 'active'
+    AND "smart_card"."valid_until" > now()
+    AND "smart_card"."valid_until" <= now() + make_interval(days => @days :: integer)
+ORDER BY
+    "smart_card"."valid_until" ASC
+LIMIT
+    @limit_number OFFSET @offset_number;
+
+-- name: CountExpiringSmartCards :one
+SELECT
+    COUNT(*) AS "count"
+FROM
+    smart_card
+WHERE
+    "state" = 'active'
+    AND "valid_until" > now()
+    AND "valid_until" <= now() + make_interval(days => @days :: integer);
@@ -0,0 +1,32 @@
+-- name: CreateSmartCardAssignment :one
+INSERT INTO
+    "smart_card_assignment" ("smart_card_id", "santri_id", "employee_id")
+VALUES
+    (
+        @smart_card_id,
+        sqlc.narg(santri_id),
+        sqlc.narg(employee_id)
+    ) RETURNING *;
+
+-- name: EndSmartCardAssignment :exec
+UPDATE
+    "smart_card_assignment"
+SET
+    "valid_until" = now()
+WHERE
+    "smart_card_id" = @smart_card_id
+    AND "valid_until" IS NULL;
+
+-- name: ListSmartCardAssignments :many
+SELECT
+    "smart_card_assignment".*,
+    "santri"."name" AS "santri_name",
+    "employee"."name" AS "employee_name"
+FROM
+    "smart_card_assignment"
+    LEFT JOIN "santri" ON "smart_card_assignment"."santri_id" = "santri"."id"
+    LEFT JOIN "employee" ON "smart_card_assignment"."employee_id" = "employee"."id"
+WHERE
+    "smart_card_assignment"."smart_card_id" = @smart_card_id
+ORDER BY
+    "smart_card_assignment"."valid_from" DESC;
@@ -0,0 +1,139 @@
+-- name: CreateTapEvent :one
+INSERT INTO
+    "tap_event" (
+        "device_id",
+        "mode",
+        "uid",
+        "santri_id",
+        "employee_id",
+        "code",
+        "message",
+        "latency_ms",
+        "tapped_at",
+        "gate_direction"
+    )
+VALUES
+    (
+        (
+            SELECT
+                "device_id"
+            FROM
+                "device_mode"
+            WHERE
+                split_part("input_topic", '/', 1) = @device_name
+            LIMIT
+                1
+        ),
+        @mode :: device_mode_type,
+        @uid,
+        @santri_id,
+        @employee_id,
+        @code,
+        @message,
+        @latency_ms,
+        COALESCE(sqlc.narg(tapped_at) :: timestamptz, now()),
+        sqlc.narg(gate_direction) :: gate_direction_type
+    ) RETURNING *;
+
+-- name: GetLastGateDirection :one
+SELECT
+    "gate_direction" :: gate_direction_type
+FROM
+    "tap_event"
+WHERE
+    "uid" = @uid
+    AND "gate_direction" IS NOT NULL
+    AND "code" < 300
+ORDER BY
+    "tapped_at" DESC
+LIMIT
+    1;
+
+-- name: ListTapEvents :many
+SELECT
+    "tap_event".*,
+    "device"."name" AS "device_name",
+    "santri"."name" AS "santri_name",
+    "employee"."name" AS "employee_name"
+FROM
+    "tap_event"
+    LEFT JOIN "device" ON "device"."id" = "tap_event"."device_id"
+    LEFT JOIN "santri" ON "santri"."id" = "tap_event"."santri_id"
+    LEFT JOIN "employee" ON "employee"."id" = "tap_event"."employee_id"
+WHERE
+    (
+        sqlc.narg(device_id) :: integer IS NULL
+        OR "tap_event"."device_id" = sqlc.narg(device_id) :: integer
+    )
+    AND (
+        sqlc.narg(mode) :: device_mode_type IS NULL
+        OR "tap_event"."mode" = sqlc.narg(mode) :: device_mode_type
+    )
+    AND (
+        sqlc.narg(uid) :: text IS NULL
+        OR "tap_event"."uid" = sqlc.narg(uid) :: text
+    )
+    AND (
+        sqlc.narg(santri_id) :: integer IS NULL
+        OR "tap_event"."santri_id" = sqlc.narg(santri_id) :: integer
+    )
+    AND (
+        sqlc.narg(employee_id) :: integer IS NULL
+        OR "tap_event"."employee_id" = sqlc.narg(employee_id) :: integer
+    )
+    AND (
+        sqlc.narg(success) :: boolean IS NULL
+        OR ("tap_event"."code" < 300) = sqlc.narg(success) :: boolean
+    )
+    AND (
+        sqlc.narg(from_date) :: date IS NULL
+        OR DATE("tap_event"."tapped_at") >= sqlc.narg(from_date) :: date
+    )
+    AND (
+        sqlc.narg(to_date) :: date IS NULL
+        OR DATE("tap_event"."tapped_at") <= sqlc.narg(to_date) :: date
+    )
+ORDER BY
+    "tap_event"."tapped_at" DESC,
+    "tap_event"."id" DESC
+LIMIT
+    @limit_number OFFSET @offset_number;
+
+-- name: CountTapEvents :one
+SELECT
+    COUNT(*)
+FROM
+    "tap_event"
+WHERE
+    (
+        sqlc.narg(device_id) :: integer IS NULL
+        OR "device_id" = sqlc.narg(device_id) :: integer
+    )
+    AND (
+        sqlc.narg(mode) :: device_mode_type IS NULL
+        OR "mode" = sqlc.narg(mode) :: device_mode_type
+    )
+    AND (
+        sqlc.narg(uid) :: text IS NULL
+        OR "uid" = sqlc.narg(uid) :: text
+    )
+    AND (
+        sqlc.narg(santri_id) :: integer IS NULL
+        OR "santri_id" = sqlc.narg(santri_id) :: integer
+    )
+    AND (
+        sqlc.narg(employee_id) :: integer IS NULL
+        OR "employee_id" = sqlc.narg(employee_id) :: integer
+    )
+    AND (
+        sqlc.narg(success) :: boolean IS NULL
+        OR ("code" < 300) = sqlc.narg(success) :: boolean
+    )
+    AND (
+        sqlc.narg(from_date) :: date IS NULL
+        OR DATE("tapped_at") >= sqlc.narg(from_date) :: date
+    )
+    AND (
+        sqlc.narg(to_date) :: date IS NULL
+        OR DATE("tapped_at") <= sqlc.narg(to_date) :: date
+    );
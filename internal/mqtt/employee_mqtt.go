@@ -16,43 +16,80 @@ import (
 type EmployeeMQTTHandler struct {
 	logger          *logrus.Logger
 	usecase         *usecase.EmployeeUseCase
-	presenceUseCase usecase.EmployeePresenceUseCase
+	presenceUseCase *usecase.EmployeePresenceUseCase
 	service         pb.EmployeeScheduleServiceClient
 }
 
-func NewEmployeeMQTTHandler(arg *EmployeeMQTTHandler) *EmployeeMQTTHandler {
-	return arg
+func NewEmployeeMQTTHandler(logger *logrus.Logger, usecase *usecase.EmployeeUseCase, service pb.EmployeeScheduleServiceClient, presenceUseCase *usecase.EmployeePresenceUseCase) *EmployeeMQTTHandler {
+	return &EmployeeMQTTHandler{
+		logger:          logger,
+		usecase:         usecase,
+		presenceUseCase: presenceUseCase,
+		service:         service,
+	}
 }
 
-func (h *EmployeeMQTTHandler) Presence(uid string, santriID int32) (*model.EmployeePresenceResponse, error) {
+func (h *EmployeeMQTTHandler) Presence(uid string, employeeID int32, access *model.DeviceAccess) (*model.EmployeePresenceResponse, error) {
 
 	activeSchedule, err := h.service.ActiveEmployeeSchedule(context.Background(), &pb.ActiveEmployeeScheduleRequest{})
 	if err != nil {
 		return nil, exception.NewNotFoundError("no active schedule found for employee attendance")
 	}
 
-	CURRENT_TIME_PRESENCE := time.Now()
-	_, err = h.usecase.GetByID(context.Background(), santriID)
+	return h.createPresence(activeSchedule, employeeID, time.Now(), access)
+}
+
+// PresenceAt record presence of a tap buffered by the device, evaluated against
+// the schedule that was active when the card was tapped instead of the current one.
+func (h *EmployeeMQTTHandler) PresenceAt(uid string, employeeID int32, tappedAt time.Time, access *model.DeviceAccess) (*model.EmployeePresenceResponse, error) {
+	schedules, err := h.service.ListEmployeeSchedule(context.Background(), &pb.ListEmployeeScheduleRequest{})
+	if err != nil {
+		h.logger.Errorf("Error listing employee schedule: %v\n", err)
+		return nil, err
+	}
+
+	schedule, ok := scheduleAt(schedules.Schedules, tappedAt)
+	if !ok {
+		return nil, exception.NewNotFoundError("no schedule found for employee attendance at tap time")
+	}
+
+	return h.createPresence(schedule, employeeID, tappedAt, access)
+}
+
+func (h *EmployeeMQTTHandler) createPresence(schedule *pb.EmployeeSchedule, employeeID int32, presenceTime time.Time, access *model.DeviceAccess) (*model.EmployeePresenceResponse, error) {
+	employee, err := h.usecase.GetByID(context.Background(), employeeID)
 	if err != nil {
 		h.logger.Errorf("Error getting employee: %v\n", err)
+		return nil, err
+	}
+
+	err = usecase.AllowTap(access, model.TapSubject{
+		Role:         repo.RoleTypeEmployee,
+		Gender:       employee.Gender,
+		OccupationID: employee.OccupationID,
+		ScheduleID:   schedule.Id,
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	startPresence, err := util.ParseHHMMWithCurrentDate(activeSchedule.StartPresence)
+	startPresence, err := util.ParseHHMMWithDate(schedule.StartPresence, presenceTime)
 	if err != nil {
 		h.logger.Errorf("Error parsing time: %v\n", err)
 		return nil, exception.NewParseTimeError("start presence", err)
 	}
-	startTime, _ := util.ParseHHMMWithCurrentDate(activeSchedule.StartTime)
+	startTime, _ := util.ParseHHMMWithDate(schedule.StartTime, presenceTime)
 
 	arg := &model.CreateEmployeePresenceRequest{
-		ScheduleID:   activeSchedule.Id,
-		ScheduleName: activeSchedule.Name,
-		EmployeeID:   santriID,
+		ScheduleID:   schedule.Id,
+		ScheduleName: schedule.Name,
+		EmployeeID:   employeeID,
+		CreatedAt:    presenceTime,
 		CreatedBy:    repo.PresenceCreatedByTypeTap,
 	}
-	if CURRENT_TIME_PRESENCE.After(startPresence) && CURRENT_TIME_PRESENCE.Before(startTime) {
+	if presenceTime.After(startPresence) && presenceTime.Before(startTime) {
 		arg.Type = repo.PresenceTypePresent
-	} else if CURRENT_TIME_PRESENCE.After(startTime) {
+	} else if presenceTime.After(startTime) {
 		arg.Type = repo.PresenceTypeLate
 	}
 	presence, err := h.presenceUseCase.CreatePresence(context.Background(), arg)
@@ -61,6 +98,7 @@ func (h *EmployeeMQTTHandler) Presence(uid string, santriID int32) (*model.Emplo
 		if exception.DatabaseErrorCode(err) == exception.ErrCodeUniqueViolation {
 			return nil, exception.NewUniqueViolationError("employee already presence today", err)
 		}
+		return nil, err
 	}
 
 	return presence, nil
@@ -14,50 +14,84 @@ import (
 )
 
 type SantriMQTTHandler struct {
-	logger          *logrus.Logger
-	usecase         usecase.SantriUseCase
-	presenceUseCase usecase.SantriPresenceUseCase
-	service         pb.SantriScheduleServiceClient
+	logger            *logrus.Logger
+	usecase           usecase.SantriUseCase
+	presenceUseCase   usecase.SantriPresenceUseCase
+	permissionUseCase usecase.SantriPermissionUseCase
+	service           pb.SantriScheduleServiceClient
 }
 
-func NewSantriMQTTHandler(logger *logrus.Logger, usecase usecase.SantriUseCase, service pb.SantriScheduleServiceClient, presenceUseCase usecase.SantriPresenceUseCase) *SantriMQTTHandler {
+func NewSantriMQTTHandler(logger *logrus.Logger, usecase usecase.SantriUseCase, service pb.SantriScheduleServiceClient, presenceUseCase usecase.SantriPresenceUseCase, permissionUseCase usecase.SantriPermissionUseCase) *SantriMQTTHandler {
 	return &SantriMQTTHandler{
-		logger:          logger,
-		usecase:         usecase,
-		presenceUseCase: presenceUseCase,
-		service:         service,
+		logger:            logger,
+		usecase:           usecase,
+		presenceUseCase:   presenceUseCase,
+		permissionUseCase: permissionUseCase,
+		service:           service,
 	}
 }
 
-func (h *SantriMQTTHandler) Presence(uid string, santriID int32) (*model.SantriPresenceResponse, error) {
+func (h *SantriMQTTHandler) Presence(uid string, santriID int32, access *model.DeviceAccess) (*model.SantriPresenceResponse, error) {
 
 	activeSchedule, err := h.service.ActiveSantriSchedule(context.Background(), &pb.ActiveSantriScheduleRequest{})
 	if err != nil {
 		return nil, exception.NewNotFoundError("no active schedule found for santri attendance")
 	}
 
-	CURRENT_TIME_PRESENCE := time.Now()
-	_, err = h.usecase.GetSantri(context.Background(), santriID)
+	return h.createPresence(activeSchedule, santriID, time.Now(), access)
+}
+
+// PresenceAt record presence of a tap buffered by the device, evaluated against
+// the schedule that was active when the card was tapped instead of the current one.
+func (h *SantriMQTTHandler) PresenceAt(uid string, santriID int32, tappedAt time.Time, access *model.DeviceAccess) (*model.SantriPresenceResponse, error) {
+	schedules, err := h.service.ListSantriSchedule(context.Background(), &pb.ListSantriScheduleRequest{})
+	if err != nil {
+		h.logger.Errorf("Error listing santri schedule: %v\n", err)
+		return nil, err
+	}
+
+	schedule, ok := scheduleAt(schedules.Schedules, tappedAt)
+	if !ok {
+		return nil, exception.NewNotFoundError("no schedule found for santri attendance at tap time")
+	}
+
+	return h.createPresence(schedule, santriID, tappedAt, access)
+}
+
+func (h *SantriMQTTHandler) createPresence(schedule *pb.SantriSchedule, santriID int32, presenceTime time.Time, access *model.DeviceAccess) (*model.SantriPresenceResponse, error) {
+	santri, err := h.usecase.GetSantri(context.Background(), santriID)
 	if err != nil {
 		h.logger.Errorf("Error getting santri: %v\n", err)
+		return nil, err
+	}
+
+	err = usecase.AllowTap(access, model.TapSubject{
+		Role:         repo.RoleTypeSantri,
+		Gender:       santri.Gender,
+		OccupationID: santri.OccupationID,
+		ScheduleID:   schedule.Id,
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	santriStartPresence, err := util.ParseHHMMWithCurrentDate(activeSchedule.StartPresence)
+	santriStartPresence, err := util.ParseHHMMWithDate(schedule.StartPresence, presenceTime)
 	if err != nil {
 		h.logger.Errorf("Error parsing time: %v\n", err)
 		return nil, exception.NewParseTimeError("start presence", err)
 	}
-	santriStartTime, _ := util.ParseHHMMWithCurrentDate(activeSchedule.StartTime)
+	santriStartTime, _ := util.ParseHHMMWithDate(schedule.StartTime, presenceTime)
 
 	arg := &model.CreateSantriPresenceRequest{
-		ScheduleID:   activeSchedule.Id,
-		ScheduleName: activeSchedule.Name,
+		ScheduleID:   schedule.Id,
+		ScheduleName: schedule.Name,
 		SantriID:     santriID,
+		CreatedAt:    presenceTime,
 		CreatedBy:    repo.PresenceCreatedByTypeTap,
 	}
-	if CURRENT_TIME_PRESENCE.After(santriStartPresence) && CURRENT_TIME_PRESENCE.Before(santriStartTime) {
+	if presenceTime.After(santriStartPresence) && presenceTime.Before(santriStartTime) {
 		arg.Type = repo.PresenceTypePresent
-	} else if CURRENT_TIME_PRESENCE.After(santriStartTime) {
+	} else if presenceTime.After(santriStartTime) {
 		arg.Type = repo.PresenceTypeLate
 	}
 	presence, err := h.presenceUseCase.CreateSantriPresence(context.Background(), arg)
@@ -66,7 +100,58 @@ func (h *SantriMQTTHandler) Presence(uid string, santriID int32) (*model.SantriP
 		if exception.DatabaseErrorCode(err) == exception.ErrCodeUniqueViolation {
 			return nil, exception.NewUniqueViolationError("santri already presence today", err)
 		}
+		return nil, err
 	}
 
 	return presence, nil
 }
+
+// Permission handle tap on gate device in permission mode.
+// The first tap under a permission is the santri leaving and is kept in left_at.
+// An open permission (without end_permission) is closed by the next tap because santri is back,
+// while permission with end_permission only confirm that santri leave under it.
+func (h *SantriMQTTHandler) Permission(santriID int32) (*model.SantriPermissionTapResponse, error) {
+	CURRENT_TIME_PERMISSION := time.Now()
+
+	santri, err := h.usecase.GetSantri(context.Background(), santriID)
+	if err != nil {
+		h.logger.Errorf("Error getting santri: %v\n", err)
+		return nil, err
+	}
+
+	permission, err := h.permissionUseCase.GetActiveSantriPermission(context.Background(), santriID, CURRENT_TIME_PERMISSION)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &model.SantriPermissionTapResponse{
+		Action: model.SantriPermissionTapCheckOut,
+		Santri: model.IdAndName{
+			Id:   santri.ID,
+			Name: santri.Name,
+		},
+		Permission: *permission,
+	}
+
+	if permission.LeftAt == "" {
+		leftPermission, err := h.permissionUseCase.MarkSantriPermissionLeft(context.Background(), permission.ID, CURRENT_TIME_PERMISSION)
+		if err != nil {
+			h.logger.Errorf("Error marking santri permission left: %v\n", err)
+			return nil, err
+		}
+		result.Permission = *leftPermission
+		return result, nil
+	}
+
+	if permission.EndPermission == "" {
+		closedPermission, err := h.permissionUseCase.CloseSantriPermission(context.Background(), permission.ID, CURRENT_TIME_PERMISSION)
+		if err != nil {
+			h.logger.Errorf("Error closing santri permission: %v\n", err)
+			return nil, err
+		}
+		result.Action = model.SantriPermissionTapCheckIn
+		result.Permission = *closedPermission
+	}
+
+	return result, nil
+}
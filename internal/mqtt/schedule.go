@@ -0,0 +1,32 @@
+package mqtt_handler
+
+import (
+	"time"
+
+	"github.com/adiubaidah/syafiiyah-main/pkg/util"
+)
+
+// presenceWindow is implemented by santri and employee schedule of the schedule service
+type presenceWindow interface {
+	GetStartPresence() string
+	GetFinishTime() string
+}
+
+// scheduleAt returns the schedule whose presence window (start_presence until finish_time) contains the given time
+func scheduleAt[T presenceWindow](schedules []T, at time.Time) (T, bool) {
+	var empty T
+	for _, schedule := range schedules {
+		startPresence, err := util.ParseHHMMWithDate(schedule.GetStartPresence(), at)
+		if err != nil {
+			continue
+		}
+		finishTime, err := util.ParseHHMMWithDate(schedule.GetFinishTime(), at)
+		if err != nil {
+			continue
+		}
+		if !at.Before(startPresence) && at.Before(finishTime) {
+			return schedule, true
+		}
+	}
+	return empty, false
+}
@@ -9,6 +9,42 @@ import (
 	"context"
 )
 
+// iteratorForCreateDeviceAllowRules implements pgx.CopyFromSource.
+type iteratorForCreateDeviceAllowRules struct {
+	rows                 []CreateDeviceAllowRulesParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCreateDeviceAllowRules) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCreateDeviceAllowRules) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].DeviceID,
+		r.rows[0].OwnerRole,
+		r.rows[0].Gender,
+		r.rows[0].OccupationID,
+		r.rows[0].ScheduleID,
+	}, nil
+}
+
+func (r iteratorForCreateDeviceAllowRules) Err() error {
+	return nil
+}
+
+func (q *Queries) CreateDeviceAllowRules(ctx context.Context, arg []CreateDeviceAllowRulesParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"device_allow_rule"}, []string{"device_id", "owner_role", "gender", "occupation_id", "schedule_id"}, &iteratorForCreateDeviceAllowRules{rows: arg})
+}
+
 // iteratorForCreateDeviceModes implements pgx.CopyFromSource.
 type iteratorForCreateDeviceModes struct {
 	rows                 []CreateDeviceModesParams
@@ -83,6 +119,74 @@ func (q *Queries) CreateEmployeePresences(ctx context.Context, arg []CreateEmplo
 	return q.db.CopyFrom(ctx, []string{"employee_presence"}, []string{"schedule_id", "schedule_name", "type", "employee_id", "notes", "created_at", "created_by", "employee_permission_id"}, &iteratorForCreateEmployeePresences{rows: arg})
 }
 
+// iteratorForCreateEnrollmentSessionItems implements pgx.CopyFromSource.
+type iteratorForCreateEnrollmentSessionItems struct {
+	rows                 []CreateEnrollmentSessionItemsParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCreateEnrollmentSessionItems) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCreateEnrollmentSessionItems) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].SessionID,
+		r.rows[0].Position,
+		r.rows[0].SantriID,
+		r.rows[0].EmployeeID,
+	}, nil
+}
+
+func (r iteratorForCreateEnrollmentSessionItems) Err() error {
+	return nil
+}
+
+func (q *Queries) CreateEnrollmentSessionItems(ctx context.Context, arg []CreateEnrollmentSessionItemsParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"enrollment_session_item"}, []string{"session_id", "position", "santri_id", "employee_id"}, &iteratorForCreateEnrollmentSessionItems{rows: arg})
+}
+
+// iteratorForCreateHolidayDates implements pgx.CopyFromSource.
+type iteratorForCreateHolidayDates struct {
+	rows                 []CreateHolidayDatesParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCreateHolidayDates) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCreateHolidayDates) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].Date,
+		r.rows[0].HolidayID,
+	}, nil
+}
+
+func (r iteratorForCreateHolidayDates) Err() error {
+	return nil
+}
+
+func (q *Queries) CreateHolidayDates(ctx context.Context, arg []CreateHolidayDatesParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"holiday_date"}, []string{"date", "holiday_id"}, &iteratorForCreateHolidayDates{rows: arg})
+}
+
 // iteratorForCreateSantriPresences implements pgx.CopyFromSource.
 type iteratorForCreateSantriPresences struct {
 	rows                 []CreateSantriPresencesParams
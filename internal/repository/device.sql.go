@@ -13,15 +13,31 @@ import (
 
 const createDevice = `-- name: CreateDevice :one
 INSERT INTO
-    "device" ("name")
+    "device" ("name", "secret")
 VALUES
-    ($1) RETURNING id, name
+    ($1, $2) RETURNING id, name, last_seen_at, firmware_version, ip_address, secret, debounce_seconds, gate_direction, anti_passback, location_id
 `
 
-func (q *Queries) CreateDevice(ctx context.Context, name string) (Device, error) {
-	row := q.db.QueryRow(ctx, createDevice, name)
+type CreateDeviceParams struct {
+	Name   string `db:"name"`
+	Secret string `db:"secret"`
+}
+
+func (q *Queries) CreateDevice(ctx context.Context, arg CreateDeviceParams) (Device, error) {
+	row := q.db.QueryRow(ctx, createDevice, arg.Name, arg.Secret)
 	var i Device
-	err := row.Scan(&i.ID, &i.Name)
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.LastSeenAt,
+		&i.FirmwareVersion,
+		&i.IpAddress,
+		&i.Secret,
+		&i.DebounceSeconds,
+		&i.GateDirection,
+		&i.AntiPassback,
+		&i.LocationID,
+	)
 	return i, err
 }
 
@@ -29,13 +45,81 @@ const deleteDevice = `-- name: DeleteDevice :one
 DELETE FROM
     "device"
 WHERE
-    "id" = $1 RETURNING id, name
+    "id" = $1 RETURNING id, name, last_seen_at, firmware_version, ip_address, secret, debounce_seconds, gate_direction, anti_passback, location_id
 `
 
 func (q *Queries) DeleteDevice(ctx context.Context, id int32) (Device, error) {
 	row := q.db.QueryRow(ctx, deleteDevice, id)
 	var i Device
-	err := row.Scan(&i.ID, &i.Name)
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.LastSeenAt,
+		&i.FirmwareVersion,
+		&i.IpAddress,
+		&i.Secret,
+		&i.DebounceSeconds,
+		&i.GateDirection,
+		&i.AntiPassback,
+		&i.LocationID,
+	)
+	return i, err
+}
+
+const getDevice = `-- name: GetDevice :one
+SELECT
+    id, name, last_seen_at, firmware_version, ip_address, secret, debounce_seconds, gate_direction, anti_passback, location_id
+FROM
+    "device"
+WHERE
+    "id" = $1
+`
+
+func (q *Queries) GetDevice(ctx context.Context, id int32) (Device, error) {
+	row := q.db.QueryRow(ctx, getDevice, id)
+	var i Device
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.LastSeenAt,
+		&i.FirmwareVersion,
+		&i.IpAddress,
+		&i.Secret,
+		&i.DebounceSeconds,
+		&i.GateDirection,
+		&i.AntiPassback,
+		&i.LocationID,
+	)
+	return i, err
+}
+
+const getDeviceByTopicName = `-- name: GetDeviceByTopicName :one
+SELECT
+    device.id, device.name, device.last_seen_at, device.firmware_version, device.ip_address, device.secret, device.debounce_seconds, device.gate_direction, device.anti_passback, device.location_id
+FROM
+    "device"
+    INNER JOIN "device_mode" ON "device"."id" = "device_mode"."device_id"
+WHERE
+    split_part("device_mode"."input_topic", '/', 1) = $1
+LIMIT
+    1
+`
+
+func (q *Queries) GetDeviceByTopicName(ctx context.Context, topicName string) (Device, error) {
+	row := q.db.QueryRow(ctx, getDeviceByTopicName, topicName)
+	var i Device
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.LastSeenAt,
+		&i.FirmwareVersion,
+		&i.IpAddress,
+		&i.Secret,
+		&i.DebounceSeconds,
+		&i.GateDirection,
+		&i.AntiPassback,
+		&i.LocationID,
+	)
 	return i, err
 }
 
@@ -43,23 +127,41 @@ const listDevices = `-- name: ListDevices :many
 SELECT
     "device"."id" AS "id",
     "device"."name" AS "name",
+    "device"."last_seen_at" AS "last_seen_at",
+    "device"."firmware_version" AS "firmware_version",
+    "device"."ip_address" AS "ip_address",
+    "device"."debounce_seconds" AS "debounce_seconds",
+    "device"."gate_direction" AS "gate_direction",
+    "device"."anti_passback" AS "anti_passback",
+    "device"."location_id" AS "location_id",
+    "location"."name" AS "location_name",
     "device_mode"."id" AS "device_mode.id",
     "device_mode"."mode" AS "device_mode.mode",
     "device_mode"."input_topic" AS "device_mode.input_topic",
     "device_mode"."acknowledgment_topic" AS "device_mode.acknowledgement_topic"
 FROM
     "device"
+LEFT JOIN
+    "location" ON "device"."location_id" = "location"."id"
 LEFT JOIN
     "device_mode" ON "device"."id" = "device_mode"."device_id"
 `
 
 type ListDevicesRow struct {
-	ID                             int32              `db:"id"`
-	Name                           string             `db:"name"`
-	DeviceModeID                   pgtype.Int4        `db:"device_mode.id"`
-	DeviceModeMode                 NullDeviceModeType `db:"device_mode.mode"`
-	DeviceModeInputTopic           pgtype.Text        `db:"device_mode.input_topic"`
-	DeviceModeAcknowledgementTopic pgtype.Text        `db:"device_mode.acknowledgement_topic"`
+	ID                             int32                 `db:"id"`
+	Name                           string                `db:"name"`
+	LastSeenAt                     pgtype.Timestamptz    `db:"last_seen_at"`
+	FirmwareVersion                pgtype.Text           `db:"firmware_version"`
+	IpAddress                      pgtype.Text           `db:"ip_address"`
+	DebounceSeconds                pgtype.Int4           `db:"debounce_seconds"`
+	GateDirection                  NullGateDirectionType `db:"gate_direction"`
+	AntiPassback                   bool                  `db:"anti_passback"`
+	LocationID                     pgtype.Int4           `db:"location_id"`
+	LocationName                   pgtype.Text           `db:"location_name"`
+	DeviceModeID                   pgtype.Int4           `db:"device_mode.id"`
+	DeviceModeMode                 NullDeviceModeType    `db:"device_mode.mode"`
+	DeviceModeInputTopic           pgtype.Text           `db:"device_mode.input_topic"`
+	DeviceModeAcknowledgementTopic pgtype.Text           `db:"device_mode.acknowledgement_topic"`
 }
 
 func (q *Queries) ListDevices(ctx context.Context) ([]ListDevicesRow, error) {
@@ -74,6 +176,14 @@ func (q *Queries) ListDevices(ctx context.Context) ([]ListDevicesRow, error) {
 		if err := rows.Scan(
 			&i.ID,
 			&i.Name,
+			&i.LastSeenAt,
+			&i.FirmwareVersion,
+			&i.IpAddress,
+			&i.DebounceSeconds,
+			&i.GateDirection,
+			&i.AntiPassback,
+			&i.LocationID,
+			&i.LocationName,
 			&i.DeviceModeID,
 			&i.DeviceModeMode,
 			&i.DeviceModeInputTopic,
@@ -95,7 +205,7 @@ UPDATE
 SET
     "name" = COALESCE($1, name)
 WHERE
-    "id" = $2 RETURNING id, name
+    "id" = $2 RETURNING id, name, last_seen_at, firmware_version, ip_address, secret, debounce_seconds, gate_direction, anti_passback, location_id
 `
 
 type UpdateDeviceParams struct {
@@ -106,6 +216,172 @@ type UpdateDeviceParams struct {
 func (q *Queries) UpdateDevice(ctx context.Context, arg UpdateDeviceParams) (Device, error) {
 	row := q.db.QueryRow(ctx, updateDevice, arg.Name, arg.ID)
 	var i Device
-	err := row.Scan(&i.ID, &i.Name)
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.LastSeenAt,
+		&i.FirmwareVersion,
+		&i.IpAddress,
+		&i.Secret,
+		&i.DebounceSeconds,
+		&i.GateDirection,
+		&i.AntiPassback,
+		&i.LocationID,
+	)
+	return i, err
+}
+
+const updateDeviceHeartbeat = `-- name: UpdateDeviceHeartbeat :one
+UPDATE
+    "device"
+SET
+    "last_seen_at" = $1,
+    "firmware_version" = COALESCE($2, firmware_version),
+    "ip_address" = COALESCE($3, ip_address)
+WHERE
+    "id" = (
+        SELECT
+            "device_id"
+        FROM
+            "device_mode"
+        WHERE
+            "input_topic" = $4
+        LIMIT
+            1
+    ) RETURNING id, name, last_seen_at, firmware_version, ip_address, secret, debounce_seconds, gate_direction, anti_passback, location_id
+`
+
+type UpdateDeviceHeartbeatParams struct {
+	LastSeenAt      pgtype.Timestamptz `db:"last_seen_at"`
+	FirmwareVersion pgtype.Text        `db:"firmware_version"`
+	IpAddress       pgtype.Text        `db:"ip_address"`
+	InputTopic      string             `db:"input_topic"`
+}
+
+func (q *Queries) UpdateDeviceHeartbeat(ctx context.Context, arg UpdateDeviceHeartbeatParams) (Device, error) {
+	row := q.db.QueryRow(ctx, updateDeviceHeartbeat,
+		arg.LastSeenAt,
+		arg.FirmwareVersion,
+		arg.IpAddress,
+		arg.InputTopic,
+	)
+	var i Device
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.LastSeenAt,
+		&i.FirmwareVersion,
+		&i.IpAddress,
+		&i.Secret,
+		&i.DebounceSeconds,
+		&i.GateDirection,
+		&i.AntiPassback,
+		&i.LocationID,
+	)
+	return i, err
+}
+
+const updateDeviceLocation = `-- name: UpdateDeviceLocation :one
+UPDATE
+    "device"
+SET
+    "location_id" = $1
+WHERE
+    "id" = $2 RETURNING id, name, last_seen_at, firmware_version, ip_address, secret, debounce_seconds, gate_direction, anti_passback, location_id
+`
+
+type UpdateDeviceLocationParams struct {
+	LocationID pgtype.Int4 `db:"location_id"`
+	ID         int32       `db:"id"`
+}
+
+func (q *Queries) UpdateDeviceLocation(ctx context.Context, arg UpdateDeviceLocationParams) (Device, error) {
+	row := q.db.QueryRow(ctx, updateDeviceLocation, arg.LocationID, arg.ID)
+	var i Device
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.LastSeenAt,
+		&i.FirmwareVersion,
+		&i.IpAddress,
+		&i.Secret,
+		&i.DebounceSeconds,
+		&i.GateDirection,
+		&i.AntiPassback,
+		&i.LocationID,
+	)
+	return i, err
+}
+
+const updateDeviceSecret = `-- name: UpdateDeviceSecret :one
+UPDATE
+    "device"
+SET
+    "secret" = $1
+WHERE
+    "id" = $2 RETURNING id, name, last_seen_at, firmware_version, ip_address, secret, debounce_seconds, gate_direction, anti_passback, location_id
+`
+
+type UpdateDeviceSecretParams struct {
+	Secret string `db:"secret"`
+	ID     int32  `db:"id"`
+}
+
+func (q *Queries) UpdateDeviceSecret(ctx context.Context, arg UpdateDeviceSecretParams) (Device, error) {
+	row := q.db.QueryRow(ctx, updateDeviceSecret, arg.Secret, arg.ID)
+	var i Device
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.LastSeenAt,
+		&i.FirmwareVersion,
+		&i.IpAddress,
+		&i.Secret,
+		&i.DebounceSeconds,
+		&i.GateDirection,
+		&i.AntiPassback,
+		&i.LocationID,
+	)
+	return i, err
+}
+
+const updateDeviceTapRule = `-- name: UpdateDeviceTapRule :one
+UPDATE
+    "device"
+SET
+    "debounce_seconds" = $1,
+    "gate_direction" = $2 :: gate_direction_type,
+    "anti_passback" = $3
+WHERE
+    "id" = $4 RETURNING id, name, last_seen_at, firmware_version, ip_address, secret, debounce_seconds, gate_direction, anti_passback, location_id
+`
+
+type UpdateDeviceTapRuleParams struct {
+	DebounceSeconds pgtype.Int4           `db:"debounce_seconds"`
+	GateDirection   NullGateDirectionType `db:"gate_direction"`
+	AntiPassback    bool                  `db:"anti_passback"`
+	ID              int32                 `db:"id"`
+}
+
+func (q *Queries) UpdateDeviceTapRule(ctx context.Context, arg UpdateDeviceTapRuleParams) (Device, error) {
+	row := q.db.QueryRow(ctx, updateDeviceTapRule,
+		arg.DebounceSeconds,
+		arg.GateDirection,
+		arg.AntiPassback,
+		arg.ID,
+	)
+	var i Device
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.LastSeenAt,
+		&i.FirmwareVersion,
+		&i.IpAddress,
+		&i.Secret,
+		&i.DebounceSeconds,
+		&i.GateDirection,
+		&i.AntiPassback,
+		&i.LocationID,
+	)
 	return i, err
 }
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/adiubaidah/syafiiyah-main/pkg/random"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateDeviceBatchTap(t *testing.T) {
+	clearDeviceTable(t)
+	nameRandom := random.RandomString(10)
+	modeParams := []CreateDeviceModesParams{
+		{
+			Mode:                 DeviceModeTypeBatch,
+			InputTopic:           fmt.Sprintf("%s/input/%s", nameRandom, DeviceModeTypeBatch),
+			AcknowledgementTopic: fmt.Sprintf("%s/acknowledgment/%s", nameRandom, DeviceModeTypeBatch),
+		},
+	}
+	device, err := sqlStore.CreateDeviceWithModes(context.Background(), nameRandom, random.RandomString(64), modeParams)
+	require.NoError(t, err)
+
+	arg := CreateDeviceBatchTapParams{
+		InputTopic: modeParams[0].InputTopic,
+		TapID:      random.RandomString(16),
+		Uid:        random.RandomString(10),
+		TappedAt:   pgtype.Timestamptz{Time: time.Now().Add(-time.Hour), Valid: true},
+		Code:       200,
+		Message:    "Presensi tercatat",
+	}
+	batchTap, err := testStore.CreateDeviceBatchTap(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, device.ID, batchTap.DeviceID)
+	require.Equal(t, arg.TapID, batchTap.TapID)
+
+	t.Run("duplicate tap is ignored", func(t *testing.T) {
+		_, err := testStore.CreateDeviceBatchTap(context.Background(), arg)
+		require.ErrorIs(t, err, pgx.ErrNoRows)
+	})
+
+	t.Run("get by input topic and tap id", func(t *testing.T) {
+		got, err := testStore.GetDeviceBatchTap(context.Background(), GetDeviceBatchTapParams{
+			InputTopic: arg.InputTopic,
+			TapID:      arg.TapID,
+		})
+		require.NoError(t, err)
+		require.Equal(t, batchTap.ID, got.ID)
+		require.Equal(t, arg.Code, got.Code)
+	})
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/adiubaidah/syafiiyah-main/pkg/random"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeviceCommand(t *testing.T) {
+	clearDeviceTable(t)
+	nameRandom := random.RandomString(10)
+	device, err := sqlStore.CreateDeviceWithModes(context.Background(), nameRandom, random.RandomString(64), []CreateDeviceModesParams{})
+	require.NoError(t, err)
+
+	arg := CreateDeviceCommandParams{
+		DeviceID:  device.ID,
+		Type:      DeviceCommandTypeSwitchMode,
+		Mode:      NullDeviceModeType{DeviceModeType: DeviceModeTypeRecord, Valid: true},
+		ExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(5 * time.Minute), Valid: true},
+	}
+	command, err := testStore.CreateDeviceCommand(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, device.ID, command.DeviceID)
+	require.Equal(t, DeviceCommandStatusPending, command.Status)
+
+	t.Run("pending switch is not active", func(t *testing.T) {
+		switches, err := testStore.ListActiveDeviceModeSwitches(context.Background())
+		require.NoError(t, err)
+		require.Empty(t, switches)
+	})
+
+	t.Run("mark as sent", func(t *testing.T) {
+		sent, err := testStore.UpdateDeviceCommandStatus(context.Background(), UpdateDeviceCommandStatusParams{
+			ID:     command.ID,
+			Status: DeviceCommandStatusSent,
+			SentAt: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+		})
+		require.NoError(t, err)
+		require.Equal(t, DeviceCommandStatusSent, sent.Status)
+		require.True(t, sent.SentAt.Valid)
+
+		switches, err := testStore.ListActiveDeviceModeSwitches(context.Background())
+		require.NoError(t, err)
+		require.Len(t, switches, 1)
+		require.Equal(t, nameRandom, switches[0].DeviceName)
+	})
+
+	t.Run("ack from other device is rejected", func(t *testing.T) {
+		_, err := testStore.UpdateDeviceCommandStatus(context.Background(), UpdateDeviceCommandStatusParams{
+			ID:       command.ID,
+			DeviceID: pgtype.Int4{Int32: device.ID + 1, Valid: true},
+			Status:   DeviceCommandStatusAcknowledged,
+		})
+		require.ErrorIs(t, err, pgx.ErrNoRows)
+	})
+
+	t.Run("list and count by status", func(t *testing.T) {
+		status := NullDeviceCommandStatus{DeviceCommandStatus: DeviceCommandStatusSent, Valid: true}
+		commands, err := testStore.ListDeviceCommands(context.Background(), ListDeviceCommandsParams{
+			DeviceID:     device.ID,
+			Status:       status,
+			LimitNumber:  10,
+			OffsetNumber: 0,
+		})
+		require.NoError(t, err)
+		require.Len(t, commands, 1)
+
+		count, err := testStore.CountDeviceCommands(context.Background(), CountDeviceCommandsParams{
+			DeviceID: device.ID,
+			Status:   NullDeviceCommandStatus{DeviceCommandStatus: DeviceCommandStatusFailed, Valid: true},
+		})
+		require.NoError(t, err)
+		require.Zero(t, count)
+	})
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/adiubaidah/syafiiyah-main/pkg/random"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/require"
 )
 
@@ -41,10 +43,77 @@ func TestCreateArduinoWithModes(t *testing.T) {
 	nameRandom := random.RandomString(10)
 	modeParams := createRandomArduinoModesParams(nameRandom)
 
-	arduino, err := sqlStore.CreateDeviceWithModes(context.Background(), nameRandom, modeParams)
+	arduino, err := sqlStore.CreateDeviceWithModes(context.Background(), nameRandom, random.RandomString(64), modeParams)
 	require.NoError(t, err)
 	require.NotEmpty(t, arduino)
 	require.NotZero(t, arduino.ID)
 	require.Equal(t, nameRandom, arduino.Name)
 
 }
+
+func TestUpdateDeviceHeartbeat(t *testing.T) {
+	clearDeviceTable(t)
+	nameRandom := random.RandomString(10)
+	modeParams := createRandomArduinoModesParams(nameRandom)
+
+	device, err := sqlStore.CreateDeviceWithModes(context.Background(), nameRandom, random.RandomString(64), modeParams)
+	require.NoError(t, err)
+	require.False(t, device.LastSeenAt.Valid)
+
+	lastSeenAt := time.Now().Truncate(time.Microsecond)
+	updated, err := testStore.UpdateDeviceHeartbeat(context.Background(), UpdateDeviceHeartbeatParams{
+		LastSeenAt:      pgtype.Timestamptz{Time: lastSeenAt, Valid: true},
+		FirmwareVersion: pgtype.Text{String: "1.0.0", Valid: true},
+		IpAddress:       pgtype.Text{String: "192.168.1.10", Valid: true},
+		InputTopic:      fmt.Sprintf("%s/input/%s", nameRandom, DeviceModeTypePing),
+	})
+	require.NoError(t, err)
+	require.Equal(t, device.ID, updated.ID)
+	require.True(t, lastSeenAt.Equal(updated.LastSeenAt.Time))
+	require.Equal(t, "1.0.0", updated.FirmwareVersion.String)
+	require.Equal(t, "192.168.1.10", updated.IpAddress.String)
+}
+
+func TestUpdateDeviceSecret(t *testing.T) {
+	clearDeviceTable(t)
+	nameRandom := random.RandomString(10)
+	modeParams := createRandomArduinoModesParams(nameRandom)
+
+	device, err := sqlStore.CreateDeviceWithModes(context.Background(), nameRandom, random.RandomString(64), modeParams)
+	require.NoError(t, err)
+
+	newSecret := random.RandomString(64)
+	updated, err := testStore.UpdateDeviceSecret(context.Background(), UpdateDeviceSecretParams{
+		ID:     device.ID,
+		Secret: newSecret,
+	})
+	require.NoError(t, err)
+	require.Equal(t, newSecret, updated.Secret)
+
+	got, err := testStore.GetDeviceByTopicName(context.Background(), nameRandom)
+	require.NoError(t, err)
+	require.Equal(t, device.ID, got.ID)
+	require.Equal(t, newSecret, got.Secret)
+}
+
+func TestUpdateDeviceTapRule(t *testing.T) {
+	clearDeviceTable(t)
+	nameRandom := random.RandomString(10)
+	modeParams := createRandomArduinoModesParams(nameRandom)
+
+	device, err := sqlStore.CreateDeviceWithModes(context.Background(), nameRandom, random.RandomString(64), modeParams)
+	require.NoError(t, err)
+	require.False(t, device.DebounceSeconds.Valid)
+	require.False(t, device.AntiPassback)
+
+	updated, err := testStore.UpdateDeviceTapRule(context.Background(), UpdateDeviceTapRuleParams{
+		ID:              device.ID,
+		DebounceSeconds: pgtype.Int4{Int32: 5, Valid: true},
+		GateDirection:   NullGateDirectionType{GateDirectionType: GateDirectionTypeEntry, Valid: true},
+		AntiPassback:    true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(5), updated.DebounceSeconds.Int32)
+	require.Equal(t, GateDirectionTypeEntry, updated.GateDirection.GateDirectionType)
+	require.True(t, updated.AntiPassback)
+}
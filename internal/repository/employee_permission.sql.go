@@ -11,6 +11,96 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const cancelEmployeePermission = `-- name: CancelEmployeePermission :one
+UPDATE
+    "employee_permission"
+SET
+    "status" = 'cancelled'
+WHERE
+    "id" = $1
+    AND "employee_id" = $2
+    AND "status" = 'pending' RETURNING id, employee_id, type, start_permission, end_permission, excuse, status, created_by, created_at, reviewed_by, reviewed_at, review_note
+`
+
+type CancelEmployeePermissionParams struct {
+	ID         int32 `db:"id"`
+	EmployeeID int32 `db:"employee_id"`
+}
+
+func (q *Queries) CancelEmployeePermission(ctx context.Context, arg CancelEmployeePermissionParams) (EmployeePermission, error) {
+	row := q.db.QueryRow(ctx, cancelEmployeePermission, arg.ID, arg.EmployeeID)
+	var i EmployeePermission
+	err := row.Scan(
+		&i.ID,
+		&i.EmployeeID,
+		&i.Type,
+		&i.StartPermission,
+		&i.EndPermission,
+		&i.Excuse,
+		&i.Status,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.ReviewedBy,
+		&i.ReviewedAt,
+		&i.ReviewNote,
+	)
+	return i, err
+}
+
+const countEmployeePermissions = `-- name: CountEmployeePermissions :one
+SELECT
+    COUNT(*)
+FROM
+    "employee_permission"
+    INNER JOIN "employee" ON "employee_permission"."employee_id" = "employee"."id"
+WHERE
+    ($1 :: text IS NULL
+    OR "employee"."name" ILIKE '%' || $1 || '%')
+    AND (
+        $2 :: integer IS NULL
+        OR "employee_id" = $2 :: integer
+    )
+    AND (
+        $3 :: permission_type IS NULL
+        OR "type" = $3 :: permission_type
+    )
+    AND (
+        $4 :: employee_permission_status IS NULL
+        OR "status" = $4 :: employee_permission_status
+    )
+    AND (
+        $5 :: timestamptz IS NULL
+        OR "start_permission" >= $5 :: timestamptz
+    )
+    AND (
+        $6 :: timestamptz IS NULL
+        OR "end_permission" <= $6 :: timestamptz
+    )
+`
+
+type CountEmployeePermissionsParams struct {
+	Q          pgtype.Text                  `db:"q"`
+	EmployeeID pgtype.Int4                  `db:"employee_id"`
+	Type       NullPermissionType           `db:"type"`
+	Status     NullEmployeePermissionStatus `db:"status"`
+	FromDate   pgtype.Timestamptz           `db:"from_date"`
+	EndDate    pgtype.Timestamptz           `db:"end_date"`
+}
+
+func (q *Queries) CountEmployeePermissions(ctx context.Context, arg CountEmployeePermissionsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countEmployeePermissions,
+		arg.Q,
+		arg.EmployeeID,
+		arg.Type,
+		arg.Status,
+		arg.FromDate,
+		arg.EndDate,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createEmployeePermission = `-- name: CreateEmployeePermission :one
 INSERT INTO
     "employee_permission" (
@@ -18,24 +108,30 @@ INSERT INTO
         start_permission,
         end_permission,
         "type",
-        excuse
+        excuse,
+        "status",
+        created_by
     )
 VALUES
     (
         $1,
         $2,
         $3,
-        $4 :: santri_permission_type,
-        $5
-    ) RETURNING id, employee_id, type, start_permission, end_permission, excuse
+        $4 :: permission_type,
+        $5,
+        $6 :: employee_permission_status,
+        $7
+    ) RETURNING id, employee_id, type, start_permission, end_permission, excuse, status, created_by, created_at, reviewed_by, reviewed_at, review_note
 `
 
 type CreateEmployeePermissionParams struct {
-	EmployeeID      int32              `db:"employee_id"`
-	StartPermission pgtype.Timestamptz `db:"start_permission"`
-	EndPermission   pgtype.Timestamptz `db:"end_permission"`
-	Type            interface{}        `db:"type"`
-	Excuse          string             `db:"excuse"`
+	EmployeeID      int32                    `db:"employee_id"`
+	StartPermission pgtype.Timestamptz       `db:"start_permission"`
+	EndPermission   pgtype.Timestamptz       `db:"end_permission"`
+	Type            PermissionType           `db:"type"`
+	Excuse          string                   `db:"excuse"`
+	Status          EmployeePermissionStatus `db:"status"`
+	CreatedBy       pgtype.Int4              `db:"created_by"`
 }
 
 func (q *Queries) CreateEmployeePermission(ctx context.Context, arg CreateEmployeePermissionParams) (EmployeePermission, error) {
@@ -45,6 +141,8 @@ func (q *Queries) CreateEmployeePermission(ctx context.Context, arg CreateEmploy
 		arg.EndPermission,
 		arg.Type,
 		arg.Excuse,
+		arg.Status,
+		arg.CreatedBy,
 	)
 	var i EmployeePermission
 	err := row.Scan(
@@ -54,6 +152,12 @@ func (q *Queries) CreateEmployeePermission(ctx context.Context, arg CreateEmploy
 		&i.StartPermission,
 		&i.EndPermission,
 		&i.Excuse,
+		&i.Status,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.ReviewedBy,
+		&i.ReviewedAt,
+		&i.ReviewNote,
 	)
 	return i, err
 }
@@ -62,7 +166,7 @@ const deleteEmployeePermission = `-- name: DeleteEmployeePermission :one
 DELETE FROM
     "employee_permission"
 WHERE
-    "id" = $1 RETURNING id, employee_id, type, start_permission, end_permission, excuse
+    "id" = $1 RETURNING id, employee_id, type, start_permission, end_permission, excuse, status, created_by, created_at, reviewed_by, reviewed_at, review_note
 `
 
 func (q *Queries) DeleteEmployeePermission(ctx context.Context, id int32) (EmployeePermission, error) {
@@ -75,13 +179,19 @@ func (q *Queries) DeleteEmployeePermission(ctx context.Context, id int32) (Emplo
 		&i.StartPermission,
 		&i.EndPermission,
 		&i.Excuse,
+		&i.Status,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.ReviewedBy,
+		&i.ReviewedAt,
+		&i.ReviewNote,
 	)
 	return i, err
 }
 
 const getEmployeePermission = `-- name: GetEmployeePermission :one
 SELECT
-    employee_permission.id, employee_permission.employee_id, employee_permission.type, employee_permission.start_permission, employee_permission.end_permission, employee_permission.excuse,
+    employee_permission.id, employee_permission.employee_id, employee_permission.type, employee_permission.start_permission, employee_permission.end_permission, employee_permission.excuse, employee_permission.status, employee_permission.created_by, employee_permission.created_at, employee_permission.reviewed_by, employee_permission.reviewed_at, employee_permission.review_note,
     "employee"."name" AS "employee_name"
 FROM
     "employee_permission"
@@ -91,13 +201,19 @@ WHERE
 `
 
 type GetEmployeePermissionRow struct {
-	ID              int32              `db:"id"`
-	EmployeeID      int32              `db:"employee_id"`
-	Type            PermissionType     `db:"type"`
-	StartPermission pgtype.Timestamptz `db:"start_permission"`
-	EndPermission   pgtype.Timestamptz `db:"end_permission"`
-	Excuse          string             `db:"excuse"`
-	EmployeeName    string             `db:"employee_name"`
+	ID              int32                    `db:"id"`
+	EmployeeID      int32                    `db:"employee_id"`
+	Type            PermissionType           `db:"type"`
+	StartPermission pgtype.Timestamptz       `db:"start_permission"`
+	EndPermission   pgtype.Timestamptz       `db:"end_permission"`
+	Excuse          string                   `db:"excuse"`
+	Status          EmployeePermissionStatus `db:"status"`
+	CreatedBy       pgtype.Int4              `db:"created_by"`
+	CreatedAt       pgtype.Timestamptz       `db:"created_at"`
+	ReviewedBy      pgtype.Int4              `db:"reviewed_by"`
+	ReviewedAt      pgtype.Timestamptz       `db:"reviewed_at"`
+	ReviewNote      pgtype.Text              `db:"review_note"`
+	EmployeeName    string                   `db:"employee_name"`
 }
 
 func (q *Queries) GetEmployeePermission(ctx context.Context, id int32) (GetEmployeePermissionRow, error) {
@@ -110,6 +226,12 @@ func (q *Queries) GetEmployeePermission(ctx context.Context, id int32) (GetEmplo
 		&i.StartPermission,
 		&i.EndPermission,
 		&i.Excuse,
+		&i.Status,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.ReviewedBy,
+		&i.ReviewedAt,
+		&i.ReviewNote,
 		&i.EmployeeName,
 	)
 	return i, err
@@ -117,7 +239,7 @@ func (q *Queries) GetEmployeePermission(ctx context.Context, id int32) (GetEmplo
 
 const listEmployeePermissions = `-- name: ListEmployeePermissions :many
 SELECT
-    employee_permission.id, employee_permission.employee_id, employee_permission.type, employee_permission.start_permission, employee_permission.end_permission, employee_permission.excuse,
+    employee_permission.id, employee_permission.employee_id, employee_permission.type, employee_permission.start_permission, employee_permission.end_permission, employee_permission.excuse, employee_permission.status, employee_permission.created_by, employee_permission.created_at, employee_permission.reviewed_by, employee_permission.reviewed_at, employee_permission.review_note,
     "employee"."name" AS "employee_name"
 FROM
     "employee_permission"
@@ -130,39 +252,52 @@ WHERE
         OR "employee_id" = $2 :: integer
     )
     AND (
-        $3 :: santri_permission_type IS NULL
-        OR "type" = $3 :: santri_permission_type
+        $3 :: permission_type IS NULL
+        OR "type" = $3 :: permission_type
     )
     AND (
-        $4 :: timestamptz IS NULL
-        OR "start_permission" >= $4 :: timestamptz
+        $4 :: employee_permission_status IS NULL
+        OR "status" = $4 :: employee_permission_status
     )
     AND (
         $5 :: timestamptz IS NULL
-        OR "end_permission" <= $5 :: timestamptz
+        OR "start_permission" >= $5 :: timestamptz
     )
+    AND (
+        $6 :: timestamptz IS NULL
+        OR "end_permission" <= $6 :: timestamptz
+    )
+ORDER BY
+    "start_permission" DESC
 LIMIT
-    $7 OFFSET $6
+    $8 OFFSET $7
 `
 
 type ListEmployeePermissionsParams struct {
-	Q            pgtype.Text        `db:"q"`
-	EmployeeID   pgtype.Int4        `db:"employee_id"`
-	Type         interface{}        `db:"type"`
-	FromDate     pgtype.Timestamptz `db:"from_date"`
-	EndDate      pgtype.Timestamptz `db:"end_date"`
-	OffsetNumber int32              `db:"offset_number"`
-	LimitNumber  int32              `db:"limit_number"`
+	Q            pgtype.Text                  `db:"q"`
+	EmployeeID   pgtype.Int4                  `db:"employee_id"`
+	Type         NullPermissionType           `db:"type"`
+	Status       NullEmployeePermissionStatus `db:"status"`
+	FromDate     pgtype.Timestamptz           `db:"from_date"`
+	EndDate      pgtype.Timestamptz           `db:"end_date"`
+	OffsetNumber int32                        `db:"offset_number"`
+	LimitNumber  int32                        `db:"limit_number"`
 }
 
 type ListEmployeePermissionsRow struct {
-	ID              int32              `db:"id"`
-	EmployeeID      int32              `db:"employee_id"`
-	Type            PermissionType     `db:"type"`
-	StartPermission pgtype.Timestamptz `db:"start_permission"`
-	EndPermission   pgtype.Timestamptz `db:"end_permission"`
-	Excuse          string             `db:"excuse"`
-	EmployeeName    string             `db:"employee_name"`
+	ID              int32                    `db:"id"`
+	EmployeeID      int32                    `db:"employee_id"`
+	Type            PermissionType           `db:"type"`
+	StartPermission pgtype.Timestamptz       `db:"start_permission"`
+	EndPermission   pgtype.Timestamptz       `db:"end_permission"`
+	Excuse          string                   `db:"excuse"`
+	Status          EmployeePermissionStatus `db:"status"`
+	CreatedBy       pgtype.Int4              `db:"created_by"`
+	CreatedAt       pgtype.Timestamptz       `db:"created_at"`
+	ReviewedBy      pgtype.Int4              `db:"reviewed_by"`
+	ReviewedAt      pgtype.Timestamptz       `db:"reviewed_at"`
+	ReviewNote      pgtype.Text              `db:"review_note"`
+	EmployeeName    string                   `db:"employee_name"`
 }
 
 func (q *Queries) ListEmployeePermissions(ctx context.Context, arg ListEmployeePermissionsParams) ([]ListEmployeePermissionsRow, error) {
@@ -170,6 +305,7 @@ func (q *Queries) ListEmployeePermissions(ctx context.Context, arg ListEmployeeP
 		arg.Q,
 		arg.EmployeeID,
 		arg.Type,
+		arg.Status,
 		arg.FromDate,
 		arg.EndDate,
 		arg.OffsetNumber,
@@ -189,6 +325,12 @@ func (q *Queries) ListEmployeePermissions(ctx context.Context, arg ListEmployeeP
 			&i.StartPermission,
 			&i.EndPermission,
 			&i.Excuse,
+			&i.Status,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.ReviewedBy,
+			&i.ReviewedAt,
+			&i.ReviewNote,
 			&i.EmployeeName,
 		); err != nil {
 			return nil, err
@@ -201,6 +343,121 @@ func (q *Queries) ListEmployeePermissions(ctx context.Context, arg ListEmployeeP
 	return items, nil
 }
 
+const listPendingEmployeePermissions = `-- name: ListPendingEmployeePermissions :many
+SELECT
+    employee_permission.id, employee_permission.employee_id, employee_permission.type, employee_permission.start_permission, employee_permission.end_permission, employee_permission.excuse, employee_permission.status, employee_permission.created_by, employee_permission.created_at, employee_permission.reviewed_by, employee_permission.reviewed_at, employee_permission.review_note,
+    "employee"."name" AS "employee_name"
+FROM
+    "employee_permission"
+    INNER JOIN "employee" ON "employee_permission"."employee_id" = "employee"."id"
+WHERE
+    "status" = 'pending'
+ORDER BY
+    "employee_permission"."created_at"
+LIMIT
+    $2 OFFSET $1
+`
+
+type ListPendingEmployeePermissionsParams struct {
+	OffsetNumber int32 `db:"offset_number"`
+	LimitNumber  int32 `db:"limit_number"`
+}
+
+type ListPendingEmployeePermissionsRow struct {
+	ID              int32                    `db:"id"`
+	EmployeeID      int32                    `db:"employee_id"`
+	Type            PermissionType           `db:"type"`
+	StartPermission pgtype.Timestamptz       `db:"start_permission"`
+	EndPermission   pgtype.Timestamptz       `db:"end_permission"`
+	Excuse          string                   `db:"excuse"`
+	Status          EmployeePermissionStatus `db:"status"`
+	CreatedBy       pgtype.Int4              `db:"created_by"`
+	CreatedAt       pgtype.Timestamptz       `db:"created_at"`
+	ReviewedBy      pgtype.Int4              `db:"reviewed_by"`
+	ReviewedAt      pgtype.Timestamptz       `db:"reviewed_at"`
+	ReviewNote      pgtype.Text              `db:"review_note"`
+	EmployeeName    string                   `db:"employee_name"`
+}
+
+func (q *Queries) ListPendingEmployeePermissions(ctx context.Context, arg ListPendingEmployeePermissionsParams) ([]ListPendingEmployeePermissionsRow, error) {
+	rows, err := q.db.Query(ctx, listPendingEmployeePermissions, arg.OffsetNumber, arg.LimitNumber)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListPendingEmployeePermissionsRow{}
+	for rows.Next() {
+		var i ListPendingEmployeePermissionsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.EmployeeID,
+			&i.Type,
+			&i.StartPermission,
+			&i.EndPermission,
+			&i.Excuse,
+			&i.Status,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.ReviewedBy,
+			&i.ReviewedAt,
+			&i.ReviewNote,
+			&i.EmployeeName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const reviewEmployeePermission = `-- name: ReviewEmployeePermission :one
+UPDATE
+    "employee_permission"
+SET
+    "status" = $1 :: employee_permission_status,
+    "reviewed_by" = $2,
+    "reviewed_at" = now(),
+    "review_note" = $3
+WHERE
+    "id" = $4
+    AND "status" = 'pending' RETURNING id, employee_id, type, start_permission, end_permission, excuse, status, created_by, created_at, reviewed_by, reviewed_at, review_note
+`
+
+type ReviewEmployeePermissionParams struct {
+	Status     EmployeePermissionStatus `db:"status"`
+	ReviewedBy pgtype.Int4              `db:"reviewed_by"`
+	ReviewNote pgtype.Text              `db:"review_note"`
+	ID         int32                    `db:"id"`
+}
+
+func (q *Queries) ReviewEmployeePermission(ctx context.Context, arg ReviewEmployeePermissionParams) (EmployeePermission, error) {
+	row := q.db.QueryRow(ctx, reviewEmployeePermission,
+		arg.Status,
+		arg.ReviewedBy,
+		arg.ReviewNote,
+		arg.ID,
+	)
+	var i EmployeePermission
+	err := row.Scan(
+		&i.ID,
+		&i.EmployeeID,
+		&i.Type,
+		&i.StartPermission,
+		&i.EndPermission,
+		&i.Excuse,
+		&i.Status,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.ReviewedBy,
+		&i.ReviewedAt,
+		&i.ReviewNote,
+	)
+	return i, err
+}
+
 const updateEmployeePermission = `-- name: UpdateEmployeePermission :one
 UPDATE
     "employee_permission"
@@ -208,15 +465,17 @@ SET
     "employee_id" = COALESCE($1, employee_id),
     "start_permission" = COALESCE($2, start_permission),
     "end_permission" = $3,
-    "excuse" = COALESCE($4, excuse)
+    "type" = COALESCE($4 :: permission_type, "type"),
+    "excuse" = COALESCE($5, excuse)
 WHERE
-    "id" = $5 RETURNING id, employee_id, type, start_permission, end_permission, excuse
+    "id" = $6 RETURNING id, employee_id, type, start_permission, end_permission, excuse, status, created_by, created_at, reviewed_by, reviewed_at, review_note
 `
 
 type UpdateEmployeePermissionParams struct {
 	EmployeeID      pgtype.Int4        `db:"employee_id"`
 	StartPermission pgtype.Timestamptz `db:"start_permission"`
 	EndPermission   pgtype.Timestamptz `db:"end_permission"`
+	Type            NullPermissionType `db:"type"`
 	Excuse          pgtype.Text        `db:"excuse"`
 	ID              int32              `db:"id"`
 }
@@ -226,6 +485,7 @@ func (q *Queries) UpdateEmployeePermission(ctx context.Context, arg UpdateEmploy
 		arg.EmployeeID,
 		arg.StartPermission,
 		arg.EndPermission,
+		arg.Type,
 		arg.Excuse,
 		arg.ID,
 	)
@@ -237,6 +497,12 @@ func (q *Queries) UpdateEmployeePermission(ctx context.Context, arg UpdateEmploy
 		&i.StartPermission,
 		&i.EndPermission,
 		&i.Excuse,
+		&i.Status,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.ReviewedBy,
+		&i.ReviewedAt,
+		&i.ReviewNote,
 	)
 	return i, err
 }
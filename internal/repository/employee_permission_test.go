@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/adiubaidah/syafiiyah-main/pkg/random"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmployeePermissionReview(t *testing.T) {
+	employee := createRandomEmployee(t)
+	start := time.Now().Add(24 * time.Hour)
+
+	permission, err := testStore.CreateEmployeePermission(context.Background(), CreateEmployeePermissionParams{
+		EmployeeID:      employee.ID,
+		StartPermission: pgtype.Timestamptz{Time: start, Valid: true},
+		EndPermission:   pgtype.Timestamptz{Time: start.Add(8 * time.Hour), Valid: true},
+		Type:            PermissionTypePermission,
+		Excuse:          random.RandomString(20),
+		Status:          EmployeePermissionStatusPending,
+	})
+	require.NoError(t, err)
+	require.Equal(t, EmployeePermissionStatusPending, permission.Status)
+	require.True(t, permission.CreatedAt.Valid)
+
+	pending, err := testStore.ListPendingEmployeePermissions(context.Background(), ListPendingEmployeePermissionsParams{LimitNumber: 1000})
+	require.NoError(t, err)
+	found := false
+	for _, item := range pending {
+		require.Equal(t, EmployeePermissionStatusPending, item.Status)
+		if item.ID == permission.ID {
+			found = true
+			require.Equal(t, employee.Name, item.EmployeeName)
+		}
+	}
+	require.True(t, found)
+
+	reviewed, err := testStore.ReviewEmployeePermission(context.Background(), ReviewEmployeePermissionParams{
+		ID:         permission.ID,
+		Status:     EmployeePermissionStatusApproved,
+		ReviewNote: pgtype.Text{String: "Hati-hati di jalan", Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, EmployeePermissionStatusApproved, reviewed.Status)
+	require.True(t, reviewed.ReviewedAt.Valid)
+
+	t.Run("reviewed permission is not reviewed again", func(t *testing.T) {
+		_, err := testStore.ReviewEmployeePermission(context.Background(), ReviewEmployeePermissionParams{
+			ID:     permission.ID,
+			Status: EmployeePermissionStatusRejected,
+		})
+		require.ErrorIs(t, err, pgx.ErrNoRows)
+	})
+
+	count, err := testStore.CountEmployeePermissions(context.Background(), CountEmployeePermissionsParams{
+		EmployeeID: pgtype.Int4{Int32: employee.ID, Valid: true},
+		Status:     NullEmployeePermissionStatus{EmployeePermissionStatus: EmployeePermissionStatusApproved, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+}
@@ -75,6 +75,7 @@ INSERT INTO
         "type",
         "employee_id",
         "notes",
+        "created_at",
         "created_by",
         "employee_permission_id"
     )
@@ -85,8 +86,9 @@ VALUES
         $3 :: presence_type,
         $4,
         $5,
-        $6 :: presence_created_by_type,
-        $7
+        COALESCE($6 :: timestamptz, now()),
+        $7 :: presence_created_by_type,
+        $8
     ) RETURNING id, schedule_id, schedule_name, type, employee_id, created_at, created_by, notes, employee_permission_id
 `
 
@@ -96,6 +98,7 @@ type CreateEmployeePresenceParams struct {
 	Type                 PresenceType          `db:"type"`
 	EmployeeID           int32                 `db:"employee_id"`
 	Notes                pgtype.Text           `db:"notes"`
+	CreatedAt            pgtype.Timestamptz    `db:"created_at"`
 	CreatedBy            PresenceCreatedByType `db:"created_by"`
 	EmployeePermissionID pgtype.Int4           `db:"employee_permission_id"`
 }
@@ -107,6 +110,7 @@ func (q *Queries) CreateEmployeePresence(ctx context.Context, arg CreateEmployee
 		arg.Type,
 		arg.EmployeeID,
 		arg.Notes,
+		arg.CreatedAt,
 		arg.CreatedBy,
 		arg.EmployeePermissionID,
 	)
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/adiubaidah/syafiiyah-main/pkg/random"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnrollmentSession(t *testing.T) {
+	deviceName := random.RandomString(10)
+	device, err := sqlStore.CreateDeviceWithModes(context.Background(), deviceName, random.RandomString(64), []CreateDeviceModesParams{
+		{
+			Mode:                 DeviceModeTypeRecord,
+			InputTopic:           deviceName + "/input/record",
+			AcknowledgementTopic: deviceName + "/acknowledgment/record",
+		},
+	})
+	require.NoError(t, err)
+
+	santri := createRandomSantri(t)
+	employee := createRandomEmployee(t)
+	session, err := sqlStore.CreateEnrollmentSessionWithItems(context.Background(), CreateEnrollmentSessionParams{
+		DeviceID:  device.ID,
+		ExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(time.Minute), Valid: true},
+	}, []CreateEnrollmentSessionItemsParams{
+		{SantriID: pgtype.Int4{Int32: santri.ID, Valid: true}},
+		{EmployeeID: pgtype.Int4{Int32: employee.ID, Valid: true}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, EnrollmentSessionStatusOpen, session.Status)
+
+	t.Run("one open session per device", func(t *testing.T) {
+		_, err := sqlStore.CreateEnrollmentSessionWithItems(context.Background(), CreateEnrollmentSessionParams{
+			DeviceID:  device.ID,
+			ExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(time.Minute), Valid: true},
+		}, []CreateEnrollmentSessionItemsParams{{SantriID: pgtype.Int4{Int32: santri.ID, Valid: true}}})
+		require.Error(t, err)
+	})
+
+	open, err := testStore.GetOpenEnrollmentSessionByDeviceName(context.Background(), deviceName)
+	require.NoError(t, err)
+	require.Equal(t, session.ID, open.ID)
+
+	santriCard, completed, err := sqlStore.EnrollSmartCard(context.Background(), session.ID, random.RandomString(12))
+	require.NoError(t, err)
+	require.False(t, completed)
+	require.Equal(t, santri.ID, santriCard.SantriID.Int32)
+	require.Equal(t, SmartCardStateActive, santriCard.State)
+
+	t.Run("card of another owner is refused", func(t *testing.T) {
+		_, _, err := sqlStore.EnrollSmartCard(context.Background(), session.ID, santriCard.Uid)
+		require.ErrorIs(t, err, ErrSmartCardOwned)
+
+		card, err := testStore.GetSmartCard(context.Background(), santriCard.Uid)
+		require.NoError(t, err)
+		require.Equal(t, santri.ID, card.SantriID.Int32)
+	})
+
+	employeeCard, completed, err := sqlStore.EnrollSmartCard(context.Background(), session.ID, random.RandomString(12))
+	require.NoError(t, err)
+	require.True(t, completed)
+	require.Equal(t, employee.ID, employeeCard.EmployeeID.Int32)
+
+	items, err := testStore.ListEnrollmentSessionItems(context.Background(), session.ID)
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	require.Equal(t, santriCard.Uid, items[0].SmartCardUid.String)
+	require.Equal(t, santri.Name, items[0].SantriName.String)
+	require.Equal(t, employeeCard.Uid, items[1].SmartCardUid.String)
+
+	assignments, err := testStore.ListSmartCardAssignments(context.Background(), santriCard.ID)
+	require.NoError(t, err)
+	require.Len(t, assignments, 1)
+
+	closed, err := testStore.GetEnrollmentSession(context.Background(), session.ID)
+	require.NoError(t, err)
+	require.Equal(t, EnrollmentSessionStatusCompleted, closed.Status)
+	require.True(t, closed.ClosedAt.Valid)
+
+	_, err = testStore.GetOpenEnrollmentSessionByDeviceName(context.Background(), deviceName)
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+}
@@ -55,6 +55,53 @@ func (q *Queries) DeleteHoliday(ctx context.Context, id int32) (Holiday, error)
 	return i, err
 }
 
+const getHoliday = `-- name: GetHoliday :one
+SELECT
+    id, name, color, description
+FROM
+    "holiday"
+WHERE
+    "id" = $1
+`
+
+func (q *Queries) GetHoliday(ctx context.Context, id int32) (Holiday, error) {
+	row := q.db.QueryRow(ctx, getHoliday, id)
+	var i Holiday
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Color,
+		&i.Description,
+	)
+	return i, err
+}
+
+const getHolidayByDate = `-- name: GetHolidayByDate :one
+SELECT
+    holiday.id, holiday.name, holiday.color, holiday.description
+FROM
+    "holiday"
+    INNER JOIN "holiday_date" ON "holiday"."id" = "holiday_date"."holiday_id"
+WHERE
+    "holiday_date"."date" = $1
+ORDER BY
+    "holiday"."id"
+LIMIT
+    1
+`
+
+func (q *Queries) GetHolidayByDate(ctx context.Context, date pgtype.Date) (Holiday, error) {
+	row := q.db.QueryRow(ctx, getHolidayByDate, date)
+	var i Holiday
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Color,
+		&i.Description,
+	)
+	return i, err
+}
+
 const listHolidays = `-- name: ListHolidays :many
 SELECT
     holiday.id, holiday.name, holiday.color, holiday.description,
@@ -27,3 +27,53 @@ func (q *Queries) DeleteHolidayDateByHolidayId(ctx context.Context, holidayID in
 	_, err := q.db.Exec(ctx, deleteHolidayDateByHolidayId, holidayID)
 	return err
 }
+
+const isHolidayDate = `-- name: IsHolidayDate :one
+SELECT
+    EXISTS (
+        SELECT
+            1
+        FROM
+            "holiday_date"
+        WHERE
+            "date" = $1
+    ) :: boolean AS "is_holiday"
+`
+
+func (q *Queries) IsHolidayDate(ctx context.Context, date pgtype.Date) (bool, error) {
+	row := q.db.QueryRow(ctx, isHolidayDate, date)
+	var is_holiday bool
+	err := row.Scan(&is_holiday)
+	return is_holiday, err
+}
+
+const listHolidayDatesByHolidayId = `-- name: ListHolidayDatesByHolidayId :many
+SELECT
+    "date"
+FROM
+    "holiday_date"
+WHERE
+    "holiday_id" = $1
+ORDER BY
+    "date"
+`
+
+func (q *Queries) ListHolidayDatesByHolidayId(ctx context.Context, holidayID int32) ([]pgtype.Date, error) {
+	rows, err := q.db.Query(ctx, listHolidayDatesByHolidayId, holidayID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []pgtype.Date{}
+	for rows.Next() {
+		var date pgtype.Date
+		if err := rows.Scan(&date); err != nil {
+			return nil, err
+		}
+		items = append(items, date)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
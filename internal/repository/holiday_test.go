@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/adiubaidah/syafiiyah-main/pkg/random"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHolidayWithDates(t *testing.T) {
+	// far in the future so the dates don't collide with other holidays
+	first := time.Date(2900+int(random.RandomInt(0, 99)), 1, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, int(random.RandomInt(0, 300)))
+	second := first.AddDate(0, 0, 1)
+
+	holiday, err := sqlStore.CreateHolidayWithDates(context.Background(), CreateHolidayParams{
+		Name: random.RandomString(8),
+	}, []pgtype.Date{{Time: first, Valid: true}, {Time: second, Valid: true}})
+	require.NoError(t, err)
+
+	isHoliday, err := testStore.IsHolidayDate(context.Background(), pgtype.Date{Time: second, Valid: true})
+	require.NoError(t, err)
+	require.True(t, isHoliday)
+
+	byDate, err := testStore.GetHolidayByDate(context.Background(), pgtype.Date{Time: first, Valid: true})
+	require.NoError(t, err)
+	require.Equal(t, holiday.ID, byDate.ID)
+
+	_, err = sqlStore.UpdateHolidayWithDates(context.Background(), UpdateHolidayParams{
+		ID:   holiday.ID,
+		Name: pgtype.Text{String: holiday.Name, Valid: true},
+	}, []pgtype.Date{{Time: second, Valid: true}})
+	require.NoError(t, err)
+
+	dates, err := testStore.ListHolidayDatesByHolidayId(context.Background(), holiday.ID)
+	require.NoError(t, err)
+	require.Len(t, dates, 1)
+	require.True(t, dates[0].Time.Equal(second))
+
+	isHoliday, err = testStore.IsHolidayDate(context.Background(), pgtype.Date{Time: first, Valid: true})
+	require.NoError(t, err)
+	require.False(t, isHoliday)
+
+	_, err = testStore.DeleteHoliday(context.Background(), holiday.ID)
+	require.NoError(t, err)
+}
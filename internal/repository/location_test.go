@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/adiubaidah/syafiiyah-main/pkg/random"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func clearLocationTable(t *testing.T) {
+	_, err := sqlStore.db.Exec(context.Background(), `DELETE FROM "location"`)
+	require.NoError(t, err)
+}
+
+func createRandomLocation(t *testing.T) Location {
+	arg := CreateLocationParams{
+		Name:        random.RandomString(8),
+		Description: pgtype.Text{String: random.RandomString(50), Valid: true},
+	}
+
+	location, err := testStore.CreateLocation(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.Name, location.Name)
+	require.Equal(t, arg.Description.String, location.Description.String)
+	return location
+}
+
+func TestLocation(t *testing.T) {
+	clearDeviceTable(t)
+	clearLocationTable(t)
+	location := createRandomLocation(t)
+
+	t.Run("update keeps empty fields", func(t *testing.T) {
+		name := random.RandomString(8)
+		updated, err := testStore.UpdateLocation(context.Background(), UpdateLocationParams{
+			ID:   location.ID,
+			Name: pgtype.Text{String: name, Valid: true},
+		})
+		require.NoError(t, err)
+		require.Equal(t, name, updated.Name)
+		require.Equal(t, location.Description.String, updated.Description.String)
+	})
+
+	t.Run("replace device access", func(t *testing.T) {
+		device, err := sqlStore.CreateDeviceWithModes(context.Background(), random.RandomString(10), random.RandomString(64), []CreateDeviceModesParams{})
+		require.NoError(t, err)
+
+		rules := []CreateDeviceAllowRulesParams{
+			{
+				OwnerRole: NullRoleType{RoleType: RoleTypeSantri, Valid: true},
+				Gender:    NullGenderType{GenderType: GenderTypeFemale, Valid: true},
+			},
+			{
+				OwnerRole:  NullRoleType{RoleType: RoleTypeEmployee, Valid: true},
+				ScheduleID: pgtype.Int4{Int32: 1, Valid: true},
+			},
+		}
+		updated, err := sqlStore.ReplaceDeviceAccess(context.Background(), device.ID, pgtype.Int4{Int32: location.ID, Valid: true}, rules)
+		require.NoError(t, err)
+		require.Equal(t, location.ID, updated.LocationID.Int32)
+
+		saved, err := testStore.ListDeviceAllowRules(context.Background(), device.ID)
+		require.NoError(t, err)
+		require.Len(t, saved, 2)
+		require.Equal(t, GenderTypeFemale, saved[0].Gender.GenderType)
+		require.False(t, saved[0].OccupationID.Valid)
+
+		updated, err = sqlStore.ReplaceDeviceAccess(context.Background(), device.ID, pgtype.Int4{}, rules[:1])
+		require.NoError(t, err)
+		require.False(t, updated.LocationID.Valid)
+
+		saved, err = testStore.ListDeviceAllowRules(context.Background(), device.ID)
+		require.NoError(t, err)
+		require.Len(t, saved, 1)
+	})
+
+	t.Run("delete unbinds devices", func(t *testing.T) {
+		device, err := sqlStore.CreateDeviceWithModes(context.Background(), random.RandomString(10), random.RandomString(64), []CreateDeviceModesParams{})
+		require.NoError(t, err)
+		_, err = testStore.UpdateDeviceLocation(context.Background(), UpdateDeviceLocationParams{
+			ID:         device.ID,
+			LocationID: pgtype.Int4{Int32: location.ID, Valid: true},
+		})
+		require.NoError(t, err)
+
+		_, err = testStore.DeleteLocation(context.Background(), location.ID)
+		require.NoError(t, err)
+
+		device, err = testStore.GetDevice(context.Background(), device.ID)
+		require.NoError(t, err)
+		require.False(t, device.LocationID.Valid)
+	})
+}
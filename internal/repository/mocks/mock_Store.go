@@ -24,26 +24,28 @@ func (_m *MockStore) EXPECT() *MockStore_Expecter {
 	return &MockStore_Expecter{mock: &_m.Mock}
 }
 
-// CountEmployeePresences provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CountEmployeePresences(ctx context.Context, arg repository.CountEmployeePresencesParams) (int64, error) {
+// ApplySantriPermissionToPresences provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ApplySantriPermissionToPresences(ctx context.Context, arg repository.ApplySantriPermissionToPresencesParams) ([]repository.SantriPresence, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CountEmployeePresences")
+		panic("no return value specified for ApplySantriPermissionToPresences")
 	}
 
-	var r0 int64
+	var r0 []repository.SantriPresence
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.CountEmployeePresencesParams) (int64, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ApplySantriPermissionToPresencesParams) ([]repository.SantriPresence, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repository.CountEmployeePresencesParams) int64); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ApplySantriPermissionToPresencesParams) []repository.SantriPresence); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(int64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.SantriPresence)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repository.CountEmployeePresencesParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, repository.ApplySantriPermissionToPresencesParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -52,55 +54,55 @@ func (_m *MockStore) CountEmployeePresences(ctx context.Context, arg repository.
 	return r0, r1
 }
 
-// MockStore_CountEmployeePresences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountEmployeePresences'
-type MockStore_CountEmployeePresences_Call struct {
+// MockStore_ApplySantriPermissionToPresences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplySantriPermissionToPresences'
+type MockStore_ApplySantriPermissionToPresences_Call struct {
 	*mock.Call
 }
 
-// CountEmployeePresences is a helper method to define mock.On call
+// ApplySantriPermissionToPresences is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.CountEmployeePresencesParams
-func (_e *MockStore_Expecter) CountEmployeePresences(ctx interface{}, arg interface{}) *MockStore_CountEmployeePresences_Call {
-	return &MockStore_CountEmployeePresences_Call{Call: _e.mock.On("CountEmployeePresences", ctx, arg)}
+//   - arg repository.ApplySantriPermissionToPresencesParams
+func (_e *MockStore_Expecter) ApplySantriPermissionToPresences(ctx interface{}, arg interface{}) *MockStore_ApplySantriPermissionToPresences_Call {
+	return &MockStore_ApplySantriPermissionToPresences_Call{Call: _e.mock.On("ApplySantriPermissionToPresences", ctx, arg)}
 }
 
-func (_c *MockStore_CountEmployeePresences_Call) Run(run func(ctx context.Context, arg repository.CountEmployeePresencesParams)) *MockStore_CountEmployeePresences_Call {
+func (_c *MockStore_ApplySantriPermissionToPresences_Call) Run(run func(ctx context.Context, arg repository.ApplySantriPermissionToPresencesParams)) *MockStore_ApplySantriPermissionToPresences_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(repository.CountEmployeePresencesParams))
+		run(args[0].(context.Context), args[1].(repository.ApplySantriPermissionToPresencesParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CountEmployeePresences_Call) Return(_a0 int64, _a1 error) *MockStore_CountEmployeePresences_Call {
+func (_c *MockStore_ApplySantriPermissionToPresences_Call) Return(_a0 []repository.SantriPresence, _a1 error) *MockStore_ApplySantriPermissionToPresences_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CountEmployeePresences_Call) RunAndReturn(run func(context.Context, repository.CountEmployeePresencesParams) (int64, error)) *MockStore_CountEmployeePresences_Call {
+func (_c *MockStore_ApplySantriPermissionToPresences_Call) RunAndReturn(run func(context.Context, repository.ApplySantriPermissionToPresencesParams) ([]repository.SantriPresence, error)) *MockStore_ApplySantriPermissionToPresences_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CountEmployees provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CountEmployees(ctx context.Context, arg repository.CountEmployeesParams) (int64, error) {
+// CancelEmployeePermission provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CancelEmployeePermission(ctx context.Context, arg repository.CancelEmployeePermissionParams) (repository.EmployeePermission, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CountEmployees")
+		panic("no return value specified for CancelEmployeePermission")
 	}
 
-	var r0 int64
+	var r0 repository.EmployeePermission
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.CountEmployeesParams) (int64, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CancelEmployeePermissionParams) (repository.EmployeePermission, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repository.CountEmployeesParams) int64); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CancelEmployeePermissionParams) repository.EmployeePermission); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(int64)
+		r0 = ret.Get(0).(repository.EmployeePermission)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repository.CountEmployeesParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CancelEmployeePermissionParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -109,56 +111,56 @@ func (_m *MockStore) CountEmployees(ctx context.Context, arg repository.CountEmp
 	return r0, r1
 }
 
-// MockStore_CountEmployees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountEmployees'
-type MockStore_CountEmployees_Call struct {
+// MockStore_CancelEmployeePermission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelEmployeePermission'
+type MockStore_CancelEmployeePermission_Call struct {
 	*mock.Call
 }
 
-// CountEmployees is a helper method to define mock.On call
+// CancelEmployeePermission is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.CountEmployeesParams
-func (_e *MockStore_Expecter) CountEmployees(ctx interface{}, arg interface{}) *MockStore_CountEmployees_Call {
-	return &MockStore_CountEmployees_Call{Call: _e.mock.On("CountEmployees", ctx, arg)}
+//   - arg repository.CancelEmployeePermissionParams
+func (_e *MockStore_Expecter) CancelEmployeePermission(ctx interface{}, arg interface{}) *MockStore_CancelEmployeePermission_Call {
+	return &MockStore_CancelEmployeePermission_Call{Call: _e.mock.On("CancelEmployeePermission", ctx, arg)}
 }
 
-func (_c *MockStore_CountEmployees_Call) Run(run func(ctx context.Context, arg repository.CountEmployeesParams)) *MockStore_CountEmployees_Call {
+func (_c *MockStore_CancelEmployeePermission_Call) Run(run func(ctx context.Context, arg repository.CancelEmployeePermissionParams)) *MockStore_CancelEmployeePermission_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(repository.CountEmployeesParams))
+		run(args[0].(context.Context), args[1].(repository.CancelEmployeePermissionParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CountEmployees_Call) Return(_a0 int64, _a1 error) *MockStore_CountEmployees_Call {
+func (_c *MockStore_CancelEmployeePermission_Call) Return(_a0 repository.EmployeePermission, _a1 error) *MockStore_CancelEmployeePermission_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CountEmployees_Call) RunAndReturn(run func(context.Context, repository.CountEmployeesParams) (int64, error)) *MockStore_CountEmployees_Call {
+func (_c *MockStore_CancelEmployeePermission_Call) RunAndReturn(run func(context.Context, repository.CancelEmployeePermissionParams) (repository.EmployeePermission, error)) *MockStore_CancelEmployeePermission_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CountParents provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CountParents(ctx context.Context, arg repository.CountParentsParams) (int64, error) {
-	ret := _m.Called(ctx, arg)
+// ChangeSantriLeaveRequestStatus provides a mock function with given fields: ctx, arg, history
+func (_m *MockStore) ChangeSantriLeaveRequestStatus(ctx context.Context, arg repository.UpdateSantriLeaveRequestStatusParams, history repository.CreateSantriLeaveRequestHistoryParams) (repository.SantriLeaveRequest, error) {
+	ret := _m.Called(ctx, arg, history)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CountParents")
+		panic("no return value specified for ChangeSantriLeaveRequestStatus")
 	}
 
-	var r0 int64
+	var r0 repository.SantriLeaveRequest
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.CountParentsParams) (int64, error)); ok {
-		return rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateSantriLeaveRequestStatusParams, repository.CreateSantriLeaveRequestHistoryParams) (repository.SantriLeaveRequest, error)); ok {
+		return rf(ctx, arg, history)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repository.CountParentsParams) int64); ok {
-		r0 = rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateSantriLeaveRequestStatusParams, repository.CreateSantriLeaveRequestHistoryParams) repository.SantriLeaveRequest); ok {
+		r0 = rf(ctx, arg, history)
 	} else {
-		r0 = ret.Get(0).(int64)
+		r0 = ret.Get(0).(repository.SantriLeaveRequest)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repository.CountParentsParams) error); ok {
-		r1 = rf(ctx, arg)
+	if rf, ok := ret.Get(1).(func(context.Context, repository.UpdateSantriLeaveRequestStatusParams, repository.CreateSantriLeaveRequestHistoryParams) error); ok {
+		r1 = rf(ctx, arg, history)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -166,56 +168,57 @@ func (_m *MockStore) CountParents(ctx context.Context, arg repository.CountParen
 	return r0, r1
 }
 
-// MockStore_CountParents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountParents'
-type MockStore_CountParents_Call struct {
+// MockStore_ChangeSantriLeaveRequestStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeSantriLeaveRequestStatus'
+type MockStore_ChangeSantriLeaveRequestStatus_Call struct {
 	*mock.Call
 }
 
-// CountParents is a helper method to define mock.On call
+// ChangeSantriLeaveRequestStatus is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.CountParentsParams
-func (_e *MockStore_Expecter) CountParents(ctx interface{}, arg interface{}) *MockStore_CountParents_Call {
-	return &MockStore_CountParents_Call{Call: _e.mock.On("CountParents", ctx, arg)}
+//   - arg repository.UpdateSantriLeaveRequestStatusParams
+//   - history repository.CreateSantriLeaveRequestHistoryParams
+func (_e *MockStore_Expecter) ChangeSantriLeaveRequestStatus(ctx interface{}, arg interface{}, history interface{}) *MockStore_ChangeSantriLeaveRequestStatus_Call {
+	return &MockStore_ChangeSantriLeaveRequestStatus_Call{Call: _e.mock.On("ChangeSantriLeaveRequestStatus", ctx, arg, history)}
 }
 
-func (_c *MockStore_CountParents_Call) Run(run func(ctx context.Context, arg repository.CountParentsParams)) *MockStore_CountParents_Call {
+func (_c *MockStore_ChangeSantriLeaveRequestStatus_Call) Run(run func(ctx context.Context, arg repository.UpdateSantriLeaveRequestStatusParams, history repository.CreateSantriLeaveRequestHistoryParams)) *MockStore_ChangeSantriLeaveRequestStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(repository.CountParentsParams))
+		run(args[0].(context.Context), args[1].(repository.UpdateSantriLeaveRequestStatusParams), args[2].(repository.CreateSantriLeaveRequestHistoryParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CountParents_Call) Return(_a0 int64, _a1 error) *MockStore_CountParents_Call {
+func (_c *MockStore_ChangeSantriLeaveRequestStatus_Call) Return(_a0 repository.SantriLeaveRequest, _a1 error) *MockStore_ChangeSantriLeaveRequestStatus_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CountParents_Call) RunAndReturn(run func(context.Context, repository.CountParentsParams) (int64, error)) *MockStore_CountParents_Call {
+func (_c *MockStore_ChangeSantriLeaveRequestStatus_Call) RunAndReturn(run func(context.Context, repository.UpdateSantriLeaveRequestStatusParams, repository.CreateSantriLeaveRequestHistoryParams) (repository.SantriLeaveRequest, error)) *MockStore_ChangeSantriLeaveRequestStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CountSantri provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CountSantri(ctx context.Context, arg repository.CountSantriParams) (int64, error) {
-	ret := _m.Called(ctx, arg)
+// ClaimPendingSmartCard provides a mock function with given fields: ctx, pendingID, arg
+func (_m *MockStore) ClaimPendingSmartCard(ctx context.Context, pendingID int32, arg repository.CreateSmartCardParams) (repository.SmartCard, error) {
+	ret := _m.Called(ctx, pendingID, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CountSantri")
+		panic("no return value specified for ClaimPendingSmartCard")
 	}
 
-	var r0 int64
+	var r0 repository.SmartCard
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.CountSantriParams) (int64, error)); ok {
-		return rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, int32, repository.CreateSmartCardParams) (repository.SmartCard, error)); ok {
+		return rf(ctx, pendingID, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repository.CountSantriParams) int64); ok {
-		r0 = rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, int32, repository.CreateSmartCardParams) repository.SmartCard); ok {
+		r0 = rf(ctx, pendingID, arg)
 	} else {
-		r0 = ret.Get(0).(int64)
+		r0 = ret.Get(0).(repository.SmartCard)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repository.CountSantriParams) error); ok {
-		r1 = rf(ctx, arg)
+	if rf, ok := ret.Get(1).(func(context.Context, int32, repository.CreateSmartCardParams) error); ok {
+		r1 = rf(ctx, pendingID, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -223,55 +226,56 @@ func (_m *MockStore) CountSantri(ctx context.Context, arg repository.CountSantri
 	return r0, r1
 }
 
-// MockStore_CountSantri_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountSantri'
-type MockStore_CountSantri_Call struct {
+// MockStore_ClaimPendingSmartCard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimPendingSmartCard'
+type MockStore_ClaimPendingSmartCard_Call struct {
 	*mock.Call
 }
 
-// CountSantri is a helper method to define mock.On call
+// ClaimPendingSmartCard is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.CountSantriParams
-func (_e *MockStore_Expecter) CountSantri(ctx interface{}, arg interface{}) *MockStore_CountSantri_Call {
-	return &MockStore_CountSantri_Call{Call: _e.mock.On("CountSantri", ctx, arg)}
+//   - pendingID int32
+//   - arg repository.CreateSmartCardParams
+func (_e *MockStore_Expecter) ClaimPendingSmartCard(ctx interface{}, pendingID interface{}, arg interface{}) *MockStore_ClaimPendingSmartCard_Call {
+	return &MockStore_ClaimPendingSmartCard_Call{Call: _e.mock.On("ClaimPendingSmartCard", ctx, pendingID, arg)}
 }
 
-func (_c *MockStore_CountSantri_Call) Run(run func(ctx context.Context, arg repository.CountSantriParams)) *MockStore_CountSantri_Call {
+func (_c *MockStore_ClaimPendingSmartCard_Call) Run(run func(ctx context.Context, pendingID int32, arg repository.CreateSmartCardParams)) *MockStore_ClaimPendingSmartCard_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(repository.CountSantriParams))
+		run(args[0].(context.Context), args[1].(int32), args[2].(repository.CreateSmartCardParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CountSantri_Call) Return(_a0 int64, _a1 error) *MockStore_CountSantri_Call {
+func (_c *MockStore_ClaimPendingSmartCard_Call) Return(_a0 repository.SmartCard, _a1 error) *MockStore_ClaimPendingSmartCard_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CountSantri_Call) RunAndReturn(run func(context.Context, repository.CountSantriParams) (int64, error)) *MockStore_CountSantri_Call {
+func (_c *MockStore_ClaimPendingSmartCard_Call) RunAndReturn(run func(context.Context, int32, repository.CreateSmartCardParams) (repository.SmartCard, error)) *MockStore_ClaimPendingSmartCard_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CountSantriPresences provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CountSantriPresences(ctx context.Context, arg repository.CountSantriPresencesParams) (int64, error) {
+// CloseEnrollmentSession provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CloseEnrollmentSession(ctx context.Context, arg repository.CloseEnrollmentSessionParams) (repository.EnrollmentSession, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CountSantriPresences")
+		panic("no return value specified for CloseEnrollmentSession")
 	}
 
-	var r0 int64
+	var r0 repository.EnrollmentSession
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.CountSantriPresencesParams) (int64, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CloseEnrollmentSessionParams) (repository.EnrollmentSession, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repository.CountSantriPresencesParams) int64); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CloseEnrollmentSessionParams) repository.EnrollmentSession); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(int64)
+		r0 = ret.Get(0).(repository.EnrollmentSession)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repository.CountSantriPresencesParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CloseEnrollmentSessionParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -280,55 +284,55 @@ func (_m *MockStore) CountSantriPresences(ctx context.Context, arg repository.Co
 	return r0, r1
 }
 
-// MockStore_CountSantriPresences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountSantriPresences'
-type MockStore_CountSantriPresences_Call struct {
+// MockStore_CloseEnrollmentSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloseEnrollmentSession'
+type MockStore_CloseEnrollmentSession_Call struct {
 	*mock.Call
 }
 
-// CountSantriPresences is a helper method to define mock.On call
+// CloseEnrollmentSession is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.CountSantriPresencesParams
-func (_e *MockStore_Expecter) CountSantriPresences(ctx interface{}, arg interface{}) *MockStore_CountSantriPresences_Call {
-	return &MockStore_CountSantriPresences_Call{Call: _e.mock.On("CountSantriPresences", ctx, arg)}
+//   - arg repository.CloseEnrollmentSessionParams
+func (_e *MockStore_Expecter) CloseEnrollmentSession(ctx interface{}, arg interface{}) *MockStore_CloseEnrollmentSession_Call {
+	return &MockStore_CloseEnrollmentSession_Call{Call: _e.mock.On("CloseEnrollmentSession", ctx, arg)}
 }
 
-func (_c *MockStore_CountSantriPresences_Call) Run(run func(ctx context.Context, arg repository.CountSantriPresencesParams)) *MockStore_CountSantriPresences_Call {
+func (_c *MockStore_CloseEnrollmentSession_Call) Run(run func(ctx context.Context, arg repository.CloseEnrollmentSessionParams)) *MockStore_CloseEnrollmentSession_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(repository.CountSantriPresencesParams))
+		run(args[0].(context.Context), args[1].(repository.CloseEnrollmentSessionParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CountSantriPresences_Call) Return(_a0 int64, _a1 error) *MockStore_CountSantriPresences_Call {
+func (_c *MockStore_CloseEnrollmentSession_Call) Return(_a0 repository.EnrollmentSession, _a1 error) *MockStore_CloseEnrollmentSession_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CountSantriPresences_Call) RunAndReturn(run func(context.Context, repository.CountSantriPresencesParams) (int64, error)) *MockStore_CountSantriPresences_Call {
+func (_c *MockStore_CloseEnrollmentSession_Call) RunAndReturn(run func(context.Context, repository.CloseEnrollmentSessionParams) (repository.EnrollmentSession, error)) *MockStore_CloseEnrollmentSession_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CountSmartCards provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CountSmartCards(ctx context.Context, arg repository.CountSmartCardsParams) (int64, error) {
+// CountDeviceCommands provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CountDeviceCommands(ctx context.Context, arg repository.CountDeviceCommandsParams) (int64, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CountSmartCards")
+		panic("no return value specified for CountDeviceCommands")
 	}
 
 	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.CountSmartCardsParams) (int64, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CountDeviceCommandsParams) (int64, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repository.CountSmartCardsParams) int64); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CountDeviceCommandsParams) int64); ok {
 		r0 = rf(ctx, arg)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repository.CountSmartCardsParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CountDeviceCommandsParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -337,55 +341,55 @@ func (_m *MockStore) CountSmartCards(ctx context.Context, arg repository.CountSm
 	return r0, r1
 }
 
-// MockStore_CountSmartCards_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountSmartCards'
-type MockStore_CountSmartCards_Call struct {
+// MockStore_CountDeviceCommands_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountDeviceCommands'
+type MockStore_CountDeviceCommands_Call struct {
 	*mock.Call
 }
 
-// CountSmartCards is a helper method to define mock.On call
+// CountDeviceCommands is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.CountSmartCardsParams
-func (_e *MockStore_Expecter) CountSmartCards(ctx interface{}, arg interface{}) *MockStore_CountSmartCards_Call {
-	return &MockStore_CountSmartCards_Call{Call: _e.mock.On("CountSmartCards", ctx, arg)}
+//   - arg repository.CountDeviceCommandsParams
+func (_e *MockStore_Expecter) CountDeviceCommands(ctx interface{}, arg interface{}) *MockStore_CountDeviceCommands_Call {
+	return &MockStore_CountDeviceCommands_Call{Call: _e.mock.On("CountDeviceCommands", ctx, arg)}
 }
 
-func (_c *MockStore_CountSmartCards_Call) Run(run func(ctx context.Context, arg repository.CountSmartCardsParams)) *MockStore_CountSmartCards_Call {
+func (_c *MockStore_CountDeviceCommands_Call) Run(run func(ctx context.Context, arg repository.CountDeviceCommandsParams)) *MockStore_CountDeviceCommands_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(repository.CountSmartCardsParams))
+		run(args[0].(context.Context), args[1].(repository.CountDeviceCommandsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CountSmartCards_Call) Return(_a0 int64, _a1 error) *MockStore_CountSmartCards_Call {
+func (_c *MockStore_CountDeviceCommands_Call) Return(_a0 int64, _a1 error) *MockStore_CountDeviceCommands_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CountSmartCards_Call) RunAndReturn(run func(context.Context, repository.CountSmartCardsParams) (int64, error)) *MockStore_CountSmartCards_Call {
+func (_c *MockStore_CountDeviceCommands_Call) RunAndReturn(run func(context.Context, repository.CountDeviceCommandsParams) (int64, error)) *MockStore_CountDeviceCommands_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CountUsers provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CountUsers(ctx context.Context, arg repository.CountUsersParams) (int64, error) {
+// CountEmployeePermissions provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CountEmployeePermissions(ctx context.Context, arg repository.CountEmployeePermissionsParams) (int64, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CountUsers")
+		panic("no return value specified for CountEmployeePermissions")
 	}
 
 	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.CountUsersParams) (int64, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CountEmployeePermissionsParams) (int64, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repository.CountUsersParams) int64); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CountEmployeePermissionsParams) int64); ok {
 		r0 = rf(ctx, arg)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repository.CountUsersParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CountEmployeePermissionsParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -394,56 +398,56 @@ func (_m *MockStore) CountUsers(ctx context.Context, arg repository.CountUsersPa
 	return r0, r1
 }
 
-// MockStore_CountUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUsers'
-type MockStore_CountUsers_Call struct {
+// MockStore_CountEmployeePermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountEmployeePermissions'
+type MockStore_CountEmployeePermissions_Call struct {
 	*mock.Call
 }
 
-// CountUsers is a helper method to define mock.On call
+// CountEmployeePermissions is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.CountUsersParams
-func (_e *MockStore_Expecter) CountUsers(ctx interface{}, arg interface{}) *MockStore_CountUsers_Call {
-	return &MockStore_CountUsers_Call{Call: _e.mock.On("CountUsers", ctx, arg)}
+//   - arg repository.CountEmployeePermissionsParams
+func (_e *MockStore_Expecter) CountEmployeePermissions(ctx interface{}, arg interface{}) *MockStore_CountEmployeePermissions_Call {
+	return &MockStore_CountEmployeePermissions_Call{Call: _e.mock.On("CountEmployeePermissions", ctx, arg)}
 }
 
-func (_c *MockStore_CountUsers_Call) Run(run func(ctx context.Context, arg repository.CountUsersParams)) *MockStore_CountUsers_Call {
+func (_c *MockStore_CountEmployeePermissions_Call) Run(run func(ctx context.Context, arg repository.CountEmployeePermissionsParams)) *MockStore_CountEmployeePermissions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(repository.CountUsersParams))
+		run(args[0].(context.Context), args[1].(repository.CountEmployeePermissionsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CountUsers_Call) Return(_a0 int64, _a1 error) *MockStore_CountUsers_Call {
+func (_c *MockStore_CountEmployeePermissions_Call) Return(_a0 int64, _a1 error) *MockStore_CountEmployeePermissions_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CountUsers_Call) RunAndReturn(run func(context.Context, repository.CountUsersParams) (int64, error)) *MockStore_CountUsers_Call {
+func (_c *MockStore_CountEmployeePermissions_Call) RunAndReturn(run func(context.Context, repository.CountEmployeePermissionsParams) (int64, error)) *MockStore_CountEmployeePermissions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateDevice provides a mock function with given fields: ctx, name
-func (_m *MockStore) CreateDevice(ctx context.Context, name string) (repository.Device, error) {
-	ret := _m.Called(ctx, name)
+// CountEmployeePresences provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CountEmployeePresences(ctx context.Context, arg repository.CountEmployeePresencesParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateDevice")
+		panic("no return value specified for CountEmployeePresences")
 	}
 
-	var r0 repository.Device
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (repository.Device, error)); ok {
-		return rf(ctx, name)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CountEmployeePresencesParams) (int64, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) repository.Device); ok {
-		r0 = rf(ctx, name)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CountEmployeePresencesParams) int64); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(repository.Device)
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, name)
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CountEmployeePresencesParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -451,55 +455,55 @@ func (_m *MockStore) CreateDevice(ctx context.Context, name string) (repository.
 	return r0, r1
 }
 
-// MockStore_CreateDevice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDevice'
-type MockStore_CreateDevice_Call struct {
+// MockStore_CountEmployeePresences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountEmployeePresences'
+type MockStore_CountEmployeePresences_Call struct {
 	*mock.Call
 }
 
-// CreateDevice is a helper method to define mock.On call
+// CountEmployeePresences is a helper method to define mock.On call
 //   - ctx context.Context
-//   - name string
-func (_e *MockStore_Expecter) CreateDevice(ctx interface{}, name interface{}) *MockStore_CreateDevice_Call {
-	return &MockStore_CreateDevice_Call{Call: _e.mock.On("CreateDevice", ctx, name)}
+//   - arg repository.CountEmployeePresencesParams
+func (_e *MockStore_Expecter) CountEmployeePresences(ctx interface{}, arg interface{}) *MockStore_CountEmployeePresences_Call {
+	return &MockStore_CountEmployeePresences_Call{Call: _e.mock.On("CountEmployeePresences", ctx, arg)}
 }
 
-func (_c *MockStore_CreateDevice_Call) Run(run func(ctx context.Context, name string)) *MockStore_CreateDevice_Call {
+func (_c *MockStore_CountEmployeePresences_Call) Run(run func(ctx context.Context, arg repository.CountEmployeePresencesParams)) *MockStore_CountEmployeePresences_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(repository.CountEmployeePresencesParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateDevice_Call) Return(_a0 repository.Device, _a1 error) *MockStore_CreateDevice_Call {
+func (_c *MockStore_CountEmployeePresences_Call) Return(_a0 int64, _a1 error) *MockStore_CountEmployeePresences_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateDevice_Call) RunAndReturn(run func(context.Context, string) (repository.Device, error)) *MockStore_CreateDevice_Call {
+func (_c *MockStore_CountEmployeePresences_Call) RunAndReturn(run func(context.Context, repository.CountEmployeePresencesParams) (int64, error)) *MockStore_CountEmployeePresences_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateDeviceModes provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateDeviceModes(ctx context.Context, arg []repository.CreateDeviceModesParams) (int64, error) {
+// CountEmployees provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CountEmployees(ctx context.Context, arg repository.CountEmployeesParams) (int64, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateDeviceModes")
+		panic("no return value specified for CountEmployees")
 	}
 
 	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, []repository.CreateDeviceModesParams) (int64, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CountEmployeesParams) (int64, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, []repository.CreateDeviceModesParams) int64); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CountEmployeesParams) int64); ok {
 		r0 = rf(ctx, arg)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, []repository.CreateDeviceModesParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CountEmployeesParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -508,56 +512,56 @@ func (_m *MockStore) CreateDeviceModes(ctx context.Context, arg []repository.Cre
 	return r0, r1
 }
 
-// MockStore_CreateDeviceModes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDeviceModes'
-type MockStore_CreateDeviceModes_Call struct {
+// MockStore_CountEmployees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountEmployees'
+type MockStore_CountEmployees_Call struct {
 	*mock.Call
 }
 
-// CreateDeviceModes is a helper method to define mock.On call
+// CountEmployees is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg []repository.CreateDeviceModesParams
-func (_e *MockStore_Expecter) CreateDeviceModes(ctx interface{}, arg interface{}) *MockStore_CreateDeviceModes_Call {
-	return &MockStore_CreateDeviceModes_Call{Call: _e.mock.On("CreateDeviceModes", ctx, arg)}
+//   - arg repository.CountEmployeesParams
+func (_e *MockStore_Expecter) CountEmployees(ctx interface{}, arg interface{}) *MockStore_CountEmployees_Call {
+	return &MockStore_CountEmployees_Call{Call: _e.mock.On("CountEmployees", ctx, arg)}
 }
 
-func (_c *MockStore_CreateDeviceModes_Call) Run(run func(ctx context.Context, arg []repository.CreateDeviceModesParams)) *MockStore_CreateDeviceModes_Call {
+func (_c *MockStore_CountEmployees_Call) Run(run func(ctx context.Context, arg repository.CountEmployeesParams)) *MockStore_CountEmployees_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].([]repository.CreateDeviceModesParams))
+		run(args[0].(context.Context), args[1].(repository.CountEmployeesParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateDeviceModes_Call) Return(_a0 int64, _a1 error) *MockStore_CreateDeviceModes_Call {
+func (_c *MockStore_CountEmployees_Call) Return(_a0 int64, _a1 error) *MockStore_CountEmployees_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateDeviceModes_Call) RunAndReturn(run func(context.Context, []repository.CreateDeviceModesParams) (int64, error)) *MockStore_CreateDeviceModes_Call {
+func (_c *MockStore_CountEmployees_Call) RunAndReturn(run func(context.Context, repository.CountEmployeesParams) (int64, error)) *MockStore_CountEmployees_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateEmployee provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateEmployee(ctx context.Context, arg repository.CreateEmployeeParams) (repository.Employee, error) {
-	ret := _m.Called(ctx, arg)
+// CountExpiringSmartCards provides a mock function with given fields: ctx, days
+func (_m *MockStore) CountExpiringSmartCards(ctx context.Context, days int32) (int64, error) {
+	ret := _m.Called(ctx, days)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateEmployee")
+		panic("no return value specified for CountExpiringSmartCards")
 	}
 
-	var r0 repository.Employee
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateEmployeeParams) (repository.Employee, error)); ok {
-		return rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (int64, error)); ok {
+		return rf(ctx, days)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateEmployeeParams) repository.Employee); ok {
-		r0 = rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, int32) int64); ok {
+		r0 = rf(ctx, days)
 	} else {
-		r0 = ret.Get(0).(repository.Employee)
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateEmployeeParams) error); ok {
-		r1 = rf(ctx, arg)
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, days)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -565,55 +569,55 @@ func (_m *MockStore) CreateEmployee(ctx context.Context, arg repository.CreateEm
 	return r0, r1
 }
 
-// MockStore_CreateEmployee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEmployee'
-type MockStore_CreateEmployee_Call struct {
+// MockStore_CountExpiringSmartCards_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountExpiringSmartCards'
+type MockStore_CountExpiringSmartCards_Call struct {
 	*mock.Call
 }
 
-// CreateEmployee is a helper method to define mock.On call
+// CountExpiringSmartCards is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.CreateEmployeeParams
-func (_e *MockStore_Expecter) CreateEmployee(ctx interface{}, arg interface{}) *MockStore_CreateEmployee_Call {
-	return &MockStore_CreateEmployee_Call{Call: _e.mock.On("CreateEmployee", ctx, arg)}
+//   - days int32
+func (_e *MockStore_Expecter) CountExpiringSmartCards(ctx interface{}, days interface{}) *MockStore_CountExpiringSmartCards_Call {
+	return &MockStore_CountExpiringSmartCards_Call{Call: _e.mock.On("CountExpiringSmartCards", ctx, days)}
 }
 
-func (_c *MockStore_CreateEmployee_Call) Run(run func(ctx context.Context, arg repository.CreateEmployeeParams)) *MockStore_CreateEmployee_Call {
+func (_c *MockStore_CountExpiringSmartCards_Call) Run(run func(ctx context.Context, days int32)) *MockStore_CountExpiringSmartCards_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(repository.CreateEmployeeParams))
+		run(args[0].(context.Context), args[1].(int32))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateEmployee_Call) Return(_a0 repository.Employee, _a1 error) *MockStore_CreateEmployee_Call {
+func (_c *MockStore_CountExpiringSmartCards_Call) Return(_a0 int64, _a1 error) *MockStore_CountExpiringSmartCards_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateEmployee_Call) RunAndReturn(run func(context.Context, repository.CreateEmployeeParams) (repository.Employee, error)) *MockStore_CreateEmployee_Call {
+func (_c *MockStore_CountExpiringSmartCards_Call) RunAndReturn(run func(context.Context, int32) (int64, error)) *MockStore_CountExpiringSmartCards_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateEmployeeOccupation provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateEmployeeOccupation(ctx context.Context, arg repository.CreateEmployeeOccupationParams) (repository.EmployeeOccupation, error) {
+// CountParents provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CountParents(ctx context.Context, arg repository.CountParentsParams) (int64, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateEmployeeOccupation")
+		panic("no return value specified for CountParents")
 	}
 
-	var r0 repository.EmployeeOccupation
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateEmployeeOccupationParams) (repository.EmployeeOccupation, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CountParentsParams) (int64, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateEmployeeOccupationParams) repository.EmployeeOccupation); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CountParentsParams) int64); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(repository.EmployeeOccupation)
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateEmployeeOccupationParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CountParentsParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -622,56 +626,56 @@ func (_m *MockStore) CreateEmployeeOccupation(ctx context.Context, arg repositor
 	return r0, r1
 }
 
-// MockStore_CreateEmployeeOccupation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEmployeeOccupation'
-type MockStore_CreateEmployeeOccupation_Call struct {
+// MockStore_CountParents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountParents'
+type MockStore_CountParents_Call struct {
 	*mock.Call
 }
 
-// CreateEmployeeOccupation is a helper method to define mock.On call
+// CountParents is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.CreateEmployeeOccupationParams
-func (_e *MockStore_Expecter) CreateEmployeeOccupation(ctx interface{}, arg interface{}) *MockStore_CreateEmployeeOccupation_Call {
-	return &MockStore_CreateEmployeeOccupation_Call{Call: _e.mock.On("CreateEmployeeOccupation", ctx, arg)}
+//   - arg repository.CountParentsParams
+func (_e *MockStore_Expecter) CountParents(ctx interface{}, arg interface{}) *MockStore_CountParents_Call {
+	return &MockStore_CountParents_Call{Call: _e.mock.On("CountParents", ctx, arg)}
 }
 
-func (_c *MockStore_CreateEmployeeOccupation_Call) Run(run func(ctx context.Context, arg repository.CreateEmployeeOccupationParams)) *MockStore_CreateEmployeeOccupation_Call {
+func (_c *MockStore_CountParents_Call) Run(run func(ctx context.Context, arg repository.CountParentsParams)) *MockStore_CountParents_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(repository.CreateEmployeeOccupationParams))
+		run(args[0].(context.Context), args[1].(repository.CountParentsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateEmployeeOccupation_Call) Return(_a0 repository.EmployeeOccupation, _a1 error) *MockStore_CreateEmployeeOccupation_Call {
+func (_c *MockStore_CountParents_Call) Return(_a0 int64, _a1 error) *MockStore_CountParents_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateEmployeeOccupation_Call) RunAndReturn(run func(context.Context, repository.CreateEmployeeOccupationParams) (repository.EmployeeOccupation, error)) *MockStore_CreateEmployeeOccupation_Call {
+func (_c *MockStore_CountParents_Call) RunAndReturn(run func(context.Context, repository.CountParentsParams) (int64, error)) *MockStore_CountParents_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateEmployeePermission provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateEmployeePermission(ctx context.Context, arg repository.CreateEmployeePermissionParams) (repository.EmployeePermission, error) {
-	ret := _m.Called(ctx, arg)
+// CountPendingSmartCards provides a mock function with given fields: ctx
+func (_m *MockStore) CountPendingSmartCards(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateEmployeePermission")
+		panic("no return value specified for CountPendingSmartCards")
 	}
 
-	var r0 repository.EmployeePermission
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateEmployeePermissionParams) (repository.EmployeePermission, error)); ok {
-		return rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateEmployeePermissionParams) repository.EmployeePermission); ok {
-		r0 = rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
 	} else {
-		r0 = ret.Get(0).(repository.EmployeePermission)
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateEmployeePermissionParams) error); ok {
-		r1 = rf(ctx, arg)
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -679,55 +683,54 @@ func (_m *MockStore) CreateEmployeePermission(ctx context.Context, arg repositor
 	return r0, r1
 }
 
-// MockStore_CreateEmployeePermission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEmployeePermission'
-type MockStore_CreateEmployeePermission_Call struct {
+// MockStore_CountPendingSmartCards_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountPendingSmartCards'
+type MockStore_CountPendingSmartCards_Call struct {
 	*mock.Call
 }
 
-// CreateEmployeePermission is a helper method to define mock.On call
+// CountPendingSmartCards is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.CreateEmployeePermissionParams
-func (_e *MockStore_Expecter) CreateEmployeePermission(ctx interface{}, arg interface{}) *MockStore_CreateEmployeePermission_Call {
-	return &MockStore_CreateEmployeePermission_Call{Call: _e.mock.On("CreateEmployeePermission", ctx, arg)}
+func (_e *MockStore_Expecter) CountPendingSmartCards(ctx interface{}) *MockStore_CountPendingSmartCards_Call {
+	return &MockStore_CountPendingSmartCards_Call{Call: _e.mock.On("CountPendingSmartCards", ctx)}
 }
 
-func (_c *MockStore_CreateEmployeePermission_Call) Run(run func(ctx context.Context, arg repository.CreateEmployeePermissionParams)) *MockStore_CreateEmployeePermission_Call {
+func (_c *MockStore_CountPendingSmartCards_Call) Run(run func(ctx context.Context)) *MockStore_CountPendingSmartCards_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(repository.CreateEmployeePermissionParams))
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateEmployeePermission_Call) Return(_a0 repository.EmployeePermission, _a1 error) *MockStore_CreateEmployeePermission_Call {
+func (_c *MockStore_CountPendingSmartCards_Call) Return(_a0 int64, _a1 error) *MockStore_CountPendingSmartCards_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateEmployeePermission_Call) RunAndReturn(run func(context.Context, repository.CreateEmployeePermissionParams) (repository.EmployeePermission, error)) *MockStore_CreateEmployeePermission_Call {
+func (_c *MockStore_CountPendingSmartCards_Call) RunAndReturn(run func(context.Context) (int64, error)) *MockStore_CountPendingSmartCards_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateEmployeePresence provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateEmployeePresence(ctx context.Context, arg repository.CreateEmployeePresenceParams) (repository.EmployeePresence, error) {
+// CountSantri provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CountSantri(ctx context.Context, arg repository.CountSantriParams) (int64, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateEmployeePresence")
+		panic("no return value specified for CountSantri")
 	}
 
-	var r0 repository.EmployeePresence
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateEmployeePresenceParams) (repository.EmployeePresence, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CountSantriParams) (int64, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateEmployeePresenceParams) repository.EmployeePresence); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CountSantriParams) int64); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(repository.EmployeePresence)
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateEmployeePresenceParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CountSantriParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -736,55 +739,55 @@ func (_m *MockStore) CreateEmployeePresence(ctx context.Context, arg repository.
 	return r0, r1
 }
 
-// MockStore_CreateEmployeePresence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEmployeePresence'
-type MockStore_CreateEmployeePresence_Call struct {
+// MockStore_CountSantri_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountSantri'
+type MockStore_CountSantri_Call struct {
 	*mock.Call
 }
 
-// CreateEmployeePresence is a helper method to define mock.On call
+// CountSantri is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.CreateEmployeePresenceParams
-func (_e *MockStore_Expecter) CreateEmployeePresence(ctx interface{}, arg interface{}) *MockStore_CreateEmployeePresence_Call {
-	return &MockStore_CreateEmployeePresence_Call{Call: _e.mock.On("CreateEmployeePresence", ctx, arg)}
+//   - arg repository.CountSantriParams
+func (_e *MockStore_Expecter) CountSantri(ctx interface{}, arg interface{}) *MockStore_CountSantri_Call {
+	return &MockStore_CountSantri_Call{Call: _e.mock.On("CountSantri", ctx, arg)}
 }
 
-func (_c *MockStore_CreateEmployeePresence_Call) Run(run func(ctx context.Context, arg repository.CreateEmployeePresenceParams)) *MockStore_CreateEmployeePresence_Call {
+func (_c *MockStore_CountSantri_Call) Run(run func(ctx context.Context, arg repository.CountSantriParams)) *MockStore_CountSantri_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(repository.CreateEmployeePresenceParams))
+		run(args[0].(context.Context), args[1].(repository.CountSantriParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateEmployeePresence_Call) Return(_a0 repository.EmployeePresence, _a1 error) *MockStore_CreateEmployeePresence_Call {
+func (_c *MockStore_CountSantri_Call) Return(_a0 int64, _a1 error) *MockStore_CountSantri_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateEmployeePresence_Call) RunAndReturn(run func(context.Context, repository.CreateEmployeePresenceParams) (repository.EmployeePresence, error)) *MockStore_CreateEmployeePresence_Call {
+func (_c *MockStore_CountSantri_Call) RunAndReturn(run func(context.Context, repository.CountSantriParams) (int64, error)) *MockStore_CountSantri_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateEmployeePresences provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateEmployeePresences(ctx context.Context, arg []repository.CreateEmployeePresencesParams) (int64, error) {
+// CountSantriLeaveRequests provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CountSantriLeaveRequests(ctx context.Context, arg repository.CountSantriLeaveRequestsParams) (int64, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateEmployeePresences")
+		panic("no return value specified for CountSantriLeaveRequests")
 	}
 
 	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, []repository.CreateEmployeePresencesParams) (int64, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CountSantriLeaveRequestsParams) (int64, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, []repository.CreateEmployeePresencesParams) int64); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CountSantriLeaveRequestsParams) int64); ok {
 		r0 = rf(ctx, arg)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, []repository.CreateEmployeePresencesParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CountSantriLeaveRequestsParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -793,55 +796,55 @@ func (_m *MockStore) CreateEmployeePresences(ctx context.Context, arg []reposito
 	return r0, r1
 }
 
-// MockStore_CreateEmployeePresences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEmployeePresences'
-type MockStore_CreateEmployeePresences_Call struct {
+// MockStore_CountSantriLeaveRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountSantriLeaveRequests'
+type MockStore_CountSantriLeaveRequests_Call struct {
 	*mock.Call
 }
 
-// CreateEmployeePresences is a helper method to define mock.On call
+// CountSantriLeaveRequests is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg []repository.CreateEmployeePresencesParams
-func (_e *MockStore_Expecter) CreateEmployeePresences(ctx interface{}, arg interface{}) *MockStore_CreateEmployeePresences_Call {
-	return &MockStore_CreateEmployeePresences_Call{Call: _e.mock.On("CreateEmployeePresences", ctx, arg)}
+//   - arg repository.CountSantriLeaveRequestsParams
+func (_e *MockStore_Expecter) CountSantriLeaveRequests(ctx interface{}, arg interface{}) *MockStore_CountSantriLeaveRequests_Call {
+	return &MockStore_CountSantriLeaveRequests_Call{Call: _e.mock.On("CountSantriLeaveRequests", ctx, arg)}
 }
 
-func (_c *MockStore_CreateEmployeePresences_Call) Run(run func(ctx context.Context, arg []repository.CreateEmployeePresencesParams)) *MockStore_CreateEmployeePresences_Call {
+func (_c *MockStore_CountSantriLeaveRequests_Call) Run(run func(ctx context.Context, arg repository.CountSantriLeaveRequestsParams)) *MockStore_CountSantriLeaveRequests_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].([]repository.CreateEmployeePresencesParams))
+		run(args[0].(context.Context), args[1].(repository.CountSantriLeaveRequestsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateEmployeePresences_Call) Return(_a0 int64, _a1 error) *MockStore_CreateEmployeePresences_Call {
+func (_c *MockStore_CountSantriLeaveRequests_Call) Return(_a0 int64, _a1 error) *MockStore_CountSantriLeaveRequests_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateEmployeePresences_Call) RunAndReturn(run func(context.Context, []repository.CreateEmployeePresencesParams) (int64, error)) *MockStore_CreateEmployeePresences_Call {
+func (_c *MockStore_CountSantriLeaveRequests_Call) RunAndReturn(run func(context.Context, repository.CountSantriLeaveRequestsParams) (int64, error)) *MockStore_CountSantriLeaveRequests_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateParent provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateParent(ctx context.Context, arg repository.CreateParentParams) (repository.Parent, error) {
+// CountSantriPermissions provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CountSantriPermissions(ctx context.Context, arg repository.CountSantriPermissionsParams) (int64, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateParent")
+		panic("no return value specified for CountSantriPermissions")
 	}
 
-	var r0 repository.Parent
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateParentParams) (repository.Parent, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CountSantriPermissionsParams) (int64, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateParentParams) repository.Parent); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CountSantriPermissionsParams) int64); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(repository.Parent)
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateParentParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CountSantriPermissionsParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -850,55 +853,55 @@ func (_m *MockStore) CreateParent(ctx context.Context, arg repository.CreatePare
 	return r0, r1
 }
 
-// MockStore_CreateParent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateParent'
-type MockStore_CreateParent_Call struct {
+// MockStore_CountSantriPermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountSantriPermissions'
+type MockStore_CountSantriPermissions_Call struct {
 	*mock.Call
 }
 
-// CreateParent is a helper method to define mock.On call
+// CountSantriPermissions is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.CreateParentParams
-func (_e *MockStore_Expecter) CreateParent(ctx interface{}, arg interface{}) *MockStore_CreateParent_Call {
-	return &MockStore_CreateParent_Call{Call: _e.mock.On("CreateParent", ctx, arg)}
+//   - arg repository.CountSantriPermissionsParams
+func (_e *MockStore_Expecter) CountSantriPermissions(ctx interface{}, arg interface{}) *MockStore_CountSantriPermissions_Call {
+	return &MockStore_CountSantriPermissions_Call{Call: _e.mock.On("CountSantriPermissions", ctx, arg)}
 }
 
-func (_c *MockStore_CreateParent_Call) Run(run func(ctx context.Context, arg repository.CreateParentParams)) *MockStore_CreateParent_Call {
+func (_c *MockStore_CountSantriPermissions_Call) Run(run func(ctx context.Context, arg repository.CountSantriPermissionsParams)) *MockStore_CountSantriPermissions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(repository.CreateParentParams))
+		run(args[0].(context.Context), args[1].(repository.CountSantriPermissionsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateParent_Call) Return(_a0 repository.Parent, _a1 error) *MockStore_CreateParent_Call {
+func (_c *MockStore_CountSantriPermissions_Call) Return(_a0 int64, _a1 error) *MockStore_CountSantriPermissions_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateParent_Call) RunAndReturn(run func(context.Context, repository.CreateParentParams) (repository.Parent, error)) *MockStore_CreateParent_Call {
+func (_c *MockStore_CountSantriPermissions_Call) RunAndReturn(run func(context.Context, repository.CountSantriPermissionsParams) (int64, error)) *MockStore_CountSantriPermissions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateSantri provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateSantri(ctx context.Context, arg repository.CreateSantriParams) (repository.Santri, error) {
+// CountSantriPresences provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CountSantriPresences(ctx context.Context, arg repository.CountSantriPresencesParams) (int64, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateSantri")
+		panic("no return value specified for CountSantriPresences")
 	}
 
-	var r0 repository.Santri
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateSantriParams) (repository.Santri, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CountSantriPresencesParams) (int64, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateSantriParams) repository.Santri); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CountSantriPresencesParams) int64); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(repository.Santri)
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateSantriParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CountSantriPresencesParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -907,55 +910,55 @@ func (_m *MockStore) CreateSantri(ctx context.Context, arg repository.CreateSant
 	return r0, r1
 }
 
-// MockStore_CreateSantri_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSantri'
-type MockStore_CreateSantri_Call struct {
+// MockStore_CountSantriPresences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountSantriPresences'
+type MockStore_CountSantriPresences_Call struct {
 	*mock.Call
 }
 
-// CreateSantri is a helper method to define mock.On call
+// CountSantriPresences is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.CreateSantriParams
-func (_e *MockStore_Expecter) CreateSantri(ctx interface{}, arg interface{}) *MockStore_CreateSantri_Call {
-	return &MockStore_CreateSantri_Call{Call: _e.mock.On("CreateSantri", ctx, arg)}
+//   - arg repository.CountSantriPresencesParams
+func (_e *MockStore_Expecter) CountSantriPresences(ctx interface{}, arg interface{}) *MockStore_CountSantriPresences_Call {
+	return &MockStore_CountSantriPresences_Call{Call: _e.mock.On("CountSantriPresences", ctx, arg)}
 }
 
-func (_c *MockStore_CreateSantri_Call) Run(run func(ctx context.Context, arg repository.CreateSantriParams)) *MockStore_CreateSantri_Call {
+func (_c *MockStore_CountSantriPresences_Call) Run(run func(ctx context.Context, arg repository.CountSantriPresencesParams)) *MockStore_CountSantriPresences_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(repository.CreateSantriParams))
+		run(args[0].(context.Context), args[1].(repository.CountSantriPresencesParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateSantri_Call) Return(_a0 repository.Santri, _a1 error) *MockStore_CreateSantri_Call {
+func (_c *MockStore_CountSantriPresences_Call) Return(_a0 int64, _a1 error) *MockStore_CountSantriPresences_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateSantri_Call) RunAndReturn(run func(context.Context, repository.CreateSantriParams) (repository.Santri, error)) *MockStore_CreateSantri_Call {
+func (_c *MockStore_CountSantriPresences_Call) RunAndReturn(run func(context.Context, repository.CountSantriPresencesParams) (int64, error)) *MockStore_CountSantriPresences_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateSantriOccupation provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateSantriOccupation(ctx context.Context, arg repository.CreateSantriOccupationParams) (repository.SantriOccupation, error) {
+// CountSmartCards provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CountSmartCards(ctx context.Context, arg repository.CountSmartCardsParams) (int64, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateSantriOccupation")
+		panic("no return value specified for CountSmartCards")
 	}
 
-	var r0 repository.SantriOccupation
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateSantriOccupationParams) (repository.SantriOccupation, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CountSmartCardsParams) (int64, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateSantriOccupationParams) repository.SantriOccupation); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CountSmartCardsParams) int64); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(repository.SantriOccupation)
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateSantriOccupationParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CountSmartCardsParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -964,55 +967,55 @@ func (_m *MockStore) CreateSantriOccupation(ctx context.Context, arg repository.
 	return r0, r1
 }
 
-// MockStore_CreateSantriOccupation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSantriOccupation'
-type MockStore_CreateSantriOccupation_Call struct {
+// MockStore_CountSmartCards_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountSmartCards'
+type MockStore_CountSmartCards_Call struct {
 	*mock.Call
 }
 
-// CreateSantriOccupation is a helper method to define mock.On call
+// CountSmartCards is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.CreateSantriOccupationParams
-func (_e *MockStore_Expecter) CreateSantriOccupation(ctx interface{}, arg interface{}) *MockStore_CreateSantriOccupation_Call {
-	return &MockStore_CreateSantriOccupation_Call{Call: _e.mock.On("CreateSantriOccupation", ctx, arg)}
+//   - arg repository.CountSmartCardsParams
+func (_e *MockStore_Expecter) CountSmartCards(ctx interface{}, arg interface{}) *MockStore_CountSmartCards_Call {
+	return &MockStore_CountSmartCards_Call{Call: _e.mock.On("CountSmartCards", ctx, arg)}
 }
 
-func (_c *MockStore_CreateSantriOccupation_Call) Run(run func(ctx context.Context, arg repository.CreateSantriOccupationParams)) *MockStore_CreateSantriOccupation_Call {
+func (_c *MockStore_CountSmartCards_Call) Run(run func(ctx context.Context, arg repository.CountSmartCardsParams)) *MockStore_CountSmartCards_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(repository.CreateSantriOccupationParams))
+		run(args[0].(context.Context), args[1].(repository.CountSmartCardsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateSantriOccupation_Call) Return(_a0 repository.SantriOccupation, _a1 error) *MockStore_CreateSantriOccupation_Call {
+func (_c *MockStore_CountSmartCards_Call) Return(_a0 int64, _a1 error) *MockStore_CountSmartCards_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateSantriOccupation_Call) RunAndReturn(run func(context.Context, repository.CreateSantriOccupationParams) (repository.SantriOccupation, error)) *MockStore_CreateSantriOccupation_Call {
+func (_c *MockStore_CountSmartCards_Call) RunAndReturn(run func(context.Context, repository.CountSmartCardsParams) (int64, error)) *MockStore_CountSmartCards_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateSantriPermission provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateSantriPermission(ctx context.Context, arg repository.CreateSantriPermissionParams) (repository.SantriPermission, error) {
+// CountTapEvents provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CountTapEvents(ctx context.Context, arg repository.CountTapEventsParams) (int64, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateSantriPermission")
+		panic("no return value specified for CountTapEvents")
 	}
 
-	var r0 repository.SantriPermission
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateSantriPermissionParams) (repository.SantriPermission, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CountTapEventsParams) (int64, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateSantriPermissionParams) repository.SantriPermission); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CountTapEventsParams) int64); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(repository.SantriPermission)
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateSantriPermissionParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CountTapEventsParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1021,55 +1024,55 @@ func (_m *MockStore) CreateSantriPermission(ctx context.Context, arg repository.
 	return r0, r1
 }
 
-// MockStore_CreateSantriPermission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSantriPermission'
-type MockStore_CreateSantriPermission_Call struct {
+// MockStore_CountTapEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountTapEvents'
+type MockStore_CountTapEvents_Call struct {
 	*mock.Call
 }
 
-// CreateSantriPermission is a helper method to define mock.On call
+// CountTapEvents is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.CreateSantriPermissionParams
-func (_e *MockStore_Expecter) CreateSantriPermission(ctx interface{}, arg interface{}) *MockStore_CreateSantriPermission_Call {
-	return &MockStore_CreateSantriPermission_Call{Call: _e.mock.On("CreateSantriPermission", ctx, arg)}
+//   - arg repository.CountTapEventsParams
+func (_e *MockStore_Expecter) CountTapEvents(ctx interface{}, arg interface{}) *MockStore_CountTapEvents_Call {
+	return &MockStore_CountTapEvents_Call{Call: _e.mock.On("CountTapEvents", ctx, arg)}
 }
 
-func (_c *MockStore_CreateSantriPermission_Call) Run(run func(ctx context.Context, arg repository.CreateSantriPermissionParams)) *MockStore_CreateSantriPermission_Call {
+func (_c *MockStore_CountTapEvents_Call) Run(run func(ctx context.Context, arg repository.CountTapEventsParams)) *MockStore_CountTapEvents_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(repository.CreateSantriPermissionParams))
+		run(args[0].(context.Context), args[1].(repository.CountTapEventsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateSantriPermission_Call) Return(_a0 repository.SantriPermission, _a1 error) *MockStore_CreateSantriPermission_Call {
+func (_c *MockStore_CountTapEvents_Call) Return(_a0 int64, _a1 error) *MockStore_CountTapEvents_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateSantriPermission_Call) RunAndReturn(run func(context.Context, repository.CreateSantriPermissionParams) (repository.SantriPermission, error)) *MockStore_CreateSantriPermission_Call {
+func (_c *MockStore_CountTapEvents_Call) RunAndReturn(run func(context.Context, repository.CountTapEventsParams) (int64, error)) *MockStore_CountTapEvents_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateSantriPresence provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateSantriPresence(ctx context.Context, arg repository.CreateSantriPresenceParams) (repository.SantriPresence, error) {
+// CountUsers provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CountUsers(ctx context.Context, arg repository.CountUsersParams) (int64, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateSantriPresence")
+		panic("no return value specified for CountUsers")
 	}
 
-	var r0 repository.SantriPresence
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateSantriPresenceParams) (repository.SantriPresence, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CountUsersParams) (int64, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateSantriPresenceParams) repository.SantriPresence); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CountUsersParams) int64); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(repository.SantriPresence)
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateSantriPresenceParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CountUsersParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1078,55 +1081,55 @@ func (_m *MockStore) CreateSantriPresence(ctx context.Context, arg repository.Cr
 	return r0, r1
 }
 
-// MockStore_CreateSantriPresence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSantriPresence'
-type MockStore_CreateSantriPresence_Call struct {
+// MockStore_CountUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUsers'
+type MockStore_CountUsers_Call struct {
 	*mock.Call
 }
 
-// CreateSantriPresence is a helper method to define mock.On call
+// CountUsers is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.CreateSantriPresenceParams
-func (_e *MockStore_Expecter) CreateSantriPresence(ctx interface{}, arg interface{}) *MockStore_CreateSantriPresence_Call {
-	return &MockStore_CreateSantriPresence_Call{Call: _e.mock.On("CreateSantriPresence", ctx, arg)}
+//   - arg repository.CountUsersParams
+func (_e *MockStore_Expecter) CountUsers(ctx interface{}, arg interface{}) *MockStore_CountUsers_Call {
+	return &MockStore_CountUsers_Call{Call: _e.mock.On("CountUsers", ctx, arg)}
 }
 
-func (_c *MockStore_CreateSantriPresence_Call) Run(run func(ctx context.Context, arg repository.CreateSantriPresenceParams)) *MockStore_CreateSantriPresence_Call {
+func (_c *MockStore_CountUsers_Call) Run(run func(ctx context.Context, arg repository.CountUsersParams)) *MockStore_CountUsers_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(repository.CreateSantriPresenceParams))
+		run(args[0].(context.Context), args[1].(repository.CountUsersParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateSantriPresence_Call) Return(_a0 repository.SantriPresence, _a1 error) *MockStore_CreateSantriPresence_Call {
+func (_c *MockStore_CountUsers_Call) Return(_a0 int64, _a1 error) *MockStore_CountUsers_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateSantriPresence_Call) RunAndReturn(run func(context.Context, repository.CreateSantriPresenceParams) (repository.SantriPresence, error)) *MockStore_CreateSantriPresence_Call {
+func (_c *MockStore_CountUsers_Call) RunAndReturn(run func(context.Context, repository.CountUsersParams) (int64, error)) *MockStore_CountUsers_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateSantriPresences provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateSantriPresences(ctx context.Context, arg []repository.CreateSantriPresencesParams) (int64, error) {
+// CreateDevice provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateDevice(ctx context.Context, arg repository.CreateDeviceParams) (repository.Device, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateSantriPresences")
+		panic("no return value specified for CreateDevice")
 	}
 
-	var r0 int64
+	var r0 repository.Device
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, []repository.CreateSantriPresencesParams) (int64, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateDeviceParams) (repository.Device, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, []repository.CreateSantriPresencesParams) int64); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateDeviceParams) repository.Device); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(int64)
+		r0 = ret.Get(0).(repository.Device)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, []repository.CreateSantriPresencesParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateDeviceParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1135,55 +1138,55 @@ func (_m *MockStore) CreateSantriPresences(ctx context.Context, arg []repository
 	return r0, r1
 }
 
-// MockStore_CreateSantriPresences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSantriPresences'
-type MockStore_CreateSantriPresences_Call struct {
+// MockStore_CreateDevice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDevice'
+type MockStore_CreateDevice_Call struct {
 	*mock.Call
 }
 
-// CreateSantriPresences is a helper method to define mock.On call
+// CreateDevice is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg []repository.CreateSantriPresencesParams
-func (_e *MockStore_Expecter) CreateSantriPresences(ctx interface{}, arg interface{}) *MockStore_CreateSantriPresences_Call {
-	return &MockStore_CreateSantriPresences_Call{Call: _e.mock.On("CreateSantriPresences", ctx, arg)}
+//   - arg repository.CreateDeviceParams
+func (_e *MockStore_Expecter) CreateDevice(ctx interface{}, arg interface{}) *MockStore_CreateDevice_Call {
+	return &MockStore_CreateDevice_Call{Call: _e.mock.On("CreateDevice", ctx, arg)}
 }
 
-func (_c *MockStore_CreateSantriPresences_Call) Run(run func(ctx context.Context, arg []repository.CreateSantriPresencesParams)) *MockStore_CreateSantriPresences_Call {
+func (_c *MockStore_CreateDevice_Call) Run(run func(ctx context.Context, arg repository.CreateDeviceParams)) *MockStore_CreateDevice_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].([]repository.CreateSantriPresencesParams))
+		run(args[0].(context.Context), args[1].(repository.CreateDeviceParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateSantriPresences_Call) Return(_a0 int64, _a1 error) *MockStore_CreateSantriPresences_Call {
+func (_c *MockStore_CreateDevice_Call) Return(_a0 repository.Device, _a1 error) *MockStore_CreateDevice_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateSantriPresences_Call) RunAndReturn(run func(context.Context, []repository.CreateSantriPresencesParams) (int64, error)) *MockStore_CreateSantriPresences_Call {
+func (_c *MockStore_CreateDevice_Call) RunAndReturn(run func(context.Context, repository.CreateDeviceParams) (repository.Device, error)) *MockStore_CreateDevice_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateSmartCard provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateSmartCard(ctx context.Context, arg repository.CreateSmartCardParams) (repository.SmartCard, error) {
+// CreateDeviceAllowRules provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateDeviceAllowRules(ctx context.Context, arg []repository.CreateDeviceAllowRulesParams) (int64, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateSmartCard")
+		panic("no return value specified for CreateDeviceAllowRules")
 	}
 
-	var r0 repository.SmartCard
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateSmartCardParams) (repository.SmartCard, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, []repository.CreateDeviceAllowRulesParams) (int64, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateSmartCardParams) repository.SmartCard); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, []repository.CreateDeviceAllowRulesParams) int64); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(repository.SmartCard)
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateSmartCardParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, []repository.CreateDeviceAllowRulesParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1192,55 +1195,55 @@ func (_m *MockStore) CreateSmartCard(ctx context.Context, arg repository.CreateS
 	return r0, r1
 }
 
-// MockStore_CreateSmartCard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSmartCard'
-type MockStore_CreateSmartCard_Call struct {
+// MockStore_CreateDeviceAllowRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDeviceAllowRules'
+type MockStore_CreateDeviceAllowRules_Call struct {
 	*mock.Call
 }
 
-// CreateSmartCard is a helper method to define mock.On call
+// CreateDeviceAllowRules is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.CreateSmartCardParams
-func (_e *MockStore_Expecter) CreateSmartCard(ctx interface{}, arg interface{}) *MockStore_CreateSmartCard_Call {
-	return &MockStore_CreateSmartCard_Call{Call: _e.mock.On("CreateSmartCard", ctx, arg)}
+//   - arg []repository.CreateDeviceAllowRulesParams
+func (_e *MockStore_Expecter) CreateDeviceAllowRules(ctx interface{}, arg interface{}) *MockStore_CreateDeviceAllowRules_Call {
+	return &MockStore_CreateDeviceAllowRules_Call{Call: _e.mock.On("CreateDeviceAllowRules", ctx, arg)}
 }
 
-func (_c *MockStore_CreateSmartCard_Call) Run(run func(ctx context.Context, arg repository.CreateSmartCardParams)) *MockStore_CreateSmartCard_Call {
+func (_c *MockStore_CreateDeviceAllowRules_Call) Run(run func(ctx context.Context, arg []repository.CreateDeviceAllowRulesParams)) *MockStore_CreateDeviceAllowRules_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(repository.CreateSmartCardParams))
+		run(args[0].(context.Context), args[1].([]repository.CreateDeviceAllowRulesParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateSmartCard_Call) Return(_a0 repository.SmartCard, _a1 error) *MockStore_CreateSmartCard_Call {
+func (_c *MockStore_CreateDeviceAllowRules_Call) Return(_a0 int64, _a1 error) *MockStore_CreateDeviceAllowRules_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateSmartCard_Call) RunAndReturn(run func(context.Context, repository.CreateSmartCardParams) (repository.SmartCard, error)) *MockStore_CreateSmartCard_Call {
+func (_c *MockStore_CreateDeviceAllowRules_Call) RunAndReturn(run func(context.Context, []repository.CreateDeviceAllowRulesParams) (int64, error)) *MockStore_CreateDeviceAllowRules_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateUser provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateUser(ctx context.Context, arg repository.CreateUserParams) (repository.User, error) {
+// CreateDeviceBatchTap provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateDeviceBatchTap(ctx context.Context, arg repository.CreateDeviceBatchTapParams) (repository.DeviceBatchTap, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateUser")
+		panic("no return value specified for CreateDeviceBatchTap")
 	}
 
-	var r0 repository.User
+	var r0 repository.DeviceBatchTap
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateUserParams) (repository.User, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateDeviceBatchTapParams) (repository.DeviceBatchTap, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateUserParams) repository.User); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateDeviceBatchTapParams) repository.DeviceBatchTap); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(repository.User)
+		r0 = ret.Get(0).(repository.DeviceBatchTap)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateUserParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateDeviceBatchTapParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1249,56 +1252,56 @@ func (_m *MockStore) CreateUser(ctx context.Context, arg repository.CreateUserPa
 	return r0, r1
 }
 
-// MockStore_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
-type MockStore_CreateUser_Call struct {
+// MockStore_CreateDeviceBatchTap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDeviceBatchTap'
+type MockStore_CreateDeviceBatchTap_Call struct {
 	*mock.Call
 }
 
-// CreateUser is a helper method to define mock.On call
+// CreateDeviceBatchTap is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.CreateUserParams
-func (_e *MockStore_Expecter) CreateUser(ctx interface{}, arg interface{}) *MockStore_CreateUser_Call {
-	return &MockStore_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, arg)}
+//   - arg repository.CreateDeviceBatchTapParams
+func (_e *MockStore_Expecter) CreateDeviceBatchTap(ctx interface{}, arg interface{}) *MockStore_CreateDeviceBatchTap_Call {
+	return &MockStore_CreateDeviceBatchTap_Call{Call: _e.mock.On("CreateDeviceBatchTap", ctx, arg)}
 }
 
-func (_c *MockStore_CreateUser_Call) Run(run func(ctx context.Context, arg repository.CreateUserParams)) *MockStore_CreateUser_Call {
+func (_c *MockStore_CreateDeviceBatchTap_Call) Run(run func(ctx context.Context, arg repository.CreateDeviceBatchTapParams)) *MockStore_CreateDeviceBatchTap_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(repository.CreateUserParams))
+		run(args[0].(context.Context), args[1].(repository.CreateDeviceBatchTapParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateUser_Call) Return(_a0 repository.User, _a1 error) *MockStore_CreateUser_Call {
+func (_c *MockStore_CreateDeviceBatchTap_Call) Return(_a0 repository.DeviceBatchTap, _a1 error) *MockStore_CreateDeviceBatchTap_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateUser_Call) RunAndReturn(run func(context.Context, repository.CreateUserParams) (repository.User, error)) *MockStore_CreateUser_Call {
+func (_c *MockStore_CreateDeviceBatchTap_Call) RunAndReturn(run func(context.Context, repository.CreateDeviceBatchTapParams) (repository.DeviceBatchTap, error)) *MockStore_CreateDeviceBatchTap_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteDevice provides a mock function with given fields: ctx, id
-func (_m *MockStore) DeleteDevice(ctx context.Context, id int32) (repository.Device, error) {
-	ret := _m.Called(ctx, id)
+// CreateDeviceCommand provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateDeviceCommand(ctx context.Context, arg repository.CreateDeviceCommandParams) (repository.DeviceCommand, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteDevice")
+		panic("no return value specified for CreateDeviceCommand")
 	}
 
-	var r0 repository.Device
+	var r0 repository.DeviceCommand
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.Device, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateDeviceCommandParams) (repository.DeviceCommand, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.Device); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateDeviceCommandParams) repository.DeviceCommand); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(repository.Device)
+		r0 = ret.Get(0).(repository.DeviceCommand)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateDeviceCommandParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1306,103 +1309,113 @@ func (_m *MockStore) DeleteDevice(ctx context.Context, id int32) (repository.Dev
 	return r0, r1
 }
 
-// MockStore_DeleteDevice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDevice'
-type MockStore_DeleteDevice_Call struct {
+// MockStore_CreateDeviceCommand_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDeviceCommand'
+type MockStore_CreateDeviceCommand_Call struct {
 	*mock.Call
 }
 
-// DeleteDevice is a helper method to define mock.On call
+// CreateDeviceCommand is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int32
-func (_e *MockStore_Expecter) DeleteDevice(ctx interface{}, id interface{}) *MockStore_DeleteDevice_Call {
-	return &MockStore_DeleteDevice_Call{Call: _e.mock.On("DeleteDevice", ctx, id)}
+//   - arg repository.CreateDeviceCommandParams
+func (_e *MockStore_Expecter) CreateDeviceCommand(ctx interface{}, arg interface{}) *MockStore_CreateDeviceCommand_Call {
+	return &MockStore_CreateDeviceCommand_Call{Call: _e.mock.On("CreateDeviceCommand", ctx, arg)}
 }
 
-func (_c *MockStore_DeleteDevice_Call) Run(run func(ctx context.Context, id int32)) *MockStore_DeleteDevice_Call {
+func (_c *MockStore_CreateDeviceCommand_Call) Run(run func(ctx context.Context, arg repository.CreateDeviceCommandParams)) *MockStore_CreateDeviceCommand_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int32))
+		run(args[0].(context.Context), args[1].(repository.CreateDeviceCommandParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_DeleteDevice_Call) Return(_a0 repository.Device, _a1 error) *MockStore_DeleteDevice_Call {
+func (_c *MockStore_CreateDeviceCommand_Call) Return(_a0 repository.DeviceCommand, _a1 error) *MockStore_CreateDeviceCommand_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_DeleteDevice_Call) RunAndReturn(run func(context.Context, int32) (repository.Device, error)) *MockStore_DeleteDevice_Call {
+func (_c *MockStore_CreateDeviceCommand_Call) RunAndReturn(run func(context.Context, repository.CreateDeviceCommandParams) (repository.DeviceCommand, error)) *MockStore_CreateDeviceCommand_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteDeviceModeByDeviceId provides a mock function with given fields: ctx, deviceID
-func (_m *MockStore) DeleteDeviceModeByDeviceId(ctx context.Context, deviceID int32) error {
-	ret := _m.Called(ctx, deviceID)
+// CreateDeviceModes provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateDeviceModes(ctx context.Context, arg []repository.CreateDeviceModesParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteDeviceModeByDeviceId")
+		panic("no return value specified for CreateDeviceModes")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
-		r0 = rf(ctx, deviceID)
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []repository.CreateDeviceModesParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []repository.CreateDeviceModesParams) int64); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(int64)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, []repository.CreateDeviceModesParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockStore_DeleteDeviceModeByDeviceId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDeviceModeByDeviceId'
-type MockStore_DeleteDeviceModeByDeviceId_Call struct {
+// MockStore_CreateDeviceModes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDeviceModes'
+type MockStore_CreateDeviceModes_Call struct {
 	*mock.Call
 }
 
-// DeleteDeviceModeByDeviceId is a helper method to define mock.On call
+// CreateDeviceModes is a helper method to define mock.On call
 //   - ctx context.Context
-//   - deviceID int32
-func (_e *MockStore_Expecter) DeleteDeviceModeByDeviceId(ctx interface{}, deviceID interface{}) *MockStore_DeleteDeviceModeByDeviceId_Call {
-	return &MockStore_DeleteDeviceModeByDeviceId_Call{Call: _e.mock.On("DeleteDeviceModeByDeviceId", ctx, deviceID)}
+//   - arg []repository.CreateDeviceModesParams
+func (_e *MockStore_Expecter) CreateDeviceModes(ctx interface{}, arg interface{}) *MockStore_CreateDeviceModes_Call {
+	return &MockStore_CreateDeviceModes_Call{Call: _e.mock.On("CreateDeviceModes", ctx, arg)}
 }
 
-func (_c *MockStore_DeleteDeviceModeByDeviceId_Call) Run(run func(ctx context.Context, deviceID int32)) *MockStore_DeleteDeviceModeByDeviceId_Call {
+func (_c *MockStore_CreateDeviceModes_Call) Run(run func(ctx context.Context, arg []repository.CreateDeviceModesParams)) *MockStore_CreateDeviceModes_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int32))
+		run(args[0].(context.Context), args[1].([]repository.CreateDeviceModesParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_DeleteDeviceModeByDeviceId_Call) Return(_a0 error) *MockStore_DeleteDeviceModeByDeviceId_Call {
-	_c.Call.Return(_a0)
+func (_c *MockStore_CreateDeviceModes_Call) Return(_a0 int64, _a1 error) *MockStore_CreateDeviceModes_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_DeleteDeviceModeByDeviceId_Call) RunAndReturn(run func(context.Context, int32) error) *MockStore_DeleteDeviceModeByDeviceId_Call {
+func (_c *MockStore_CreateDeviceModes_Call) RunAndReturn(run func(context.Context, []repository.CreateDeviceModesParams) (int64, error)) *MockStore_CreateDeviceModes_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteEmployee provides a mock function with given fields: ctx, id
-func (_m *MockStore) DeleteEmployee(ctx context.Context, id int32) (repository.Employee, error) {
-	ret := _m.Called(ctx, id)
+// CreateEmployee provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateEmployee(ctx context.Context, arg repository.CreateEmployeeParams) (repository.Employee, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteEmployee")
+		panic("no return value specified for CreateEmployee")
 	}
 
 	var r0 repository.Employee
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.Employee, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateEmployeeParams) (repository.Employee, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.Employee); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateEmployeeParams) repository.Employee); ok {
+		r0 = rf(ctx, arg)
 	} else {
 		r0 = ret.Get(0).(repository.Employee)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateEmployeeParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1410,56 +1423,56 @@ func (_m *MockStore) DeleteEmployee(ctx context.Context, id int32) (repository.E
 	return r0, r1
 }
 
-// MockStore_DeleteEmployee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteEmployee'
-type MockStore_DeleteEmployee_Call struct {
+// MockStore_CreateEmployee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEmployee'
+type MockStore_CreateEmployee_Call struct {
 	*mock.Call
 }
 
-// DeleteEmployee is a helper method to define mock.On call
+// CreateEmployee is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int32
-func (_e *MockStore_Expecter) DeleteEmployee(ctx interface{}, id interface{}) *MockStore_DeleteEmployee_Call {
-	return &MockStore_DeleteEmployee_Call{Call: _e.mock.On("DeleteEmployee", ctx, id)}
+//   - arg repository.CreateEmployeeParams
+func (_e *MockStore_Expecter) CreateEmployee(ctx interface{}, arg interface{}) *MockStore_CreateEmployee_Call {
+	return &MockStore_CreateEmployee_Call{Call: _e.mock.On("CreateEmployee", ctx, arg)}
 }
 
-func (_c *MockStore_DeleteEmployee_Call) Run(run func(ctx context.Context, id int32)) *MockStore_DeleteEmployee_Call {
+func (_c *MockStore_CreateEmployee_Call) Run(run func(ctx context.Context, arg repository.CreateEmployeeParams)) *MockStore_CreateEmployee_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int32))
+		run(args[0].(context.Context), args[1].(repository.CreateEmployeeParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_DeleteEmployee_Call) Return(_a0 repository.Employee, _a1 error) *MockStore_DeleteEmployee_Call {
+func (_c *MockStore_CreateEmployee_Call) Return(_a0 repository.Employee, _a1 error) *MockStore_CreateEmployee_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_DeleteEmployee_Call) RunAndReturn(run func(context.Context, int32) (repository.Employee, error)) *MockStore_DeleteEmployee_Call {
+func (_c *MockStore_CreateEmployee_Call) RunAndReturn(run func(context.Context, repository.CreateEmployeeParams) (repository.Employee, error)) *MockStore_CreateEmployee_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteEmployeeOccupation provides a mock function with given fields: ctx, id
-func (_m *MockStore) DeleteEmployeeOccupation(ctx context.Context, id int32) (repository.EmployeeOccupation, error) {
-	ret := _m.Called(ctx, id)
+// CreateEmployeeOccupation provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateEmployeeOccupation(ctx context.Context, arg repository.CreateEmployeeOccupationParams) (repository.EmployeeOccupation, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteEmployeeOccupation")
+		panic("no return value specified for CreateEmployeeOccupation")
 	}
 
 	var r0 repository.EmployeeOccupation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.EmployeeOccupation, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateEmployeeOccupationParams) (repository.EmployeeOccupation, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.EmployeeOccupation); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateEmployeeOccupationParams) repository.EmployeeOccupation); ok {
+		r0 = rf(ctx, arg)
 	} else {
 		r0 = ret.Get(0).(repository.EmployeeOccupation)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateEmployeeOccupationParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1467,56 +1480,56 @@ func (_m *MockStore) DeleteEmployeeOccupation(ctx context.Context, id int32) (re
 	return r0, r1
 }
 
-// MockStore_DeleteEmployeeOccupation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteEmployeeOccupation'
-type MockStore_DeleteEmployeeOccupation_Call struct {
+// MockStore_CreateEmployeeOccupation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEmployeeOccupation'
+type MockStore_CreateEmployeeOccupation_Call struct {
 	*mock.Call
 }
 
-// DeleteEmployeeOccupation is a helper method to define mock.On call
+// CreateEmployeeOccupation is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int32
-func (_e *MockStore_Expecter) DeleteEmployeeOccupation(ctx interface{}, id interface{}) *MockStore_DeleteEmployeeOccupation_Call {
-	return &MockStore_DeleteEmployeeOccupation_Call{Call: _e.mock.On("DeleteEmployeeOccupation", ctx, id)}
+//   - arg repository.CreateEmployeeOccupationParams
+func (_e *MockStore_Expecter) CreateEmployeeOccupation(ctx interface{}, arg interface{}) *MockStore_CreateEmployeeOccupation_Call {
+	return &MockStore_CreateEmployeeOccupation_Call{Call: _e.mock.On("CreateEmployeeOccupation", ctx, arg)}
 }
 
-func (_c *MockStore_DeleteEmployeeOccupation_Call) Run(run func(ctx context.Context, id int32)) *MockStore_DeleteEmployeeOccupation_Call {
+func (_c *MockStore_CreateEmployeeOccupation_Call) Run(run func(ctx context.Context, arg repository.CreateEmployeeOccupationParams)) *MockStore_CreateEmployeeOccupation_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int32))
+		run(args[0].(context.Context), args[1].(repository.CreateEmployeeOccupationParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_DeleteEmployeeOccupation_Call) Return(_a0 repository.EmployeeOccupation, _a1 error) *MockStore_DeleteEmployeeOccupation_Call {
+func (_c *MockStore_CreateEmployeeOccupation_Call) Return(_a0 repository.EmployeeOccupation, _a1 error) *MockStore_CreateEmployeeOccupation_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_DeleteEmployeeOccupation_Call) RunAndReturn(run func(context.Context, int32) (repository.EmployeeOccupation, error)) *MockStore_DeleteEmployeeOccupation_Call {
+func (_c *MockStore_CreateEmployeeOccupation_Call) RunAndReturn(run func(context.Context, repository.CreateEmployeeOccupationParams) (repository.EmployeeOccupation, error)) *MockStore_CreateEmployeeOccupation_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteEmployeePermission provides a mock function with given fields: ctx, id
-func (_m *MockStore) DeleteEmployeePermission(ctx context.Context, id int32) (repository.EmployeePermission, error) {
-	ret := _m.Called(ctx, id)
+// CreateEmployeePermission provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateEmployeePermission(ctx context.Context, arg repository.CreateEmployeePermissionParams) (repository.EmployeePermission, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteEmployeePermission")
+		panic("no return value specified for CreateEmployeePermission")
 	}
 
 	var r0 repository.EmployeePermission
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.EmployeePermission, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateEmployeePermissionParams) (repository.EmployeePermission, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.EmployeePermission); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateEmployeePermissionParams) repository.EmployeePermission); ok {
+		r0 = rf(ctx, arg)
 	} else {
 		r0 = ret.Get(0).(repository.EmployeePermission)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateEmployeePermissionParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1524,56 +1537,56 @@ func (_m *MockStore) DeleteEmployeePermission(ctx context.Context, id int32) (re
 	return r0, r1
 }
 
-// MockStore_DeleteEmployeePermission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteEmployeePermission'
-type MockStore_DeleteEmployeePermission_Call struct {
+// MockStore_CreateEmployeePermission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEmployeePermission'
+type MockStore_CreateEmployeePermission_Call struct {
 	*mock.Call
 }
 
-// DeleteEmployeePermission is a helper method to define mock.On call
+// CreateEmployeePermission is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int32
-func (_e *MockStore_Expecter) DeleteEmployeePermission(ctx interface{}, id interface{}) *MockStore_DeleteEmployeePermission_Call {
-	return &MockStore_DeleteEmployeePermission_Call{Call: _e.mock.On("DeleteEmployeePermission", ctx, id)}
+//   - arg repository.CreateEmployeePermissionParams
+func (_e *MockStore_Expecter) CreateEmployeePermission(ctx interface{}, arg interface{}) *MockStore_CreateEmployeePermission_Call {
+	return &MockStore_CreateEmployeePermission_Call{Call: _e.mock.On("CreateEmployeePermission", ctx, arg)}
 }
 
-func (_c *MockStore_DeleteEmployeePermission_Call) Run(run func(ctx context.Context, id int32)) *MockStore_DeleteEmployeePermission_Call {
+func (_c *MockStore_CreateEmployeePermission_Call) Run(run func(ctx context.Context, arg repository.CreateEmployeePermissionParams)) *MockStore_CreateEmployeePermission_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int32))
+		run(args[0].(context.Context), args[1].(repository.CreateEmployeePermissionParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_DeleteEmployeePermission_Call) Return(_a0 repository.EmployeePermission, _a1 error) *MockStore_DeleteEmployeePermission_Call {
+func (_c *MockStore_CreateEmployeePermission_Call) Return(_a0 repository.EmployeePermission, _a1 error) *MockStore_CreateEmployeePermission_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_DeleteEmployeePermission_Call) RunAndReturn(run func(context.Context, int32) (repository.EmployeePermission, error)) *MockStore_DeleteEmployeePermission_Call {
+func (_c *MockStore_CreateEmployeePermission_Call) RunAndReturn(run func(context.Context, repository.CreateEmployeePermissionParams) (repository.EmployeePermission, error)) *MockStore_CreateEmployeePermission_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteEmployeePresence provides a mock function with given fields: ctx, id
-func (_m *MockStore) DeleteEmployeePresence(ctx context.Context, id int32) (repository.EmployeePresence, error) {
-	ret := _m.Called(ctx, id)
+// CreateEmployeePresence provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateEmployeePresence(ctx context.Context, arg repository.CreateEmployeePresenceParams) (repository.EmployeePresence, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteEmployeePresence")
+		panic("no return value specified for CreateEmployeePresence")
 	}
 
 	var r0 repository.EmployeePresence
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.EmployeePresence, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateEmployeePresenceParams) (repository.EmployeePresence, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.EmployeePresence); ok {
-		r0 = rf(ctx, id)
-	} else {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateEmployeePresenceParams) repository.EmployeePresence); ok {
+		r0 = rf(ctx, arg)
+	} else {
 		r0 = ret.Get(0).(repository.EmployeePresence)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateEmployeePresenceParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1581,56 +1594,56 @@ func (_m *MockStore) DeleteEmployeePresence(ctx context.Context, id int32) (repo
 	return r0, r1
 }
 
-// MockStore_DeleteEmployeePresence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteEmployeePresence'
-type MockStore_DeleteEmployeePresence_Call struct {
+// MockStore_CreateEmployeePresence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEmployeePresence'
+type MockStore_CreateEmployeePresence_Call struct {
 	*mock.Call
 }
 
-// DeleteEmployeePresence is a helper method to define mock.On call
+// CreateEmployeePresence is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int32
-func (_e *MockStore_Expecter) DeleteEmployeePresence(ctx interface{}, id interface{}) *MockStore_DeleteEmployeePresence_Call {
-	return &MockStore_DeleteEmployeePresence_Call{Call: _e.mock.On("DeleteEmployeePresence", ctx, id)}
+//   - arg repository.CreateEmployeePresenceParams
+func (_e *MockStore_Expecter) CreateEmployeePresence(ctx interface{}, arg interface{}) *MockStore_CreateEmployeePresence_Call {
+	return &MockStore_CreateEmployeePresence_Call{Call: _e.mock.On("CreateEmployeePresence", ctx, arg)}
 }
 
-func (_c *MockStore_DeleteEmployeePresence_Call) Run(run func(ctx context.Context, id int32)) *MockStore_DeleteEmployeePresence_Call {
+func (_c *MockStore_CreateEmployeePresence_Call) Run(run func(ctx context.Context, arg repository.CreateEmployeePresenceParams)) *MockStore_CreateEmployeePresence_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int32))
+		run(args[0].(context.Context), args[1].(repository.CreateEmployeePresenceParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_DeleteEmployeePresence_Call) Return(_a0 repository.EmployeePresence, _a1 error) *MockStore_DeleteEmployeePresence_Call {
+func (_c *MockStore_CreateEmployeePresence_Call) Return(_a0 repository.EmployeePresence, _a1 error) *MockStore_CreateEmployeePresence_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_DeleteEmployeePresence_Call) RunAndReturn(run func(context.Context, int32) (repository.EmployeePresence, error)) *MockStore_DeleteEmployeePresence_Call {
+func (_c *MockStore_CreateEmployeePresence_Call) RunAndReturn(run func(context.Context, repository.CreateEmployeePresenceParams) (repository.EmployeePresence, error)) *MockStore_CreateEmployeePresence_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteParent provides a mock function with given fields: ctx, id
-func (_m *MockStore) DeleteParent(ctx context.Context, id int32) (repository.Parent, error) {
-	ret := _m.Called(ctx, id)
+// CreateEmployeePresences provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateEmployeePresences(ctx context.Context, arg []repository.CreateEmployeePresencesParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteParent")
+		panic("no return value specified for CreateEmployeePresences")
 	}
 
-	var r0 repository.Parent
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.Parent, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, []repository.CreateEmployeePresencesParams) (int64, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.Parent); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, []repository.CreateEmployeePresencesParams) int64); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(repository.Parent)
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, []repository.CreateEmployeePresencesParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1638,56 +1651,56 @@ func (_m *MockStore) DeleteParent(ctx context.Context, id int32) (repository.Par
 	return r0, r1
 }
 
-// MockStore_DeleteParent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteParent'
-type MockStore_DeleteParent_Call struct {
+// MockStore_CreateEmployeePresences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEmployeePresences'
+type MockStore_CreateEmployeePresences_Call struct {
 	*mock.Call
 }
 
-// DeleteParent is a helper method to define mock.On call
+// CreateEmployeePresences is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int32
-func (_e *MockStore_Expecter) DeleteParent(ctx interface{}, id interface{}) *MockStore_DeleteParent_Call {
-	return &MockStore_DeleteParent_Call{Call: _e.mock.On("DeleteParent", ctx, id)}
+//   - arg []repository.CreateEmployeePresencesParams
+func (_e *MockStore_Expecter) CreateEmployeePresences(ctx interface{}, arg interface{}) *MockStore_CreateEmployeePresences_Call {
+	return &MockStore_CreateEmployeePresences_Call{Call: _e.mock.On("CreateEmployeePresences", ctx, arg)}
 }
 
-func (_c *MockStore_DeleteParent_Call) Run(run func(ctx context.Context, id int32)) *MockStore_DeleteParent_Call {
+func (_c *MockStore_CreateEmployeePresences_Call) Run(run func(ctx context.Context, arg []repository.CreateEmployeePresencesParams)) *MockStore_CreateEmployeePresences_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int32))
+		run(args[0].(context.Context), args[1].([]repository.CreateEmployeePresencesParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_DeleteParent_Call) Return(_a0 repository.Parent, _a1 error) *MockStore_DeleteParent_Call {
+func (_c *MockStore_CreateEmployeePresences_Call) Return(_a0 int64, _a1 error) *MockStore_CreateEmployeePresences_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_DeleteParent_Call) RunAndReturn(run func(context.Context, int32) (repository.Parent, error)) *MockStore_DeleteParent_Call {
+func (_c *MockStore_CreateEmployeePresences_Call) RunAndReturn(run func(context.Context, []repository.CreateEmployeePresencesParams) (int64, error)) *MockStore_CreateEmployeePresences_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteSantri provides a mock function with given fields: ctx, id
-func (_m *MockStore) DeleteSantri(ctx context.Context, id int32) (repository.Santri, error) {
-	ret := _m.Called(ctx, id)
+// CreateEnrollmentSession provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateEnrollmentSession(ctx context.Context, arg repository.CreateEnrollmentSessionParams) (repository.EnrollmentSession, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteSantri")
+		panic("no return value specified for CreateEnrollmentSession")
 	}
 
-	var r0 repository.Santri
+	var r0 repository.EnrollmentSession
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.Santri, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateEnrollmentSessionParams) (repository.EnrollmentSession, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.Santri); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateEnrollmentSessionParams) repository.EnrollmentSession); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(repository.Santri)
+		r0 = ret.Get(0).(repository.EnrollmentSession)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateEnrollmentSessionParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1695,56 +1708,56 @@ func (_m *MockStore) DeleteSantri(ctx context.Context, id int32) (repository.San
 	return r0, r1
 }
 
-// MockStore_DeleteSantri_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSantri'
-type MockStore_DeleteSantri_Call struct {
+// MockStore_CreateEnrollmentSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEnrollmentSession'
+type MockStore_CreateEnrollmentSession_Call struct {
 	*mock.Call
 }
 
-// DeleteSantri is a helper method to define mock.On call
+// CreateEnrollmentSession is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int32
-func (_e *MockStore_Expecter) DeleteSantri(ctx interface{}, id interface{}) *MockStore_DeleteSantri_Call {
-	return &MockStore_DeleteSantri_Call{Call: _e.mock.On("DeleteSantri", ctx, id)}
+//   - arg repository.CreateEnrollmentSessionParams
+func (_e *MockStore_Expecter) CreateEnrollmentSession(ctx interface{}, arg interface{}) *MockStore_CreateEnrollmentSession_Call {
+	return &MockStore_CreateEnrollmentSession_Call{Call: _e.mock.On("CreateEnrollmentSession", ctx, arg)}
 }
 
-func (_c *MockStore_DeleteSantri_Call) Run(run func(ctx context.Context, id int32)) *MockStore_DeleteSantri_Call {
+func (_c *MockStore_CreateEnrollmentSession_Call) Run(run func(ctx context.Context, arg repository.CreateEnrollmentSessionParams)) *MockStore_CreateEnrollmentSession_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int32))
+		run(args[0].(context.Context), args[1].(repository.CreateEnrollmentSessionParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_DeleteSantri_Call) Return(_a0 repository.Santri, _a1 error) *MockStore_DeleteSantri_Call {
+func (_c *MockStore_CreateEnrollmentSession_Call) Return(_a0 repository.EnrollmentSession, _a1 error) *MockStore_CreateEnrollmentSession_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_DeleteSantri_Call) RunAndReturn(run func(context.Context, int32) (repository.Santri, error)) *MockStore_DeleteSantri_Call {
+func (_c *MockStore_CreateEnrollmentSession_Call) RunAndReturn(run func(context.Context, repository.CreateEnrollmentSessionParams) (repository.EnrollmentSession, error)) *MockStore_CreateEnrollmentSession_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteSantriOccupation provides a mock function with given fields: ctx, id
-func (_m *MockStore) DeleteSantriOccupation(ctx context.Context, id int32) (repository.SantriOccupation, error) {
-	ret := _m.Called(ctx, id)
+// CreateEnrollmentSessionItems provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateEnrollmentSessionItems(ctx context.Context, arg []repository.CreateEnrollmentSessionItemsParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteSantriOccupation")
+		panic("no return value specified for CreateEnrollmentSessionItems")
 	}
 
-	var r0 repository.SantriOccupation
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.SantriOccupation, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, []repository.CreateEnrollmentSessionItemsParams) (int64, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.SantriOccupation); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, []repository.CreateEnrollmentSessionItemsParams) int64); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(repository.SantriOccupation)
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, []repository.CreateEnrollmentSessionItemsParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1752,56 +1765,56 @@ func (_m *MockStore) DeleteSantriOccupation(ctx context.Context, id int32) (repo
 	return r0, r1
 }
 
-// MockStore_DeleteSantriOccupation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSantriOccupation'
-type MockStore_DeleteSantriOccupation_Call struct {
+// MockStore_CreateEnrollmentSessionItems_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEnrollmentSessionItems'
+type MockStore_CreateEnrollmentSessionItems_Call struct {
 	*mock.Call
 }
 
-// DeleteSantriOccupation is a helper method to define mock.On call
+// CreateEnrollmentSessionItems is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int32
-func (_e *MockStore_Expecter) DeleteSantriOccupation(ctx interface{}, id interface{}) *MockStore_DeleteSantriOccupation_Call {
-	return &MockStore_DeleteSantriOccupation_Call{Call: _e.mock.On("DeleteSantriOccupation", ctx, id)}
+//   - arg []repository.CreateEnrollmentSessionItemsParams
+func (_e *MockStore_Expecter) CreateEnrollmentSessionItems(ctx interface{}, arg interface{}) *MockStore_CreateEnrollmentSessionItems_Call {
+	return &MockStore_CreateEnrollmentSessionItems_Call{Call: _e.mock.On("CreateEnrollmentSessionItems", ctx, arg)}
 }
 
-func (_c *MockStore_DeleteSantriOccupation_Call) Run(run func(ctx context.Context, id int32)) *MockStore_DeleteSantriOccupation_Call {
+func (_c *MockStore_CreateEnrollmentSessionItems_Call) Run(run func(ctx context.Context, arg []repository.CreateEnrollmentSessionItemsParams)) *MockStore_CreateEnrollmentSessionItems_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int32))
+		run(args[0].(context.Context), args[1].([]repository.CreateEnrollmentSessionItemsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_DeleteSantriOccupation_Call) Return(_a0 repository.SantriOccupation, _a1 error) *MockStore_DeleteSantriOccupation_Call {
+func (_c *MockStore_CreateEnrollmentSessionItems_Call) Return(_a0 int64, _a1 error) *MockStore_CreateEnrollmentSessionItems_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_DeleteSantriOccupation_Call) RunAndReturn(run func(context.Context, int32) (repository.SantriOccupation, error)) *MockStore_DeleteSantriOccupation_Call {
+func (_c *MockStore_CreateEnrollmentSessionItems_Call) RunAndReturn(run func(context.Context, []repository.CreateEnrollmentSessionItemsParams) (int64, error)) *MockStore_CreateEnrollmentSessionItems_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteSantriPermission provides a mock function with given fields: ctx, id
-func (_m *MockStore) DeleteSantriPermission(ctx context.Context, id int32) (repository.SantriPermission, error) {
-	ret := _m.Called(ctx, id)
+// CreateEnrollmentSessionWithItems provides a mock function with given fields: ctx, arg, itemParams
+func (_m *MockStore) CreateEnrollmentSessionWithItems(ctx context.Context, arg repository.CreateEnrollmentSessionParams, itemParams []repository.CreateEnrollmentSessionItemsParams) (repository.EnrollmentSession, error) {
+	ret := _m.Called(ctx, arg, itemParams)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteSantriPermission")
+		panic("no return value specified for CreateEnrollmentSessionWithItems")
 	}
 
-	var r0 repository.SantriPermission
+	var r0 repository.EnrollmentSession
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.SantriPermission, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateEnrollmentSessionParams, []repository.CreateEnrollmentSessionItemsParams) (repository.EnrollmentSession, error)); ok {
+		return rf(ctx, arg, itemParams)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.SantriPermission); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateEnrollmentSessionParams, []repository.CreateEnrollmentSessionItemsParams) repository.EnrollmentSession); ok {
+		r0 = rf(ctx, arg, itemParams)
 	} else {
-		r0 = ret.Get(0).(repository.SantriPermission)
+		r0 = ret.Get(0).(repository.EnrollmentSession)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateEnrollmentSessionParams, []repository.CreateEnrollmentSessionItemsParams) error); ok {
+		r1 = rf(ctx, arg, itemParams)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1809,56 +1822,57 @@ func (_m *MockStore) DeleteSantriPermission(ctx context.Context, id int32) (repo
 	return r0, r1
 }
 
-// MockStore_DeleteSantriPermission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSantriPermission'
-type MockStore_DeleteSantriPermission_Call struct {
+// MockStore_CreateEnrollmentSessionWithItems_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEnrollmentSessionWithItems'
+type MockStore_CreateEnrollmentSessionWithItems_Call struct {
 	*mock.Call
 }
 
-// DeleteSantriPermission is a helper method to define mock.On call
+// CreateEnrollmentSessionWithItems is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int32
-func (_e *MockStore_Expecter) DeleteSantriPermission(ctx interface{}, id interface{}) *MockStore_DeleteSantriPermission_Call {
-	return &MockStore_DeleteSantriPermission_Call{Call: _e.mock.On("DeleteSantriPermission", ctx, id)}
+//   - arg repository.CreateEnrollmentSessionParams
+//   - itemParams []repository.CreateEnrollmentSessionItemsParams
+func (_e *MockStore_Expecter) CreateEnrollmentSessionWithItems(ctx interface{}, arg interface{}, itemParams interface{}) *MockStore_CreateEnrollmentSessionWithItems_Call {
+	return &MockStore_CreateEnrollmentSessionWithItems_Call{Call: _e.mock.On("CreateEnrollmentSessionWithItems", ctx, arg, itemParams)}
 }
 
-func (_c *MockStore_DeleteSantriPermission_Call) Run(run func(ctx context.Context, id int32)) *MockStore_DeleteSantriPermission_Call {
+func (_c *MockStore_CreateEnrollmentSessionWithItems_Call) Run(run func(ctx context.Context, arg repository.CreateEnrollmentSessionParams, itemParams []repository.CreateEnrollmentSessionItemsParams)) *MockStore_CreateEnrollmentSessionWithItems_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int32))
+		run(args[0].(context.Context), args[1].(repository.CreateEnrollmentSessionParams), args[2].([]repository.CreateEnrollmentSessionItemsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_DeleteSantriPermission_Call) Return(_a0 repository.SantriPermission, _a1 error) *MockStore_DeleteSantriPermission_Call {
+func (_c *MockStore_CreateEnrollmentSessionWithItems_Call) Return(_a0 repository.EnrollmentSession, _a1 error) *MockStore_CreateEnrollmentSessionWithItems_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_DeleteSantriPermission_Call) RunAndReturn(run func(context.Context, int32) (repository.SantriPermission, error)) *MockStore_DeleteSantriPermission_Call {
+func (_c *MockStore_CreateEnrollmentSessionWithItems_Call) RunAndReturn(run func(context.Context, repository.CreateEnrollmentSessionParams, []repository.CreateEnrollmentSessionItemsParams) (repository.EnrollmentSession, error)) *MockStore_CreateEnrollmentSessionWithItems_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteSantriPresence provides a mock function with given fields: ctx, id
-func (_m *MockStore) DeleteSantriPresence(ctx context.Context, id int32) (repository.SantriPresence, error) {
-	ret := _m.Called(ctx, id)
+// CreateHoliday provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateHoliday(ctx context.Context, arg repository.CreateHolidayParams) (repository.Holiday, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteSantriPresence")
+		panic("no return value specified for CreateHoliday")
 	}
 
-	var r0 repository.SantriPresence
+	var r0 repository.Holiday
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.SantriPresence, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateHolidayParams) (repository.Holiday, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.SantriPresence); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateHolidayParams) repository.Holiday); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(repository.SantriPresence)
+		r0 = ret.Get(0).(repository.Holiday)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateHolidayParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1866,56 +1880,56 @@ func (_m *MockStore) DeleteSantriPresence(ctx context.Context, id int32) (reposi
 	return r0, r1
 }
 
-// MockStore_DeleteSantriPresence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSantriPresence'
-type MockStore_DeleteSantriPresence_Call struct {
+// MockStore_CreateHoliday_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateHoliday'
+type MockStore_CreateHoliday_Call struct {
 	*mock.Call
 }
 
-// DeleteSantriPresence is a helper method to define mock.On call
+// CreateHoliday is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int32
-func (_e *MockStore_Expecter) DeleteSantriPresence(ctx interface{}, id interface{}) *MockStore_DeleteSantriPresence_Call {
-	return &MockStore_DeleteSantriPresence_Call{Call: _e.mock.On("DeleteSantriPresence", ctx, id)}
+//   - arg repository.CreateHolidayParams
+func (_e *MockStore_Expecter) CreateHoliday(ctx interface{}, arg interface{}) *MockStore_CreateHoliday_Call {
+	return &MockStore_CreateHoliday_Call{Call: _e.mock.On("CreateHoliday", ctx, arg)}
 }
 
-func (_c *MockStore_DeleteSantriPresence_Call) Run(run func(ctx context.Context, id int32)) *MockStore_DeleteSantriPresence_Call {
+func (_c *MockStore_CreateHoliday_Call) Run(run func(ctx context.Context, arg repository.CreateHolidayParams)) *MockStore_CreateHoliday_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int32))
+		run(args[0].(context.Context), args[1].(repository.CreateHolidayParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_DeleteSantriPresence_Call) Return(_a0 repository.SantriPresence, _a1 error) *MockStore_DeleteSantriPresence_Call {
+func (_c *MockStore_CreateHoliday_Call) Return(_a0 repository.Holiday, _a1 error) *MockStore_CreateHoliday_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_DeleteSantriPresence_Call) RunAndReturn(run func(context.Context, int32) (repository.SantriPresence, error)) *MockStore_DeleteSantriPresence_Call {
+func (_c *MockStore_CreateHoliday_Call) RunAndReturn(run func(context.Context, repository.CreateHolidayParams) (repository.Holiday, error)) *MockStore_CreateHoliday_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteSmartCard provides a mock function with given fields: ctx, id
-func (_m *MockStore) DeleteSmartCard(ctx context.Context, id int32) (repository.SmartCard, error) {
-	ret := _m.Called(ctx, id)
+// CreateHolidayDates provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateHolidayDates(ctx context.Context, arg []repository.CreateHolidayDatesParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteSmartCard")
+		panic("no return value specified for CreateHolidayDates")
 	}
 
-	var r0 repository.SmartCard
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.SmartCard, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, []repository.CreateHolidayDatesParams) (int64, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.SmartCard); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, []repository.CreateHolidayDatesParams) int64); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(repository.SmartCard)
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, []repository.CreateHolidayDatesParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1923,56 +1937,56 @@ func (_m *MockStore) DeleteSmartCard(ctx context.Context, id int32) (repository.
 	return r0, r1
 }
 
-// MockStore_DeleteSmartCard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSmartCard'
-type MockStore_DeleteSmartCard_Call struct {
+// MockStore_CreateHolidayDates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateHolidayDates'
+type MockStore_CreateHolidayDates_Call struct {
 	*mock.Call
 }
 
-// DeleteSmartCard is a helper method to define mock.On call
+// CreateHolidayDates is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int32
-func (_e *MockStore_Expecter) DeleteSmartCard(ctx interface{}, id interface{}) *MockStore_DeleteSmartCard_Call {
-	return &MockStore_DeleteSmartCard_Call{Call: _e.mock.On("DeleteSmartCard", ctx, id)}
+//   - arg []repository.CreateHolidayDatesParams
+func (_e *MockStore_Expecter) CreateHolidayDates(ctx interface{}, arg interface{}) *MockStore_CreateHolidayDates_Call {
+	return &MockStore_CreateHolidayDates_Call{Call: _e.mock.On("CreateHolidayDates", ctx, arg)}
 }
 
-func (_c *MockStore_DeleteSmartCard_Call) Run(run func(ctx context.Context, id int32)) *MockStore_DeleteSmartCard_Call {
+func (_c *MockStore_CreateHolidayDates_Call) Run(run func(ctx context.Context, arg []repository.CreateHolidayDatesParams)) *MockStore_CreateHolidayDates_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int32))
+		run(args[0].(context.Context), args[1].([]repository.CreateHolidayDatesParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_DeleteSmartCard_Call) Return(_a0 repository.SmartCard, _a1 error) *MockStore_DeleteSmartCard_Call {
+func (_c *MockStore_CreateHolidayDates_Call) Return(_a0 int64, _a1 error) *MockStore_CreateHolidayDates_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_DeleteSmartCard_Call) RunAndReturn(run func(context.Context, int32) (repository.SmartCard, error)) *MockStore_DeleteSmartCard_Call {
+func (_c *MockStore_CreateHolidayDates_Call) RunAndReturn(run func(context.Context, []repository.CreateHolidayDatesParams) (int64, error)) *MockStore_CreateHolidayDates_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteUser provides a mock function with given fields: ctx, id
-func (_m *MockStore) DeleteUser(ctx context.Context, id int32) (repository.User, error) {
-	ret := _m.Called(ctx, id)
+// CreateHolidayWithDates provides a mock function with given fields: ctx, arg, dates
+func (_m *MockStore) CreateHolidayWithDates(ctx context.Context, arg repository.CreateHolidayParams, dates []pgtype.Date) (repository.Holiday, error) {
+	ret := _m.Called(ctx, arg, dates)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteUser")
+		panic("no return value specified for CreateHolidayWithDates")
 	}
 
-	var r0 repository.User
+	var r0 repository.Holiday
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.User, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateHolidayParams, []pgtype.Date) (repository.Holiday, error)); ok {
+		return rf(ctx, arg, dates)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.User); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateHolidayParams, []pgtype.Date) repository.Holiday); ok {
+		r0 = rf(ctx, arg, dates)
 	} else {
-		r0 = ret.Get(0).(repository.User)
+		r0 = ret.Get(0).(repository.Holiday)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateHolidayParams, []pgtype.Date) error); ok {
+		r1 = rf(ctx, arg, dates)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1980,56 +1994,57 @@ func (_m *MockStore) DeleteUser(ctx context.Context, id int32) (repository.User,
 	return r0, r1
 }
 
-// MockStore_DeleteUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUser'
-type MockStore_DeleteUser_Call struct {
+// MockStore_CreateHolidayWithDates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateHolidayWithDates'
+type MockStore_CreateHolidayWithDates_Call struct {
 	*mock.Call
 }
 
-// DeleteUser is a helper method to define mock.On call
+// CreateHolidayWithDates is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int32
-func (_e *MockStore_Expecter) DeleteUser(ctx interface{}, id interface{}) *MockStore_DeleteUser_Call {
-	return &MockStore_DeleteUser_Call{Call: _e.mock.On("DeleteUser", ctx, id)}
+//   - arg repository.CreateHolidayParams
+//   - dates []pgtype.Date
+func (_e *MockStore_Expecter) CreateHolidayWithDates(ctx interface{}, arg interface{}, dates interface{}) *MockStore_CreateHolidayWithDates_Call {
+	return &MockStore_CreateHolidayWithDates_Call{Call: _e.mock.On("CreateHolidayWithDates", ctx, arg, dates)}
 }
 
-func (_c *MockStore_DeleteUser_Call) Run(run func(ctx context.Context, id int32)) *MockStore_DeleteUser_Call {
+func (_c *MockStore_CreateHolidayWithDates_Call) Run(run func(ctx context.Context, arg repository.CreateHolidayParams, dates []pgtype.Date)) *MockStore_CreateHolidayWithDates_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int32))
+		run(args[0].(context.Context), args[1].(repository.CreateHolidayParams), args[2].([]pgtype.Date))
 	})
 	return _c
 }
 
-func (_c *MockStore_DeleteUser_Call) Return(_a0 repository.User, _a1 error) *MockStore_DeleteUser_Call {
+func (_c *MockStore_CreateHolidayWithDates_Call) Return(_a0 repository.Holiday, _a1 error) *MockStore_CreateHolidayWithDates_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_DeleteUser_Call) RunAndReturn(run func(context.Context, int32) (repository.User, error)) *MockStore_DeleteUser_Call {
+func (_c *MockStore_CreateHolidayWithDates_Call) RunAndReturn(run func(context.Context, repository.CreateHolidayParams, []pgtype.Date) (repository.Holiday, error)) *MockStore_CreateHolidayWithDates_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetEmployeeByID provides a mock function with given fields: ctx, id
-func (_m *MockStore) GetEmployeeByID(ctx context.Context, id int32) (repository.GetEmployeeByIDRow, error) {
-	ret := _m.Called(ctx, id)
+// CreateLocation provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateLocation(ctx context.Context, arg repository.CreateLocationParams) (repository.Location, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetEmployeeByID")
+		panic("no return value specified for CreateLocation")
 	}
 
-	var r0 repository.GetEmployeeByIDRow
+	var r0 repository.Location
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.GetEmployeeByIDRow, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateLocationParams) (repository.Location, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.GetEmployeeByIDRow); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateLocationParams) repository.Location); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(repository.GetEmployeeByIDRow)
+		r0 = ret.Get(0).(repository.Location)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateLocationParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2037,56 +2052,56 @@ func (_m *MockStore) GetEmployeeByID(ctx context.Context, id int32) (repository.
 	return r0, r1
 }
 
-// MockStore_GetEmployeeByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEmployeeByID'
-type MockStore_GetEmployeeByID_Call struct {
+// MockStore_CreateLocation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateLocation'
+type MockStore_CreateLocation_Call struct {
 	*mock.Call
 }
 
-// GetEmployeeByID is a helper method to define mock.On call
+// CreateLocation is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int32
-func (_e *MockStore_Expecter) GetEmployeeByID(ctx interface{}, id interface{}) *MockStore_GetEmployeeByID_Call {
-	return &MockStore_GetEmployeeByID_Call{Call: _e.mock.On("GetEmployeeByID", ctx, id)}
+//   - arg repository.CreateLocationParams
+func (_e *MockStore_Expecter) CreateLocation(ctx interface{}, arg interface{}) *MockStore_CreateLocation_Call {
+	return &MockStore_CreateLocation_Call{Call: _e.mock.On("CreateLocation", ctx, arg)}
 }
 
-func (_c *MockStore_GetEmployeeByID_Call) Run(run func(ctx context.Context, id int32)) *MockStore_GetEmployeeByID_Call {
+func (_c *MockStore_CreateLocation_Call) Run(run func(ctx context.Context, arg repository.CreateLocationParams)) *MockStore_CreateLocation_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int32))
+		run(args[0].(context.Context), args[1].(repository.CreateLocationParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetEmployeeByID_Call) Return(_a0 repository.GetEmployeeByIDRow, _a1 error) *MockStore_GetEmployeeByID_Call {
+func (_c *MockStore_CreateLocation_Call) Return(_a0 repository.Location, _a1 error) *MockStore_CreateLocation_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetEmployeeByID_Call) RunAndReturn(run func(context.Context, int32) (repository.GetEmployeeByIDRow, error)) *MockStore_GetEmployeeByID_Call {
+func (_c *MockStore_CreateLocation_Call) RunAndReturn(run func(context.Context, repository.CreateLocationParams) (repository.Location, error)) *MockStore_CreateLocation_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetEmployeeByUserID provides a mock function with given fields: ctx, userID
-func (_m *MockStore) GetEmployeeByUserID(ctx context.Context, userID pgtype.Int4) (repository.Employee, error) {
-	ret := _m.Called(ctx, userID)
+// CreateParent provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateParent(ctx context.Context, arg repository.CreateParentParams) (repository.Parent, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetEmployeeByUserID")
+		panic("no return value specified for CreateParent")
 	}
 
-	var r0 repository.Employee
+	var r0 repository.Parent
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Int4) (repository.Employee, error)); ok {
-		return rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateParentParams) (repository.Parent, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Int4) repository.Employee); ok {
-		r0 = rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateParentParams) repository.Parent); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(repository.Employee)
+		r0 = ret.Get(0).(repository.Parent)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Int4) error); ok {
-		r1 = rf(ctx, userID)
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateParentParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2094,56 +2109,56 @@ func (_m *MockStore) GetEmployeeByUserID(ctx context.Context, userID pgtype.Int4
 	return r0, r1
 }
 
-// MockStore_GetEmployeeByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEmployeeByUserID'
-type MockStore_GetEmployeeByUserID_Call struct {
+// MockStore_CreateParent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateParent'
+type MockStore_CreateParent_Call struct {
 	*mock.Call
 }
 
-// GetEmployeeByUserID is a helper method to define mock.On call
+// CreateParent is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID pgtype.Int4
-func (_e *MockStore_Expecter) GetEmployeeByUserID(ctx interface{}, userID interface{}) *MockStore_GetEmployeeByUserID_Call {
-	return &MockStore_GetEmployeeByUserID_Call{Call: _e.mock.On("GetEmployeeByUserID", ctx, userID)}
+//   - arg repository.CreateParentParams
+func (_e *MockStore_Expecter) CreateParent(ctx interface{}, arg interface{}) *MockStore_CreateParent_Call {
+	return &MockStore_CreateParent_Call{Call: _e.mock.On("CreateParent", ctx, arg)}
 }
 
-func (_c *MockStore_GetEmployeeByUserID_Call) Run(run func(ctx context.Context, userID pgtype.Int4)) *MockStore_GetEmployeeByUserID_Call {
+func (_c *MockStore_CreateParent_Call) Run(run func(ctx context.Context, arg repository.CreateParentParams)) *MockStore_CreateParent_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(pgtype.Int4))
+		run(args[0].(context.Context), args[1].(repository.CreateParentParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetEmployeeByUserID_Call) Return(_a0 repository.Employee, _a1 error) *MockStore_GetEmployeeByUserID_Call {
+func (_c *MockStore_CreateParent_Call) Return(_a0 repository.Parent, _a1 error) *MockStore_CreateParent_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetEmployeeByUserID_Call) RunAndReturn(run func(context.Context, pgtype.Int4) (repository.Employee, error)) *MockStore_GetEmployeeByUserID_Call {
+func (_c *MockStore_CreateParent_Call) RunAndReturn(run func(context.Context, repository.CreateParentParams) (repository.Parent, error)) *MockStore_CreateParent_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetEmployeePermission provides a mock function with given fields: ctx, id
-func (_m *MockStore) GetEmployeePermission(ctx context.Context, id int32) (repository.GetEmployeePermissionRow, error) {
-	ret := _m.Called(ctx, id)
+// CreateSantri provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateSantri(ctx context.Context, arg repository.CreateSantriParams) (repository.Santri, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetEmployeePermission")
+		panic("no return value specified for CreateSantri")
 	}
 
-	var r0 repository.GetEmployeePermissionRow
+	var r0 repository.Santri
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.GetEmployeePermissionRow, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateSantriParams) (repository.Santri, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.GetEmployeePermissionRow); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateSantriParams) repository.Santri); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(repository.GetEmployeePermissionRow)
+		r0 = ret.Get(0).(repository.Santri)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateSantriParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2151,56 +2166,56 @@ func (_m *MockStore) GetEmployeePermission(ctx context.Context, id int32) (repos
 	return r0, r1
 }
 
-// MockStore_GetEmployeePermission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEmployeePermission'
-type MockStore_GetEmployeePermission_Call struct {
+// MockStore_CreateSantri_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSantri'
+type MockStore_CreateSantri_Call struct {
 	*mock.Call
 }
 
-// GetEmployeePermission is a helper method to define mock.On call
+// CreateSantri is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int32
-func (_e *MockStore_Expecter) GetEmployeePermission(ctx interface{}, id interface{}) *MockStore_GetEmployeePermission_Call {
-	return &MockStore_GetEmployeePermission_Call{Call: _e.mock.On("GetEmployeePermission", ctx, id)}
+//   - arg repository.CreateSantriParams
+func (_e *MockStore_Expecter) CreateSantri(ctx interface{}, arg interface{}) *MockStore_CreateSantri_Call {
+	return &MockStore_CreateSantri_Call{Call: _e.mock.On("CreateSantri", ctx, arg)}
 }
 
-func (_c *MockStore_GetEmployeePermission_Call) Run(run func(ctx context.Context, id int32)) *MockStore_GetEmployeePermission_Call {
+func (_c *MockStore_CreateSantri_Call) Run(run func(ctx context.Context, arg repository.CreateSantriParams)) *MockStore_CreateSantri_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int32))
+		run(args[0].(context.Context), args[1].(repository.CreateSantriParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetEmployeePermission_Call) Return(_a0 repository.GetEmployeePermissionRow, _a1 error) *MockStore_GetEmployeePermission_Call {
+func (_c *MockStore_CreateSantri_Call) Return(_a0 repository.Santri, _a1 error) *MockStore_CreateSantri_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetEmployeePermission_Call) RunAndReturn(run func(context.Context, int32) (repository.GetEmployeePermissionRow, error)) *MockStore_GetEmployeePermission_Call {
+func (_c *MockStore_CreateSantri_Call) RunAndReturn(run func(context.Context, repository.CreateSantriParams) (repository.Santri, error)) *MockStore_CreateSantri_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetParent provides a mock function with given fields: ctx, id
-func (_m *MockStore) GetParent(ctx context.Context, id int32) (repository.GetParentRow, error) {
-	ret := _m.Called(ctx, id)
+// CreateSantriLeaveRequest provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateSantriLeaveRequest(ctx context.Context, arg repository.CreateSantriLeaveRequestParams) (repository.SantriLeaveRequest, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetParent")
+		panic("no return value specified for CreateSantriLeaveRequest")
 	}
 
-	var r0 repository.GetParentRow
+	var r0 repository.SantriLeaveRequest
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.GetParentRow, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateSantriLeaveRequestParams) (repository.SantriLeaveRequest, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.GetParentRow); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateSantriLeaveRequestParams) repository.SantriLeaveRequest); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(repository.GetParentRow)
+		r0 = ret.Get(0).(repository.SantriLeaveRequest)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateSantriLeaveRequestParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2208,56 +2223,56 @@ func (_m *MockStore) GetParent(ctx context.Context, id int32) (repository.GetPar
 	return r0, r1
 }
 
-// MockStore_GetParent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetParent'
-type MockStore_GetParent_Call struct {
+// MockStore_CreateSantriLeaveRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSantriLeaveRequest'
+type MockStore_CreateSantriLeaveRequest_Call struct {
 	*mock.Call
 }
 
-// GetParent is a helper method to define mock.On call
+// CreateSantriLeaveRequest is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int32
-func (_e *MockStore_Expecter) GetParent(ctx interface{}, id interface{}) *MockStore_GetParent_Call {
-	return &MockStore_GetParent_Call{Call: _e.mock.On("GetParent", ctx, id)}
+//   - arg repository.CreateSantriLeaveRequestParams
+func (_e *MockStore_Expecter) CreateSantriLeaveRequest(ctx interface{}, arg interface{}) *MockStore_CreateSantriLeaveRequest_Call {
+	return &MockStore_CreateSantriLeaveRequest_Call{Call: _e.mock.On("CreateSantriLeaveRequest", ctx, arg)}
 }
 
-func (_c *MockStore_GetParent_Call) Run(run func(ctx context.Context, id int32)) *MockStore_GetParent_Call {
+func (_c *MockStore_CreateSantriLeaveRequest_Call) Run(run func(ctx context.Context, arg repository.CreateSantriLeaveRequestParams)) *MockStore_CreateSantriLeaveRequest_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int32))
+		run(args[0].(context.Context), args[1].(repository.CreateSantriLeaveRequestParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetParent_Call) Return(_a0 repository.GetParentRow, _a1 error) *MockStore_GetParent_Call {
+func (_c *MockStore_CreateSantriLeaveRequest_Call) Return(_a0 repository.SantriLeaveRequest, _a1 error) *MockStore_CreateSantriLeaveRequest_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetParent_Call) RunAndReturn(run func(context.Context, int32) (repository.GetParentRow, error)) *MockStore_GetParent_Call {
+func (_c *MockStore_CreateSantriLeaveRequest_Call) RunAndReturn(run func(context.Context, repository.CreateSantriLeaveRequestParams) (repository.SantriLeaveRequest, error)) *MockStore_CreateSantriLeaveRequest_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetParentByUserId provides a mock function with given fields: ctx, userID
-func (_m *MockStore) GetParentByUserId(ctx context.Context, userID pgtype.Int4) (repository.Parent, error) {
-	ret := _m.Called(ctx, userID)
+// CreateSantriLeaveRequestHistory provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateSantriLeaveRequestHistory(ctx context.Context, arg repository.CreateSantriLeaveRequestHistoryParams) (repository.SantriLeaveRequestHistory, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetParentByUserId")
+		panic("no return value specified for CreateSantriLeaveRequestHistory")
 	}
 
-	var r0 repository.Parent
+	var r0 repository.SantriLeaveRequestHistory
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Int4) (repository.Parent, error)); ok {
-		return rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateSantriLeaveRequestHistoryParams) (repository.SantriLeaveRequestHistory, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Int4) repository.Parent); ok {
-		r0 = rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateSantriLeaveRequestHistoryParams) repository.SantriLeaveRequestHistory); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(repository.Parent)
+		r0 = ret.Get(0).(repository.SantriLeaveRequestHistory)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Int4) error); ok {
-		r1 = rf(ctx, userID)
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateSantriLeaveRequestHistoryParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2265,56 +2280,56 @@ func (_m *MockStore) GetParentByUserId(ctx context.Context, userID pgtype.Int4)
 	return r0, r1
 }
 
-// MockStore_GetParentByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetParentByUserId'
-type MockStore_GetParentByUserId_Call struct {
+// MockStore_CreateSantriLeaveRequestHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSantriLeaveRequestHistory'
+type MockStore_CreateSantriLeaveRequestHistory_Call struct {
 	*mock.Call
 }
 
-// GetParentByUserId is a helper method to define mock.On call
+// CreateSantriLeaveRequestHistory is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID pgtype.Int4
-func (_e *MockStore_Expecter) GetParentByUserId(ctx interface{}, userID interface{}) *MockStore_GetParentByUserId_Call {
-	return &MockStore_GetParentByUserId_Call{Call: _e.mock.On("GetParentByUserId", ctx, userID)}
+//   - arg repository.CreateSantriLeaveRequestHistoryParams
+func (_e *MockStore_Expecter) CreateSantriLeaveRequestHistory(ctx interface{}, arg interface{}) *MockStore_CreateSantriLeaveRequestHistory_Call {
+	return &MockStore_CreateSantriLeaveRequestHistory_Call{Call: _e.mock.On("CreateSantriLeaveRequestHistory", ctx, arg)}
 }
 
-func (_c *MockStore_GetParentByUserId_Call) Run(run func(ctx context.Context, userID pgtype.Int4)) *MockStore_GetParentByUserId_Call {
+func (_c *MockStore_CreateSantriLeaveRequestHistory_Call) Run(run func(ctx context.Context, arg repository.CreateSantriLeaveRequestHistoryParams)) *MockStore_CreateSantriLeaveRequestHistory_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(pgtype.Int4))
+		run(args[0].(context.Context), args[1].(repository.CreateSantriLeaveRequestHistoryParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetParentByUserId_Call) Return(_a0 repository.Parent, _a1 error) *MockStore_GetParentByUserId_Call {
+func (_c *MockStore_CreateSantriLeaveRequestHistory_Call) Return(_a0 repository.SantriLeaveRequestHistory, _a1 error) *MockStore_CreateSantriLeaveRequestHistory_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetParentByUserId_Call) RunAndReturn(run func(context.Context, pgtype.Int4) (repository.Parent, error)) *MockStore_GetParentByUserId_Call {
+func (_c *MockStore_CreateSantriLeaveRequestHistory_Call) RunAndReturn(run func(context.Context, repository.CreateSantriLeaveRequestHistoryParams) (repository.SantriLeaveRequestHistory, error)) *MockStore_CreateSantriLeaveRequestHistory_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSantri provides a mock function with given fields: ctx, id
-func (_m *MockStore) GetSantri(ctx context.Context, id int32) (repository.GetSantriRow, error) {
-	ret := _m.Called(ctx, id)
+// CreateSantriLeaveRequestWithHistory provides a mock function with given fields: ctx, arg, history
+func (_m *MockStore) CreateSantriLeaveRequestWithHistory(ctx context.Context, arg repository.CreateSantriLeaveRequestParams, history repository.CreateSantriLeaveRequestHistoryParams) (repository.SantriLeaveRequest, error) {
+	ret := _m.Called(ctx, arg, history)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetSantri")
+		panic("no return value specified for CreateSantriLeaveRequestWithHistory")
 	}
 
-	var r0 repository.GetSantriRow
+	var r0 repository.SantriLeaveRequest
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.GetSantriRow, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateSantriLeaveRequestParams, repository.CreateSantriLeaveRequestHistoryParams) (repository.SantriLeaveRequest, error)); ok {
+		return rf(ctx, arg, history)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.GetSantriRow); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateSantriLeaveRequestParams, repository.CreateSantriLeaveRequestHistoryParams) repository.SantriLeaveRequest); ok {
+		r0 = rf(ctx, arg, history)
 	} else {
-		r0 = ret.Get(0).(repository.GetSantriRow)
+		r0 = ret.Get(0).(repository.SantriLeaveRequest)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateSantriLeaveRequestParams, repository.CreateSantriLeaveRequestHistoryParams) error); ok {
+		r1 = rf(ctx, arg, history)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2322,56 +2337,57 @@ func (_m *MockStore) GetSantri(ctx context.Context, id int32) (repository.GetSan
 	return r0, r1
 }
 
-// MockStore_GetSantri_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSantri'
-type MockStore_GetSantri_Call struct {
+// MockStore_CreateSantriLeaveRequestWithHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSantriLeaveRequestWithHistory'
+type MockStore_CreateSantriLeaveRequestWithHistory_Call struct {
 	*mock.Call
 }
 
-// GetSantri is a helper method to define mock.On call
+// CreateSantriLeaveRequestWithHistory is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int32
-func (_e *MockStore_Expecter) GetSantri(ctx interface{}, id interface{}) *MockStore_GetSantri_Call {
-	return &MockStore_GetSantri_Call{Call: _e.mock.On("GetSantri", ctx, id)}
+//   - arg repository.CreateSantriLeaveRequestParams
+//   - history repository.CreateSantriLeaveRequestHistoryParams
+func (_e *MockStore_Expecter) CreateSantriLeaveRequestWithHistory(ctx interface{}, arg interface{}, history interface{}) *MockStore_CreateSantriLeaveRequestWithHistory_Call {
+	return &MockStore_CreateSantriLeaveRequestWithHistory_Call{Call: _e.mock.On("CreateSantriLeaveRequestWithHistory", ctx, arg, history)}
 }
 
-func (_c *MockStore_GetSantri_Call) Run(run func(ctx context.Context, id int32)) *MockStore_GetSantri_Call {
+func (_c *MockStore_CreateSantriLeaveRequestWithHistory_Call) Run(run func(ctx context.Context, arg repository.CreateSantriLeaveRequestParams, history repository.CreateSantriLeaveRequestHistoryParams)) *MockStore_CreateSantriLeaveRequestWithHistory_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int32))
+		run(args[0].(context.Context), args[1].(repository.CreateSantriLeaveRequestParams), args[2].(repository.CreateSantriLeaveRequestHistoryParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetSantri_Call) Return(_a0 repository.GetSantriRow, _a1 error) *MockStore_GetSantri_Call {
+func (_c *MockStore_CreateSantriLeaveRequestWithHistory_Call) Return(_a0 repository.SantriLeaveRequest, _a1 error) *MockStore_CreateSantriLeaveRequestWithHistory_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetSantri_Call) RunAndReturn(run func(context.Context, int32) (repository.GetSantriRow, error)) *MockStore_GetSantri_Call {
+func (_c *MockStore_CreateSantriLeaveRequestWithHistory_Call) RunAndReturn(run func(context.Context, repository.CreateSantriLeaveRequestParams, repository.CreateSantriLeaveRequestHistoryParams) (repository.SantriLeaveRequest, error)) *MockStore_CreateSantriLeaveRequestWithHistory_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSantriPermission provides a mock function with given fields: ctx, id
-func (_m *MockStore) GetSantriPermission(ctx context.Context, id int32) (repository.GetSantriPermissionRow, error) {
-	ret := _m.Called(ctx, id)
+// CreateSantriOccupation provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateSantriOccupation(ctx context.Context, arg repository.CreateSantriOccupationParams) (repository.SantriOccupation, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetSantriPermission")
+		panic("no return value specified for CreateSantriOccupation")
 	}
 
-	var r0 repository.GetSantriPermissionRow
+	var r0 repository.SantriOccupation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.GetSantriPermissionRow, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateSantriOccupationParams) (repository.SantriOccupation, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.GetSantriPermissionRow); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateSantriOccupationParams) repository.SantriOccupation); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(repository.GetSantriPermissionRow)
+		r0 = ret.Get(0).(repository.SantriOccupation)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateSantriOccupationParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2379,56 +2395,56 @@ func (_m *MockStore) GetSantriPermission(ctx context.Context, id int32) (reposit
 	return r0, r1
 }
 
-// MockStore_GetSantriPermission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSantriPermission'
-type MockStore_GetSantriPermission_Call struct {
+// MockStore_CreateSantriOccupation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSantriOccupation'
+type MockStore_CreateSantriOccupation_Call struct {
 	*mock.Call
 }
 
-// GetSantriPermission is a helper method to define mock.On call
+// CreateSantriOccupation is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int32
-func (_e *MockStore_Expecter) GetSantriPermission(ctx interface{}, id interface{}) *MockStore_GetSantriPermission_Call {
-	return &MockStore_GetSantriPermission_Call{Call: _e.mock.On("GetSantriPermission", ctx, id)}
+//   - arg repository.CreateSantriOccupationParams
+func (_e *MockStore_Expecter) CreateSantriOccupation(ctx interface{}, arg interface{}) *MockStore_CreateSantriOccupation_Call {
+	return &MockStore_CreateSantriOccupation_Call{Call: _e.mock.On("CreateSantriOccupation", ctx, arg)}
 }
 
-func (_c *MockStore_GetSantriPermission_Call) Run(run func(ctx context.Context, id int32)) *MockStore_GetSantriPermission_Call {
+func (_c *MockStore_CreateSantriOccupation_Call) Run(run func(ctx context.Context, arg repository.CreateSantriOccupationParams)) *MockStore_CreateSantriOccupation_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int32))
+		run(args[0].(context.Context), args[1].(repository.CreateSantriOccupationParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetSantriPermission_Call) Return(_a0 repository.GetSantriPermissionRow, _a1 error) *MockStore_GetSantriPermission_Call {
+func (_c *MockStore_CreateSantriOccupation_Call) Return(_a0 repository.SantriOccupation, _a1 error) *MockStore_CreateSantriOccupation_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetSantriPermission_Call) RunAndReturn(run func(context.Context, int32) (repository.GetSantriPermissionRow, error)) *MockStore_GetSantriPermission_Call {
+func (_c *MockStore_CreateSantriOccupation_Call) RunAndReturn(run func(context.Context, repository.CreateSantriOccupationParams) (repository.SantriOccupation, error)) *MockStore_CreateSantriOccupation_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSmartCard provides a mock function with given fields: ctx, uid
-func (_m *MockStore) GetSmartCard(ctx context.Context, uid string) (repository.GetSmartCardRow, error) {
-	ret := _m.Called(ctx, uid)
+// CreateSantriPermission provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateSantriPermission(ctx context.Context, arg repository.CreateSantriPermissionParams) (repository.SantriPermission, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetSmartCard")
+		panic("no return value specified for CreateSantriPermission")
 	}
 
-	var r0 repository.GetSmartCardRow
+	var r0 repository.SantriPermission
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (repository.GetSmartCardRow, error)); ok {
-		return rf(ctx, uid)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateSantriPermissionParams) (repository.SantriPermission, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) repository.GetSmartCardRow); ok {
-		r0 = rf(ctx, uid)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateSantriPermissionParams) repository.SantriPermission); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(repository.GetSmartCardRow)
+		r0 = ret.Get(0).(repository.SantriPermission)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, uid)
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateSantriPermissionParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2436,56 +2452,4744 @@ func (_m *MockStore) GetSmartCard(ctx context.Context, uid string) (repository.G
 	return r0, r1
 }
 
-// MockStore_GetSmartCard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSmartCard'
-type MockStore_GetSmartCard_Call struct {
+// MockStore_CreateSantriPermission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSantriPermission'
+type MockStore_CreateSantriPermission_Call struct {
 	*mock.Call
 }
 
-// GetSmartCard is a helper method to define mock.On call
+// CreateSantriPermission is a helper method to define mock.On call
 //   - ctx context.Context
-//   - uid string
-func (_e *MockStore_Expecter) GetSmartCard(ctx interface{}, uid interface{}) *MockStore_GetSmartCard_Call {
-	return &MockStore_GetSmartCard_Call{Call: _e.mock.On("GetSmartCard", ctx, uid)}
+//   - arg repository.CreateSantriPermissionParams
+func (_e *MockStore_Expecter) CreateSantriPermission(ctx interface{}, arg interface{}) *MockStore_CreateSantriPermission_Call {
+	return &MockStore_CreateSantriPermission_Call{Call: _e.mock.On("CreateSantriPermission", ctx, arg)}
 }
 
-func (_c *MockStore_GetSmartCard_Call) Run(run func(ctx context.Context, uid string)) *MockStore_GetSmartCard_Call {
+func (_c *MockStore_CreateSantriPermission_Call) Run(run func(ctx context.Context, arg repository.CreateSantriPermissionParams)) *MockStore_CreateSantriPermission_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(repository.CreateSantriPermissionParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetSmartCard_Call) Return(_a0 repository.GetSmartCardRow, _a1 error) *MockStore_GetSmartCard_Call {
+func (_c *MockStore_CreateSantriPermission_Call) Return(_a0 repository.SantriPermission, _a1 error) *MockStore_CreateSantriPermission_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetSmartCard_Call) RunAndReturn(run func(context.Context, string) (repository.GetSmartCardRow, error)) *MockStore_GetSmartCard_Call {
+func (_c *MockStore_CreateSantriPermission_Call) RunAndReturn(run func(context.Context, repository.CreateSantriPermissionParams) (repository.SantriPermission, error)) *MockStore_CreateSantriPermission_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserByEmail provides a mock function with given fields: ctx, email
-func (_m *MockStore) GetUserByEmail(ctx context.Context, email pgtype.Text) (repository.GetUserByEmailRow, error) {
-	ret := _m.Called(ctx, email)
+// CreateSantriPermissionWithPresences provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateSantriPermissionWithPresences(ctx context.Context, arg repository.CreateSantriPermissionParams) (repository.SantriPermission, []repository.SantriPresence, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserByEmail")
+		panic("no return value specified for CreateSantriPermissionWithPresences")
 	}
 
-	var r0 repository.GetUserByEmailRow
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) (repository.GetUserByEmailRow, error)); ok {
-		return rf(ctx, email)
+	var r0 repository.SantriPermission
+	var r1 []repository.SantriPresence
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateSantriPermissionParams) (repository.SantriPermission, []repository.SantriPresence, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) repository.GetUserByEmailRow); ok {
-		r0 = rf(ctx, email)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateSantriPermissionParams) repository.SantriPermission); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.SantriPermission)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateSantriPermissionParams) []repository.SantriPresence); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]repository.SantriPresence)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, repository.CreateSantriPermissionParams) error); ok {
+		r2 = rf(ctx, arg)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockStore_CreateSantriPermissionWithPresences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSantriPermissionWithPresences'
+type MockStore_CreateSantriPermissionWithPresences_Call struct {
+	*mock.Call
+}
+
+// CreateSantriPermissionWithPresences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.CreateSantriPermissionParams
+func (_e *MockStore_Expecter) CreateSantriPermissionWithPresences(ctx interface{}, arg interface{}) *MockStore_CreateSantriPermissionWithPresences_Call {
+	return &MockStore_CreateSantriPermissionWithPresences_Call{Call: _e.mock.On("CreateSantriPermissionWithPresences", ctx, arg)}
+}
+
+func (_c *MockStore_CreateSantriPermissionWithPresences_Call) Run(run func(ctx context.Context, arg repository.CreateSantriPermissionParams)) *MockStore_CreateSantriPermissionWithPresences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.CreateSantriPermissionParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateSantriPermissionWithPresences_Call) Return(_a0 repository.SantriPermission, _a1 []repository.SantriPresence, _a2 error) *MockStore_CreateSantriPermissionWithPresences_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockStore_CreateSantriPermissionWithPresences_Call) RunAndReturn(run func(context.Context, repository.CreateSantriPermissionParams) (repository.SantriPermission, []repository.SantriPresence, error)) *MockStore_CreateSantriPermissionWithPresences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSantriPresence provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateSantriPresence(ctx context.Context, arg repository.CreateSantriPresenceParams) (repository.SantriPresence, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSantriPresence")
+	}
+
+	var r0 repository.SantriPresence
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateSantriPresenceParams) (repository.SantriPresence, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateSantriPresenceParams) repository.SantriPresence); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.SantriPresence)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateSantriPresenceParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateSantriPresence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSantriPresence'
+type MockStore_CreateSantriPresence_Call struct {
+	*mock.Call
+}
+
+// CreateSantriPresence is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.CreateSantriPresenceParams
+func (_e *MockStore_Expecter) CreateSantriPresence(ctx interface{}, arg interface{}) *MockStore_CreateSantriPresence_Call {
+	return &MockStore_CreateSantriPresence_Call{Call: _e.mock.On("CreateSantriPresence", ctx, arg)}
+}
+
+func (_c *MockStore_CreateSantriPresence_Call) Run(run func(ctx context.Context, arg repository.CreateSantriPresenceParams)) *MockStore_CreateSantriPresence_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.CreateSantriPresenceParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateSantriPresence_Call) Return(_a0 repository.SantriPresence, _a1 error) *MockStore_CreateSantriPresence_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateSantriPresence_Call) RunAndReturn(run func(context.Context, repository.CreateSantriPresenceParams) (repository.SantriPresence, error)) *MockStore_CreateSantriPresence_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSantriPresences provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateSantriPresences(ctx context.Context, arg []repository.CreateSantriPresencesParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSantriPresences")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []repository.CreateSantriPresencesParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []repository.CreateSantriPresencesParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []repository.CreateSantriPresencesParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateSantriPresences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSantriPresences'
+type MockStore_CreateSantriPresences_Call struct {
+	*mock.Call
+}
+
+// CreateSantriPresences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg []repository.CreateSantriPresencesParams
+func (_e *MockStore_Expecter) CreateSantriPresences(ctx interface{}, arg interface{}) *MockStore_CreateSantriPresences_Call {
+	return &MockStore_CreateSantriPresences_Call{Call: _e.mock.On("CreateSantriPresences", ctx, arg)}
+}
+
+func (_c *MockStore_CreateSantriPresences_Call) Run(run func(ctx context.Context, arg []repository.CreateSantriPresencesParams)) *MockStore_CreateSantriPresences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]repository.CreateSantriPresencesParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateSantriPresences_Call) Return(_a0 int64, _a1 error) *MockStore_CreateSantriPresences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateSantriPresences_Call) RunAndReturn(run func(context.Context, []repository.CreateSantriPresencesParams) (int64, error)) *MockStore_CreateSantriPresences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSantriTapPresence provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateSantriTapPresence(ctx context.Context, arg repository.CreateSantriTapPresenceParams) (repository.SantriPresence, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSantriTapPresence")
+	}
+
+	var r0 repository.SantriPresence
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateSantriTapPresenceParams) (repository.SantriPresence, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateSantriTapPresenceParams) repository.SantriPresence); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.SantriPresence)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateSantriTapPresenceParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateSantriTapPresence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSantriTapPresence'
+type MockStore_CreateSantriTapPresence_Call struct {
+	*mock.Call
+}
+
+// CreateSantriTapPresence is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.CreateSantriTapPresenceParams
+func (_e *MockStore_Expecter) CreateSantriTapPresence(ctx interface{}, arg interface{}) *MockStore_CreateSantriTapPresence_Call {
+	return &MockStore_CreateSantriTapPresence_Call{Call: _e.mock.On("CreateSantriTapPresence", ctx, arg)}
+}
+
+func (_c *MockStore_CreateSantriTapPresence_Call) Run(run func(ctx context.Context, arg repository.CreateSantriTapPresenceParams)) *MockStore_CreateSantriTapPresence_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.CreateSantriTapPresenceParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateSantriTapPresence_Call) Return(_a0 repository.SantriPresence, _a1 error) *MockStore_CreateSantriTapPresence_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateSantriTapPresence_Call) RunAndReturn(run func(context.Context, repository.CreateSantriTapPresenceParams) (repository.SantriPresence, error)) *MockStore_CreateSantriTapPresence_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSmartCard provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateSmartCard(ctx context.Context, arg repository.CreateSmartCardParams) (repository.SmartCard, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSmartCard")
+	}
+
+	var r0 repository.SmartCard
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateSmartCardParams) (repository.SmartCard, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateSmartCardParams) repository.SmartCard); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.SmartCard)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateSmartCardParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateSmartCard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSmartCard'
+type MockStore_CreateSmartCard_Call struct {
+	*mock.Call
+}
+
+// CreateSmartCard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.CreateSmartCardParams
+func (_e *MockStore_Expecter) CreateSmartCard(ctx interface{}, arg interface{}) *MockStore_CreateSmartCard_Call {
+	return &MockStore_CreateSmartCard_Call{Call: _e.mock.On("CreateSmartCard", ctx, arg)}
+}
+
+func (_c *MockStore_CreateSmartCard_Call) Run(run func(ctx context.Context, arg repository.CreateSmartCardParams)) *MockStore_CreateSmartCard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.CreateSmartCardParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateSmartCard_Call) Return(_a0 repository.SmartCard, _a1 error) *MockStore_CreateSmartCard_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateSmartCard_Call) RunAndReturn(run func(context.Context, repository.CreateSmartCardParams) (repository.SmartCard, error)) *MockStore_CreateSmartCard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSmartCardAssignment provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateSmartCardAssignment(ctx context.Context, arg repository.CreateSmartCardAssignmentParams) (repository.SmartCardAssignment, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSmartCardAssignment")
+	}
+
+	var r0 repository.SmartCardAssignment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateSmartCardAssignmentParams) (repository.SmartCardAssignment, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateSmartCardAssignmentParams) repository.SmartCardAssignment); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.SmartCardAssignment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateSmartCardAssignmentParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateSmartCardAssignment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSmartCardAssignment'
+type MockStore_CreateSmartCardAssignment_Call struct {
+	*mock.Call
+}
+
+// CreateSmartCardAssignment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.CreateSmartCardAssignmentParams
+func (_e *MockStore_Expecter) CreateSmartCardAssignment(ctx interface{}, arg interface{}) *MockStore_CreateSmartCardAssignment_Call {
+	return &MockStore_CreateSmartCardAssignment_Call{Call: _e.mock.On("CreateSmartCardAssignment", ctx, arg)}
+}
+
+func (_c *MockStore_CreateSmartCardAssignment_Call) Run(run func(ctx context.Context, arg repository.CreateSmartCardAssignmentParams)) *MockStore_CreateSmartCardAssignment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.CreateSmartCardAssignmentParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateSmartCardAssignment_Call) Return(_a0 repository.SmartCardAssignment, _a1 error) *MockStore_CreateSmartCardAssignment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateSmartCardAssignment_Call) RunAndReturn(run func(context.Context, repository.CreateSmartCardAssignmentParams) (repository.SmartCardAssignment, error)) *MockStore_CreateSmartCardAssignment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateTapEvent provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateTapEvent(ctx context.Context, arg repository.CreateTapEventParams) (repository.TapEvent, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTapEvent")
+	}
+
+	var r0 repository.TapEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateTapEventParams) (repository.TapEvent, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateTapEventParams) repository.TapEvent); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.TapEvent)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateTapEventParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateTapEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTapEvent'
+type MockStore_CreateTapEvent_Call struct {
+	*mock.Call
+}
+
+// CreateTapEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.CreateTapEventParams
+func (_e *MockStore_Expecter) CreateTapEvent(ctx interface{}, arg interface{}) *MockStore_CreateTapEvent_Call {
+	return &MockStore_CreateTapEvent_Call{Call: _e.mock.On("CreateTapEvent", ctx, arg)}
+}
+
+func (_c *MockStore_CreateTapEvent_Call) Run(run func(ctx context.Context, arg repository.CreateTapEventParams)) *MockStore_CreateTapEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.CreateTapEventParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateTapEvent_Call) Return(_a0 repository.TapEvent, _a1 error) *MockStore_CreateTapEvent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateTapEvent_Call) RunAndReturn(run func(context.Context, repository.CreateTapEventParams) (repository.TapEvent, error)) *MockStore_CreateTapEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateUser provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateUser(ctx context.Context, arg repository.CreateUserParams) (repository.User, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUser")
+	}
+
+	var r0 repository.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateUserParams) (repository.User, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateUserParams) repository.User); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateUserParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
+type MockStore_CreateUser_Call struct {
+	*mock.Call
+}
+
+// CreateUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.CreateUserParams
+func (_e *MockStore_Expecter) CreateUser(ctx interface{}, arg interface{}) *MockStore_CreateUser_Call {
+	return &MockStore_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, arg)}
+}
+
+func (_c *MockStore_CreateUser_Call) Run(run func(ctx context.Context, arg repository.CreateUserParams)) *MockStore_CreateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.CreateUserParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateUser_Call) Return(_a0 repository.User, _a1 error) *MockStore_CreateUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateUser_Call) RunAndReturn(run func(context.Context, repository.CreateUserParams) (repository.User, error)) *MockStore_CreateUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeactivateSantri provides a mock function with given fields: ctx, arg
+func (_m *MockStore) DeactivateSantri(ctx context.Context, arg repository.UpdateSantriParams) (repository.Santri, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeactivateSantri")
+	}
+
+	var r0 repository.Santri
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateSantriParams) (repository.Santri, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateSantriParams) repository.Santri); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.Santri)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.UpdateSantriParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_DeactivateSantri_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeactivateSantri'
+type MockStore_DeactivateSantri_Call struct {
+	*mock.Call
+}
+
+// DeactivateSantri is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.UpdateSantriParams
+func (_e *MockStore_Expecter) DeactivateSantri(ctx interface{}, arg interface{}) *MockStore_DeactivateSantri_Call {
+	return &MockStore_DeactivateSantri_Call{Call: _e.mock.On("DeactivateSantri", ctx, arg)}
+}
+
+func (_c *MockStore_DeactivateSantri_Call) Run(run func(ctx context.Context, arg repository.UpdateSantriParams)) *MockStore_DeactivateSantri_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.UpdateSantriParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeactivateSantri_Call) Return(_a0 repository.Santri, _a1 error) *MockStore_DeactivateSantri_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_DeactivateSantri_Call) RunAndReturn(run func(context.Context, repository.UpdateSantriParams) (repository.Santri, error)) *MockStore_DeactivateSantri_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDevice provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteDevice(ctx context.Context, id int32) (repository.Device, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDevice")
+	}
+
+	var r0 repository.Device
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.Device, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.Device); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(repository.Device)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_DeleteDevice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDevice'
+type MockStore_DeleteDevice_Call struct {
+	*mock.Call
+}
+
+// DeleteDevice is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockStore_Expecter) DeleteDevice(ctx interface{}, id interface{}) *MockStore_DeleteDevice_Call {
+	return &MockStore_DeleteDevice_Call{Call: _e.mock.On("DeleteDevice", ctx, id)}
+}
+
+func (_c *MockStore_DeleteDevice_Call) Run(run func(ctx context.Context, id int32)) *MockStore_DeleteDevice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteDevice_Call) Return(_a0 repository.Device, _a1 error) *MockStore_DeleteDevice_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_DeleteDevice_Call) RunAndReturn(run func(context.Context, int32) (repository.Device, error)) *MockStore_DeleteDevice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDeviceAllowRules provides a mock function with given fields: ctx, deviceID
+func (_m *MockStore) DeleteDeviceAllowRules(ctx context.Context, deviceID int32) error {
+	ret := _m.Called(ctx, deviceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDeviceAllowRules")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, deviceID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteDeviceAllowRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDeviceAllowRules'
+type MockStore_DeleteDeviceAllowRules_Call struct {
+	*mock.Call
+}
+
+// DeleteDeviceAllowRules is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deviceID int32
+func (_e *MockStore_Expecter) DeleteDeviceAllowRules(ctx interface{}, deviceID interface{}) *MockStore_DeleteDeviceAllowRules_Call {
+	return &MockStore_DeleteDeviceAllowRules_Call{Call: _e.mock.On("DeleteDeviceAllowRules", ctx, deviceID)}
+}
+
+func (_c *MockStore_DeleteDeviceAllowRules_Call) Run(run func(ctx context.Context, deviceID int32)) *MockStore_DeleteDeviceAllowRules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteDeviceAllowRules_Call) Return(_a0 error) *MockStore_DeleteDeviceAllowRules_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteDeviceAllowRules_Call) RunAndReturn(run func(context.Context, int32) error) *MockStore_DeleteDeviceAllowRules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDeviceModeByDeviceId provides a mock function with given fields: ctx, deviceID
+func (_m *MockStore) DeleteDeviceModeByDeviceId(ctx context.Context, deviceID int32) error {
+	ret := _m.Called(ctx, deviceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDeviceModeByDeviceId")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, deviceID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteDeviceModeByDeviceId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDeviceModeByDeviceId'
+type MockStore_DeleteDeviceModeByDeviceId_Call struct {
+	*mock.Call
+}
+
+// DeleteDeviceModeByDeviceId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deviceID int32
+func (_e *MockStore_Expecter) DeleteDeviceModeByDeviceId(ctx interface{}, deviceID interface{}) *MockStore_DeleteDeviceModeByDeviceId_Call {
+	return &MockStore_DeleteDeviceModeByDeviceId_Call{Call: _e.mock.On("DeleteDeviceModeByDeviceId", ctx, deviceID)}
+}
+
+func (_c *MockStore_DeleteDeviceModeByDeviceId_Call) Run(run func(ctx context.Context, deviceID int32)) *MockStore_DeleteDeviceModeByDeviceId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteDeviceModeByDeviceId_Call) Return(_a0 error) *MockStore_DeleteDeviceModeByDeviceId_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteDeviceModeByDeviceId_Call) RunAndReturn(run func(context.Context, int32) error) *MockStore_DeleteDeviceModeByDeviceId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteEmployee provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteEmployee(ctx context.Context, id int32) (repository.Employee, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteEmployee")
+	}
+
+	var r0 repository.Employee
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.Employee, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.Employee); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(repository.Employee)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_DeleteEmployee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteEmployee'
+type MockStore_DeleteEmployee_Call struct {
+	*mock.Call
+}
+
+// DeleteEmployee is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockStore_Expecter) DeleteEmployee(ctx interface{}, id interface{}) *MockStore_DeleteEmployee_Call {
+	return &MockStore_DeleteEmployee_Call{Call: _e.mock.On("DeleteEmployee", ctx, id)}
+}
+
+func (_c *MockStore_DeleteEmployee_Call) Run(run func(ctx context.Context, id int32)) *MockStore_DeleteEmployee_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteEmployee_Call) Return(_a0 repository.Employee, _a1 error) *MockStore_DeleteEmployee_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_DeleteEmployee_Call) RunAndReturn(run func(context.Context, int32) (repository.Employee, error)) *MockStore_DeleteEmployee_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteEmployeeOccupation provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteEmployeeOccupation(ctx context.Context, id int32) (repository.EmployeeOccupation, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteEmployeeOccupation")
+	}
+
+	var r0 repository.EmployeeOccupation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.EmployeeOccupation, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.EmployeeOccupation); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(repository.EmployeeOccupation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_DeleteEmployeeOccupation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteEmployeeOccupation'
+type MockStore_DeleteEmployeeOccupation_Call struct {
+	*mock.Call
+}
+
+// DeleteEmployeeOccupation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockStore_Expecter) DeleteEmployeeOccupation(ctx interface{}, id interface{}) *MockStore_DeleteEmployeeOccupation_Call {
+	return &MockStore_DeleteEmployeeOccupation_Call{Call: _e.mock.On("DeleteEmployeeOccupation", ctx, id)}
+}
+
+func (_c *MockStore_DeleteEmployeeOccupation_Call) Run(run func(ctx context.Context, id int32)) *MockStore_DeleteEmployeeOccupation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteEmployeeOccupation_Call) Return(_a0 repository.EmployeeOccupation, _a1 error) *MockStore_DeleteEmployeeOccupation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_DeleteEmployeeOccupation_Call) RunAndReturn(run func(context.Context, int32) (repository.EmployeeOccupation, error)) *MockStore_DeleteEmployeeOccupation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteEmployeePermission provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteEmployeePermission(ctx context.Context, id int32) (repository.EmployeePermission, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteEmployeePermission")
+	}
+
+	var r0 repository.EmployeePermission
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.EmployeePermission, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.EmployeePermission); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(repository.EmployeePermission)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_DeleteEmployeePermission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteEmployeePermission'
+type MockStore_DeleteEmployeePermission_Call struct {
+	*mock.Call
+}
+
+// DeleteEmployeePermission is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockStore_Expecter) DeleteEmployeePermission(ctx interface{}, id interface{}) *MockStore_DeleteEmployeePermission_Call {
+	return &MockStore_DeleteEmployeePermission_Call{Call: _e.mock.On("DeleteEmployeePermission", ctx, id)}
+}
+
+func (_c *MockStore_DeleteEmployeePermission_Call) Run(run func(ctx context.Context, id int32)) *MockStore_DeleteEmployeePermission_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteEmployeePermission_Call) Return(_a0 repository.EmployeePermission, _a1 error) *MockStore_DeleteEmployeePermission_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_DeleteEmployeePermission_Call) RunAndReturn(run func(context.Context, int32) (repository.EmployeePermission, error)) *MockStore_DeleteEmployeePermission_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteEmployeePresence provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteEmployeePresence(ctx context.Context, id int32) (repository.EmployeePresence, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteEmployeePresence")
+	}
+
+	var r0 repository.EmployeePresence
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.EmployeePresence, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.EmployeePresence); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(repository.EmployeePresence)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_DeleteEmployeePresence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteEmployeePresence'
+type MockStore_DeleteEmployeePresence_Call struct {
+	*mock.Call
+}
+
+// DeleteEmployeePresence is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockStore_Expecter) DeleteEmployeePresence(ctx interface{}, id interface{}) *MockStore_DeleteEmployeePresence_Call {
+	return &MockStore_DeleteEmployeePresence_Call{Call: _e.mock.On("DeleteEmployeePresence", ctx, id)}
+}
+
+func (_c *MockStore_DeleteEmployeePresence_Call) Run(run func(ctx context.Context, id int32)) *MockStore_DeleteEmployeePresence_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteEmployeePresence_Call) Return(_a0 repository.EmployeePresence, _a1 error) *MockStore_DeleteEmployeePresence_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_DeleteEmployeePresence_Call) RunAndReturn(run func(context.Context, int32) (repository.EmployeePresence, error)) *MockStore_DeleteEmployeePresence_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteHoliday provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteHoliday(ctx context.Context, id int32) (repository.Holiday, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteHoliday")
+	}
+
+	var r0 repository.Holiday
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.Holiday, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.Holiday); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(repository.Holiday)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_DeleteHoliday_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteHoliday'
+type MockStore_DeleteHoliday_Call struct {
+	*mock.Call
+}
+
+// DeleteHoliday is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockStore_Expecter) DeleteHoliday(ctx interface{}, id interface{}) *MockStore_DeleteHoliday_Call {
+	return &MockStore_DeleteHoliday_Call{Call: _e.mock.On("DeleteHoliday", ctx, id)}
+}
+
+func (_c *MockStore_DeleteHoliday_Call) Run(run func(ctx context.Context, id int32)) *MockStore_DeleteHoliday_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteHoliday_Call) Return(_a0 repository.Holiday, _a1 error) *MockStore_DeleteHoliday_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_DeleteHoliday_Call) RunAndReturn(run func(context.Context, int32) (repository.Holiday, error)) *MockStore_DeleteHoliday_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteHolidayDateByHolidayId provides a mock function with given fields: ctx, holidayID
+func (_m *MockStore) DeleteHolidayDateByHolidayId(ctx context.Context, holidayID int32) error {
+	ret := _m.Called(ctx, holidayID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteHolidayDateByHolidayId")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, holidayID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteHolidayDateByHolidayId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteHolidayDateByHolidayId'
+type MockStore_DeleteHolidayDateByHolidayId_Call struct {
+	*mock.Call
+}
+
+// DeleteHolidayDateByHolidayId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - holidayID int32
+func (_e *MockStore_Expecter) DeleteHolidayDateByHolidayId(ctx interface{}, holidayID interface{}) *MockStore_DeleteHolidayDateByHolidayId_Call {
+	return &MockStore_DeleteHolidayDateByHolidayId_Call{Call: _e.mock.On("DeleteHolidayDateByHolidayId", ctx, holidayID)}
+}
+
+func (_c *MockStore_DeleteHolidayDateByHolidayId_Call) Run(run func(ctx context.Context, holidayID int32)) *MockStore_DeleteHolidayDateByHolidayId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteHolidayDateByHolidayId_Call) Return(_a0 error) *MockStore_DeleteHolidayDateByHolidayId_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteHolidayDateByHolidayId_Call) RunAndReturn(run func(context.Context, int32) error) *MockStore_DeleteHolidayDateByHolidayId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteLocation provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteLocation(ctx context.Context, id int32) (repository.Location, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteLocation")
+	}
+
+	var r0 repository.Location
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.Location, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.Location); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(repository.Location)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_DeleteLocation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLocation'
+type MockStore_DeleteLocation_Call struct {
+	*mock.Call
+}
+
+// DeleteLocation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockStore_Expecter) DeleteLocation(ctx interface{}, id interface{}) *MockStore_DeleteLocation_Call {
+	return &MockStore_DeleteLocation_Call{Call: _e.mock.On("DeleteLocation", ctx, id)}
+}
+
+func (_c *MockStore_DeleteLocation_Call) Run(run func(ctx context.Context, id int32)) *MockStore_DeleteLocation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteLocation_Call) Return(_a0 repository.Location, _a1 error) *MockStore_DeleteLocation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_DeleteLocation_Call) RunAndReturn(run func(context.Context, int32) (repository.Location, error)) *MockStore_DeleteLocation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteParent provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteParent(ctx context.Context, id int32) (repository.Parent, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteParent")
+	}
+
+	var r0 repository.Parent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.Parent, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.Parent); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(repository.Parent)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_DeleteParent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteParent'
+type MockStore_DeleteParent_Call struct {
+	*mock.Call
+}
+
+// DeleteParent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockStore_Expecter) DeleteParent(ctx interface{}, id interface{}) *MockStore_DeleteParent_Call {
+	return &MockStore_DeleteParent_Call{Call: _e.mock.On("DeleteParent", ctx, id)}
+}
+
+func (_c *MockStore_DeleteParent_Call) Run(run func(ctx context.Context, id int32)) *MockStore_DeleteParent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteParent_Call) Return(_a0 repository.Parent, _a1 error) *MockStore_DeleteParent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_DeleteParent_Call) RunAndReturn(run func(context.Context, int32) (repository.Parent, error)) *MockStore_DeleteParent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePendingSmartCard provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeletePendingSmartCard(ctx context.Context, id int32) (repository.PendingSmartCard, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePendingSmartCard")
+	}
+
+	var r0 repository.PendingSmartCard
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.PendingSmartCard, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.PendingSmartCard); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(repository.PendingSmartCard)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_DeletePendingSmartCard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePendingSmartCard'
+type MockStore_DeletePendingSmartCard_Call struct {
+	*mock.Call
+}
+
+// DeletePendingSmartCard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockStore_Expecter) DeletePendingSmartCard(ctx interface{}, id interface{}) *MockStore_DeletePendingSmartCard_Call {
+	return &MockStore_DeletePendingSmartCard_Call{Call: _e.mock.On("DeletePendingSmartCard", ctx, id)}
+}
+
+func (_c *MockStore_DeletePendingSmartCard_Call) Run(run func(ctx context.Context, id int32)) *MockStore_DeletePendingSmartCard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeletePendingSmartCard_Call) Return(_a0 repository.PendingSmartCard, _a1 error) *MockStore_DeletePendingSmartCard_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_DeletePendingSmartCard_Call) RunAndReturn(run func(context.Context, int32) (repository.PendingSmartCard, error)) *MockStore_DeletePendingSmartCard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSantri provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteSantri(ctx context.Context, id int32) (repository.Santri, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSantri")
+	}
+
+	var r0 repository.Santri
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.Santri, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.Santri); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(repository.Santri)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_DeleteSantri_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSantri'
+type MockStore_DeleteSantri_Call struct {
+	*mock.Call
+}
+
+// DeleteSantri is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockStore_Expecter) DeleteSantri(ctx interface{}, id interface{}) *MockStore_DeleteSantri_Call {
+	return &MockStore_DeleteSantri_Call{Call: _e.mock.On("DeleteSantri", ctx, id)}
+}
+
+func (_c *MockStore_DeleteSantri_Call) Run(run func(ctx context.Context, id int32)) *MockStore_DeleteSantri_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteSantri_Call) Return(_a0 repository.Santri, _a1 error) *MockStore_DeleteSantri_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_DeleteSantri_Call) RunAndReturn(run func(context.Context, int32) (repository.Santri, error)) *MockStore_DeleteSantri_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSantriOccupation provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteSantriOccupation(ctx context.Context, id int32) (repository.SantriOccupation, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSantriOccupation")
+	}
+
+	var r0 repository.SantriOccupation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.SantriOccupation, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.SantriOccupation); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(repository.SantriOccupation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_DeleteSantriOccupation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSantriOccupation'
+type MockStore_DeleteSantriOccupation_Call struct {
+	*mock.Call
+}
+
+// DeleteSantriOccupation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockStore_Expecter) DeleteSantriOccupation(ctx interface{}, id interface{}) *MockStore_DeleteSantriOccupation_Call {
+	return &MockStore_DeleteSantriOccupation_Call{Call: _e.mock.On("DeleteSantriOccupation", ctx, id)}
+}
+
+func (_c *MockStore_DeleteSantriOccupation_Call) Run(run func(ctx context.Context, id int32)) *MockStore_DeleteSantriOccupation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteSantriOccupation_Call) Return(_a0 repository.SantriOccupation, _a1 error) *MockStore_DeleteSantriOccupation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_DeleteSantriOccupation_Call) RunAndReturn(run func(context.Context, int32) (repository.SantriOccupation, error)) *MockStore_DeleteSantriOccupation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSantriPermission provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteSantriPermission(ctx context.Context, id int32) (repository.SantriPermission, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSantriPermission")
+	}
+
+	var r0 repository.SantriPermission
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.SantriPermission, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.SantriPermission); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(repository.SantriPermission)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_DeleteSantriPermission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSantriPermission'
+type MockStore_DeleteSantriPermission_Call struct {
+	*mock.Call
+}
+
+// DeleteSantriPermission is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockStore_Expecter) DeleteSantriPermission(ctx interface{}, id interface{}) *MockStore_DeleteSantriPermission_Call {
+	return &MockStore_DeleteSantriPermission_Call{Call: _e.mock.On("DeleteSantriPermission", ctx, id)}
+}
+
+func (_c *MockStore_DeleteSantriPermission_Call) Run(run func(ctx context.Context, id int32)) *MockStore_DeleteSantriPermission_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteSantriPermission_Call) Return(_a0 repository.SantriPermission, _a1 error) *MockStore_DeleteSantriPermission_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_DeleteSantriPermission_Call) RunAndReturn(run func(context.Context, int32) (repository.SantriPermission, error)) *MockStore_DeleteSantriPermission_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSantriPermissionWithPresences provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteSantriPermissionWithPresences(ctx context.Context, id int32) (repository.SantriPermission, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSantriPermissionWithPresences")
+	}
+
+	var r0 repository.SantriPermission
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.SantriPermission, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.SantriPermission); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(repository.SantriPermission)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_DeleteSantriPermissionWithPresences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSantriPermissionWithPresences'
+type MockStore_DeleteSantriPermissionWithPresences_Call struct {
+	*mock.Call
+}
+
+// DeleteSantriPermissionWithPresences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockStore_Expecter) DeleteSantriPermissionWithPresences(ctx interface{}, id interface{}) *MockStore_DeleteSantriPermissionWithPresences_Call {
+	return &MockStore_DeleteSantriPermissionWithPresences_Call{Call: _e.mock.On("DeleteSantriPermissionWithPresences", ctx, id)}
+}
+
+func (_c *MockStore_DeleteSantriPermissionWithPresences_Call) Run(run func(ctx context.Context, id int32)) *MockStore_DeleteSantriPermissionWithPresences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteSantriPermissionWithPresences_Call) Return(_a0 repository.SantriPermission, _a1 error) *MockStore_DeleteSantriPermissionWithPresences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_DeleteSantriPermissionWithPresences_Call) RunAndReturn(run func(context.Context, int32) (repository.SantriPermission, error)) *MockStore_DeleteSantriPermissionWithPresences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSantriPresence provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteSantriPresence(ctx context.Context, id int32) (repository.SantriPresence, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSantriPresence")
+	}
+
+	var r0 repository.SantriPresence
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.SantriPresence, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.SantriPresence); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(repository.SantriPresence)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_DeleteSantriPresence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSantriPresence'
+type MockStore_DeleteSantriPresence_Call struct {
+	*mock.Call
+}
+
+// DeleteSantriPresence is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockStore_Expecter) DeleteSantriPresence(ctx interface{}, id interface{}) *MockStore_DeleteSantriPresence_Call {
+	return &MockStore_DeleteSantriPresence_Call{Call: _e.mock.On("DeleteSantriPresence", ctx, id)}
+}
+
+func (_c *MockStore_DeleteSantriPresence_Call) Run(run func(ctx context.Context, id int32)) *MockStore_DeleteSantriPresence_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteSantriPresence_Call) Return(_a0 repository.SantriPresence, _a1 error) *MockStore_DeleteSantriPresence_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_DeleteSantriPresence_Call) RunAndReturn(run func(context.Context, int32) (repository.SantriPresence, error)) *MockStore_DeleteSantriPresence_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSmartCard provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteSmartCard(ctx context.Context, id int32) (repository.SmartCard, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSmartCard")
+	}
+
+	var r0 repository.SmartCard
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.SmartCard, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.SmartCard); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(repository.SmartCard)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_DeleteSmartCard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSmartCard'
+type MockStore_DeleteSmartCard_Call struct {
+	*mock.Call
+}
+
+// DeleteSmartCard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockStore_Expecter) DeleteSmartCard(ctx interface{}, id interface{}) *MockStore_DeleteSmartCard_Call {
+	return &MockStore_DeleteSmartCard_Call{Call: _e.mock.On("DeleteSmartCard", ctx, id)}
+}
+
+func (_c *MockStore_DeleteSmartCard_Call) Run(run func(ctx context.Context, id int32)) *MockStore_DeleteSmartCard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteSmartCard_Call) Return(_a0 repository.SmartCard, _a1 error) *MockStore_DeleteSmartCard_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_DeleteSmartCard_Call) RunAndReturn(run func(context.Context, int32) (repository.SmartCard, error)) *MockStore_DeleteSmartCard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUser provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteUser(ctx context.Context, id int32) (repository.User, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUser")
+	}
+
+	var r0 repository.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.User, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.User); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(repository.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_DeleteUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUser'
+type MockStore_DeleteUser_Call struct {
+	*mock.Call
+}
+
+// DeleteUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockStore_Expecter) DeleteUser(ctx interface{}, id interface{}) *MockStore_DeleteUser_Call {
+	return &MockStore_DeleteUser_Call{Call: _e.mock.On("DeleteUser", ctx, id)}
+}
+
+func (_c *MockStore_DeleteUser_Call) Run(run func(ctx context.Context, id int32)) *MockStore_DeleteUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteUser_Call) Return(_a0 repository.User, _a1 error) *MockStore_DeleteUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_DeleteUser_Call) RunAndReturn(run func(context.Context, int32) (repository.User, error)) *MockStore_DeleteUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DetachSantriPermissionPresences provides a mock function with given fields: ctx, santriPermissionID
+func (_m *MockStore) DetachSantriPermissionPresences(ctx context.Context, santriPermissionID pgtype.Int4) ([]repository.SantriPresence, error) {
+	ret := _m.Called(ctx, santriPermissionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DetachSantriPermissionPresences")
+	}
+
+	var r0 []repository.SantriPresence
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Int4) ([]repository.SantriPresence, error)); ok {
+		return rf(ctx, santriPermissionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Int4) []repository.SantriPresence); ok {
+		r0 = rf(ctx, santriPermissionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.SantriPresence)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Int4) error); ok {
+		r1 = rf(ctx, santriPermissionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_DetachSantriPermissionPresences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DetachSantriPermissionPresences'
+type MockStore_DetachSantriPermissionPresences_Call struct {
+	*mock.Call
+}
+
+// DetachSantriPermissionPresences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - santriPermissionID pgtype.Int4
+func (_e *MockStore_Expecter) DetachSantriPermissionPresences(ctx interface{}, santriPermissionID interface{}) *MockStore_DetachSantriPermissionPresences_Call {
+	return &MockStore_DetachSantriPermissionPresences_Call{Call: _e.mock.On("DetachSantriPermissionPresences", ctx, santriPermissionID)}
+}
+
+func (_c *MockStore_DetachSantriPermissionPresences_Call) Run(run func(ctx context.Context, santriPermissionID pgtype.Int4)) *MockStore_DetachSantriPermissionPresences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgtype.Int4))
+	})
+	return _c
+}
+
+func (_c *MockStore_DetachSantriPermissionPresences_Call) Return(_a0 []repository.SantriPresence, _a1 error) *MockStore_DetachSantriPermissionPresences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_DetachSantriPermissionPresences_Call) RunAndReturn(run func(context.Context, pgtype.Int4) ([]repository.SantriPresence, error)) *MockStore_DetachSantriPermissionPresences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EndSmartCardAssignment provides a mock function with given fields: ctx, smartCardID
+func (_m *MockStore) EndSmartCardAssignment(ctx context.Context, smartCardID int32) error {
+	ret := _m.Called(ctx, smartCardID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EndSmartCardAssignment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, smartCardID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_EndSmartCardAssignment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EndSmartCardAssignment'
+type MockStore_EndSmartCardAssignment_Call struct {
+	*mock.Call
+}
+
+// EndSmartCardAssignment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - smartCardID int32
+func (_e *MockStore_Expecter) EndSmartCardAssignment(ctx interface{}, smartCardID interface{}) *MockStore_EndSmartCardAssignment_Call {
+	return &MockStore_EndSmartCardAssignment_Call{Call: _e.mock.On("EndSmartCardAssignment", ctx, smartCardID)}
+}
+
+func (_c *MockStore_EndSmartCardAssignment_Call) Run(run func(ctx context.Context, smartCardID int32)) *MockStore_EndSmartCardAssignment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockStore_EndSmartCardAssignment_Call) Return(_a0 error) *MockStore_EndSmartCardAssignment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_EndSmartCardAssignment_Call) RunAndReturn(run func(context.Context, int32) error) *MockStore_EndSmartCardAssignment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnrollSmartCard provides a mock function with given fields: ctx, sessionID, uid
+func (_m *MockStore) EnrollSmartCard(ctx context.Context, sessionID int32, uid string) (repository.SmartCard, bool, error) {
+	ret := _m.Called(ctx, sessionID, uid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnrollSmartCard")
+	}
+
+	var r0 repository.SmartCard
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, string) (repository.SmartCard, bool, error)); ok {
+		return rf(ctx, sessionID, uid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32, string) repository.SmartCard); ok {
+		r0 = rf(ctx, sessionID, uid)
+	} else {
+		r0 = ret.Get(0).(repository.SmartCard)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32, string) bool); ok {
+		r1 = rf(ctx, sessionID, uid)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int32, string) error); ok {
+		r2 = rf(ctx, sessionID, uid)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockStore_EnrollSmartCard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnrollSmartCard'
+type MockStore_EnrollSmartCard_Call struct {
+	*mock.Call
+}
+
+// EnrollSmartCard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionID int32
+//   - uid string
+func (_e *MockStore_Expecter) EnrollSmartCard(ctx interface{}, sessionID interface{}, uid interface{}) *MockStore_EnrollSmartCard_Call {
+	return &MockStore_EnrollSmartCard_Call{Call: _e.mock.On("EnrollSmartCard", ctx, sessionID, uid)}
+}
+
+func (_c *MockStore_EnrollSmartCard_Call) Run(run func(ctx context.Context, sessionID int32, uid string)) *MockStore_EnrollSmartCard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_EnrollSmartCard_Call) Return(_a0 repository.SmartCard, _a1 bool, _a2 error) *MockStore_EnrollSmartCard_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockStore_EnrollSmartCard_Call) RunAndReturn(run func(context.Context, int32, string) (repository.SmartCard, bool, error)) *MockStore_EnrollSmartCard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpireEnrollmentSessions provides a mock function with given fields: ctx, deviceID
+func (_m *MockStore) ExpireEnrollmentSessions(ctx context.Context, deviceID int32) error {
+	ret := _m.Called(ctx, deviceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpireEnrollmentSessions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, deviceID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_ExpireEnrollmentSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpireEnrollmentSessions'
+type MockStore_ExpireEnrollmentSessions_Call struct {
+	*mock.Call
+}
+
+// ExpireEnrollmentSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deviceID int32
+func (_e *MockStore_Expecter) ExpireEnrollmentSessions(ctx interface{}, deviceID interface{}) *MockStore_ExpireEnrollmentSessions_Call {
+	return &MockStore_ExpireEnrollmentSessions_Call{Call: _e.mock.On("ExpireEnrollmentSessions", ctx, deviceID)}
+}
+
+func (_c *MockStore_ExpireEnrollmentSessions_Call) Run(run func(ctx context.Context, deviceID int32)) *MockStore_ExpireEnrollmentSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockStore_ExpireEnrollmentSessions_Call) Return(_a0 error) *MockStore_ExpireEnrollmentSessions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_ExpireEnrollmentSessions_Call) RunAndReturn(run func(context.Context, int32) error) *MockStore_ExpireEnrollmentSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpireSmartCards provides a mock function with given fields: ctx
+func (_m *MockStore) ExpireSmartCards(ctx context.Context) ([]repository.SmartCard, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpireSmartCards")
+	}
+
+	var r0 []repository.SmartCard
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]repository.SmartCard, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []repository.SmartCard); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.SmartCard)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ExpireSmartCards_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpireSmartCards'
+type MockStore_ExpireSmartCards_Call struct {
+	*mock.Call
+}
+
+// ExpireSmartCards is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockStore_Expecter) ExpireSmartCards(ctx interface{}) *MockStore_ExpireSmartCards_Call {
+	return &MockStore_ExpireSmartCards_Call{Call: _e.mock.On("ExpireSmartCards", ctx)}
+}
+
+func (_c *MockStore_ExpireSmartCards_Call) Run(run func(ctx context.Context)) *MockStore_ExpireSmartCards_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockStore_ExpireSmartCards_Call) Return(_a0 []repository.SmartCard, _a1 error) *MockStore_ExpireSmartCards_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ExpireSmartCards_Call) RunAndReturn(run func(context.Context) ([]repository.SmartCard, error)) *MockStore_ExpireSmartCards_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActiveSantriPermission provides a mock function with given fields: ctx, arg
+func (_m *MockStore) GetActiveSantriPermission(ctx context.Context, arg repository.GetActiveSantriPermissionParams) (repository.SantriPermission, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveSantriPermission")
+	}
+
+	var r0 repository.SantriPermission
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.GetActiveSantriPermissionParams) (repository.SantriPermission, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.GetActiveSantriPermissionParams) repository.SantriPermission); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.SantriPermission)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.GetActiveSantriPermissionParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetActiveSantriPermission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveSantriPermission'
+type MockStore_GetActiveSantriPermission_Call struct {
+	*mock.Call
+}
+
+// GetActiveSantriPermission is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.GetActiveSantriPermissionParams
+func (_e *MockStore_Expecter) GetActiveSantriPermission(ctx interface{}, arg interface{}) *MockStore_GetActiveSantriPermission_Call {
+	return &MockStore_GetActiveSantriPermission_Call{Call: _e.mock.On("GetActiveSantriPermission", ctx, arg)}
+}
+
+func (_c *MockStore_GetActiveSantriPermission_Call) Run(run func(ctx context.Context, arg repository.GetActiveSantriPermissionParams)) *MockStore_GetActiveSantriPermission_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.GetActiveSantriPermissionParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetActiveSantriPermission_Call) Return(_a0 repository.SantriPermission, _a1 error) *MockStore_GetActiveSantriPermission_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetActiveSantriPermission_Call) RunAndReturn(run func(context.Context, repository.GetActiveSantriPermissionParams) (repository.SantriPermission, error)) *MockStore_GetActiveSantriPermission_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDevice provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetDevice(ctx context.Context, id int32) (repository.Device, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDevice")
+	}
+
+	var r0 repository.Device
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.Device, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.Device); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(repository.Device)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetDevice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDevice'
+type MockStore_GetDevice_Call struct {
+	*mock.Call
+}
+
+// GetDevice is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockStore_Expecter) GetDevice(ctx interface{}, id interface{}) *MockStore_GetDevice_Call {
+	return &MockStore_GetDevice_Call{Call: _e.mock.On("GetDevice", ctx, id)}
+}
+
+func (_c *MockStore_GetDevice_Call) Run(run func(ctx context.Context, id int32)) *MockStore_GetDevice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetDevice_Call) Return(_a0 repository.Device, _a1 error) *MockStore_GetDevice_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetDevice_Call) RunAndReturn(run func(context.Context, int32) (repository.Device, error)) *MockStore_GetDevice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDeviceBatchTap provides a mock function with given fields: ctx, arg
+func (_m *MockStore) GetDeviceBatchTap(ctx context.Context, arg repository.GetDeviceBatchTapParams) (repository.DeviceBatchTap, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeviceBatchTap")
+	}
+
+	var r0 repository.DeviceBatchTap
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.GetDeviceBatchTapParams) (repository.DeviceBatchTap, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.GetDeviceBatchTapParams) repository.DeviceBatchTap); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.DeviceBatchTap)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.GetDeviceBatchTapParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetDeviceBatchTap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeviceBatchTap'
+type MockStore_GetDeviceBatchTap_Call struct {
+	*mock.Call
+}
+
+// GetDeviceBatchTap is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.GetDeviceBatchTapParams
+func (_e *MockStore_Expecter) GetDeviceBatchTap(ctx interface{}, arg interface{}) *MockStore_GetDeviceBatchTap_Call {
+	return &MockStore_GetDeviceBatchTap_Call{Call: _e.mock.On("GetDeviceBatchTap", ctx, arg)}
+}
+
+func (_c *MockStore_GetDeviceBatchTap_Call) Run(run func(ctx context.Context, arg repository.GetDeviceBatchTapParams)) *MockStore_GetDeviceBatchTap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.GetDeviceBatchTapParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetDeviceBatchTap_Call) Return(_a0 repository.DeviceBatchTap, _a1 error) *MockStore_GetDeviceBatchTap_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetDeviceBatchTap_Call) RunAndReturn(run func(context.Context, repository.GetDeviceBatchTapParams) (repository.DeviceBatchTap, error)) *MockStore_GetDeviceBatchTap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDeviceByTopicName provides a mock function with given fields: ctx, topicName
+func (_m *MockStore) GetDeviceByTopicName(ctx context.Context, topicName string) (repository.Device, error) {
+	ret := _m.Called(ctx, topicName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeviceByTopicName")
+	}
+
+	var r0 repository.Device
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (repository.Device, error)); ok {
+		return rf(ctx, topicName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) repository.Device); ok {
+		r0 = rf(ctx, topicName)
+	} else {
+		r0 = ret.Get(0).(repository.Device)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, topicName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetDeviceByTopicName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeviceByTopicName'
+type MockStore_GetDeviceByTopicName_Call struct {
+	*mock.Call
+}
+
+// GetDeviceByTopicName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - topicName string
+func (_e *MockStore_Expecter) GetDeviceByTopicName(ctx interface{}, topicName interface{}) *MockStore_GetDeviceByTopicName_Call {
+	return &MockStore_GetDeviceByTopicName_Call{Call: _e.mock.On("GetDeviceByTopicName", ctx, topicName)}
+}
+
+func (_c *MockStore_GetDeviceByTopicName_Call) Run(run func(ctx context.Context, topicName string)) *MockStore_GetDeviceByTopicName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetDeviceByTopicName_Call) Return(_a0 repository.Device, _a1 error) *MockStore_GetDeviceByTopicName_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetDeviceByTopicName_Call) RunAndReturn(run func(context.Context, string) (repository.Device, error)) *MockStore_GetDeviceByTopicName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEmployeeByID provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetEmployeeByID(ctx context.Context, id int32) (repository.GetEmployeeByIDRow, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEmployeeByID")
+	}
+
+	var r0 repository.GetEmployeeByIDRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.GetEmployeeByIDRow, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.GetEmployeeByIDRow); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(repository.GetEmployeeByIDRow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetEmployeeByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEmployeeByID'
+type MockStore_GetEmployeeByID_Call struct {
+	*mock.Call
+}
+
+// GetEmployeeByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockStore_Expecter) GetEmployeeByID(ctx interface{}, id interface{}) *MockStore_GetEmployeeByID_Call {
+	return &MockStore_GetEmployeeByID_Call{Call: _e.mock.On("GetEmployeeByID", ctx, id)}
+}
+
+func (_c *MockStore_GetEmployeeByID_Call) Run(run func(ctx context.Context, id int32)) *MockStore_GetEmployeeByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetEmployeeByID_Call) Return(_a0 repository.GetEmployeeByIDRow, _a1 error) *MockStore_GetEmployeeByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetEmployeeByID_Call) RunAndReturn(run func(context.Context, int32) (repository.GetEmployeeByIDRow, error)) *MockStore_GetEmployeeByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEmployeeByUserID provides a mock function with given fields: ctx, userID
+func (_m *MockStore) GetEmployeeByUserID(ctx context.Context, userID pgtype.Int4) (repository.Employee, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEmployeeByUserID")
+	}
+
+	var r0 repository.Employee
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Int4) (repository.Employee, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Int4) repository.Employee); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(repository.Employee)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Int4) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetEmployeeByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEmployeeByUserID'
+type MockStore_GetEmployeeByUserID_Call struct {
+	*mock.Call
+}
+
+// GetEmployeeByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID pgtype.Int4
+func (_e *MockStore_Expecter) GetEmployeeByUserID(ctx interface{}, userID interface{}) *MockStore_GetEmployeeByUserID_Call {
+	return &MockStore_GetEmployeeByUserID_Call{Call: _e.mock.On("GetEmployeeByUserID", ctx, userID)}
+}
+
+func (_c *MockStore_GetEmployeeByUserID_Call) Run(run func(ctx context.Context, userID pgtype.Int4)) *MockStore_GetEmployeeByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgtype.Int4))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetEmployeeByUserID_Call) Return(_a0 repository.Employee, _a1 error) *MockStore_GetEmployeeByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetEmployeeByUserID_Call) RunAndReturn(run func(context.Context, pgtype.Int4) (repository.Employee, error)) *MockStore_GetEmployeeByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEmployeePermission provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetEmployeePermission(ctx context.Context, id int32) (repository.GetEmployeePermissionRow, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEmployeePermission")
+	}
+
+	var r0 repository.GetEmployeePermissionRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.GetEmployeePermissionRow, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.GetEmployeePermissionRow); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(repository.GetEmployeePermissionRow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetEmployeePermission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEmployeePermission'
+type MockStore_GetEmployeePermission_Call struct {
+	*mock.Call
+}
+
+// GetEmployeePermission is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockStore_Expecter) GetEmployeePermission(ctx interface{}, id interface{}) *MockStore_GetEmployeePermission_Call {
+	return &MockStore_GetEmployeePermission_Call{Call: _e.mock.On("GetEmployeePermission", ctx, id)}
+}
+
+func (_c *MockStore_GetEmployeePermission_Call) Run(run func(ctx context.Context, id int32)) *MockStore_GetEmployeePermission_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetEmployeePermission_Call) Return(_a0 repository.GetEmployeePermissionRow, _a1 error) *MockStore_GetEmployeePermission_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetEmployeePermission_Call) RunAndReturn(run func(context.Context, int32) (repository.GetEmployeePermissionRow, error)) *MockStore_GetEmployeePermission_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEnrollmentSession provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetEnrollmentSession(ctx context.Context, id int32) (repository.GetEnrollmentSessionRow, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEnrollmentSession")
+	}
+
+	var r0 repository.GetEnrollmentSessionRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.GetEnrollmentSessionRow, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.GetEnrollmentSessionRow); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(repository.GetEnrollmentSessionRow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetEnrollmentSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEnrollmentSession'
+type MockStore_GetEnrollmentSession_Call struct {
+	*mock.Call
+}
+
+// GetEnrollmentSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockStore_Expecter) GetEnrollmentSession(ctx interface{}, id interface{}) *MockStore_GetEnrollmentSession_Call {
+	return &MockStore_GetEnrollmentSession_Call{Call: _e.mock.On("GetEnrollmentSession", ctx, id)}
+}
+
+func (_c *MockStore_GetEnrollmentSession_Call) Run(run func(ctx context.Context, id int32)) *MockStore_GetEnrollmentSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetEnrollmentSession_Call) Return(_a0 repository.GetEnrollmentSessionRow, _a1 error) *MockStore_GetEnrollmentSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetEnrollmentSession_Call) RunAndReturn(run func(context.Context, int32) (repository.GetEnrollmentSessionRow, error)) *MockStore_GetEnrollmentSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetHoliday provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetHoliday(ctx context.Context, id int32) (repository.Holiday, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHoliday")
+	}
+
+	var r0 repository.Holiday
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.Holiday, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.Holiday); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(repository.Holiday)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetHoliday_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHoliday'
+type MockStore_GetHoliday_Call struct {
+	*mock.Call
+}
+
+// GetHoliday is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockStore_Expecter) GetHoliday(ctx interface{}, id interface{}) *MockStore_GetHoliday_Call {
+	return &MockStore_GetHoliday_Call{Call: _e.mock.On("GetHoliday", ctx, id)}
+}
+
+func (_c *MockStore_GetHoliday_Call) Run(run func(ctx context.Context, id int32)) *MockStore_GetHoliday_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetHoliday_Call) Return(_a0 repository.Holiday, _a1 error) *MockStore_GetHoliday_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetHoliday_Call) RunAndReturn(run func(context.Context, int32) (repository.Holiday, error)) *MockStore_GetHoliday_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetHolidayByDate provides a mock function with given fields: ctx, date
+func (_m *MockStore) GetHolidayByDate(ctx context.Context, date pgtype.Date) (repository.Holiday, error) {
+	ret := _m.Called(ctx, date)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHolidayByDate")
+	}
+
+	var r0 repository.Holiday
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Date) (repository.Holiday, error)); ok {
+		return rf(ctx, date)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Date) repository.Holiday); ok {
+		r0 = rf(ctx, date)
+	} else {
+		r0 = ret.Get(0).(repository.Holiday)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Date) error); ok {
+		r1 = rf(ctx, date)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetHolidayByDate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHolidayByDate'
+type MockStore_GetHolidayByDate_Call struct {
+	*mock.Call
+}
+
+// GetHolidayByDate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - date pgtype.Date
+func (_e *MockStore_Expecter) GetHolidayByDate(ctx interface{}, date interface{}) *MockStore_GetHolidayByDate_Call {
+	return &MockStore_GetHolidayByDate_Call{Call: _e.mock.On("GetHolidayByDate", ctx, date)}
+}
+
+func (_c *MockStore_GetHolidayByDate_Call) Run(run func(ctx context.Context, date pgtype.Date)) *MockStore_GetHolidayByDate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgtype.Date))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetHolidayByDate_Call) Return(_a0 repository.Holiday, _a1 error) *MockStore_GetHolidayByDate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetHolidayByDate_Call) RunAndReturn(run func(context.Context, pgtype.Date) (repository.Holiday, error)) *MockStore_GetHolidayByDate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLastGateDirection provides a mock function with given fields: ctx, uid
+func (_m *MockStore) GetLastGateDirection(ctx context.Context, uid pgtype.Text) (repository.GateDirectionType, error) {
+	ret := _m.Called(ctx, uid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLastGateDirection")
+	}
+
+	var r0 repository.GateDirectionType
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) (repository.GateDirectionType, error)); ok {
+		return rf(ctx, uid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) repository.GateDirectionType); ok {
+		r0 = rf(ctx, uid)
+	} else {
+		r0 = ret.Get(0).(repository.GateDirectionType)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Text) error); ok {
+		r1 = rf(ctx, uid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetLastGateDirection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLastGateDirection'
+type MockStore_GetLastGateDirection_Call struct {
+	*mock.Call
+}
+
+// GetLastGateDirection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uid pgtype.Text
+func (_e *MockStore_Expecter) GetLastGateDirection(ctx interface{}, uid interface{}) *MockStore_GetLastGateDirection_Call {
+	return &MockStore_GetLastGateDirection_Call{Call: _e.mock.On("GetLastGateDirection", ctx, uid)}
+}
+
+func (_c *MockStore_GetLastGateDirection_Call) Run(run func(ctx context.Context, uid pgtype.Text)) *MockStore_GetLastGateDirection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgtype.Text))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetLastGateDirection_Call) Return(_a0 repository.GateDirectionType, _a1 error) *MockStore_GetLastGateDirection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetLastGateDirection_Call) RunAndReturn(run func(context.Context, pgtype.Text) (repository.GateDirectionType, error)) *MockStore_GetLastGateDirection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLocation provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetLocation(ctx context.Context, id int32) (repository.Location, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLocation")
+	}
+
+	var r0 repository.Location
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.Location, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.Location); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(repository.Location)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetLocation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLocation'
+type MockStore_GetLocation_Call struct {
+	*mock.Call
+}
+
+// GetLocation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockStore_Expecter) GetLocation(ctx interface{}, id interface{}) *MockStore_GetLocation_Call {
+	return &MockStore_GetLocation_Call{Call: _e.mock.On("GetLocation", ctx, id)}
+}
+
+func (_c *MockStore_GetLocation_Call) Run(run func(ctx context.Context, id int32)) *MockStore_GetLocation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetLocation_Call) Return(_a0 repository.Location, _a1 error) *MockStore_GetLocation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetLocation_Call) RunAndReturn(run func(context.Context, int32) (repository.Location, error)) *MockStore_GetLocation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNextEnrollmentSessionItem provides a mock function with given fields: ctx, sessionID
+func (_m *MockStore) GetNextEnrollmentSessionItem(ctx context.Context, sessionID int32) (repository.EnrollmentSessionItem, error) {
+	ret := _m.Called(ctx, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNextEnrollmentSessionItem")
+	}
+
+	var r0 repository.EnrollmentSessionItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.EnrollmentSessionItem, error)); ok {
+		return rf(ctx, sessionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.EnrollmentSessionItem); ok {
+		r0 = rf(ctx, sessionID)
+	} else {
+		r0 = ret.Get(0).(repository.EnrollmentSessionItem)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, sessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetNextEnrollmentSessionItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNextEnrollmentSessionItem'
+type MockStore_GetNextEnrollmentSessionItem_Call struct {
+	*mock.Call
+}
+
+// GetNextEnrollmentSessionItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionID int32
+func (_e *MockStore_Expecter) GetNextEnrollmentSessionItem(ctx interface{}, sessionID interface{}) *MockStore_GetNextEnrollmentSessionItem_Call {
+	return &MockStore_GetNextEnrollmentSessionItem_Call{Call: _e.mock.On("GetNextEnrollmentSessionItem", ctx, sessionID)}
+}
+
+func (_c *MockStore_GetNextEnrollmentSessionItem_Call) Run(run func(ctx context.Context, sessionID int32)) *MockStore_GetNextEnrollmentSessionItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetNextEnrollmentSessionItem_Call) Return(_a0 repository.EnrollmentSessionItem, _a1 error) *MockStore_GetNextEnrollmentSessionItem_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetNextEnrollmentSessionItem_Call) RunAndReturn(run func(context.Context, int32) (repository.EnrollmentSessionItem, error)) *MockStore_GetNextEnrollmentSessionItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOpenEnrollmentSessionByDeviceName provides a mock function with given fields: ctx, deviceName
+func (_m *MockStore) GetOpenEnrollmentSessionByDeviceName(ctx context.Context, deviceName string) (repository.EnrollmentSession, error) {
+	ret := _m.Called(ctx, deviceName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOpenEnrollmentSessionByDeviceName")
+	}
+
+	var r0 repository.EnrollmentSession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (repository.EnrollmentSession, error)); ok {
+		return rf(ctx, deviceName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) repository.EnrollmentSession); ok {
+		r0 = rf(ctx, deviceName)
+	} else {
+		r0 = ret.Get(0).(repository.EnrollmentSession)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, deviceName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetOpenEnrollmentSessionByDeviceName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOpenEnrollmentSessionByDeviceName'
+type MockStore_GetOpenEnrollmentSessionByDeviceName_Call struct {
+	*mock.Call
+}
+
+// GetOpenEnrollmentSessionByDeviceName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deviceName string
+func (_e *MockStore_Expecter) GetOpenEnrollmentSessionByDeviceName(ctx interface{}, deviceName interface{}) *MockStore_GetOpenEnrollmentSessionByDeviceName_Call {
+	return &MockStore_GetOpenEnrollmentSessionByDeviceName_Call{Call: _e.mock.On("GetOpenEnrollmentSessionByDeviceName", ctx, deviceName)}
+}
+
+func (_c *MockStore_GetOpenEnrollmentSessionByDeviceName_Call) Run(run func(ctx context.Context, deviceName string)) *MockStore_GetOpenEnrollmentSessionByDeviceName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetOpenEnrollmentSessionByDeviceName_Call) Return(_a0 repository.EnrollmentSession, _a1 error) *MockStore_GetOpenEnrollmentSessionByDeviceName_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetOpenEnrollmentSessionByDeviceName_Call) RunAndReturn(run func(context.Context, string) (repository.EnrollmentSession, error)) *MockStore_GetOpenEnrollmentSessionByDeviceName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOwnerSmartCard provides a mock function with given fields: ctx, arg
+func (_m *MockStore) GetOwnerSmartCard(ctx context.Context, arg repository.GetOwnerSmartCardParams) (repository.SmartCard, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOwnerSmartCard")
+	}
+
+	var r0 repository.SmartCard
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.GetOwnerSmartCardParams) (repository.SmartCard, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.GetOwnerSmartCardParams) repository.SmartCard); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.SmartCard)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.GetOwnerSmartCardParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetOwnerSmartCard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOwnerSmartCard'
+type MockStore_GetOwnerSmartCard_Call struct {
+	*mock.Call
+}
+
+// GetOwnerSmartCard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.GetOwnerSmartCardParams
+func (_e *MockStore_Expecter) GetOwnerSmartCard(ctx interface{}, arg interface{}) *MockStore_GetOwnerSmartCard_Call {
+	return &MockStore_GetOwnerSmartCard_Call{Call: _e.mock.On("GetOwnerSmartCard", ctx, arg)}
+}
+
+func (_c *MockStore_GetOwnerSmartCard_Call) Run(run func(ctx context.Context, arg repository.GetOwnerSmartCardParams)) *MockStore_GetOwnerSmartCard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.GetOwnerSmartCardParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetOwnerSmartCard_Call) Return(_a0 repository.SmartCard, _a1 error) *MockStore_GetOwnerSmartCard_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetOwnerSmartCard_Call) RunAndReturn(run func(context.Context, repository.GetOwnerSmartCardParams) (repository.SmartCard, error)) *MockStore_GetOwnerSmartCard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetParent provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetParent(ctx context.Context, id int32) (repository.GetParentRow, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetParent")
+	}
+
+	var r0 repository.GetParentRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.GetParentRow, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.GetParentRow); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(repository.GetParentRow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetParent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetParent'
+type MockStore_GetParent_Call struct {
+	*mock.Call
+}
+
+// GetParent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockStore_Expecter) GetParent(ctx interface{}, id interface{}) *MockStore_GetParent_Call {
+	return &MockStore_GetParent_Call{Call: _e.mock.On("GetParent", ctx, id)}
+}
+
+func (_c *MockStore_GetParent_Call) Run(run func(ctx context.Context, id int32)) *MockStore_GetParent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetParent_Call) Return(_a0 repository.GetParentRow, _a1 error) *MockStore_GetParent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetParent_Call) RunAndReturn(run func(context.Context, int32) (repository.GetParentRow, error)) *MockStore_GetParent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetParentByUserId provides a mock function with given fields: ctx, userID
+func (_m *MockStore) GetParentByUserId(ctx context.Context, userID pgtype.Int4) (repository.Parent, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetParentByUserId")
+	}
+
+	var r0 repository.Parent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Int4) (repository.Parent, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Int4) repository.Parent); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(repository.Parent)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Int4) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetParentByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetParentByUserId'
+type MockStore_GetParentByUserId_Call struct {
+	*mock.Call
+}
+
+// GetParentByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID pgtype.Int4
+func (_e *MockStore_Expecter) GetParentByUserId(ctx interface{}, userID interface{}) *MockStore_GetParentByUserId_Call {
+	return &MockStore_GetParentByUserId_Call{Call: _e.mock.On("GetParentByUserId", ctx, userID)}
+}
+
+func (_c *MockStore_GetParentByUserId_Call) Run(run func(ctx context.Context, userID pgtype.Int4)) *MockStore_GetParentByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgtype.Int4))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetParentByUserId_Call) Return(_a0 repository.Parent, _a1 error) *MockStore_GetParentByUserId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetParentByUserId_Call) RunAndReturn(run func(context.Context, pgtype.Int4) (repository.Parent, error)) *MockStore_GetParentByUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSantri provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetSantri(ctx context.Context, id int32) (repository.GetSantriRow, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSantri")
+	}
+
+	var r0 repository.GetSantriRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.GetSantriRow, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.GetSantriRow); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(repository.GetSantriRow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetSantri_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSantri'
+type MockStore_GetSantri_Call struct {
+	*mock.Call
+}
+
+// GetSantri is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockStore_Expecter) GetSantri(ctx interface{}, id interface{}) *MockStore_GetSantri_Call {
+	return &MockStore_GetSantri_Call{Call: _e.mock.On("GetSantri", ctx, id)}
+}
+
+func (_c *MockStore_GetSantri_Call) Run(run func(ctx context.Context, id int32)) *MockStore_GetSantri_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetSantri_Call) Return(_a0 repository.GetSantriRow, _a1 error) *MockStore_GetSantri_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetSantri_Call) RunAndReturn(run func(context.Context, int32) (repository.GetSantriRow, error)) *MockStore_GetSantri_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSantriLeaveRequest provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetSantriLeaveRequest(ctx context.Context, id int32) (repository.GetSantriLeaveRequestRow, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSantriLeaveRequest")
+	}
+
+	var r0 repository.GetSantriLeaveRequestRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.GetSantriLeaveRequestRow, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.GetSantriLeaveRequestRow); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(repository.GetSantriLeaveRequestRow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetSantriLeaveRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSantriLeaveRequest'
+type MockStore_GetSantriLeaveRequest_Call struct {
+	*mock.Call
+}
+
+// GetSantriLeaveRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockStore_Expecter) GetSantriLeaveRequest(ctx interface{}, id interface{}) *MockStore_GetSantriLeaveRequest_Call {
+	return &MockStore_GetSantriLeaveRequest_Call{Call: _e.mock.On("GetSantriLeaveRequest", ctx, id)}
+}
+
+func (_c *MockStore_GetSantriLeaveRequest_Call) Run(run func(ctx context.Context, id int32)) *MockStore_GetSantriLeaveRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetSantriLeaveRequest_Call) Return(_a0 repository.GetSantriLeaveRequestRow, _a1 error) *MockStore_GetSantriLeaveRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetSantriLeaveRequest_Call) RunAndReturn(run func(context.Context, int32) (repository.GetSantriLeaveRequestRow, error)) *MockStore_GetSantriLeaveRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSantriPermission provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetSantriPermission(ctx context.Context, id int32) (repository.GetSantriPermissionRow, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSantriPermission")
+	}
+
+	var r0 repository.GetSantriPermissionRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.GetSantriPermissionRow, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.GetSantriPermissionRow); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(repository.GetSantriPermissionRow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetSantriPermission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSantriPermission'
+type MockStore_GetSantriPermission_Call struct {
+	*mock.Call
+}
+
+// GetSantriPermission is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockStore_Expecter) GetSantriPermission(ctx interface{}, id interface{}) *MockStore_GetSantriPermission_Call {
+	return &MockStore_GetSantriPermission_Call{Call: _e.mock.On("GetSantriPermission", ctx, id)}
+}
+
+func (_c *MockStore_GetSantriPermission_Call) Run(run func(ctx context.Context, id int32)) *MockStore_GetSantriPermission_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetSantriPermission_Call) Return(_a0 repository.GetSantriPermissionRow, _a1 error) *MockStore_GetSantriPermission_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetSantriPermission_Call) RunAndReturn(run func(context.Context, int32) (repository.GetSantriPermissionRow, error)) *MockStore_GetSantriPermission_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSmartCard provides a mock function with given fields: ctx, uid
+func (_m *MockStore) GetSmartCard(ctx context.Context, uid string) (repository.GetSmartCardRow, error) {
+	ret := _m.Called(ctx, uid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSmartCard")
+	}
+
+	var r0 repository.GetSmartCardRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (repository.GetSmartCardRow, error)); ok {
+		return rf(ctx, uid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) repository.GetSmartCardRow); ok {
+		r0 = rf(ctx, uid)
+	} else {
+		r0 = ret.Get(0).(repository.GetSmartCardRow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, uid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetSmartCard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSmartCard'
+type MockStore_GetSmartCard_Call struct {
+	*mock.Call
+}
+
+// GetSmartCard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uid string
+func (_e *MockStore_Expecter) GetSmartCard(ctx interface{}, uid interface{}) *MockStore_GetSmartCard_Call {
+	return &MockStore_GetSmartCard_Call{Call: _e.mock.On("GetSmartCard", ctx, uid)}
+}
+
+func (_c *MockStore_GetSmartCard_Call) Run(run func(ctx context.Context, uid string)) *MockStore_GetSmartCard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetSmartCard_Call) Return(_a0 repository.GetSmartCardRow, _a1 error) *MockStore_GetSmartCard_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetSmartCard_Call) RunAndReturn(run func(context.Context, string) (repository.GetSmartCardRow, error)) *MockStore_GetSmartCard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSmartCardByID provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetSmartCardByID(ctx context.Context, id int32) (repository.SmartCard, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSmartCardByID")
+	}
+
+	var r0 repository.SmartCard
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (repository.SmartCard, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) repository.SmartCard); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(repository.SmartCard)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetSmartCardByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSmartCardByID'
+type MockStore_GetSmartCardByID_Call struct {
+	*mock.Call
+}
+
+// GetSmartCardByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockStore_Expecter) GetSmartCardByID(ctx interface{}, id interface{}) *MockStore_GetSmartCardByID_Call {
+	return &MockStore_GetSmartCardByID_Call{Call: _e.mock.On("GetSmartCardByID", ctx, id)}
+}
+
+func (_c *MockStore_GetSmartCardByID_Call) Run(run func(ctx context.Context, id int32)) *MockStore_GetSmartCardByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetSmartCardByID_Call) Return(_a0 repository.SmartCard, _a1 error) *MockStore_GetSmartCardByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetSmartCardByID_Call) RunAndReturn(run func(context.Context, int32) (repository.SmartCard, error)) *MockStore_GetSmartCardByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserByEmail provides a mock function with given fields: ctx, email
+func (_m *MockStore) GetUserByEmail(ctx context.Context, email pgtype.Text) (repository.GetUserByEmailRow, error) {
+	ret := _m.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByEmail")
+	}
+
+	var r0 repository.GetUserByEmailRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) (repository.GetUserByEmailRow, error)); ok {
+		return rf(ctx, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) repository.GetUserByEmailRow); ok {
+		r0 = rf(ctx, email)
+	} else {
+		r0 = ret.Get(0).(repository.GetUserByEmailRow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Text) error); ok {
+		r1 = rf(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetUserByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByEmail'
+type MockStore_GetUserByEmail_Call struct {
+	*mock.Call
+}
+
+// GetUserByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email pgtype.Text
+func (_e *MockStore_Expecter) GetUserByEmail(ctx interface{}, email interface{}) *MockStore_GetUserByEmail_Call {
+	return &MockStore_GetUserByEmail_Call{Call: _e.mock.On("GetUserByEmail", ctx, email)}
+}
+
+func (_c *MockStore_GetUserByEmail_Call) Run(run func(ctx context.Context, email pgtype.Text)) *MockStore_GetUserByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgtype.Text))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetUserByEmail_Call) Return(_a0 repository.GetUserByEmailRow, _a1 error) *MockStore_GetUserByEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetUserByEmail_Call) RunAndReturn(run func(context.Context, pgtype.Text) (repository.GetUserByEmailRow, error)) *MockStore_GetUserByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserById provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetUserById(ctx context.Context, id pgtype.Int4) (repository.GetUserByIdRow, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserById")
+	}
+
+	var r0 repository.GetUserByIdRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Int4) (repository.GetUserByIdRow, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Int4) repository.GetUserByIdRow); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(repository.GetUserByIdRow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Int4) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetUserById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserById'
+type MockStore_GetUserById_Call struct {
+	*mock.Call
+}
+
+// GetUserById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id pgtype.Int4
+func (_e *MockStore_Expecter) GetUserById(ctx interface{}, id interface{}) *MockStore_GetUserById_Call {
+	return &MockStore_GetUserById_Call{Call: _e.mock.On("GetUserById", ctx, id)}
+}
+
+func (_c *MockStore_GetUserById_Call) Run(run func(ctx context.Context, id pgtype.Int4)) *MockStore_GetUserById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgtype.Int4))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetUserById_Call) Return(_a0 repository.GetUserByIdRow, _a1 error) *MockStore_GetUserById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetUserById_Call) RunAndReturn(run func(context.Context, pgtype.Int4) (repository.GetUserByIdRow, error)) *MockStore_GetUserById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserByUsername provides a mock function with given fields: ctx, username
+func (_m *MockStore) GetUserByUsername(ctx context.Context, username pgtype.Text) (repository.GetUserByUsernameRow, error) {
+	ret := _m.Called(ctx, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByUsername")
+	}
+
+	var r0 repository.GetUserByUsernameRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) (repository.GetUserByUsernameRow, error)); ok {
+		return rf(ctx, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) repository.GetUserByUsernameRow); ok {
+		r0 = rf(ctx, username)
+	} else {
+		r0 = ret.Get(0).(repository.GetUserByUsernameRow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Text) error); ok {
+		r1 = rf(ctx, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetUserByUsername_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByUsername'
+type MockStore_GetUserByUsername_Call struct {
+	*mock.Call
+}
+
+// GetUserByUsername is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username pgtype.Text
+func (_e *MockStore_Expecter) GetUserByUsername(ctx interface{}, username interface{}) *MockStore_GetUserByUsername_Call {
+	return &MockStore_GetUserByUsername_Call{Call: _e.mock.On("GetUserByUsername", ctx, username)}
+}
+
+func (_c *MockStore_GetUserByUsername_Call) Run(run func(ctx context.Context, username pgtype.Text)) *MockStore_GetUserByUsername_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgtype.Text))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetUserByUsername_Call) Return(_a0 repository.GetUserByUsernameRow, _a1 error) *MockStore_GetUserByUsername_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetUserByUsername_Call) RunAndReturn(run func(context.Context, pgtype.Text) (repository.GetUserByUsernameRow, error)) *MockStore_GetUserByUsername_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsHolidayDate provides a mock function with given fields: ctx, date
+func (_m *MockStore) IsHolidayDate(ctx context.Context, date pgtype.Date) (bool, error) {
+	ret := _m.Called(ctx, date)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsHolidayDate")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Date) (bool, error)); ok {
+		return rf(ctx, date)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Date) bool); ok {
+		r0 = rf(ctx, date)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Date) error); ok {
+		r1 = rf(ctx, date)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_IsHolidayDate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsHolidayDate'
+type MockStore_IsHolidayDate_Call struct {
+	*mock.Call
+}
+
+// IsHolidayDate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - date pgtype.Date
+func (_e *MockStore_Expecter) IsHolidayDate(ctx interface{}, date interface{}) *MockStore_IsHolidayDate_Call {
+	return &MockStore_IsHolidayDate_Call{Call: _e.mock.On("IsHolidayDate", ctx, date)}
+}
+
+func (_c *MockStore_IsHolidayDate_Call) Run(run func(ctx context.Context, date pgtype.Date)) *MockStore_IsHolidayDate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgtype.Date))
+	})
+	return _c
+}
+
+func (_c *MockStore_IsHolidayDate_Call) Return(_a0 bool, _a1 error) *MockStore_IsHolidayDate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_IsHolidayDate_Call) RunAndReturn(run func(context.Context, pgtype.Date) (bool, error)) *MockStore_IsHolidayDate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListActiveDeviceModeSwitches provides a mock function with given fields: ctx
+func (_m *MockStore) ListActiveDeviceModeSwitches(ctx context.Context) ([]repository.ListActiveDeviceModeSwitchesRow, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListActiveDeviceModeSwitches")
+	}
+
+	var r0 []repository.ListActiveDeviceModeSwitchesRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]repository.ListActiveDeviceModeSwitchesRow, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []repository.ListActiveDeviceModeSwitchesRow); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListActiveDeviceModeSwitchesRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListActiveDeviceModeSwitches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListActiveDeviceModeSwitches'
+type MockStore_ListActiveDeviceModeSwitches_Call struct {
+	*mock.Call
+}
+
+// ListActiveDeviceModeSwitches is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockStore_Expecter) ListActiveDeviceModeSwitches(ctx interface{}) *MockStore_ListActiveDeviceModeSwitches_Call {
+	return &MockStore_ListActiveDeviceModeSwitches_Call{Call: _e.mock.On("ListActiveDeviceModeSwitches", ctx)}
+}
+
+func (_c *MockStore_ListActiveDeviceModeSwitches_Call) Run(run func(ctx context.Context)) *MockStore_ListActiveDeviceModeSwitches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListActiveDeviceModeSwitches_Call) Return(_a0 []repository.ListActiveDeviceModeSwitchesRow, _a1 error) *MockStore_ListActiveDeviceModeSwitches_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListActiveDeviceModeSwitches_Call) RunAndReturn(run func(context.Context) ([]repository.ListActiveDeviceModeSwitchesRow, error)) *MockStore_ListActiveDeviceModeSwitches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListActiveSantriPermissions provides a mock function with given fields: ctx, at
+func (_m *MockStore) ListActiveSantriPermissions(ctx context.Context, at pgtype.Timestamptz) ([]repository.SantriPermission, error) {
+	ret := _m.Called(ctx, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListActiveSantriPermissions")
+	}
+
+	var r0 []repository.SantriPermission
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Timestamptz) ([]repository.SantriPermission, error)); ok {
+		return rf(ctx, at)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Timestamptz) []repository.SantriPermission); ok {
+		r0 = rf(ctx, at)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.SantriPermission)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Timestamptz) error); ok {
+		r1 = rf(ctx, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListActiveSantriPermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListActiveSantriPermissions'
+type MockStore_ListActiveSantriPermissions_Call struct {
+	*mock.Call
+}
+
+// ListActiveSantriPermissions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - at pgtype.Timestamptz
+func (_e *MockStore_Expecter) ListActiveSantriPermissions(ctx interface{}, at interface{}) *MockStore_ListActiveSantriPermissions_Call {
+	return &MockStore_ListActiveSantriPermissions_Call{Call: _e.mock.On("ListActiveSantriPermissions", ctx, at)}
+}
+
+func (_c *MockStore_ListActiveSantriPermissions_Call) Run(run func(ctx context.Context, at pgtype.Timestamptz)) *MockStore_ListActiveSantriPermissions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgtype.Timestamptz))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListActiveSantriPermissions_Call) Return(_a0 []repository.SantriPermission, _a1 error) *MockStore_ListActiveSantriPermissions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListActiveSantriPermissions_Call) RunAndReturn(run func(context.Context, pgtype.Timestamptz) ([]repository.SantriPermission, error)) *MockStore_ListActiveSantriPermissions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDeviceAllowRules provides a mock function with given fields: ctx, deviceID
+func (_m *MockStore) ListDeviceAllowRules(ctx context.Context, deviceID int32) ([]repository.DeviceAllowRule, error) {
+	ret := _m.Called(ctx, deviceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDeviceAllowRules")
+	}
+
+	var r0 []repository.DeviceAllowRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) ([]repository.DeviceAllowRule, error)); ok {
+		return rf(ctx, deviceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) []repository.DeviceAllowRule); ok {
+		r0 = rf(ctx, deviceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.DeviceAllowRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, deviceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListDeviceAllowRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDeviceAllowRules'
+type MockStore_ListDeviceAllowRules_Call struct {
+	*mock.Call
+}
+
+// ListDeviceAllowRules is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deviceID int32
+func (_e *MockStore_Expecter) ListDeviceAllowRules(ctx interface{}, deviceID interface{}) *MockStore_ListDeviceAllowRules_Call {
+	return &MockStore_ListDeviceAllowRules_Call{Call: _e.mock.On("ListDeviceAllowRules", ctx, deviceID)}
+}
+
+func (_c *MockStore_ListDeviceAllowRules_Call) Run(run func(ctx context.Context, deviceID int32)) *MockStore_ListDeviceAllowRules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListDeviceAllowRules_Call) Return(_a0 []repository.DeviceAllowRule, _a1 error) *MockStore_ListDeviceAllowRules_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListDeviceAllowRules_Call) RunAndReturn(run func(context.Context, int32) ([]repository.DeviceAllowRule, error)) *MockStore_ListDeviceAllowRules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDeviceCommands provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListDeviceCommands(ctx context.Context, arg repository.ListDeviceCommandsParams) ([]repository.DeviceCommand, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDeviceCommands")
+	}
+
+	var r0 []repository.DeviceCommand
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ListDeviceCommandsParams) ([]repository.DeviceCommand, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ListDeviceCommandsParams) []repository.DeviceCommand); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.DeviceCommand)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.ListDeviceCommandsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListDeviceCommands_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDeviceCommands'
+type MockStore_ListDeviceCommands_Call struct {
+	*mock.Call
+}
+
+// ListDeviceCommands is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.ListDeviceCommandsParams
+func (_e *MockStore_Expecter) ListDeviceCommands(ctx interface{}, arg interface{}) *MockStore_ListDeviceCommands_Call {
+	return &MockStore_ListDeviceCommands_Call{Call: _e.mock.On("ListDeviceCommands", ctx, arg)}
+}
+
+func (_c *MockStore_ListDeviceCommands_Call) Run(run func(ctx context.Context, arg repository.ListDeviceCommandsParams)) *MockStore_ListDeviceCommands_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.ListDeviceCommandsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListDeviceCommands_Call) Return(_a0 []repository.DeviceCommand, _a1 error) *MockStore_ListDeviceCommands_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListDeviceCommands_Call) RunAndReturn(run func(context.Context, repository.ListDeviceCommandsParams) ([]repository.DeviceCommand, error)) *MockStore_ListDeviceCommands_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDeviceModes provides a mock function with given fields: ctx, deviceID
+func (_m *MockStore) ListDeviceModes(ctx context.Context, deviceID int32) ([]repository.DeviceMode, error) {
+	ret := _m.Called(ctx, deviceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDeviceModes")
+	}
+
+	var r0 []repository.DeviceMode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) ([]repository.DeviceMode, error)); ok {
+		return rf(ctx, deviceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) []repository.DeviceMode); ok {
+		r0 = rf(ctx, deviceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.DeviceMode)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, deviceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListDeviceModes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDeviceModes'
+type MockStore_ListDeviceModes_Call struct {
+	*mock.Call
+}
+
+// ListDeviceModes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deviceID int32
+func (_e *MockStore_Expecter) ListDeviceModes(ctx interface{}, deviceID interface{}) *MockStore_ListDeviceModes_Call {
+	return &MockStore_ListDeviceModes_Call{Call: _e.mock.On("ListDeviceModes", ctx, deviceID)}
+}
+
+func (_c *MockStore_ListDeviceModes_Call) Run(run func(ctx context.Context, deviceID int32)) *MockStore_ListDeviceModes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListDeviceModes_Call) Return(_a0 []repository.DeviceMode, _a1 error) *MockStore_ListDeviceModes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListDeviceModes_Call) RunAndReturn(run func(context.Context, int32) ([]repository.DeviceMode, error)) *MockStore_ListDeviceModes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDevices provides a mock function with given fields: ctx
+func (_m *MockStore) ListDevices(ctx context.Context) ([]repository.ListDevicesRow, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDevices")
+	}
+
+	var r0 []repository.ListDevicesRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]repository.ListDevicesRow, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []repository.ListDevicesRow); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListDevicesRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListDevices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDevices'
+type MockStore_ListDevices_Call struct {
+	*mock.Call
+}
+
+// ListDevices is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockStore_Expecter) ListDevices(ctx interface{}) *MockStore_ListDevices_Call {
+	return &MockStore_ListDevices_Call{Call: _e.mock.On("ListDevices", ctx)}
+}
+
+func (_c *MockStore_ListDevices_Call) Run(run func(ctx context.Context)) *MockStore_ListDevices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListDevices_Call) Return(_a0 []repository.ListDevicesRow, _a1 error) *MockStore_ListDevices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListDevices_Call) RunAndReturn(run func(context.Context) ([]repository.ListDevicesRow, error)) *MockStore_ListDevices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEmployeeOccupations provides a mock function with given fields: ctx
+func (_m *MockStore) ListEmployeeOccupations(ctx context.Context) ([]repository.ListEmployeeOccupationsRow, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEmployeeOccupations")
+	}
+
+	var r0 []repository.ListEmployeeOccupationsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]repository.ListEmployeeOccupationsRow, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []repository.ListEmployeeOccupationsRow); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListEmployeeOccupationsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListEmployeeOccupations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEmployeeOccupations'
+type MockStore_ListEmployeeOccupations_Call struct {
+	*mock.Call
+}
+
+// ListEmployeeOccupations is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockStore_Expecter) ListEmployeeOccupations(ctx interface{}) *MockStore_ListEmployeeOccupations_Call {
+	return &MockStore_ListEmployeeOccupations_Call{Call: _e.mock.On("ListEmployeeOccupations", ctx)}
+}
+
+func (_c *MockStore_ListEmployeeOccupations_Call) Run(run func(ctx context.Context)) *MockStore_ListEmployeeOccupations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListEmployeeOccupations_Call) Return(_a0 []repository.ListEmployeeOccupationsRow, _a1 error) *MockStore_ListEmployeeOccupations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListEmployeeOccupations_Call) RunAndReturn(run func(context.Context) ([]repository.ListEmployeeOccupationsRow, error)) *MockStore_ListEmployeeOccupations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEmployeePermissions provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListEmployeePermissions(ctx context.Context, arg repository.ListEmployeePermissionsParams) ([]repository.ListEmployeePermissionsRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEmployeePermissions")
+	}
+
+	var r0 []repository.ListEmployeePermissionsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ListEmployeePermissionsParams) ([]repository.ListEmployeePermissionsRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ListEmployeePermissionsParams) []repository.ListEmployeePermissionsRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListEmployeePermissionsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.ListEmployeePermissionsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListEmployeePermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEmployeePermissions'
+type MockStore_ListEmployeePermissions_Call struct {
+	*mock.Call
+}
+
+// ListEmployeePermissions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.ListEmployeePermissionsParams
+func (_e *MockStore_Expecter) ListEmployeePermissions(ctx interface{}, arg interface{}) *MockStore_ListEmployeePermissions_Call {
+	return &MockStore_ListEmployeePermissions_Call{Call: _e.mock.On("ListEmployeePermissions", ctx, arg)}
+}
+
+func (_c *MockStore_ListEmployeePermissions_Call) Run(run func(ctx context.Context, arg repository.ListEmployeePermissionsParams)) *MockStore_ListEmployeePermissions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.ListEmployeePermissionsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListEmployeePermissions_Call) Return(_a0 []repository.ListEmployeePermissionsRow, _a1 error) *MockStore_ListEmployeePermissions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListEmployeePermissions_Call) RunAndReturn(run func(context.Context, repository.ListEmployeePermissionsParams) ([]repository.ListEmployeePermissionsRow, error)) *MockStore_ListEmployeePermissions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEmployeePresences provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListEmployeePresences(ctx context.Context, arg repository.ListEmployeePresencesParams) ([]repository.ListEmployeePresencesRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEmployeePresences")
+	}
+
+	var r0 []repository.ListEmployeePresencesRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ListEmployeePresencesParams) ([]repository.ListEmployeePresencesRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ListEmployeePresencesParams) []repository.ListEmployeePresencesRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListEmployeePresencesRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.ListEmployeePresencesParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListEmployeePresences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEmployeePresences'
+type MockStore_ListEmployeePresences_Call struct {
+	*mock.Call
+}
+
+// ListEmployeePresences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.ListEmployeePresencesParams
+func (_e *MockStore_Expecter) ListEmployeePresences(ctx interface{}, arg interface{}) *MockStore_ListEmployeePresences_Call {
+	return &MockStore_ListEmployeePresences_Call{Call: _e.mock.On("ListEmployeePresences", ctx, arg)}
+}
+
+func (_c *MockStore_ListEmployeePresences_Call) Run(run func(ctx context.Context, arg repository.ListEmployeePresencesParams)) *MockStore_ListEmployeePresences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.ListEmployeePresencesParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListEmployeePresences_Call) Return(_a0 []repository.ListEmployeePresencesRow, _a1 error) *MockStore_ListEmployeePresences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListEmployeePresences_Call) RunAndReturn(run func(context.Context, repository.ListEmployeePresencesParams) ([]repository.ListEmployeePresencesRow, error)) *MockStore_ListEmployeePresences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEmployees provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListEmployees(ctx context.Context, arg repository.ListEmployeesParams) ([]repository.ListEmployeesRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEmployees")
+	}
+
+	var r0 []repository.ListEmployeesRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ListEmployeesParams) ([]repository.ListEmployeesRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ListEmployeesParams) []repository.ListEmployeesRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListEmployeesRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.ListEmployeesParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListEmployees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEmployees'
+type MockStore_ListEmployees_Call struct {
+	*mock.Call
+}
+
+// ListEmployees is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.ListEmployeesParams
+func (_e *MockStore_Expecter) ListEmployees(ctx interface{}, arg interface{}) *MockStore_ListEmployees_Call {
+	return &MockStore_ListEmployees_Call{Call: _e.mock.On("ListEmployees", ctx, arg)}
+}
+
+func (_c *MockStore_ListEmployees_Call) Run(run func(ctx context.Context, arg repository.ListEmployeesParams)) *MockStore_ListEmployees_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.ListEmployeesParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListEmployees_Call) Return(_a0 []repository.ListEmployeesRow, _a1 error) *MockStore_ListEmployees_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListEmployees_Call) RunAndReturn(run func(context.Context, repository.ListEmployeesParams) ([]repository.ListEmployeesRow, error)) *MockStore_ListEmployees_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEnrollmentSessionItems provides a mock function with given fields: ctx, sessionID
+func (_m *MockStore) ListEnrollmentSessionItems(ctx context.Context, sessionID int32) ([]repository.ListEnrollmentSessionItemsRow, error) {
+	ret := _m.Called(ctx, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEnrollmentSessionItems")
+	}
+
+	var r0 []repository.ListEnrollmentSessionItemsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) ([]repository.ListEnrollmentSessionItemsRow, error)); ok {
+		return rf(ctx, sessionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) []repository.ListEnrollmentSessionItemsRow); ok {
+		r0 = rf(ctx, sessionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListEnrollmentSessionItemsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, sessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListEnrollmentSessionItems_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEnrollmentSessionItems'
+type MockStore_ListEnrollmentSessionItems_Call struct {
+	*mock.Call
+}
+
+// ListEnrollmentSessionItems is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionID int32
+func (_e *MockStore_Expecter) ListEnrollmentSessionItems(ctx interface{}, sessionID interface{}) *MockStore_ListEnrollmentSessionItems_Call {
+	return &MockStore_ListEnrollmentSessionItems_Call{Call: _e.mock.On("ListEnrollmentSessionItems", ctx, sessionID)}
+}
+
+func (_c *MockStore_ListEnrollmentSessionItems_Call) Run(run func(ctx context.Context, sessionID int32)) *MockStore_ListEnrollmentSessionItems_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListEnrollmentSessionItems_Call) Return(_a0 []repository.ListEnrollmentSessionItemsRow, _a1 error) *MockStore_ListEnrollmentSessionItems_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListEnrollmentSessionItems_Call) RunAndReturn(run func(context.Context, int32) ([]repository.ListEnrollmentSessionItemsRow, error)) *MockStore_ListEnrollmentSessionItems_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListExpiringSmartCards provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListExpiringSmartCards(ctx context.Context, arg repository.ListExpiringSmartCardsParams) ([]repository.ListExpiringSmartCardsRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListExpiringSmartCards")
+	}
+
+	var r0 []repository.ListExpiringSmartCardsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ListExpiringSmartCardsParams) ([]repository.ListExpiringSmartCardsRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ListExpiringSmartCardsParams) []repository.ListExpiringSmartCardsRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListExpiringSmartCardsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.ListExpiringSmartCardsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListExpiringSmartCards_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListExpiringSmartCards'
+type MockStore_ListExpiringSmartCards_Call struct {
+	*mock.Call
+}
+
+// ListExpiringSmartCards is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.ListExpiringSmartCardsParams
+func (_e *MockStore_Expecter) ListExpiringSmartCards(ctx interface{}, arg interface{}) *MockStore_ListExpiringSmartCards_Call {
+	return &MockStore_ListExpiringSmartCards_Call{Call: _e.mock.On("ListExpiringSmartCards", ctx, arg)}
+}
+
+func (_c *MockStore_ListExpiringSmartCards_Call) Run(run func(ctx context.Context, arg repository.ListExpiringSmartCardsParams)) *MockStore_ListExpiringSmartCards_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.ListExpiringSmartCardsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListExpiringSmartCards_Call) Return(_a0 []repository.ListExpiringSmartCardsRow, _a1 error) *MockStore_ListExpiringSmartCards_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListExpiringSmartCards_Call) RunAndReturn(run func(context.Context, repository.ListExpiringSmartCardsParams) ([]repository.ListExpiringSmartCardsRow, error)) *MockStore_ListExpiringSmartCards_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListHolidayDatesByHolidayId provides a mock function with given fields: ctx, holidayID
+func (_m *MockStore) ListHolidayDatesByHolidayId(ctx context.Context, holidayID int32) ([]pgtype.Date, error) {
+	ret := _m.Called(ctx, holidayID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListHolidayDatesByHolidayId")
+	}
+
+	var r0 []pgtype.Date
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) ([]pgtype.Date, error)); ok {
+		return rf(ctx, holidayID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) []pgtype.Date); ok {
+		r0 = rf(ctx, holidayID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pgtype.Date)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, holidayID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListHolidayDatesByHolidayId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListHolidayDatesByHolidayId'
+type MockStore_ListHolidayDatesByHolidayId_Call struct {
+	*mock.Call
+}
+
+// ListHolidayDatesByHolidayId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - holidayID int32
+func (_e *MockStore_Expecter) ListHolidayDatesByHolidayId(ctx interface{}, holidayID interface{}) *MockStore_ListHolidayDatesByHolidayId_Call {
+	return &MockStore_ListHolidayDatesByHolidayId_Call{Call: _e.mock.On("ListHolidayDatesByHolidayId", ctx, holidayID)}
+}
+
+func (_c *MockStore_ListHolidayDatesByHolidayId_Call) Run(run func(ctx context.Context, holidayID int32)) *MockStore_ListHolidayDatesByHolidayId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListHolidayDatesByHolidayId_Call) Return(_a0 []pgtype.Date, _a1 error) *MockStore_ListHolidayDatesByHolidayId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListHolidayDatesByHolidayId_Call) RunAndReturn(run func(context.Context, int32) ([]pgtype.Date, error)) *MockStore_ListHolidayDatesByHolidayId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListHolidays provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListHolidays(ctx context.Context, arg repository.ListHolidaysParams) ([]repository.ListHolidaysRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListHolidays")
+	}
+
+	var r0 []repository.ListHolidaysRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ListHolidaysParams) ([]repository.ListHolidaysRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ListHolidaysParams) []repository.ListHolidaysRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListHolidaysRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.ListHolidaysParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListHolidays_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListHolidays'
+type MockStore_ListHolidays_Call struct {
+	*mock.Call
+}
+
+// ListHolidays is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.ListHolidaysParams
+func (_e *MockStore_Expecter) ListHolidays(ctx interface{}, arg interface{}) *MockStore_ListHolidays_Call {
+	return &MockStore_ListHolidays_Call{Call: _e.mock.On("ListHolidays", ctx, arg)}
+}
+
+func (_c *MockStore_ListHolidays_Call) Run(run func(ctx context.Context, arg repository.ListHolidaysParams)) *MockStore_ListHolidays_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.ListHolidaysParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListHolidays_Call) Return(_a0 []repository.ListHolidaysRow, _a1 error) *MockStore_ListHolidays_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListHolidays_Call) RunAndReturn(run func(context.Context, repository.ListHolidaysParams) ([]repository.ListHolidaysRow, error)) *MockStore_ListHolidays_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListLocations provides a mock function with given fields: ctx
+func (_m *MockStore) ListLocations(ctx context.Context) ([]repository.Location, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListLocations")
+	}
+
+	var r0 []repository.Location
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]repository.Location, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []repository.Location); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.Location)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListLocations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLocations'
+type MockStore_ListLocations_Call struct {
+	*mock.Call
+}
+
+// ListLocations is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockStore_Expecter) ListLocations(ctx interface{}) *MockStore_ListLocations_Call {
+	return &MockStore_ListLocations_Call{Call: _e.mock.On("ListLocations", ctx)}
+}
+
+func (_c *MockStore_ListLocations_Call) Run(run func(ctx context.Context)) *MockStore_ListLocations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListLocations_Call) Return(_a0 []repository.Location, _a1 error) *MockStore_ListLocations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListLocations_Call) RunAndReturn(run func(context.Context) ([]repository.Location, error)) *MockStore_ListLocations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMissingEmployeePresences provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListMissingEmployeePresences(ctx context.Context, arg repository.ListMissingEmployeePresencesParams) ([]repository.ListMissingEmployeePresencesRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMissingEmployeePresences")
+	}
+
+	var r0 []repository.ListMissingEmployeePresencesRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ListMissingEmployeePresencesParams) ([]repository.ListMissingEmployeePresencesRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ListMissingEmployeePresencesParams) []repository.ListMissingEmployeePresencesRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListMissingEmployeePresencesRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.ListMissingEmployeePresencesParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListMissingEmployeePresences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMissingEmployeePresences'
+type MockStore_ListMissingEmployeePresences_Call struct {
+	*mock.Call
+}
+
+// ListMissingEmployeePresences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.ListMissingEmployeePresencesParams
+func (_e *MockStore_Expecter) ListMissingEmployeePresences(ctx interface{}, arg interface{}) *MockStore_ListMissingEmployeePresences_Call {
+	return &MockStore_ListMissingEmployeePresences_Call{Call: _e.mock.On("ListMissingEmployeePresences", ctx, arg)}
+}
+
+func (_c *MockStore_ListMissingEmployeePresences_Call) Run(run func(ctx context.Context, arg repository.ListMissingEmployeePresencesParams)) *MockStore_ListMissingEmployeePresences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.ListMissingEmployeePresencesParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListMissingEmployeePresences_Call) Return(_a0 []repository.ListMissingEmployeePresencesRow, _a1 error) *MockStore_ListMissingEmployeePresences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListMissingEmployeePresences_Call) RunAndReturn(run func(context.Context, repository.ListMissingEmployeePresencesParams) ([]repository.ListMissingEmployeePresencesRow, error)) *MockStore_ListMissingEmployeePresences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMissingSantriPresences provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListMissingSantriPresences(ctx context.Context, arg repository.ListMissingSantriPresencesParams) ([]repository.ListMissingSantriPresencesRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMissingSantriPresences")
+	}
+
+	var r0 []repository.ListMissingSantriPresencesRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ListMissingSantriPresencesParams) ([]repository.ListMissingSantriPresencesRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ListMissingSantriPresencesParams) []repository.ListMissingSantriPresencesRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListMissingSantriPresencesRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.ListMissingSantriPresencesParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListMissingSantriPresences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMissingSantriPresences'
+type MockStore_ListMissingSantriPresences_Call struct {
+	*mock.Call
+}
+
+// ListMissingSantriPresences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.ListMissingSantriPresencesParams
+func (_e *MockStore_Expecter) ListMissingSantriPresences(ctx interface{}, arg interface{}) *MockStore_ListMissingSantriPresences_Call {
+	return &MockStore_ListMissingSantriPresences_Call{Call: _e.mock.On("ListMissingSantriPresences", ctx, arg)}
+}
+
+func (_c *MockStore_ListMissingSantriPresences_Call) Run(run func(ctx context.Context, arg repository.ListMissingSantriPresencesParams)) *MockStore_ListMissingSantriPresences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.ListMissingSantriPresencesParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListMissingSantriPresences_Call) Return(_a0 []repository.ListMissingSantriPresencesRow, _a1 error) *MockStore_ListMissingSantriPresences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListMissingSantriPresences_Call) RunAndReturn(run func(context.Context, repository.ListMissingSantriPresencesParams) ([]repository.ListMissingSantriPresencesRow, error)) *MockStore_ListMissingSantriPresences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListParents provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListParents(ctx context.Context, arg repository.ListParentParams) ([]repository.ListParentRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListParents")
+	}
+
+	var r0 []repository.ListParentRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ListParentParams) ([]repository.ListParentRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ListParentParams) []repository.ListParentRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListParentRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.ListParentParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListParents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListParents'
+type MockStore_ListParents_Call struct {
+	*mock.Call
+}
+
+// ListParents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.ListParentParams
+func (_e *MockStore_Expecter) ListParents(ctx interface{}, arg interface{}) *MockStore_ListParents_Call {
+	return &MockStore_ListParents_Call{Call: _e.mock.On("ListParents", ctx, arg)}
+}
+
+func (_c *MockStore_ListParents_Call) Run(run func(ctx context.Context, arg repository.ListParentParams)) *MockStore_ListParents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.ListParentParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListParents_Call) Return(_a0 []repository.ListParentRow, _a1 error) *MockStore_ListParents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListParents_Call) RunAndReturn(run func(context.Context, repository.ListParentParams) ([]repository.ListParentRow, error)) *MockStore_ListParents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPendingEmployeePermissions provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListPendingEmployeePermissions(ctx context.Context, arg repository.ListPendingEmployeePermissionsParams) ([]repository.ListPendingEmployeePermissionsRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPendingEmployeePermissions")
+	}
+
+	var r0 []repository.ListPendingEmployeePermissionsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ListPendingEmployeePermissionsParams) ([]repository.ListPendingEmployeePermissionsRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ListPendingEmployeePermissionsParams) []repository.ListPendingEmployeePermissionsRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListPendingEmployeePermissionsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.ListPendingEmployeePermissionsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListPendingEmployeePermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPendingEmployeePermissions'
+type MockStore_ListPendingEmployeePermissions_Call struct {
+	*mock.Call
+}
+
+// ListPendingEmployeePermissions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.ListPendingEmployeePermissionsParams
+func (_e *MockStore_Expecter) ListPendingEmployeePermissions(ctx interface{}, arg interface{}) *MockStore_ListPendingEmployeePermissions_Call {
+	return &MockStore_ListPendingEmployeePermissions_Call{Call: _e.mock.On("ListPendingEmployeePermissions", ctx, arg)}
+}
+
+func (_c *MockStore_ListPendingEmployeePermissions_Call) Run(run func(ctx context.Context, arg repository.ListPendingEmployeePermissionsParams)) *MockStore_ListPendingEmployeePermissions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.ListPendingEmployeePermissionsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListPendingEmployeePermissions_Call) Return(_a0 []repository.ListPendingEmployeePermissionsRow, _a1 error) *MockStore_ListPendingEmployeePermissions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListPendingEmployeePermissions_Call) RunAndReturn(run func(context.Context, repository.ListPendingEmployeePermissionsParams) ([]repository.ListPendingEmployeePermissionsRow, error)) *MockStore_ListPendingEmployeePermissions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPendingSmartCards provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListPendingSmartCards(ctx context.Context, arg repository.ListPendingSmartCardsParams) ([]repository.ListPendingSmartCardsRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPendingSmartCards")
+	}
+
+	var r0 []repository.ListPendingSmartCardsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ListPendingSmartCardsParams) ([]repository.ListPendingSmartCardsRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ListPendingSmartCardsParams) []repository.ListPendingSmartCardsRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListPendingSmartCardsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.ListPendingSmartCardsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListPendingSmartCards_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPendingSmartCards'
+type MockStore_ListPendingSmartCards_Call struct {
+	*mock.Call
+}
+
+// ListPendingSmartCards is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.ListPendingSmartCardsParams
+func (_e *MockStore_Expecter) ListPendingSmartCards(ctx interface{}, arg interface{}) *MockStore_ListPendingSmartCards_Call {
+	return &MockStore_ListPendingSmartCards_Call{Call: _e.mock.On("ListPendingSmartCards", ctx, arg)}
+}
+
+func (_c *MockStore_ListPendingSmartCards_Call) Run(run func(ctx context.Context, arg repository.ListPendingSmartCardsParams)) *MockStore_ListPendingSmartCards_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.ListPendingSmartCardsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListPendingSmartCards_Call) Return(_a0 []repository.ListPendingSmartCardsRow, _a1 error) *MockStore_ListPendingSmartCards_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListPendingSmartCards_Call) RunAndReturn(run func(context.Context, repository.ListPendingSmartCardsParams) ([]repository.ListPendingSmartCardsRow, error)) *MockStore_ListPendingSmartCards_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSantri provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListSantri(ctx context.Context, arg repository.ListSantriParams) ([]repository.ListSantriRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSantri")
+	}
+
+	var r0 []repository.ListSantriRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ListSantriParams) ([]repository.ListSantriRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ListSantriParams) []repository.ListSantriRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListSantriRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.ListSantriParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListSantri_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSantri'
+type MockStore_ListSantri_Call struct {
+	*mock.Call
+}
+
+// ListSantri is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.ListSantriParams
+func (_e *MockStore_Expecter) ListSantri(ctx interface{}, arg interface{}) *MockStore_ListSantri_Call {
+	return &MockStore_ListSantri_Call{Call: _e.mock.On("ListSantri", ctx, arg)}
+}
+
+func (_c *MockStore_ListSantri_Call) Run(run func(ctx context.Context, arg repository.ListSantriParams)) *MockStore_ListSantri_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.ListSantriParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListSantri_Call) Return(_a0 []repository.ListSantriRow, _a1 error) *MockStore_ListSantri_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListSantri_Call) RunAndReturn(run func(context.Context, repository.ListSantriParams) ([]repository.ListSantriRow, error)) *MockStore_ListSantri_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSantriLeaveRequestHistories provides a mock function with given fields: ctx, leaveRequestID
+func (_m *MockStore) ListSantriLeaveRequestHistories(ctx context.Context, leaveRequestID int32) ([]repository.ListSantriLeaveRequestHistoriesRow, error) {
+	ret := _m.Called(ctx, leaveRequestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSantriLeaveRequestHistories")
+	}
+
+	var r0 []repository.ListSantriLeaveRequestHistoriesRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) ([]repository.ListSantriLeaveRequestHistoriesRow, error)); ok {
+		return rf(ctx, leaveRequestID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) []repository.ListSantriLeaveRequestHistoriesRow); ok {
+		r0 = rf(ctx, leaveRequestID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListSantriLeaveRequestHistoriesRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, leaveRequestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListSantriLeaveRequestHistories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSantriLeaveRequestHistories'
+type MockStore_ListSantriLeaveRequestHistories_Call struct {
+	*mock.Call
+}
+
+// ListSantriLeaveRequestHistories is a helper method to define mock.On call
+//   - ctx context.Context
+//   - leaveRequestID int32
+func (_e *MockStore_Expecter) ListSantriLeaveRequestHistories(ctx interface{}, leaveRequestID interface{}) *MockStore_ListSantriLeaveRequestHistories_Call {
+	return &MockStore_ListSantriLeaveRequestHistories_Call{Call: _e.mock.On("ListSantriLeaveRequestHistories", ctx, leaveRequestID)}
+}
+
+func (_c *MockStore_ListSantriLeaveRequestHistories_Call) Run(run func(ctx context.Context, leaveRequestID int32)) *MockStore_ListSantriLeaveRequestHistories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListSantriLeaveRequestHistories_Call) Return(_a0 []repository.ListSantriLeaveRequestHistoriesRow, _a1 error) *MockStore_ListSantriLeaveRequestHistories_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListSantriLeaveRequestHistories_Call) RunAndReturn(run func(context.Context, int32) ([]repository.ListSantriLeaveRequestHistoriesRow, error)) *MockStore_ListSantriLeaveRequestHistories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSantriLeaveRequests provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListSantriLeaveRequests(ctx context.Context, arg repository.ListSantriLeaveRequestsParams) ([]repository.ListSantriLeaveRequestsRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSantriLeaveRequests")
+	}
+
+	var r0 []repository.ListSantriLeaveRequestsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ListSantriLeaveRequestsParams) ([]repository.ListSantriLeaveRequestsRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ListSantriLeaveRequestsParams) []repository.ListSantriLeaveRequestsRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListSantriLeaveRequestsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.ListSantriLeaveRequestsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListSantriLeaveRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSantriLeaveRequests'
+type MockStore_ListSantriLeaveRequests_Call struct {
+	*mock.Call
+}
+
+// ListSantriLeaveRequests is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.ListSantriLeaveRequestsParams
+func (_e *MockStore_Expecter) ListSantriLeaveRequests(ctx interface{}, arg interface{}) *MockStore_ListSantriLeaveRequests_Call {
+	return &MockStore_ListSantriLeaveRequests_Call{Call: _e.mock.On("ListSantriLeaveRequests", ctx, arg)}
+}
+
+func (_c *MockStore_ListSantriLeaveRequests_Call) Run(run func(ctx context.Context, arg repository.ListSantriLeaveRequestsParams)) *MockStore_ListSantriLeaveRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.ListSantriLeaveRequestsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListSantriLeaveRequests_Call) Return(_a0 []repository.ListSantriLeaveRequestsRow, _a1 error) *MockStore_ListSantriLeaveRequests_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListSantriLeaveRequests_Call) RunAndReturn(run func(context.Context, repository.ListSantriLeaveRequestsParams) ([]repository.ListSantriLeaveRequestsRow, error)) *MockStore_ListSantriLeaveRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSantriOccupations provides a mock function with given fields: ctx
+func (_m *MockStore) ListSantriOccupations(ctx context.Context) ([]repository.ListSantriOccupationsRow, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSantriOccupations")
+	}
+
+	var r0 []repository.ListSantriOccupationsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]repository.ListSantriOccupationsRow, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []repository.ListSantriOccupationsRow); ok {
+		r0 = rf(ctx)
 	} else {
-		r0 = ret.Get(0).(repository.GetUserByEmailRow)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListSantriOccupationsRow)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Text) error); ok {
-		r1 = rf(ctx, email)
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2493,56 +7197,57 @@ func (_m *MockStore) GetUserByEmail(ctx context.Context, email pgtype.Text) (rep
 	return r0, r1
 }
 
-// MockStore_GetUserByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByEmail'
-type MockStore_GetUserByEmail_Call struct {
+// MockStore_ListSantriOccupations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSantriOccupations'
+type MockStore_ListSantriOccupations_Call struct {
 	*mock.Call
 }
 
-// GetUserByEmail is a helper method to define mock.On call
+// ListSantriOccupations is a helper method to define mock.On call
 //   - ctx context.Context
-//   - email pgtype.Text
-func (_e *MockStore_Expecter) GetUserByEmail(ctx interface{}, email interface{}) *MockStore_GetUserByEmail_Call {
-	return &MockStore_GetUserByEmail_Call{Call: _e.mock.On("GetUserByEmail", ctx, email)}
+func (_e *MockStore_Expecter) ListSantriOccupations(ctx interface{}) *MockStore_ListSantriOccupations_Call {
+	return &MockStore_ListSantriOccupations_Call{Call: _e.mock.On("ListSantriOccupations", ctx)}
 }
 
-func (_c *MockStore_GetUserByEmail_Call) Run(run func(ctx context.Context, email pgtype.Text)) *MockStore_GetUserByEmail_Call {
+func (_c *MockStore_ListSantriOccupations_Call) Run(run func(ctx context.Context)) *MockStore_ListSantriOccupations_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(pgtype.Text))
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetUserByEmail_Call) Return(_a0 repository.GetUserByEmailRow, _a1 error) *MockStore_GetUserByEmail_Call {
+func (_c *MockStore_ListSantriOccupations_Call) Return(_a0 []repository.ListSantriOccupationsRow, _a1 error) *MockStore_ListSantriOccupations_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetUserByEmail_Call) RunAndReturn(run func(context.Context, pgtype.Text) (repository.GetUserByEmailRow, error)) *MockStore_GetUserByEmail_Call {
+func (_c *MockStore_ListSantriOccupations_Call) RunAndReturn(run func(context.Context) ([]repository.ListSantriOccupationsRow, error)) *MockStore_ListSantriOccupations_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserById provides a mock function with given fields: ctx, id
-func (_m *MockStore) GetUserById(ctx context.Context, id pgtype.Int4) (repository.GetUserByIdRow, error) {
-	ret := _m.Called(ctx, id)
+// ListSantriPermissions provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListSantriPermissions(ctx context.Context, arg repository.ListSantriPermissionsParams) ([]repository.ListSantriPermissionsRow, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserById")
+		panic("no return value specified for ListSantriPermissions")
 	}
 
-	var r0 repository.GetUserByIdRow
+	var r0 []repository.ListSantriPermissionsRow
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Int4) (repository.GetUserByIdRow, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ListSantriPermissionsParams) ([]repository.ListSantriPermissionsRow, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Int4) repository.GetUserByIdRow); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ListSantriPermissionsParams) []repository.ListSantriPermissionsRow); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(repository.GetUserByIdRow)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListSantriPermissionsRow)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Int4) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, repository.ListSantriPermissionsParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2550,56 +7255,235 @@ func (_m *MockStore) GetUserById(ctx context.Context, id pgtype.Int4) (repositor
 	return r0, r1
 }
 
-// MockStore_GetUserById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserById'
-type MockStore_GetUserById_Call struct {
+// MockStore_ListSantriPermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSantriPermissions'
+type MockStore_ListSantriPermissions_Call struct {
 	*mock.Call
 }
 
-// GetUserById is a helper method to define mock.On call
+// ListSantriPermissions is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id pgtype.Int4
-func (_e *MockStore_Expecter) GetUserById(ctx interface{}, id interface{}) *MockStore_GetUserById_Call {
-	return &MockStore_GetUserById_Call{Call: _e.mock.On("GetUserById", ctx, id)}
+//   - arg repository.ListSantriPermissionsParams
+func (_e *MockStore_Expecter) ListSantriPermissions(ctx interface{}, arg interface{}) *MockStore_ListSantriPermissions_Call {
+	return &MockStore_ListSantriPermissions_Call{Call: _e.mock.On("ListSantriPermissions", ctx, arg)}
 }
 
-func (_c *MockStore_GetUserById_Call) Run(run func(ctx context.Context, id pgtype.Int4)) *MockStore_GetUserById_Call {
+func (_c *MockStore_ListSantriPermissions_Call) Run(run func(ctx context.Context, arg repository.ListSantriPermissionsParams)) *MockStore_ListSantriPermissions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(pgtype.Int4))
+		run(args[0].(context.Context), args[1].(repository.ListSantriPermissionsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetUserById_Call) Return(_a0 repository.GetUserByIdRow, _a1 error) *MockStore_GetUserById_Call {
+func (_c *MockStore_ListSantriPermissions_Call) Return(_a0 []repository.ListSantriPermissionsRow, _a1 error) *MockStore_ListSantriPermissions_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetUserById_Call) RunAndReturn(run func(context.Context, pgtype.Int4) (repository.GetUserByIdRow, error)) *MockStore_GetUserById_Call {
+func (_c *MockStore_ListSantriPermissions_Call) RunAndReturn(run func(context.Context, repository.ListSantriPermissionsParams) ([]repository.ListSantriPermissionsRow, error)) *MockStore_ListSantriPermissions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserByUsername provides a mock function with given fields: ctx, username
-func (_m *MockStore) GetUserByUsername(ctx context.Context, username pgtype.Text) (repository.GetUserByUsernameRow, error) {
-	ret := _m.Called(ctx, username)
+// ListSantriPresences provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListSantriPresences(ctx context.Context, arg repository.ListSantriPresencesParams) ([]repository.ListSantriPresencesRow, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserByUsername")
+		panic("no return value specified for ListSantriPresences")
 	}
 
-	var r0 repository.GetUserByUsernameRow
+	var r0 []repository.ListSantriPresencesRow
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) (repository.GetUserByUsernameRow, error)); ok {
-		return rf(ctx, username)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ListSantriPresencesParams) ([]repository.ListSantriPresencesRow, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) repository.GetUserByUsernameRow); ok {
-		r0 = rf(ctx, username)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ListSantriPresencesParams) []repository.ListSantriPresencesRow); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(repository.GetUserByUsernameRow)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListSantriPresencesRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.ListSantriPresencesParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListSantriPresences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSantriPresences'
+type MockStore_ListSantriPresences_Call struct {
+	*mock.Call
+}
+
+// ListSantriPresences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.ListSantriPresencesParams
+func (_e *MockStore_Expecter) ListSantriPresences(ctx interface{}, arg interface{}) *MockStore_ListSantriPresences_Call {
+	return &MockStore_ListSantriPresences_Call{Call: _e.mock.On("ListSantriPresences", ctx, arg)}
+}
+
+func (_c *MockStore_ListSantriPresences_Call) Run(run func(ctx context.Context, arg repository.ListSantriPresencesParams)) *MockStore_ListSantriPresences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.ListSantriPresencesParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListSantriPresences_Call) Return(_a0 []repository.ListSantriPresencesRow, _a1 error) *MockStore_ListSantriPresences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListSantriPresences_Call) RunAndReturn(run func(context.Context, repository.ListSantriPresencesParams) ([]repository.ListSantriPresencesRow, error)) *MockStore_ListSantriPresences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSmartCardAssignments provides a mock function with given fields: ctx, smartCardID
+func (_m *MockStore) ListSmartCardAssignments(ctx context.Context, smartCardID int32) ([]repository.ListSmartCardAssignmentsRow, error) {
+	ret := _m.Called(ctx, smartCardID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSmartCardAssignments")
+	}
+
+	var r0 []repository.ListSmartCardAssignmentsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) ([]repository.ListSmartCardAssignmentsRow, error)); ok {
+		return rf(ctx, smartCardID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) []repository.ListSmartCardAssignmentsRow); ok {
+		r0 = rf(ctx, smartCardID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListSmartCardAssignmentsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, smartCardID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListSmartCardAssignments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSmartCardAssignments'
+type MockStore_ListSmartCardAssignments_Call struct {
+	*mock.Call
+}
+
+// ListSmartCardAssignments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - smartCardID int32
+func (_e *MockStore_Expecter) ListSmartCardAssignments(ctx interface{}, smartCardID interface{}) *MockStore_ListSmartCardAssignments_Call {
+	return &MockStore_ListSmartCardAssignments_Call{Call: _e.mock.On("ListSmartCardAssignments", ctx, smartCardID)}
+}
+
+func (_c *MockStore_ListSmartCardAssignments_Call) Run(run func(ctx context.Context, smartCardID int32)) *MockStore_ListSmartCardAssignments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListSmartCardAssignments_Call) Return(_a0 []repository.ListSmartCardAssignmentsRow, _a1 error) *MockStore_ListSmartCardAssignments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListSmartCardAssignments_Call) RunAndReturn(run func(context.Context, int32) ([]repository.ListSmartCardAssignmentsRow, error)) *MockStore_ListSmartCardAssignments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSmartCards provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListSmartCards(ctx context.Context, arg repository.ListSmartCardsParams) ([]repository.ListSmartCardsRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSmartCards")
+	}
+
+	var r0 []repository.ListSmartCardsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ListSmartCardsParams) ([]repository.ListSmartCardsRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ListSmartCardsParams) []repository.ListSmartCardsRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListSmartCardsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.ListSmartCardsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListSmartCards_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSmartCards'
+type MockStore_ListSmartCards_Call struct {
+	*mock.Call
+}
+
+// ListSmartCards is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.ListSmartCardsParams
+func (_e *MockStore_Expecter) ListSmartCards(ctx interface{}, arg interface{}) *MockStore_ListSmartCards_Call {
+	return &MockStore_ListSmartCards_Call{Call: _e.mock.On("ListSmartCards", ctx, arg)}
+}
+
+func (_c *MockStore_ListSmartCards_Call) Run(run func(ctx context.Context, arg repository.ListSmartCardsParams)) *MockStore_ListSmartCards_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.ListSmartCardsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListSmartCards_Call) Return(_a0 []repository.ListSmartCardsRow, _a1 error) *MockStore_ListSmartCards_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListSmartCards_Call) RunAndReturn(run func(context.Context, repository.ListSmartCardsParams) ([]repository.ListSmartCardsRow, error)) *MockStore_ListSmartCards_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTapEvents provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListTapEvents(ctx context.Context, arg repository.ListTapEventsParams) ([]repository.ListTapEventsRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTapEvents")
+	}
+
+	var r0 []repository.ListTapEventsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ListTapEventsParams) ([]repository.ListTapEventsRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ListTapEventsParams) []repository.ListTapEventsRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListTapEventsRow)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Text) error); ok {
-		r1 = rf(ctx, username)
+	if rf, ok := ret.Get(1).(func(context.Context, repository.ListTapEventsParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2607,58 +7491,58 @@ func (_m *MockStore) GetUserByUsername(ctx context.Context, username pgtype.Text
 	return r0, r1
 }
 
-// MockStore_GetUserByUsername_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByUsername'
-type MockStore_GetUserByUsername_Call struct {
+// MockStore_ListTapEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTapEvents'
+type MockStore_ListTapEvents_Call struct {
 	*mock.Call
 }
 
-// GetUserByUsername is a helper method to define mock.On call
+// ListTapEvents is a helper method to define mock.On call
 //   - ctx context.Context
-//   - username pgtype.Text
-func (_e *MockStore_Expecter) GetUserByUsername(ctx interface{}, username interface{}) *MockStore_GetUserByUsername_Call {
-	return &MockStore_GetUserByUsername_Call{Call: _e.mock.On("GetUserByUsername", ctx, username)}
+//   - arg repository.ListTapEventsParams
+func (_e *MockStore_Expecter) ListTapEvents(ctx interface{}, arg interface{}) *MockStore_ListTapEvents_Call {
+	return &MockStore_ListTapEvents_Call{Call: _e.mock.On("ListTapEvents", ctx, arg)}
 }
 
-func (_c *MockStore_GetUserByUsername_Call) Run(run func(ctx context.Context, username pgtype.Text)) *MockStore_GetUserByUsername_Call {
+func (_c *MockStore_ListTapEvents_Call) Run(run func(ctx context.Context, arg repository.ListTapEventsParams)) *MockStore_ListTapEvents_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(pgtype.Text))
+		run(args[0].(context.Context), args[1].(repository.ListTapEventsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetUserByUsername_Call) Return(_a0 repository.GetUserByUsernameRow, _a1 error) *MockStore_GetUserByUsername_Call {
+func (_c *MockStore_ListTapEvents_Call) Return(_a0 []repository.ListTapEventsRow, _a1 error) *MockStore_ListTapEvents_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetUserByUsername_Call) RunAndReturn(run func(context.Context, pgtype.Text) (repository.GetUserByUsernameRow, error)) *MockStore_GetUserByUsername_Call {
+func (_c *MockStore_ListTapEvents_Call) RunAndReturn(run func(context.Context, repository.ListTapEventsParams) ([]repository.ListTapEventsRow, error)) *MockStore_ListTapEvents_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListDeviceModes provides a mock function with given fields: ctx, deviceID
-func (_m *MockStore) ListDeviceModes(ctx context.Context, deviceID int32) ([]repository.DeviceMode, error) {
-	ret := _m.Called(ctx, deviceID)
+// ListUsers provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListUsers(ctx context.Context, arg repository.ListUserParams) ([]repository.ListUserRow, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListDeviceModes")
+		panic("no return value specified for ListUsers")
 	}
 
-	var r0 []repository.DeviceMode
+	var r0 []repository.ListUserRow
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int32) ([]repository.DeviceMode, error)); ok {
-		return rf(ctx, deviceID)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ListUserParams) ([]repository.ListUserRow, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int32) []repository.DeviceMode); ok {
-		r0 = rf(ctx, deviceID)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ListUserParams) []repository.ListUserRow); ok {
+		r0 = rf(ctx, arg)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]repository.DeviceMode)
+			r0 = ret.Get(0).([]repository.ListUserRow)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
-		r1 = rf(ctx, deviceID)
+	if rf, ok := ret.Get(1).(func(context.Context, repository.ListUserParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2666,58 +7550,56 @@ func (_m *MockStore) ListDeviceModes(ctx context.Context, deviceID int32) ([]rep
 	return r0, r1
 }
 
-// MockStore_ListDeviceModes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDeviceModes'
-type MockStore_ListDeviceModes_Call struct {
+// MockStore_ListUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUsers'
+type MockStore_ListUsers_Call struct {
 	*mock.Call
 }
 
-// ListDeviceModes is a helper method to define mock.On call
+// ListUsers is a helper method to define mock.On call
 //   - ctx context.Context
-//   - deviceID int32
-func (_e *MockStore_Expecter) ListDeviceModes(ctx interface{}, deviceID interface{}) *MockStore_ListDeviceModes_Call {
-	return &MockStore_ListDeviceModes_Call{Call: _e.mock.On("ListDeviceModes", ctx, deviceID)}
+//   - arg repository.ListUserParams
+func (_e *MockStore_Expecter) ListUsers(ctx interface{}, arg interface{}) *MockStore_ListUsers_Call {
+	return &MockStore_ListUsers_Call{Call: _e.mock.On("ListUsers", ctx, arg)}
 }
 
-func (_c *MockStore_ListDeviceModes_Call) Run(run func(ctx context.Context, deviceID int32)) *MockStore_ListDeviceModes_Call {
+func (_c *MockStore_ListUsers_Call) Run(run func(ctx context.Context, arg repository.ListUserParams)) *MockStore_ListUsers_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int32))
+		run(args[0].(context.Context), args[1].(repository.ListUserParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_ListDeviceModes_Call) Return(_a0 []repository.DeviceMode, _a1 error) *MockStore_ListDeviceModes_Call {
+func (_c *MockStore_ListUsers_Call) Return(_a0 []repository.ListUserRow, _a1 error) *MockStore_ListUsers_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_ListDeviceModes_Call) RunAndReturn(run func(context.Context, int32) ([]repository.DeviceMode, error)) *MockStore_ListDeviceModes_Call {
+func (_c *MockStore_ListUsers_Call) RunAndReturn(run func(context.Context, repository.ListUserParams) ([]repository.ListUserRow, error)) *MockStore_ListUsers_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListDevices provides a mock function with given fields: ctx
-func (_m *MockStore) ListDevices(ctx context.Context) ([]repository.ListDevicesRow, error) {
-	ret := _m.Called(ctx)
+// MarkSantriPermissionLeft provides a mock function with given fields: ctx, arg
+func (_m *MockStore) MarkSantriPermissionLeft(ctx context.Context, arg repository.MarkSantriPermissionLeftParams) (repository.SantriPermission, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListDevices")
+		panic("no return value specified for MarkSantriPermissionLeft")
 	}
 
-	var r0 []repository.ListDevicesRow
+	var r0 repository.SantriPermission
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]repository.ListDevicesRow, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.MarkSantriPermissionLeftParams) (repository.SantriPermission, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []repository.ListDevicesRow); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.MarkSantriPermissionLeftParams) repository.SantriPermission); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]repository.ListDevicesRow)
-		}
+		r0 = ret.Get(0).(repository.SantriPermission)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, repository.MarkSantriPermissionLeftParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2725,57 +7607,56 @@ func (_m *MockStore) ListDevices(ctx context.Context) ([]repository.ListDevicesR
 	return r0, r1
 }
 
-// MockStore_ListDevices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDevices'
-type MockStore_ListDevices_Call struct {
+// MockStore_MarkSantriPermissionLeft_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkSantriPermissionLeft'
+type MockStore_MarkSantriPermissionLeft_Call struct {
 	*mock.Call
 }
 
-// ListDevices is a helper method to define mock.On call
+// MarkSantriPermissionLeft is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockStore_Expecter) ListDevices(ctx interface{}) *MockStore_ListDevices_Call {
-	return &MockStore_ListDevices_Call{Call: _e.mock.On("ListDevices", ctx)}
+//   - arg repository.MarkSantriPermissionLeftParams
+func (_e *MockStore_Expecter) MarkSantriPermissionLeft(ctx interface{}, arg interface{}) *MockStore_MarkSantriPermissionLeft_Call {
+	return &MockStore_MarkSantriPermissionLeft_Call{Call: _e.mock.On("MarkSantriPermissionLeft", ctx, arg)}
 }
 
-func (_c *MockStore_ListDevices_Call) Run(run func(ctx context.Context)) *MockStore_ListDevices_Call {
+func (_c *MockStore_MarkSantriPermissionLeft_Call) Run(run func(ctx context.Context, arg repository.MarkSantriPermissionLeftParams)) *MockStore_MarkSantriPermissionLeft_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(repository.MarkSantriPermissionLeftParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_ListDevices_Call) Return(_a0 []repository.ListDevicesRow, _a1 error) *MockStore_ListDevices_Call {
+func (_c *MockStore_MarkSantriPermissionLeft_Call) Return(_a0 repository.SantriPermission, _a1 error) *MockStore_MarkSantriPermissionLeft_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_ListDevices_Call) RunAndReturn(run func(context.Context) ([]repository.ListDevicesRow, error)) *MockStore_ListDevices_Call {
+func (_c *MockStore_MarkSantriPermissionLeft_Call) RunAndReturn(run func(context.Context, repository.MarkSantriPermissionLeftParams) (repository.SantriPermission, error)) *MockStore_MarkSantriPermissionLeft_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListEmployeeOccupations provides a mock function with given fields: ctx
-func (_m *MockStore) ListEmployeeOccupations(ctx context.Context) ([]repository.ListEmployeeOccupationsRow, error) {
-	ret := _m.Called(ctx)
+// ReplaceSmartCard provides a mock function with given fields: ctx, oldID, oldState, newUid
+func (_m *MockStore) ReplaceSmartCard(ctx context.Context, oldID int32, oldState repository.SmartCardState, newUid string) (repository.SmartCard, error) {
+	ret := _m.Called(ctx, oldID, oldState, newUid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListEmployeeOccupations")
+		panic("no return value specified for ReplaceSmartCard")
 	}
 
-	var r0 []repository.ListEmployeeOccupationsRow
+	var r0 repository.SmartCard
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]repository.ListEmployeeOccupationsRow, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, int32, repository.SmartCardState, string) (repository.SmartCard, error)); ok {
+		return rf(ctx, oldID, oldState, newUid)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []repository.ListEmployeeOccupationsRow); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, int32, repository.SmartCardState, string) repository.SmartCard); ok {
+		r0 = rf(ctx, oldID, oldState, newUid)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]repository.ListEmployeeOccupationsRow)
-		}
+		r0 = ret.Get(0).(repository.SmartCard)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, int32, repository.SmartCardState, string) error); ok {
+		r1 = rf(ctx, oldID, oldState, newUid)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2783,57 +7664,60 @@ func (_m *MockStore) ListEmployeeOccupations(ctx context.Context) ([]repository.
 	return r0, r1
 }
 
-// MockStore_ListEmployeeOccupations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEmployeeOccupations'
-type MockStore_ListEmployeeOccupations_Call struct {
+// MockStore_ReplaceSmartCard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceSmartCard'
+type MockStore_ReplaceSmartCard_Call struct {
 	*mock.Call
 }
 
-// ListEmployeeOccupations is a helper method to define mock.On call
+// ReplaceSmartCard is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockStore_Expecter) ListEmployeeOccupations(ctx interface{}) *MockStore_ListEmployeeOccupations_Call {
-	return &MockStore_ListEmployeeOccupations_Call{Call: _e.mock.On("ListEmployeeOccupations", ctx)}
+//   - oldID int32
+//   - oldState repository.SmartCardState
+//   - newUid string
+func (_e *MockStore_Expecter) ReplaceSmartCard(ctx interface{}, oldID interface{}, oldState interface{}, newUid interface{}) *MockStore_ReplaceSmartCard_Call {
+	return &MockStore_ReplaceSmartCard_Call{Call: _e.mock.On("ReplaceSmartCard", ctx, oldID, oldState, newUid)}
 }
 
-func (_c *MockStore_ListEmployeeOccupations_Call) Run(run func(ctx context.Context)) *MockStore_ListEmployeeOccupations_Call {
+func (_c *MockStore_ReplaceSmartCard_Call) Run(run func(ctx context.Context, oldID int32, oldState repository.SmartCardState, newUid string)) *MockStore_ReplaceSmartCard_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(int32), args[2].(repository.SmartCardState), args[3].(string))
 	})
 	return _c
 }
 
-func (_c *MockStore_ListEmployeeOccupations_Call) Return(_a0 []repository.ListEmployeeOccupationsRow, _a1 error) *MockStore_ListEmployeeOccupations_Call {
+func (_c *MockStore_ReplaceSmartCard_Call) Return(_a0 repository.SmartCard, _a1 error) *MockStore_ReplaceSmartCard_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_ListEmployeeOccupations_Call) RunAndReturn(run func(context.Context) ([]repository.ListEmployeeOccupationsRow, error)) *MockStore_ListEmployeeOccupations_Call {
+func (_c *MockStore_ReplaceSmartCard_Call) RunAndReturn(run func(context.Context, int32, repository.SmartCardState, string) (repository.SmartCard, error)) *MockStore_ReplaceSmartCard_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListEmployeePermissions provides a mock function with given fields: ctx, arg
-func (_m *MockStore) ListEmployeePermissions(ctx context.Context, arg repository.ListEmployeePermissionsParams) ([]repository.ListEmployeePermissionsRow, error) {
-	ret := _m.Called(ctx, arg)
+// RevertSantriPermissionPresences provides a mock function with given fields: ctx, santriPermissionID
+func (_m *MockStore) RevertSantriPermissionPresences(ctx context.Context, santriPermissionID pgtype.Int4) ([]repository.SantriPresence, error) {
+	ret := _m.Called(ctx, santriPermissionID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListEmployeePermissions")
+		panic("no return value specified for RevertSantriPermissionPresences")
 	}
 
-	var r0 []repository.ListEmployeePermissionsRow
+	var r0 []repository.SantriPresence
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.ListEmployeePermissionsParams) ([]repository.ListEmployeePermissionsRow, error)); ok {
-		return rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Int4) ([]repository.SantriPresence, error)); ok {
+		return rf(ctx, santriPermissionID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repository.ListEmployeePermissionsParams) []repository.ListEmployeePermissionsRow); ok {
-		r0 = rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Int4) []repository.SantriPresence); ok {
+		r0 = rf(ctx, santriPermissionID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]repository.ListEmployeePermissionsRow)
+			r0 = ret.Get(0).([]repository.SantriPresence)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repository.ListEmployeePermissionsParams) error); ok {
-		r1 = rf(ctx, arg)
+	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Int4) error); ok {
+		r1 = rf(ctx, santriPermissionID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2841,57 +7725,55 @@ func (_m *MockStore) ListEmployeePermissions(ctx context.Context, arg repository
 	return r0, r1
 }
 
-// MockStore_ListEmployeePermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEmployeePermissions'
-type MockStore_ListEmployeePermissions_Call struct {
+// MockStore_RevertSantriPermissionPresences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevertSantriPermissionPresences'
+type MockStore_RevertSantriPermissionPresences_Call struct {
 	*mock.Call
 }
 
-// ListEmployeePermissions is a helper method to define mock.On call
+// RevertSantriPermissionPresences is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.ListEmployeePermissionsParams
-func (_e *MockStore_Expecter) ListEmployeePermissions(ctx interface{}, arg interface{}) *MockStore_ListEmployeePermissions_Call {
-	return &MockStore_ListEmployeePermissions_Call{Call: _e.mock.On("ListEmployeePermissions", ctx, arg)}
+//   - santriPermissionID pgtype.Int4
+func (_e *MockStore_Expecter) RevertSantriPermissionPresences(ctx interface{}, santriPermissionID interface{}) *MockStore_RevertSantriPermissionPresences_Call {
+	return &MockStore_RevertSantriPermissionPresences_Call{Call: _e.mock.On("RevertSantriPermissionPresences", ctx, santriPermissionID)}
 }
 
-func (_c *MockStore_ListEmployeePermissions_Call) Run(run func(ctx context.Context, arg repository.ListEmployeePermissionsParams)) *MockStore_ListEmployeePermissions_Call {
+func (_c *MockStore_RevertSantriPermissionPresences_Call) Run(run func(ctx context.Context, santriPermissionID pgtype.Int4)) *MockStore_RevertSantriPermissionPresences_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(repository.ListEmployeePermissionsParams))
+		run(args[0].(context.Context), args[1].(pgtype.Int4))
 	})
 	return _c
 }
 
-func (_c *MockStore_ListEmployeePermissions_Call) Return(_a0 []repository.ListEmployeePermissionsRow, _a1 error) *MockStore_ListEmployeePermissions_Call {
+func (_c *MockStore_RevertSantriPermissionPresences_Call) Return(_a0 []repository.SantriPresence, _a1 error) *MockStore_RevertSantriPermissionPresences_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_ListEmployeePermissions_Call) RunAndReturn(run func(context.Context, repository.ListEmployeePermissionsParams) ([]repository.ListEmployeePermissionsRow, error)) *MockStore_ListEmployeePermissions_Call {
+func (_c *MockStore_RevertSantriPermissionPresences_Call) RunAndReturn(run func(context.Context, pgtype.Int4) ([]repository.SantriPresence, error)) *MockStore_RevertSantriPermissionPresences_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListEmployeePresences provides a mock function with given fields: ctx, arg
-func (_m *MockStore) ListEmployeePresences(ctx context.Context, arg repository.ListEmployeePresencesParams) ([]repository.ListEmployeePresencesRow, error) {
+// ReviewEmployeePermission provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ReviewEmployeePermission(ctx context.Context, arg repository.ReviewEmployeePermissionParams) (repository.EmployeePermission, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListEmployeePresences")
+		panic("no return value specified for ReviewEmployeePermission")
 	}
 
-	var r0 []repository.ListEmployeePresencesRow
+	var r0 repository.EmployeePermission
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.ListEmployeePresencesParams) ([]repository.ListEmployeePresencesRow, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ReviewEmployeePermissionParams) (repository.EmployeePermission, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repository.ListEmployeePresencesParams) []repository.ListEmployeePresencesRow); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ReviewEmployeePermissionParams) repository.EmployeePermission); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]repository.ListEmployeePresencesRow)
-		}
+		r0 = ret.Get(0).(repository.EmployeePermission)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repository.ListEmployeePresencesParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, repository.ReviewEmployeePermissionParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -2900,58 +7782,58 @@ func (_m *MockStore) ListEmployeePresences(ctx context.Context, arg repository.L
 	return r0, r1
 }
 
-// MockStore_ListEmployeePresences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEmployeePresences'
-type MockStore_ListEmployeePresences_Call struct {
+// MockStore_ReviewEmployeePermission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReviewEmployeePermission'
+type MockStore_ReviewEmployeePermission_Call struct {
 	*mock.Call
 }
 
-// ListEmployeePresences is a helper method to define mock.On call
+// ReviewEmployeePermission is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.ListEmployeePresencesParams
-func (_e *MockStore_Expecter) ListEmployeePresences(ctx interface{}, arg interface{}) *MockStore_ListEmployeePresences_Call {
-	return &MockStore_ListEmployeePresences_Call{Call: _e.mock.On("ListEmployeePresences", ctx, arg)}
+//   - arg repository.ReviewEmployeePermissionParams
+func (_e *MockStore_Expecter) ReviewEmployeePermission(ctx interface{}, arg interface{}) *MockStore_ReviewEmployeePermission_Call {
+	return &MockStore_ReviewEmployeePermission_Call{Call: _e.mock.On("ReviewEmployeePermission", ctx, arg)}
 }
 
-func (_c *MockStore_ListEmployeePresences_Call) Run(run func(ctx context.Context, arg repository.ListEmployeePresencesParams)) *MockStore_ListEmployeePresences_Call {
+func (_c *MockStore_ReviewEmployeePermission_Call) Run(run func(ctx context.Context, arg repository.ReviewEmployeePermissionParams)) *MockStore_ReviewEmployeePermission_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(repository.ListEmployeePresencesParams))
+		run(args[0].(context.Context), args[1].(repository.ReviewEmployeePermissionParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_ListEmployeePresences_Call) Return(_a0 []repository.ListEmployeePresencesRow, _a1 error) *MockStore_ListEmployeePresences_Call {
+func (_c *MockStore_ReviewEmployeePermission_Call) Return(_a0 repository.EmployeePermission, _a1 error) *MockStore_ReviewEmployeePermission_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_ListEmployeePresences_Call) RunAndReturn(run func(context.Context, repository.ListEmployeePresencesParams) ([]repository.ListEmployeePresencesRow, error)) *MockStore_ListEmployeePresences_Call {
+func (_c *MockStore_ReviewEmployeePermission_Call) RunAndReturn(run func(context.Context, repository.ReviewEmployeePermissionParams) (repository.EmployeePermission, error)) *MockStore_ReviewEmployeePermission_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListEmployees provides a mock function with given fields: ctx, arg
-func (_m *MockStore) ListEmployees(ctx context.Context, arg repository.ListEmployeesParams) ([]repository.ListEmployeesRow, error) {
-	ret := _m.Called(ctx, arg)
+// SuspendSantriSmartCards provides a mock function with given fields: ctx, santriID
+func (_m *MockStore) SuspendSantriSmartCards(ctx context.Context, santriID pgtype.Int4) ([]repository.SmartCard, error) {
+	ret := _m.Called(ctx, santriID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListEmployees")
+		panic("no return value specified for SuspendSantriSmartCards")
 	}
 
-	var r0 []repository.ListEmployeesRow
+	var r0 []repository.SmartCard
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.ListEmployeesParams) ([]repository.ListEmployeesRow, error)); ok {
-		return rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Int4) ([]repository.SmartCard, error)); ok {
+		return rf(ctx, santriID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repository.ListEmployeesParams) []repository.ListEmployeesRow); ok {
-		r0 = rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Int4) []repository.SmartCard); ok {
+		r0 = rf(ctx, santriID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]repository.ListEmployeesRow)
+			r0 = ret.Get(0).([]repository.SmartCard)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repository.ListEmployeesParams) error); ok {
-		r1 = rf(ctx, arg)
+	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Int4) error); ok {
+		r1 = rf(ctx, santriID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2959,57 +7841,55 @@ func (_m *MockStore) ListEmployees(ctx context.Context, arg repository.ListEmplo
 	return r0, r1
 }
 
-// MockStore_ListEmployees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEmployees'
-type MockStore_ListEmployees_Call struct {
+// MockStore_SuspendSantriSmartCards_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SuspendSantriSmartCards'
+type MockStore_SuspendSantriSmartCards_Call struct {
 	*mock.Call
 }
 
-// ListEmployees is a helper method to define mock.On call
+// SuspendSantriSmartCards is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.ListEmployeesParams
-func (_e *MockStore_Expecter) ListEmployees(ctx interface{}, arg interface{}) *MockStore_ListEmployees_Call {
-	return &MockStore_ListEmployees_Call{Call: _e.mock.On("ListEmployees", ctx, arg)}
+//   - santriID pgtype.Int4
+func (_e *MockStore_Expecter) SuspendSantriSmartCards(ctx interface{}, santriID interface{}) *MockStore_SuspendSantriSmartCards_Call {
+	return &MockStore_SuspendSantriSmartCards_Call{Call: _e.mock.On("SuspendSantriSmartCards", ctx, santriID)}
 }
 
-func (_c *MockStore_ListEmployees_Call) Run(run func(ctx context.Context, arg repository.ListEmployeesParams)) *MockStore_ListEmployees_Call {
+func (_c *MockStore_SuspendSantriSmartCards_Call) Run(run func(ctx context.Context, santriID pgtype.Int4)) *MockStore_SuspendSantriSmartCards_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(repository.ListEmployeesParams))
+		run(args[0].(context.Context), args[1].(pgtype.Int4))
 	})
 	return _c
 }
 
-func (_c *MockStore_ListEmployees_Call) Return(_a0 []repository.ListEmployeesRow, _a1 error) *MockStore_ListEmployees_Call {
+func (_c *MockStore_SuspendSantriSmartCards_Call) Return(_a0 []repository.SmartCard, _a1 error) *MockStore_SuspendSantriSmartCards_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_ListEmployees_Call) RunAndReturn(run func(context.Context, repository.ListEmployeesParams) ([]repository.ListEmployeesRow, error)) *MockStore_ListEmployees_Call {
+func (_c *MockStore_SuspendSantriSmartCards_Call) RunAndReturn(run func(context.Context, pgtype.Int4) ([]repository.SmartCard, error)) *MockStore_SuspendSantriSmartCards_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListMissingEmployeePresences provides a mock function with given fields: ctx, arg
-func (_m *MockStore) ListMissingEmployeePresences(ctx context.Context, arg repository.ListMissingEmployeePresencesParams) ([]repository.ListMissingEmployeePresencesRow, error) {
+// UpdateDevice provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateDevice(ctx context.Context, arg repository.UpdateDeviceParams) (repository.Device, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListMissingEmployeePresences")
+		panic("no return value specified for UpdateDevice")
 	}
 
-	var r0 []repository.ListMissingEmployeePresencesRow
+	var r0 repository.Device
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.ListMissingEmployeePresencesParams) ([]repository.ListMissingEmployeePresencesRow, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateDeviceParams) (repository.Device, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repository.ListMissingEmployeePresencesParams) []repository.ListMissingEmployeePresencesRow); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateDeviceParams) repository.Device); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]repository.ListMissingEmployeePresencesRow)
-		}
+		r0 = ret.Get(0).(repository.Device)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repository.ListMissingEmployeePresencesParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, repository.UpdateDeviceParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -3018,57 +7898,55 @@ func (_m *MockStore) ListMissingEmployeePresences(ctx context.Context, arg repos
 	return r0, r1
 }
 
-// MockStore_ListMissingEmployeePresences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMissingEmployeePresences'
-type MockStore_ListMissingEmployeePresences_Call struct {
+// MockStore_UpdateDevice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDevice'
+type MockStore_UpdateDevice_Call struct {
 	*mock.Call
 }
 
-// ListMissingEmployeePresences is a helper method to define mock.On call
+// UpdateDevice is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.ListMissingEmployeePresencesParams
-func (_e *MockStore_Expecter) ListMissingEmployeePresences(ctx interface{}, arg interface{}) *MockStore_ListMissingEmployeePresences_Call {
-	return &MockStore_ListMissingEmployeePresences_Call{Call: _e.mock.On("ListMissingEmployeePresences", ctx, arg)}
+//   - arg repository.UpdateDeviceParams
+func (_e *MockStore_Expecter) UpdateDevice(ctx interface{}, arg interface{}) *MockStore_UpdateDevice_Call {
+	return &MockStore_UpdateDevice_Call{Call: _e.mock.On("UpdateDevice", ctx, arg)}
 }
 
-func (_c *MockStore_ListMissingEmployeePresences_Call) Run(run func(ctx context.Context, arg repository.ListMissingEmployeePresencesParams)) *MockStore_ListMissingEmployeePresences_Call {
+func (_c *MockStore_UpdateDevice_Call) Run(run func(ctx context.Context, arg repository.UpdateDeviceParams)) *MockStore_UpdateDevice_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(repository.ListMissingEmployeePresencesParams))
+		run(args[0].(context.Context), args[1].(repository.UpdateDeviceParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_ListMissingEmployeePresences_Call) Return(_a0 []repository.ListMissingEmployeePresencesRow, _a1 error) *MockStore_ListMissingEmployeePresences_Call {
+func (_c *MockStore_UpdateDevice_Call) Return(_a0 repository.Device, _a1 error) *MockStore_UpdateDevice_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_ListMissingEmployeePresences_Call) RunAndReturn(run func(context.Context, repository.ListMissingEmployeePresencesParams) ([]repository.ListMissingEmployeePresencesRow, error)) *MockStore_ListMissingEmployeePresences_Call {
+func (_c *MockStore_UpdateDevice_Call) RunAndReturn(run func(context.Context, repository.UpdateDeviceParams) (repository.Device, error)) *MockStore_UpdateDevice_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListMissingSantriPresences provides a mock function with given fields: ctx, arg
-func (_m *MockStore) ListMissingSantriPresences(ctx context.Context, arg repository.ListMissingSantriPresencesParams) ([]repository.ListMissingSantriPresencesRow, error) {
+// UpdateDeviceCommandStatus provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateDeviceCommandStatus(ctx context.Context, arg repository.UpdateDeviceCommandStatusParams) (repository.DeviceCommand, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListMissingSantriPresences")
+		panic("no return value specified for UpdateDeviceCommandStatus")
 	}
 
-	var r0 []repository.ListMissingSantriPresencesRow
+	var r0 repository.DeviceCommand
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.ListMissingSantriPresencesParams) ([]repository.ListMissingSantriPresencesRow, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateDeviceCommandStatusParams) (repository.DeviceCommand, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repository.ListMissingSantriPresencesParams) []repository.ListMissingSantriPresencesRow); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateDeviceCommandStatusParams) repository.DeviceCommand); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]repository.ListMissingSantriPresencesRow)
-		}
+		r0 = ret.Get(0).(repository.DeviceCommand)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repository.ListMissingSantriPresencesParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, repository.UpdateDeviceCommandStatusParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -3077,57 +7955,55 @@ func (_m *MockStore) ListMissingSantriPresences(ctx context.Context, arg reposit
 	return r0, r1
 }
 
-// MockStore_ListMissingSantriPresences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMissingSantriPresences'
-type MockStore_ListMissingSantriPresences_Call struct {
+// MockStore_UpdateDeviceCommandStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDeviceCommandStatus'
+type MockStore_UpdateDeviceCommandStatus_Call struct {
 	*mock.Call
 }
 
-// ListMissingSantriPresences is a helper method to define mock.On call
+// UpdateDeviceCommandStatus is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.ListMissingSantriPresencesParams
-func (_e *MockStore_Expecter) ListMissingSantriPresences(ctx interface{}, arg interface{}) *MockStore_ListMissingSantriPresences_Call {
-	return &MockStore_ListMissingSantriPresences_Call{Call: _e.mock.On("ListMissingSantriPresences", ctx, arg)}
+//   - arg repository.UpdateDeviceCommandStatusParams
+func (_e *MockStore_Expecter) UpdateDeviceCommandStatus(ctx interface{}, arg interface{}) *MockStore_UpdateDeviceCommandStatus_Call {
+	return &MockStore_UpdateDeviceCommandStatus_Call{Call: _e.mock.On("UpdateDeviceCommandStatus", ctx, arg)}
 }
 
-func (_c *MockStore_ListMissingSantriPresences_Call) Run(run func(ctx context.Context, arg repository.ListMissingSantriPresencesParams)) *MockStore_ListMissingSantriPresences_Call {
+func (_c *MockStore_UpdateDeviceCommandStatus_Call) Run(run func(ctx context.Context, arg repository.UpdateDeviceCommandStatusParams)) *MockStore_UpdateDeviceCommandStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(repository.ListMissingSantriPresencesParams))
+		run(args[0].(context.Context), args[1].(repository.UpdateDeviceCommandStatusParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_ListMissingSantriPresences_Call) Return(_a0 []repository.ListMissingSantriPresencesRow, _a1 error) *MockStore_ListMissingSantriPresences_Call {
+func (_c *MockStore_UpdateDeviceCommandStatus_Call) Return(_a0 repository.DeviceCommand, _a1 error) *MockStore_UpdateDeviceCommandStatus_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_ListMissingSantriPresences_Call) RunAndReturn(run func(context.Context, repository.ListMissingSantriPresencesParams) ([]repository.ListMissingSantriPresencesRow, error)) *MockStore_ListMissingSantriPresences_Call {
+func (_c *MockStore_UpdateDeviceCommandStatus_Call) RunAndReturn(run func(context.Context, repository.UpdateDeviceCommandStatusParams) (repository.DeviceCommand, error)) *MockStore_UpdateDeviceCommandStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListParents provides a mock function with given fields: ctx, arg
-func (_m *MockStore) ListParents(ctx context.Context, arg repository.ListParentParams) ([]repository.ListParentRow, error) {
+// UpdateDeviceHeartbeat provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateDeviceHeartbeat(ctx context.Context, arg repository.UpdateDeviceHeartbeatParams) (repository.Device, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListParents")
+		panic("no return value specified for UpdateDeviceHeartbeat")
 	}
 
-	var r0 []repository.ListParentRow
+	var r0 repository.Device
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.ListParentParams) ([]repository.ListParentRow, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateDeviceHeartbeatParams) (repository.Device, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repository.ListParentParams) []repository.ListParentRow); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateDeviceHeartbeatParams) repository.Device); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]repository.ListParentRow)
-		}
+		r0 = ret.Get(0).(repository.Device)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repository.ListParentParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, repository.UpdateDeviceHeartbeatParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -3136,57 +8012,55 @@ func (_m *MockStore) ListParents(ctx context.Context, arg repository.ListParentP
 	return r0, r1
 }
 
-// MockStore_ListParents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListParents'
-type MockStore_ListParents_Call struct {
+// MockStore_UpdateDeviceHeartbeat_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDeviceHeartbeat'
+type MockStore_UpdateDeviceHeartbeat_Call struct {
 	*mock.Call
 }
 
-// ListParents is a helper method to define mock.On call
+// UpdateDeviceHeartbeat is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.ListParentParams
-func (_e *MockStore_Expecter) ListParents(ctx interface{}, arg interface{}) *MockStore_ListParents_Call {
-	return &MockStore_ListParents_Call{Call: _e.mock.On("ListParents", ctx, arg)}
+//   - arg repository.UpdateDeviceHeartbeatParams
+func (_e *MockStore_Expecter) UpdateDeviceHeartbeat(ctx interface{}, arg interface{}) *MockStore_UpdateDeviceHeartbeat_Call {
+	return &MockStore_UpdateDeviceHeartbeat_Call{Call: _e.mock.On("UpdateDeviceHeartbeat", ctx, arg)}
 }
 
-func (_c *MockStore_ListParents_Call) Run(run func(ctx context.Context, arg repository.ListParentParams)) *MockStore_ListParents_Call {
+func (_c *MockStore_UpdateDeviceHeartbeat_Call) Run(run func(ctx context.Context, arg repository.UpdateDeviceHeartbeatParams)) *MockStore_UpdateDeviceHeartbeat_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(repository.ListParentParams))
+		run(args[0].(context.Context), args[1].(repository.UpdateDeviceHeartbeatParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_ListParents_Call) Return(_a0 []repository.ListParentRow, _a1 error) *MockStore_ListParents_Call {
+func (_c *MockStore_UpdateDeviceHeartbeat_Call) Return(_a0 repository.Device, _a1 error) *MockStore_UpdateDeviceHeartbeat_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_ListParents_Call) RunAndReturn(run func(context.Context, repository.ListParentParams) ([]repository.ListParentRow, error)) *MockStore_ListParents_Call {
+func (_c *MockStore_UpdateDeviceHeartbeat_Call) RunAndReturn(run func(context.Context, repository.UpdateDeviceHeartbeatParams) (repository.Device, error)) *MockStore_UpdateDeviceHeartbeat_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListSantri provides a mock function with given fields: ctx, arg
-func (_m *MockStore) ListSantri(ctx context.Context, arg repository.ListSantriParams) ([]repository.ListSantriRow, error) {
+// UpdateDeviceLocation provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateDeviceLocation(ctx context.Context, arg repository.UpdateDeviceLocationParams) (repository.Device, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListSantri")
+		panic("no return value specified for UpdateDeviceLocation")
 	}
 
-	var r0 []repository.ListSantriRow
+	var r0 repository.Device
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.ListSantriParams) ([]repository.ListSantriRow, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateDeviceLocationParams) (repository.Device, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repository.ListSantriParams) []repository.ListSantriRow); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateDeviceLocationParams) repository.Device); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]repository.ListSantriRow)
-		}
+		r0 = ret.Get(0).(repository.Device)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repository.ListSantriParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, repository.UpdateDeviceLocationParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -3195,58 +8069,56 @@ func (_m *MockStore) ListSantri(ctx context.Context, arg repository.ListSantriPa
 	return r0, r1
 }
 
-// MockStore_ListSantri_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSantri'
-type MockStore_ListSantri_Call struct {
+// MockStore_UpdateDeviceLocation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDeviceLocation'
+type MockStore_UpdateDeviceLocation_Call struct {
 	*mock.Call
 }
 
-// ListSantri is a helper method to define mock.On call
+// UpdateDeviceLocation is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.ListSantriParams
-func (_e *MockStore_Expecter) ListSantri(ctx interface{}, arg interface{}) *MockStore_ListSantri_Call {
-	return &MockStore_ListSantri_Call{Call: _e.mock.On("ListSantri", ctx, arg)}
+//   - arg repository.UpdateDeviceLocationParams
+func (_e *MockStore_Expecter) UpdateDeviceLocation(ctx interface{}, arg interface{}) *MockStore_UpdateDeviceLocation_Call {
+	return &MockStore_UpdateDeviceLocation_Call{Call: _e.mock.On("UpdateDeviceLocation", ctx, arg)}
 }
 
-func (_c *MockStore_ListSantri_Call) Run(run func(ctx context.Context, arg repository.ListSantriParams)) *MockStore_ListSantri_Call {
+func (_c *MockStore_UpdateDeviceLocation_Call) Run(run func(ctx context.Context, arg repository.UpdateDeviceLocationParams)) *MockStore_UpdateDeviceLocation_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(repository.ListSantriParams))
+		run(args[0].(context.Context), args[1].(repository.UpdateDeviceLocationParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_ListSantri_Call) Return(_a0 []repository.ListSantriRow, _a1 error) *MockStore_ListSantri_Call {
+func (_c *MockStore_UpdateDeviceLocation_Call) Return(_a0 repository.Device, _a1 error) *MockStore_UpdateDeviceLocation_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_ListSantri_Call) RunAndReturn(run func(context.Context, repository.ListSantriParams) ([]repository.ListSantriRow, error)) *MockStore_ListSantri_Call {
+func (_c *MockStore_UpdateDeviceLocation_Call) RunAndReturn(run func(context.Context, repository.UpdateDeviceLocationParams) (repository.Device, error)) *MockStore_UpdateDeviceLocation_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListSantriOccupations provides a mock function with given fields: ctx
-func (_m *MockStore) ListSantriOccupations(ctx context.Context) ([]repository.ListSantriOccupationsRow, error) {
-	ret := _m.Called(ctx)
+// UpdateDeviceMode provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateDeviceMode(ctx context.Context, arg repository.UpdateDeviceModeParams) (repository.DeviceMode, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListSantriOccupations")
+		panic("no return value specified for UpdateDeviceMode")
 	}
 
-	var r0 []repository.ListSantriOccupationsRow
+	var r0 repository.DeviceMode
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]repository.ListSantriOccupationsRow, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateDeviceModeParams) (repository.DeviceMode, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []repository.ListSantriOccupationsRow); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateDeviceModeParams) repository.DeviceMode); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]repository.ListSantriOccupationsRow)
-		}
+		r0 = ret.Get(0).(repository.DeviceMode)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, repository.UpdateDeviceModeParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3254,56 +8126,55 @@ func (_m *MockStore) ListSantriOccupations(ctx context.Context) ([]repository.Li
 	return r0, r1
 }
 
-// MockStore_ListSantriOccupations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSantriOccupations'
-type MockStore_ListSantriOccupations_Call struct {
+// MockStore_UpdateDeviceMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDeviceMode'
+type MockStore_UpdateDeviceMode_Call struct {
 	*mock.Call
 }
 
-// ListSantriOccupations is a helper method to define mock.On call
+// UpdateDeviceMode is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockStore_Expecter) ListSantriOccupations(ctx interface{}) *MockStore_ListSantriOccupations_Call {
-	return &MockStore_ListSantriOccupations_Call{Call: _e.mock.On("ListSantriOccupations", ctx)}
+//   - arg repository.UpdateDeviceModeParams
+func (_e *MockStore_Expecter) UpdateDeviceMode(ctx interface{}, arg interface{}) *MockStore_UpdateDeviceMode_Call {
+	return &MockStore_UpdateDeviceMode_Call{Call: _e.mock.On("UpdateDeviceMode", ctx, arg)}
 }
 
-func (_c *MockStore_ListSantriOccupations_Call) Run(run func(ctx context.Context)) *MockStore_ListSantriOccupations_Call {
+func (_c *MockStore_UpdateDeviceMode_Call) Run(run func(ctx context.Context, arg repository.UpdateDeviceModeParams)) *MockStore_UpdateDeviceMode_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(repository.UpdateDeviceModeParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_ListSantriOccupations_Call) Return(_a0 []repository.ListSantriOccupationsRow, _a1 error) *MockStore_ListSantriOccupations_Call {
+func (_c *MockStore_UpdateDeviceMode_Call) Return(_a0 repository.DeviceMode, _a1 error) *MockStore_UpdateDeviceMode_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_ListSantriOccupations_Call) RunAndReturn(run func(context.Context) ([]repository.ListSantriOccupationsRow, error)) *MockStore_ListSantriOccupations_Call {
+func (_c *MockStore_UpdateDeviceMode_Call) RunAndReturn(run func(context.Context, repository.UpdateDeviceModeParams) (repository.DeviceMode, error)) *MockStore_UpdateDeviceMode_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListSantriPermissions provides a mock function with given fields: ctx, arg
-func (_m *MockStore) ListSantriPermissions(ctx context.Context, arg repository.ListSantriPermissionsParams) ([]repository.ListSantriPermissionsRow, error) {
+// UpdateDeviceSecret provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateDeviceSecret(ctx context.Context, arg repository.UpdateDeviceSecretParams) (repository.Device, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListSantriPermissions")
+		panic("no return value specified for UpdateDeviceSecret")
 	}
 
-	var r0 []repository.ListSantriPermissionsRow
+	var r0 repository.Device
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.ListSantriPermissionsParams) ([]repository.ListSantriPermissionsRow, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateDeviceSecretParams) (repository.Device, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repository.ListSantriPermissionsParams) []repository.ListSantriPermissionsRow); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateDeviceSecretParams) repository.Device); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]repository.ListSantriPermissionsRow)
-		}
+		r0 = ret.Get(0).(repository.Device)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repository.ListSantriPermissionsParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, repository.UpdateDeviceSecretParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -3312,57 +8183,55 @@ func (_m *MockStore) ListSantriPermissions(ctx context.Context, arg repository.L
 	return r0, r1
 }
 
-// MockStore_ListSantriPermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSantriPermissions'
-type MockStore_ListSantriPermissions_Call struct {
+// MockStore_UpdateDeviceSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDeviceSecret'
+type MockStore_UpdateDeviceSecret_Call struct {
 	*mock.Call
 }
 
-// ListSantriPermissions is a helper method to define mock.On call
+// UpdateDeviceSecret is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.ListSantriPermissionsParams
-func (_e *MockStore_Expecter) ListSantriPermissions(ctx interface{}, arg interface{}) *MockStore_ListSantriPermissions_Call {
-	return &MockStore_ListSantriPermissions_Call{Call: _e.mock.On("ListSantriPermissions", ctx, arg)}
+//   - arg repository.UpdateDeviceSecretParams
+func (_e *MockStore_Expecter) UpdateDeviceSecret(ctx interface{}, arg interface{}) *MockStore_UpdateDeviceSecret_Call {
+	return &MockStore_UpdateDeviceSecret_Call{Call: _e.mock.On("UpdateDeviceSecret", ctx, arg)}
 }
 
-func (_c *MockStore_ListSantriPermissions_Call) Run(run func(ctx context.Context, arg repository.ListSantriPermissionsParams)) *MockStore_ListSantriPermissions_Call {
+func (_c *MockStore_UpdateDeviceSecret_Call) Run(run func(ctx context.Context, arg repository.UpdateDeviceSecretParams)) *MockStore_UpdateDeviceSecret_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(repository.ListSantriPermissionsParams))
+		run(args[0].(context.Context), args[1].(repository.UpdateDeviceSecretParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_ListSantriPermissions_Call) Return(_a0 []repository.ListSantriPermissionsRow, _a1 error) *MockStore_ListSantriPermissions_Call {
+func (_c *MockStore_UpdateDeviceSecret_Call) Return(_a0 repository.Device, _a1 error) *MockStore_UpdateDeviceSecret_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_ListSantriPermissions_Call) RunAndReturn(run func(context.Context, repository.ListSantriPermissionsParams) ([]repository.ListSantriPermissionsRow, error)) *MockStore_ListSantriPermissions_Call {
+func (_c *MockStore_UpdateDeviceSecret_Call) RunAndReturn(run func(context.Context, repository.UpdateDeviceSecretParams) (repository.Device, error)) *MockStore_UpdateDeviceSecret_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListSantriPresences provides a mock function with given fields: ctx, arg
-func (_m *MockStore) ListSantriPresences(ctx context.Context, arg repository.ListSantriPresencesParams) ([]repository.ListSantriPresencesRow, error) {
+// UpdateDeviceTapRule provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateDeviceTapRule(ctx context.Context, arg repository.UpdateDeviceTapRuleParams) (repository.Device, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListSantriPresences")
+		panic("no return value specified for UpdateDeviceTapRule")
 	}
 
-	var r0 []repository.ListSantriPresencesRow
+	var r0 repository.Device
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.ListSantriPresencesParams) ([]repository.ListSantriPresencesRow, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateDeviceTapRuleParams) (repository.Device, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repository.ListSantriPresencesParams) []repository.ListSantriPresencesRow); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateDeviceTapRuleParams) repository.Device); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]repository.ListSantriPresencesRow)
-		}
+		r0 = ret.Get(0).(repository.Device)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repository.ListSantriPresencesParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, repository.UpdateDeviceTapRuleParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -3371,57 +8240,55 @@ func (_m *MockStore) ListSantriPresences(ctx context.Context, arg repository.Lis
 	return r0, r1
 }
 
-// MockStore_ListSantriPresences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSantriPresences'
-type MockStore_ListSantriPresences_Call struct {
+// MockStore_UpdateDeviceTapRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDeviceTapRule'
+type MockStore_UpdateDeviceTapRule_Call struct {
 	*mock.Call
 }
 
-// ListSantriPresences is a helper method to define mock.On call
+// UpdateDeviceTapRule is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.ListSantriPresencesParams
-func (_e *MockStore_Expecter) ListSantriPresences(ctx interface{}, arg interface{}) *MockStore_ListSantriPresences_Call {
-	return &MockStore_ListSantriPresences_Call{Call: _e.mock.On("ListSantriPresences", ctx, arg)}
+//   - arg repository.UpdateDeviceTapRuleParams
+func (_e *MockStore_Expecter) UpdateDeviceTapRule(ctx interface{}, arg interface{}) *MockStore_UpdateDeviceTapRule_Call {
+	return &MockStore_UpdateDeviceTapRule_Call{Call: _e.mock.On("UpdateDeviceTapRule", ctx, arg)}
 }
 
-func (_c *MockStore_ListSantriPresences_Call) Run(run func(ctx context.Context, arg repository.ListSantriPresencesParams)) *MockStore_ListSantriPresences_Call {
+func (_c *MockStore_UpdateDeviceTapRule_Call) Run(run func(ctx context.Context, arg repository.UpdateDeviceTapRuleParams)) *MockStore_UpdateDeviceTapRule_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(repository.ListSantriPresencesParams))
+		run(args[0].(context.Context), args[1].(repository.UpdateDeviceTapRuleParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_ListSantriPresences_Call) Return(_a0 []repository.ListSantriPresencesRow, _a1 error) *MockStore_ListSantriPresences_Call {
+func (_c *MockStore_UpdateDeviceTapRule_Call) Return(_a0 repository.Device, _a1 error) *MockStore_UpdateDeviceTapRule_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_ListSantriPresences_Call) RunAndReturn(run func(context.Context, repository.ListSantriPresencesParams) ([]repository.ListSantriPresencesRow, error)) *MockStore_ListSantriPresences_Call {
+func (_c *MockStore_UpdateDeviceTapRule_Call) RunAndReturn(run func(context.Context, repository.UpdateDeviceTapRuleParams) (repository.Device, error)) *MockStore_UpdateDeviceTapRule_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListSmartCards provides a mock function with given fields: ctx, arg
-func (_m *MockStore) ListSmartCards(ctx context.Context, arg repository.ListSmartCardsParams) ([]repository.ListSmartCardsRow, error) {
+// UpdateEmployee provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateEmployee(ctx context.Context, arg repository.UpdateEmployeeParams) (repository.Employee, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListSmartCards")
+		panic("no return value specified for UpdateEmployee")
 	}
 
-	var r0 []repository.ListSmartCardsRow
+	var r0 repository.Employee
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.ListSmartCardsParams) ([]repository.ListSmartCardsRow, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateEmployeeParams) (repository.Employee, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repository.ListSmartCardsParams) []repository.ListSmartCardsRow); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateEmployeeParams) repository.Employee); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]repository.ListSmartCardsRow)
-		}
+		r0 = ret.Get(0).(repository.Employee)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repository.ListSmartCardsParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, repository.UpdateEmployeeParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -3430,57 +8297,55 @@ func (_m *MockStore) ListSmartCards(ctx context.Context, arg repository.ListSmar
 	return r0, r1
 }
 
-// MockStore_ListSmartCards_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSmartCards'
-type MockStore_ListSmartCards_Call struct {
+// MockStore_UpdateEmployee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEmployee'
+type MockStore_UpdateEmployee_Call struct {
 	*mock.Call
 }
 
-// ListSmartCards is a helper method to define mock.On call
+// UpdateEmployee is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.ListSmartCardsParams
-func (_e *MockStore_Expecter) ListSmartCards(ctx interface{}, arg interface{}) *MockStore_ListSmartCards_Call {
-	return &MockStore_ListSmartCards_Call{Call: _e.mock.On("ListSmartCards", ctx, arg)}
+//   - arg repository.UpdateEmployeeParams
+func (_e *MockStore_Expecter) UpdateEmployee(ctx interface{}, arg interface{}) *MockStore_UpdateEmployee_Call {
+	return &MockStore_UpdateEmployee_Call{Call: _e.mock.On("UpdateEmployee", ctx, arg)}
 }
 
-func (_c *MockStore_ListSmartCards_Call) Run(run func(ctx context.Context, arg repository.ListSmartCardsParams)) *MockStore_ListSmartCards_Call {
+func (_c *MockStore_UpdateEmployee_Call) Run(run func(ctx context.Context, arg repository.UpdateEmployeeParams)) *MockStore_UpdateEmployee_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(repository.ListSmartCardsParams))
+		run(args[0].(context.Context), args[1].(repository.UpdateEmployeeParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_ListSmartCards_Call) Return(_a0 []repository.ListSmartCardsRow, _a1 error) *MockStore_ListSmartCards_Call {
+func (_c *MockStore_UpdateEmployee_Call) Return(_a0 repository.Employee, _a1 error) *MockStore_UpdateEmployee_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_ListSmartCards_Call) RunAndReturn(run func(context.Context, repository.ListSmartCardsParams) ([]repository.ListSmartCardsRow, error)) *MockStore_ListSmartCards_Call {
+func (_c *MockStore_UpdateEmployee_Call) RunAndReturn(run func(context.Context, repository.UpdateEmployeeParams) (repository.Employee, error)) *MockStore_UpdateEmployee_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListUsers provides a mock function with given fields: ctx, arg
-func (_m *MockStore) ListUsers(ctx context.Context, arg repository.ListUserParams) ([]repository.ListUserRow, error) {
+// UpdateEmployeeOccupation provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateEmployeeOccupation(ctx context.Context, arg repository.UpdateEmployeeOccupationParams) (repository.EmployeeOccupation, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListUsers")
+		panic("no return value specified for UpdateEmployeeOccupation")
 	}
 
-	var r0 []repository.ListUserRow
+	var r0 repository.EmployeeOccupation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.ListUserParams) ([]repository.ListUserRow, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateEmployeeOccupationParams) (repository.EmployeeOccupation, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repository.ListUserParams) []repository.ListUserRow); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateEmployeeOccupationParams) repository.EmployeeOccupation); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]repository.ListUserRow)
-		}
+		r0 = ret.Get(0).(repository.EmployeeOccupation)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repository.ListUserParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, repository.UpdateEmployeeOccupationParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -3489,55 +8354,55 @@ func (_m *MockStore) ListUsers(ctx context.Context, arg repository.ListUserParam
 	return r0, r1
 }
 
-// MockStore_ListUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUsers'
-type MockStore_ListUsers_Call struct {
+// MockStore_UpdateEmployeeOccupation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEmployeeOccupation'
+type MockStore_UpdateEmployeeOccupation_Call struct {
 	*mock.Call
 }
 
-// ListUsers is a helper method to define mock.On call
+// UpdateEmployeeOccupation is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.ListUserParams
-func (_e *MockStore_Expecter) ListUsers(ctx interface{}, arg interface{}) *MockStore_ListUsers_Call {
-	return &MockStore_ListUsers_Call{Call: _e.mock.On("ListUsers", ctx, arg)}
+//   - arg repository.UpdateEmployeeOccupationParams
+func (_e *MockStore_Expecter) UpdateEmployeeOccupation(ctx interface{}, arg interface{}) *MockStore_UpdateEmployeeOccupation_Call {
+	return &MockStore_UpdateEmployeeOccupation_Call{Call: _e.mock.On("UpdateEmployeeOccupation", ctx, arg)}
 }
 
-func (_c *MockStore_ListUsers_Call) Run(run func(ctx context.Context, arg repository.ListUserParams)) *MockStore_ListUsers_Call {
+func (_c *MockStore_UpdateEmployeeOccupation_Call) Run(run func(ctx context.Context, arg repository.UpdateEmployeeOccupationParams)) *MockStore_UpdateEmployeeOccupation_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(repository.ListUserParams))
+		run(args[0].(context.Context), args[1].(repository.UpdateEmployeeOccupationParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_ListUsers_Call) Return(_a0 []repository.ListUserRow, _a1 error) *MockStore_ListUsers_Call {
+func (_c *MockStore_UpdateEmployeeOccupation_Call) Return(_a0 repository.EmployeeOccupation, _a1 error) *MockStore_UpdateEmployeeOccupation_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_ListUsers_Call) RunAndReturn(run func(context.Context, repository.ListUserParams) ([]repository.ListUserRow, error)) *MockStore_ListUsers_Call {
+func (_c *MockStore_UpdateEmployeeOccupation_Call) RunAndReturn(run func(context.Context, repository.UpdateEmployeeOccupationParams) (repository.EmployeeOccupation, error)) *MockStore_UpdateEmployeeOccupation_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateDevice provides a mock function with given fields: ctx, arg
-func (_m *MockStore) UpdateDevice(ctx context.Context, arg repository.UpdateDeviceParams) (repository.Device, error) {
+// UpdateEmployeePermission provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateEmployeePermission(ctx context.Context, arg repository.UpdateEmployeePermissionParams) (repository.EmployeePermission, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateDevice")
+		panic("no return value specified for UpdateEmployeePermission")
 	}
 
-	var r0 repository.Device
+	var r0 repository.EmployeePermission
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateDeviceParams) (repository.Device, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateEmployeePermissionParams) (repository.EmployeePermission, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateDeviceParams) repository.Device); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateEmployeePermissionParams) repository.EmployeePermission); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(repository.Device)
+		r0 = ret.Get(0).(repository.EmployeePermission)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repository.UpdateDeviceParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, repository.UpdateEmployeePermissionParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -3546,55 +8411,55 @@ func (_m *MockStore) UpdateDevice(ctx context.Context, arg repository.UpdateDevi
 	return r0, r1
 }
 
-// MockStore_UpdateDevice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDevice'
-type MockStore_UpdateDevice_Call struct {
+// MockStore_UpdateEmployeePermission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEmployeePermission'
+type MockStore_UpdateEmployeePermission_Call struct {
 	*mock.Call
 }
 
-// UpdateDevice is a helper method to define mock.On call
+// UpdateEmployeePermission is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.UpdateDeviceParams
-func (_e *MockStore_Expecter) UpdateDevice(ctx interface{}, arg interface{}) *MockStore_UpdateDevice_Call {
-	return &MockStore_UpdateDevice_Call{Call: _e.mock.On("UpdateDevice", ctx, arg)}
+//   - arg repository.UpdateEmployeePermissionParams
+func (_e *MockStore_Expecter) UpdateEmployeePermission(ctx interface{}, arg interface{}) *MockStore_UpdateEmployeePermission_Call {
+	return &MockStore_UpdateEmployeePermission_Call{Call: _e.mock.On("UpdateEmployeePermission", ctx, arg)}
 }
 
-func (_c *MockStore_UpdateDevice_Call) Run(run func(ctx context.Context, arg repository.UpdateDeviceParams)) *MockStore_UpdateDevice_Call {
+func (_c *MockStore_UpdateEmployeePermission_Call) Run(run func(ctx context.Context, arg repository.UpdateEmployeePermissionParams)) *MockStore_UpdateEmployeePermission_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(repository.UpdateDeviceParams))
+		run(args[0].(context.Context), args[1].(repository.UpdateEmployeePermissionParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdateDevice_Call) Return(_a0 repository.Device, _a1 error) *MockStore_UpdateDevice_Call {
+func (_c *MockStore_UpdateEmployeePermission_Call) Return(_a0 repository.EmployeePermission, _a1 error) *MockStore_UpdateEmployeePermission_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_UpdateDevice_Call) RunAndReturn(run func(context.Context, repository.UpdateDeviceParams) (repository.Device, error)) *MockStore_UpdateDevice_Call {
+func (_c *MockStore_UpdateEmployeePermission_Call) RunAndReturn(run func(context.Context, repository.UpdateEmployeePermissionParams) (repository.EmployeePermission, error)) *MockStore_UpdateEmployeePermission_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateDeviceMode provides a mock function with given fields: ctx, arg
-func (_m *MockStore) UpdateDeviceMode(ctx context.Context, arg repository.UpdateDeviceModeParams) (repository.DeviceMode, error) {
+// UpdateEmployeePresence provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateEmployeePresence(ctx context.Context, arg repository.UpdateEmployeePresenceParams) (repository.EmployeePresence, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateDeviceMode")
+		panic("no return value specified for UpdateEmployeePresence")
 	}
 
-	var r0 repository.DeviceMode
+	var r0 repository.EmployeePresence
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateDeviceModeParams) (repository.DeviceMode, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateEmployeePresenceParams) (repository.EmployeePresence, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateDeviceModeParams) repository.DeviceMode); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateEmployeePresenceParams) repository.EmployeePresence); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(repository.DeviceMode)
+		r0 = ret.Get(0).(repository.EmployeePresence)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repository.UpdateDeviceModeParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, repository.UpdateEmployeePresenceParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -3603,55 +8468,55 @@ func (_m *MockStore) UpdateDeviceMode(ctx context.Context, arg repository.Update
 	return r0, r1
 }
 
-// MockStore_UpdateDeviceMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDeviceMode'
-type MockStore_UpdateDeviceMode_Call struct {
+// MockStore_UpdateEmployeePresence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEmployeePresence'
+type MockStore_UpdateEmployeePresence_Call struct {
 	*mock.Call
 }
 
-// UpdateDeviceMode is a helper method to define mock.On call
+// UpdateEmployeePresence is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.UpdateDeviceModeParams
-func (_e *MockStore_Expecter) UpdateDeviceMode(ctx interface{}, arg interface{}) *MockStore_UpdateDeviceMode_Call {
-	return &MockStore_UpdateDeviceMode_Call{Call: _e.mock.On("UpdateDeviceMode", ctx, arg)}
+//   - arg repository.UpdateEmployeePresenceParams
+func (_e *MockStore_Expecter) UpdateEmployeePresence(ctx interface{}, arg interface{}) *MockStore_UpdateEmployeePresence_Call {
+	return &MockStore_UpdateEmployeePresence_Call{Call: _e.mock.On("UpdateEmployeePresence", ctx, arg)}
 }
 
-func (_c *MockStore_UpdateDeviceMode_Call) Run(run func(ctx context.Context, arg repository.UpdateDeviceModeParams)) *MockStore_UpdateDeviceMode_Call {
+func (_c *MockStore_UpdateEmployeePresence_Call) Run(run func(ctx context.Context, arg repository.UpdateEmployeePresenceParams)) *MockStore_UpdateEmployeePresence_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(repository.UpdateDeviceModeParams))
+		run(args[0].(context.Context), args[1].(repository.UpdateEmployeePresenceParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdateDeviceMode_Call) Return(_a0 repository.DeviceMode, _a1 error) *MockStore_UpdateDeviceMode_Call {
+func (_c *MockStore_UpdateEmployeePresence_Call) Return(_a0 repository.EmployeePresence, _a1 error) *MockStore_UpdateEmployeePresence_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_UpdateDeviceMode_Call) RunAndReturn(run func(context.Context, repository.UpdateDeviceModeParams) (repository.DeviceMode, error)) *MockStore_UpdateDeviceMode_Call {
+func (_c *MockStore_UpdateEmployeePresence_Call) RunAndReturn(run func(context.Context, repository.UpdateEmployeePresenceParams) (repository.EmployeePresence, error)) *MockStore_UpdateEmployeePresence_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateEmployee provides a mock function with given fields: ctx, arg
-func (_m *MockStore) UpdateEmployee(ctx context.Context, arg repository.UpdateEmployeeParams) (repository.Employee, error) {
+// UpdateEnrollmentSessionItemCard provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateEnrollmentSessionItemCard(ctx context.Context, arg repository.UpdateEnrollmentSessionItemCardParams) (repository.EnrollmentSessionItem, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateEmployee")
+		panic("no return value specified for UpdateEnrollmentSessionItemCard")
 	}
 
-	var r0 repository.Employee
+	var r0 repository.EnrollmentSessionItem
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateEmployeeParams) (repository.Employee, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateEnrollmentSessionItemCardParams) (repository.EnrollmentSessionItem, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateEmployeeParams) repository.Employee); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateEnrollmentSessionItemCardParams) repository.EnrollmentSessionItem); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(repository.Employee)
+		r0 = ret.Get(0).(repository.EnrollmentSessionItem)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repository.UpdateEmployeeParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, repository.UpdateEnrollmentSessionItemCardParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -3660,55 +8525,55 @@ func (_m *MockStore) UpdateEmployee(ctx context.Context, arg repository.UpdateEm
 	return r0, r1
 }
 
-// MockStore_UpdateEmployee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEmployee'
-type MockStore_UpdateEmployee_Call struct {
+// MockStore_UpdateEnrollmentSessionItemCard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEnrollmentSessionItemCard'
+type MockStore_UpdateEnrollmentSessionItemCard_Call struct {
 	*mock.Call
 }
 
-// UpdateEmployee is a helper method to define mock.On call
+// UpdateEnrollmentSessionItemCard is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.UpdateEmployeeParams
-func (_e *MockStore_Expecter) UpdateEmployee(ctx interface{}, arg interface{}) *MockStore_UpdateEmployee_Call {
-	return &MockStore_UpdateEmployee_Call{Call: _e.mock.On("UpdateEmployee", ctx, arg)}
+//   - arg repository.UpdateEnrollmentSessionItemCardParams
+func (_e *MockStore_Expecter) UpdateEnrollmentSessionItemCard(ctx interface{}, arg interface{}) *MockStore_UpdateEnrollmentSessionItemCard_Call {
+	return &MockStore_UpdateEnrollmentSessionItemCard_Call{Call: _e.mock.On("UpdateEnrollmentSessionItemCard", ctx, arg)}
 }
 
-func (_c *MockStore_UpdateEmployee_Call) Run(run func(ctx context.Context, arg repository.UpdateEmployeeParams)) *MockStore_UpdateEmployee_Call {
+func (_c *MockStore_UpdateEnrollmentSessionItemCard_Call) Run(run func(ctx context.Context, arg repository.UpdateEnrollmentSessionItemCardParams)) *MockStore_UpdateEnrollmentSessionItemCard_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(repository.UpdateEmployeeParams))
+		run(args[0].(context.Context), args[1].(repository.UpdateEnrollmentSessionItemCardParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdateEmployee_Call) Return(_a0 repository.Employee, _a1 error) *MockStore_UpdateEmployee_Call {
+func (_c *MockStore_UpdateEnrollmentSessionItemCard_Call) Return(_a0 repository.EnrollmentSessionItem, _a1 error) *MockStore_UpdateEnrollmentSessionItemCard_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_UpdateEmployee_Call) RunAndReturn(run func(context.Context, repository.UpdateEmployeeParams) (repository.Employee, error)) *MockStore_UpdateEmployee_Call {
+func (_c *MockStore_UpdateEnrollmentSessionItemCard_Call) RunAndReturn(run func(context.Context, repository.UpdateEnrollmentSessionItemCardParams) (repository.EnrollmentSessionItem, error)) *MockStore_UpdateEnrollmentSessionItemCard_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateEmployeeOccupation provides a mock function with given fields: ctx, arg
-func (_m *MockStore) UpdateEmployeeOccupation(ctx context.Context, arg repository.UpdateEmployeeOccupationParams) (repository.EmployeeOccupation, error) {
+// UpdateHoliday provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateHoliday(ctx context.Context, arg repository.UpdateHolidayParams) (repository.Holiday, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateEmployeeOccupation")
+		panic("no return value specified for UpdateHoliday")
 	}
 
-	var r0 repository.EmployeeOccupation
+	var r0 repository.Holiday
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateEmployeeOccupationParams) (repository.EmployeeOccupation, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateHolidayParams) (repository.Holiday, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateEmployeeOccupationParams) repository.EmployeeOccupation); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateHolidayParams) repository.Holiday); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(repository.EmployeeOccupation)
+		r0 = ret.Get(0).(repository.Holiday)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repository.UpdateEmployeeOccupationParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, repository.UpdateHolidayParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -3717,56 +8582,56 @@ func (_m *MockStore) UpdateEmployeeOccupation(ctx context.Context, arg repositor
 	return r0, r1
 }
 
-// MockStore_UpdateEmployeeOccupation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEmployeeOccupation'
-type MockStore_UpdateEmployeeOccupation_Call struct {
+// MockStore_UpdateHoliday_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateHoliday'
+type MockStore_UpdateHoliday_Call struct {
 	*mock.Call
 }
 
-// UpdateEmployeeOccupation is a helper method to define mock.On call
+// UpdateHoliday is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.UpdateEmployeeOccupationParams
-func (_e *MockStore_Expecter) UpdateEmployeeOccupation(ctx interface{}, arg interface{}) *MockStore_UpdateEmployeeOccupation_Call {
-	return &MockStore_UpdateEmployeeOccupation_Call{Call: _e.mock.On("UpdateEmployeeOccupation", ctx, arg)}
+//   - arg repository.UpdateHolidayParams
+func (_e *MockStore_Expecter) UpdateHoliday(ctx interface{}, arg interface{}) *MockStore_UpdateHoliday_Call {
+	return &MockStore_UpdateHoliday_Call{Call: _e.mock.On("UpdateHoliday", ctx, arg)}
 }
 
-func (_c *MockStore_UpdateEmployeeOccupation_Call) Run(run func(ctx context.Context, arg repository.UpdateEmployeeOccupationParams)) *MockStore_UpdateEmployeeOccupation_Call {
+func (_c *MockStore_UpdateHoliday_Call) Run(run func(ctx context.Context, arg repository.UpdateHolidayParams)) *MockStore_UpdateHoliday_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(repository.UpdateEmployeeOccupationParams))
+		run(args[0].(context.Context), args[1].(repository.UpdateHolidayParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdateEmployeeOccupation_Call) Return(_a0 repository.EmployeeOccupation, _a1 error) *MockStore_UpdateEmployeeOccupation_Call {
+func (_c *MockStore_UpdateHoliday_Call) Return(_a0 repository.Holiday, _a1 error) *MockStore_UpdateHoliday_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_UpdateEmployeeOccupation_Call) RunAndReturn(run func(context.Context, repository.UpdateEmployeeOccupationParams) (repository.EmployeeOccupation, error)) *MockStore_UpdateEmployeeOccupation_Call {
+func (_c *MockStore_UpdateHoliday_Call) RunAndReturn(run func(context.Context, repository.UpdateHolidayParams) (repository.Holiday, error)) *MockStore_UpdateHoliday_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateEmployeePermission provides a mock function with given fields: ctx, arg
-func (_m *MockStore) UpdateEmployeePermission(ctx context.Context, arg repository.UpdateEmployeePermissionParams) (repository.EmployeePermission, error) {
-	ret := _m.Called(ctx, arg)
+// UpdateHolidayWithDates provides a mock function with given fields: ctx, arg, dates
+func (_m *MockStore) UpdateHolidayWithDates(ctx context.Context, arg repository.UpdateHolidayParams, dates []pgtype.Date) (repository.Holiday, error) {
+	ret := _m.Called(ctx, arg, dates)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateEmployeePermission")
+		panic("no return value specified for UpdateHolidayWithDates")
 	}
 
-	var r0 repository.EmployeePermission
+	var r0 repository.Holiday
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateEmployeePermissionParams) (repository.EmployeePermission, error)); ok {
-		return rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateHolidayParams, []pgtype.Date) (repository.Holiday, error)); ok {
+		return rf(ctx, arg, dates)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateEmployeePermissionParams) repository.EmployeePermission); ok {
-		r0 = rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateHolidayParams, []pgtype.Date) repository.Holiday); ok {
+		r0 = rf(ctx, arg, dates)
 	} else {
-		r0 = ret.Get(0).(repository.EmployeePermission)
+		r0 = ret.Get(0).(repository.Holiday)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repository.UpdateEmployeePermissionParams) error); ok {
-		r1 = rf(ctx, arg)
+	if rf, ok := ret.Get(1).(func(context.Context, repository.UpdateHolidayParams, []pgtype.Date) error); ok {
+		r1 = rf(ctx, arg, dates)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3774,55 +8639,56 @@ func (_m *MockStore) UpdateEmployeePermission(ctx context.Context, arg repositor
 	return r0, r1
 }
 
-// MockStore_UpdateEmployeePermission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEmployeePermission'
-type MockStore_UpdateEmployeePermission_Call struct {
+// MockStore_UpdateHolidayWithDates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateHolidayWithDates'
+type MockStore_UpdateHolidayWithDates_Call struct {
 	*mock.Call
 }
 
-// UpdateEmployeePermission is a helper method to define mock.On call
+// UpdateHolidayWithDates is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.UpdateEmployeePermissionParams
-func (_e *MockStore_Expecter) UpdateEmployeePermission(ctx interface{}, arg interface{}) *MockStore_UpdateEmployeePermission_Call {
-	return &MockStore_UpdateEmployeePermission_Call{Call: _e.mock.On("UpdateEmployeePermission", ctx, arg)}
+//   - arg repository.UpdateHolidayParams
+//   - dates []pgtype.Date
+func (_e *MockStore_Expecter) UpdateHolidayWithDates(ctx interface{}, arg interface{}, dates interface{}) *MockStore_UpdateHolidayWithDates_Call {
+	return &MockStore_UpdateHolidayWithDates_Call{Call: _e.mock.On("UpdateHolidayWithDates", ctx, arg, dates)}
 }
 
-func (_c *MockStore_UpdateEmployeePermission_Call) Run(run func(ctx context.Context, arg repository.UpdateEmployeePermissionParams)) *MockStore_UpdateEmployeePermission_Call {
+func (_c *MockStore_UpdateHolidayWithDates_Call) Run(run func(ctx context.Context, arg repository.UpdateHolidayParams, dates []pgtype.Date)) *MockStore_UpdateHolidayWithDates_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(repository.UpdateEmployeePermissionParams))
+		run(args[0].(context.Context), args[1].(repository.UpdateHolidayParams), args[2].([]pgtype.Date))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdateEmployeePermission_Call) Return(_a0 repository.EmployeePermission, _a1 error) *MockStore_UpdateEmployeePermission_Call {
+func (_c *MockStore_UpdateHolidayWithDates_Call) Return(_a0 repository.Holiday, _a1 error) *MockStore_UpdateHolidayWithDates_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_UpdateEmployeePermission_Call) RunAndReturn(run func(context.Context, repository.UpdateEmployeePermissionParams) (repository.EmployeePermission, error)) *MockStore_UpdateEmployeePermission_Call {
+func (_c *MockStore_UpdateHolidayWithDates_Call) RunAndReturn(run func(context.Context, repository.UpdateHolidayParams, []pgtype.Date) (repository.Holiday, error)) *MockStore_UpdateHolidayWithDates_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateEmployeePresence provides a mock function with given fields: ctx, arg
-func (_m *MockStore) UpdateEmployeePresence(ctx context.Context, arg repository.UpdateEmployeePresenceParams) (repository.EmployeePresence, error) {
+// UpdateLocation provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateLocation(ctx context.Context, arg repository.UpdateLocationParams) (repository.Location, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateEmployeePresence")
+		panic("no return value specified for UpdateLocation")
 	}
 
-	var r0 repository.EmployeePresence
+	var r0 repository.Location
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateEmployeePresenceParams) (repository.EmployeePresence, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateLocationParams) (repository.Location, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateEmployeePresenceParams) repository.EmployeePresence); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateLocationParams) repository.Location); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(repository.EmployeePresence)
+		r0 = ret.Get(0).(repository.Location)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repository.UpdateEmployeePresenceParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, repository.UpdateLocationParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -3831,31 +8697,31 @@ func (_m *MockStore) UpdateEmployeePresence(ctx context.Context, arg repository.
 	return r0, r1
 }
 
-// MockStore_UpdateEmployeePresence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEmployeePresence'
-type MockStore_UpdateEmployeePresence_Call struct {
+// MockStore_UpdateLocation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLocation'
+type MockStore_UpdateLocation_Call struct {
 	*mock.Call
 }
 
-// UpdateEmployeePresence is a helper method to define mock.On call
+// UpdateLocation is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.UpdateEmployeePresenceParams
-func (_e *MockStore_Expecter) UpdateEmployeePresence(ctx interface{}, arg interface{}) *MockStore_UpdateEmployeePresence_Call {
-	return &MockStore_UpdateEmployeePresence_Call{Call: _e.mock.On("UpdateEmployeePresence", ctx, arg)}
+//   - arg repository.UpdateLocationParams
+func (_e *MockStore_Expecter) UpdateLocation(ctx interface{}, arg interface{}) *MockStore_UpdateLocation_Call {
+	return &MockStore_UpdateLocation_Call{Call: _e.mock.On("UpdateLocation", ctx, arg)}
 }
 
-func (_c *MockStore_UpdateEmployeePresence_Call) Run(run func(ctx context.Context, arg repository.UpdateEmployeePresenceParams)) *MockStore_UpdateEmployeePresence_Call {
+func (_c *MockStore_UpdateLocation_Call) Run(run func(ctx context.Context, arg repository.UpdateLocationParams)) *MockStore_UpdateLocation_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(repository.UpdateEmployeePresenceParams))
+		run(args[0].(context.Context), args[1].(repository.UpdateLocationParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdateEmployeePresence_Call) Return(_a0 repository.EmployeePresence, _a1 error) *MockStore_UpdateEmployeePresence_Call {
+func (_c *MockStore_UpdateLocation_Call) Return(_a0 repository.Location, _a1 error) *MockStore_UpdateLocation_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_UpdateEmployeePresence_Call) RunAndReturn(run func(context.Context, repository.UpdateEmployeePresenceParams) (repository.EmployeePresence, error)) *MockStore_UpdateEmployeePresence_Call {
+func (_c *MockStore_UpdateLocation_Call) RunAndReturn(run func(context.Context, repository.UpdateLocationParams) (repository.Location, error)) *MockStore_UpdateLocation_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -3974,6 +8840,63 @@ func (_c *MockStore_UpdateSantri_Call) RunAndReturn(run func(context.Context, re
 	return _c
 }
 
+// UpdateSantriLeaveRequestStatus provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateSantriLeaveRequestStatus(ctx context.Context, arg repository.UpdateSantriLeaveRequestStatusParams) (repository.SantriLeaveRequest, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSantriLeaveRequestStatus")
+	}
+
+	var r0 repository.SantriLeaveRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateSantriLeaveRequestStatusParams) (repository.SantriLeaveRequest, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateSantriLeaveRequestStatusParams) repository.SantriLeaveRequest); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.SantriLeaveRequest)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.UpdateSantriLeaveRequestStatusParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_UpdateSantriLeaveRequestStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSantriLeaveRequestStatus'
+type MockStore_UpdateSantriLeaveRequestStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateSantriLeaveRequestStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.UpdateSantriLeaveRequestStatusParams
+func (_e *MockStore_Expecter) UpdateSantriLeaveRequestStatus(ctx interface{}, arg interface{}) *MockStore_UpdateSantriLeaveRequestStatus_Call {
+	return &MockStore_UpdateSantriLeaveRequestStatus_Call{Call: _e.mock.On("UpdateSantriLeaveRequestStatus", ctx, arg)}
+}
+
+func (_c *MockStore_UpdateSantriLeaveRequestStatus_Call) Run(run func(ctx context.Context, arg repository.UpdateSantriLeaveRequestStatusParams)) *MockStore_UpdateSantriLeaveRequestStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.UpdateSantriLeaveRequestStatusParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_UpdateSantriLeaveRequestStatus_Call) Return(_a0 repository.SantriLeaveRequest, _a1 error) *MockStore_UpdateSantriLeaveRequestStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_UpdateSantriLeaveRequestStatus_Call) RunAndReturn(run func(context.Context, repository.UpdateSantriLeaveRequestStatusParams) (repository.SantriLeaveRequest, error)) *MockStore_UpdateSantriLeaveRequestStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateSantriOccupation provides a mock function with given fields: ctx, arg
 func (_m *MockStore) UpdateSantriOccupation(ctx context.Context, arg repository.UpdateSantriOccupationParams) (repository.SantriOccupation, error) {
 	ret := _m.Called(ctx, arg)
@@ -4088,6 +9011,63 @@ func (_c *MockStore_UpdateSantriPermission_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// UpdateSantriPermissionWithPresences provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateSantriPermissionWithPresences(ctx context.Context, arg repository.UpdateSantriPermissionParams) (repository.SantriPermission, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSantriPermissionWithPresences")
+	}
+
+	var r0 repository.SantriPermission
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateSantriPermissionParams) (repository.SantriPermission, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateSantriPermissionParams) repository.SantriPermission); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.SantriPermission)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.UpdateSantriPermissionParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_UpdateSantriPermissionWithPresences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSantriPermissionWithPresences'
+type MockStore_UpdateSantriPermissionWithPresences_Call struct {
+	*mock.Call
+}
+
+// UpdateSantriPermissionWithPresences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.UpdateSantriPermissionParams
+func (_e *MockStore_Expecter) UpdateSantriPermissionWithPresences(ctx interface{}, arg interface{}) *MockStore_UpdateSantriPermissionWithPresences_Call {
+	return &MockStore_UpdateSantriPermissionWithPresences_Call{Call: _e.mock.On("UpdateSantriPermissionWithPresences", ctx, arg)}
+}
+
+func (_c *MockStore_UpdateSantriPermissionWithPresences_Call) Run(run func(ctx context.Context, arg repository.UpdateSantriPermissionParams)) *MockStore_UpdateSantriPermissionWithPresences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.UpdateSantriPermissionParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_UpdateSantriPermissionWithPresences_Call) Return(_a0 repository.SantriPermission, _a1 error) *MockStore_UpdateSantriPermissionWithPresences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_UpdateSantriPermissionWithPresences_Call) RunAndReturn(run func(context.Context, repository.UpdateSantriPermissionParams) (repository.SantriPermission, error)) *MockStore_UpdateSantriPermissionWithPresences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateSantriPresence provides a mock function with given fields: ctx, arg
 func (_m *MockStore) UpdateSantriPresence(ctx context.Context, arg repository.UpdateSantriPresenceParams) (repository.SantriPresence, error) {
 	ret := _m.Called(ctx, arg)
@@ -4202,6 +9182,63 @@ func (_c *MockStore_UpdateSmartCard_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// UpdateSmartCardWithAssignment provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateSmartCardWithAssignment(ctx context.Context, arg repository.UpdateSmartCardParams) (repository.SmartCard, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSmartCardWithAssignment")
+	}
+
+	var r0 repository.SmartCard
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateSmartCardParams) (repository.SmartCard, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateSmartCardParams) repository.SmartCard); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.SmartCard)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.UpdateSmartCardParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_UpdateSmartCardWithAssignment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSmartCardWithAssignment'
+type MockStore_UpdateSmartCardWithAssignment_Call struct {
+	*mock.Call
+}
+
+// UpdateSmartCardWithAssignment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.UpdateSmartCardParams
+func (_e *MockStore_Expecter) UpdateSmartCardWithAssignment(ctx interface{}, arg interface{}) *MockStore_UpdateSmartCardWithAssignment_Call {
+	return &MockStore_UpdateSmartCardWithAssignment_Call{Call: _e.mock.On("UpdateSmartCardWithAssignment", ctx, arg)}
+}
+
+func (_c *MockStore_UpdateSmartCardWithAssignment_Call) Run(run func(ctx context.Context, arg repository.UpdateSmartCardParams)) *MockStore_UpdateSmartCardWithAssignment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.UpdateSmartCardParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_UpdateSmartCardWithAssignment_Call) Return(_a0 repository.SmartCard, _a1 error) *MockStore_UpdateSmartCardWithAssignment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_UpdateSmartCardWithAssignment_Call) RunAndReturn(run func(context.Context, repository.UpdateSmartCardParams) (repository.SmartCard, error)) *MockStore_UpdateSmartCardWithAssignment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateUser provides a mock function with given fields: ctx, arg
 func (_m *MockStore) UpdateUser(ctx context.Context, arg repository.UpdateUserParams) (repository.User, error) {
 	ret := _m.Called(ctx, arg)
@@ -4259,6 +9296,63 @@ func (_c *MockStore_UpdateUser_Call) RunAndReturn(run func(context.Context, repo
 	return _c
 }
 
+// UpsertPendingSmartCard provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpsertPendingSmartCard(ctx context.Context, arg repository.UpsertPendingSmartCardParams) (repository.PendingSmartCard, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertPendingSmartCard")
+	}
+
+	var r0 repository.PendingSmartCard
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpsertPendingSmartCardParams) (repository.PendingSmartCard, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpsertPendingSmartCardParams) repository.PendingSmartCard); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.PendingSmartCard)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.UpsertPendingSmartCardParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_UpsertPendingSmartCard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertPendingSmartCard'
+type MockStore_UpsertPendingSmartCard_Call struct {
+	*mock.Call
+}
+
+// UpsertPendingSmartCard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.UpsertPendingSmartCardParams
+func (_e *MockStore_Expecter) UpsertPendingSmartCard(ctx interface{}, arg interface{}) *MockStore_UpsertPendingSmartCard_Call {
+	return &MockStore_UpsertPendingSmartCard_Call{Call: _e.mock.On("UpsertPendingSmartCard", ctx, arg)}
+}
+
+func (_c *MockStore_UpsertPendingSmartCard_Call) Run(run func(ctx context.Context, arg repository.UpsertPendingSmartCardParams)) *MockStore_UpsertPendingSmartCard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.UpsertPendingSmartCardParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_UpsertPendingSmartCard_Call) Return(_a0 repository.PendingSmartCard, _a1 error) *MockStore_UpsertPendingSmartCard_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_UpsertPendingSmartCard_Call) RunAndReturn(run func(context.Context, repository.UpsertPendingSmartCardParams) (repository.PendingSmartCard, error)) *MockStore_UpsertPendingSmartCard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockStore creates a new instance of MockStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockStore(t interface {
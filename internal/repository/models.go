@@ -55,6 +55,94 @@ func (ns NullCardOwner) Value() (driver.Value, error) {
 	return string(ns.CardOwner), nil
 }
 
+type DeviceCommandStatus string
+
+const (
+	DeviceCommandStatusPending      DeviceCommandStatus = "pending"
+	DeviceCommandStatusSent         DeviceCommandStatus = "sent"
+	DeviceCommandStatusAcknowledged DeviceCommandStatus = "acknowledged"
+	DeviceCommandStatusFailed       DeviceCommandStatus = "failed"
+)
+
+func (e *DeviceCommandStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = DeviceCommandStatus(s)
+	case string:
+		*e = DeviceCommandStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for DeviceCommandStatus: %T", src)
+	}
+	return nil
+}
+
+type NullDeviceCommandStatus struct {
+	DeviceCommandStatus DeviceCommandStatus
+	Valid               bool // Valid is true if DeviceCommandStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullDeviceCommandStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.DeviceCommandStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.DeviceCommandStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullDeviceCommandStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.DeviceCommandStatus), nil
+}
+
+type DeviceCommandType string
+
+const (
+	DeviceCommandTypeSwitchMode     DeviceCommandType = "switch_mode"
+	DeviceCommandTypeReboot         DeviceCommandType = "reboot"
+	DeviceCommandTypeBeep           DeviceCommandType = "beep"
+	DeviceCommandTypeDisplayMessage DeviceCommandType = "display_message"
+)
+
+func (e *DeviceCommandType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = DeviceCommandType(s)
+	case string:
+		*e = DeviceCommandType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for DeviceCommandType: %T", src)
+	}
+	return nil
+}
+
+type NullDeviceCommandType struct {
+	DeviceCommandType DeviceCommandType
+	Valid             bool // Valid is true if DeviceCommandType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullDeviceCommandType) Scan(value interface{}) error {
+	if value == nil {
+		ns.DeviceCommandType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.DeviceCommandType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullDeviceCommandType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.DeviceCommandType), nil
+}
+
 type DeviceModeType string
 
 const (
@@ -62,6 +150,7 @@ const (
 	DeviceModeTypePresence   DeviceModeType = "presence"
 	DeviceModeTypePermission DeviceModeType = "permission"
 	DeviceModeTypePing       DeviceModeType = "ping"
+	DeviceModeTypeBatch      DeviceModeType = "batch"
 )
 
 func (e *DeviceModeType) Scan(src interface{}) error {
@@ -141,6 +230,136 @@ func (ns NullEmployeeOrderBy) Value() (driver.Value, error) {
 	return string(ns.EmployeeOrderBy), nil
 }
 
+type EmployeePermissionStatus string
+
+const (
+	EmployeePermissionStatusPending   EmployeePermissionStatus = "pending"
+	EmployeePermissionStatusApproved  EmployeePermissionStatus = "approved"
+	EmployeePermissionStatusRejected  EmployeePermissionStatus = "rejected"
+	EmployeePermissionStatusCancelled EmployeePermissionStatus = "cancelled"
+)
+
+func (e *EmployeePermissionStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = EmployeePermissionStatus(s)
+	case string:
+		*e = EmployeePermissionStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for EmployeePermissionStatus: %T", src)
+	}
+	return nil
+}
+
+type NullEmployeePermissionStatus struct {
+	EmployeePermissionStatus EmployeePermissionStatus
+	Valid                    bool // Valid is true if EmployeePermissionStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullEmployeePermissionStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.EmployeePermissionStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.EmployeePermissionStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullEmployeePermissionStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.EmployeePermissionStatus), nil
+}
+
+type EnrollmentSessionStatus string
+
+const (
+	EnrollmentSessionStatusOpen      EnrollmentSessionStatus = "open"
+	EnrollmentSessionStatusCompleted EnrollmentSessionStatus = "completed"
+	EnrollmentSessionStatusCancelled EnrollmentSessionStatus = "cancelled"
+	EnrollmentSessionStatusExpired   EnrollmentSessionStatus = "expired"
+)
+
+func (e *EnrollmentSessionStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = EnrollmentSessionStatus(s)
+	case string:
+		*e = EnrollmentSessionStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for EnrollmentSessionStatus: %T", src)
+	}
+	return nil
+}
+
+type NullEnrollmentSessionStatus struct {
+	EnrollmentSessionStatus EnrollmentSessionStatus
+	Valid                   bool // Valid is true if EnrollmentSessionStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullEnrollmentSessionStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.EnrollmentSessionStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.EnrollmentSessionStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullEnrollmentSessionStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.EnrollmentSessionStatus), nil
+}
+
+type GateDirectionType string
+
+const (
+	GateDirectionTypeEntry GateDirectionType = "entry"
+	GateDirectionTypeExit  GateDirectionType = "exit"
+)
+
+func (e *GateDirectionType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = GateDirectionType(s)
+	case string:
+		*e = GateDirectionType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for GateDirectionType: %T", src)
+	}
+	return nil
+}
+
+type NullGateDirectionType struct {
+	GateDirectionType GateDirectionType
+	Valid             bool // Valid is true if GateDirectionType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullGateDirectionType) Scan(value interface{}) error {
+	if value == nil {
+		ns.GateDirectionType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.GateDirectionType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullGateDirectionType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.GateDirectionType), nil
+}
+
 type GenderType string
 
 const (
@@ -183,6 +402,51 @@ func (ns NullGenderType) Value() (driver.Value, error) {
 	return string(ns.GenderType), nil
 }
 
+type LeaveRequestStatus string
+
+const (
+	LeaveRequestStatusPending   LeaveRequestStatus = "pending"
+	LeaveRequestStatusApproved  LeaveRequestStatus = "approved"
+	LeaveRequestStatusRejected  LeaveRequestStatus = "rejected"
+	LeaveRequestStatusCancelled LeaveRequestStatus = "cancelled"
+	LeaveRequestStatusCompleted LeaveRequestStatus = "completed"
+)
+
+func (e *LeaveRequestStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = LeaveRequestStatus(s)
+	case string:
+		*e = LeaveRequestStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for LeaveRequestStatus: %T", src)
+	}
+	return nil
+}
+
+type NullLeaveRequestStatus struct {
+	LeaveRequestStatus LeaveRequestStatus
+	Valid              bool // Valid is true if LeaveRequestStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullLeaveRequestStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.LeaveRequestStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.LeaveRequestStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullLeaveRequestStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.LeaveRequestStatus), nil
+}
+
 type ParentOrderBy string
 
 const (
@@ -446,6 +710,50 @@ func (ns NullSantriOrderBy) Value() (driver.Value, error) {
 	return string(ns.SantriOrderBy), nil
 }
 
+type SmartCardState string
+
+const (
+	SmartCardStateActive    SmartCardState = "active"
+	SmartCardStateSuspended SmartCardState = "suspended"
+	SmartCardStateLost      SmartCardState = "lost"
+	SmartCardStateRetired   SmartCardState = "retired"
+)
+
+func (e *SmartCardState) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SmartCardState(s)
+	case string:
+		*e = SmartCardState(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SmartCardState: %T", src)
+	}
+	return nil
+}
+
+type NullSmartCardState struct {
+	SmartCardState SmartCardState
+	Valid          bool // Valid is true if SmartCardState is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSmartCardState) Scan(value interface{}) error {
+	if value == nil {
+		ns.SmartCardState, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SmartCardState.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSmartCardState) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SmartCardState), nil
+}
+
 type UserOrderBy string
 
 const (
@@ -500,6 +808,64 @@ type Device struct {
 	ID int32 `db:"id"`
 	// ex: device1
 	Name string `db:"name"`
+	// Waktu terakhir device mengirim ping
+	LastSeenAt      pgtype.Timestamptz `db:"last_seen_at"`
+	FirmwareVersion pgtype.Text        `db:"firmware_version"`
+	IpAddress       pgtype.Text        `db:"ip_address"`
+	// Kunci HMAC untuk menandatangani payload dari device
+	Secret string `db:"secret"`
+	// Jendela debounce tap berulang dari kartu yang sama, NULL berarti memakai default server dan 0 berarti nonaktif
+	DebounceSeconds pgtype.Int4 `db:"debounce_seconds"`
+	// Arah gerbang untuk device yang dipasang di gerbang, NULL berarti bukan gerbang
+	GateDirection NullGateDirectionType `db:"gate_direction"`
+	// Tolak kartu yang masuk dua kali tanpa keluar, atau keluar dua kali tanpa masuk
+	AntiPassback bool `db:"anti_passback"`
+	// Lokasi tempat device dipasang
+	LocationID pgtype.Int4 `db:"location_id"`
+}
+
+// Kartu diterima device jika cocok dengan salah satu aturan, device tanpa aturan menerima semua kartu
+type DeviceAllowRule struct {
+	ID       int32 `db:"id"`
+	DeviceID int32 `db:"device_id"`
+	// santri atau employee, NULL berarti semua pemilik
+	OwnerRole NullRoleType `db:"owner_role"`
+	// NULL berarti semua gender
+	Gender NullGenderType `db:"gender"`
+	// Id santri_occupation atau employee_occupation sesuai owner_role, NULL berarti semua
+	OccupationID pgtype.Int4 `db:"occupation_id"`
+	// Id santri_schedule atau employee_schedule sesuai owner_role, NULL berarti semua jadwal
+	ScheduleID pgtype.Int4 `db:"schedule_id"`
+}
+
+type DeviceBatchTap struct {
+	ID       int32 `db:"id"`
+	DeviceID int32 `db:"device_id"`
+	// ID unik tap dari device, agar upload ulang tidak tercatat dua kali
+	TapID string `db:"tap_id"`
+	Uid   string `db:"uid"`
+	// Waktu tap menurut device, bukan waktu diterima server
+	TappedAt  pgtype.Timestamptz `db:"tapped_at"`
+	Code      int32              `db:"code"`
+	Message   string             `db:"message"`
+	CreatedAt pgtype.Timestamptz `db:"created_at"`
+}
+
+type DeviceCommand struct {
+	ID       int32             `db:"id"`
+	DeviceID int32             `db:"device_id"`
+	Type     DeviceCommandType `db:"type"`
+	// Mode sementara untuk perintah switch_mode
+	Mode    NullDeviceModeType `db:"mode"`
+	Message pgtype.Text        `db:"message"`
+	// Batas waktu mode sementara, setelahnya device kembali ke mode semula
+	ExpiresAt      pgtype.Timestamptz  `db:"expires_at"`
+	Status         DeviceCommandStatus `db:"status"`
+	Error          pgtype.Text         `db:"error"`
+	CreatedBy      pgtype.Int4         `db:"created_by"`
+	CreatedAt      pgtype.Timestamptz  `db:"created_at"`
+	SentAt         pgtype.Timestamptz  `db:"sent_at"`
+	AcknowledgedAt pgtype.Timestamptz  `db:"acknowledged_at"`
 }
 
 type DeviceMode struct {
@@ -535,6 +901,15 @@ type EmployeePermission struct {
 	StartPermission pgtype.Timestamptz `db:"start_permission"`
 	EndPermission   pgtype.Timestamptz `db:"end_permission"`
 	Excuse          string             `db:"excuse"`
+	// Izin yang diajukan sendiri oleh pegawai menunggu persetujuan admin, izin dari admin langsung disetujui
+	Status EmployeePermissionStatus `db:"status"`
+	// User yang mencatat atau mengajukan izin
+	CreatedBy pgtype.Int4        `db:"created_by"`
+	CreatedAt pgtype.Timestamptz `db:"created_at"`
+	// Admin yang menyetujui atau menolak pengajuan
+	ReviewedBy pgtype.Int4        `db:"reviewed_by"`
+	ReviewedAt pgtype.Timestamptz `db:"reviewed_at"`
+	ReviewNote pgtype.Text        `db:"review_note"`
 }
 
 type EmployeePresence struct {
@@ -549,6 +924,30 @@ type EmployeePresence struct {
 	EmployeePermissionID pgtype.Int4           `db:"employee_permission_id"`
 }
 
+// Sesi pendaftaran kartu, setiap tap di device mendaftarkan kartu untuk pemilik berikutnya
+type EnrollmentSession struct {
+	ID       int32                   `db:"id"`
+	DeviceID int32                   `db:"device_id"`
+	Status   EnrollmentSessionStatus `db:"status"`
+	// Sesi yang lewat batas waktu tidak menerima tap lagi
+	ExpiresAt pgtype.Timestamptz `db:"expires_at"`
+	CreatedBy pgtype.Int4        `db:"created_by"`
+	CreatedAt pgtype.Timestamptz `db:"created_at"`
+	ClosedAt  pgtype.Timestamptz `db:"closed_at"`
+}
+
+// Pemilik yang menunggu kartu, dilayani sesuai urutan position
+type EnrollmentSessionItem struct {
+	ID         int32       `db:"id"`
+	SessionID  int32       `db:"session_id"`
+	Position   int32       `db:"position"`
+	SantriID   pgtype.Int4 `db:"santri_id"`
+	EmployeeID pgtype.Int4 `db:"employee_id"`
+	// Kartu yang didaftarkan, kosong selama belum ditap
+	SmartCardID pgtype.Int4        `db:"smart_card_id"`
+	EnrolledAt  pgtype.Timestamptz `db:"enrolled_at"`
+}
+
 type Holiday struct {
 	ID int32 `db:"id"`
 	// Optional description of the holiday
@@ -563,6 +962,13 @@ type HolidayDate struct {
 	HolidayID int32       `db:"holiday_id"`
 }
 
+type Location struct {
+	ID int32 `db:"id"`
+	// ex: Masjid Putra, Asrama Putri
+	Name        string      `db:"name"`
+	Description pgtype.Text `db:"description"`
+}
+
 type Parent struct {
 	ID             int32       `db:"id"`
 	Name           string      `db:"name"`
@@ -573,6 +979,17 @@ type Parent struct {
 	UserID         pgtype.Int4 `db:"user_id"`
 }
 
+// Kartu yang belum terdaftar tetapi ditap di device, admin bisa mengklaimnya menjadi smart_card
+type PendingSmartCard struct {
+	ID  int32  `db:"id"`
+	Uid string `db:"uid"`
+	// Device tempat kartu terakhir ditap
+	DeviceID      pgtype.Int4        `db:"device_id"`
+	TapCount      int32              `db:"tap_count"`
+	FirstTappedAt pgtype.Timestamptz `db:"first_tapped_at"`
+	LastTappedAt  pgtype.Timestamptz `db:"last_tapped_at"`
+}
+
 type Santri struct {
 	ID     int32       `db:"id"`
 	Nis    pgtype.Text `db:"nis"`
@@ -586,6 +1003,37 @@ type Santri struct {
 	ParentID     pgtype.Int4 `db:"parent_id"`
 }
 
+// Pengajuan izin santri oleh wali, izin santri dibuat saat pengajuan disetujui admin
+type SantriLeaveRequest struct {
+	ID         int32              `db:"id"`
+	SantriID   int32              `db:"santri_id"`
+	ParentID   int32              `db:"parent_id"`
+	Type       PermissionType     `db:"type"`
+	Reason     string             `db:"reason"`
+	StartLeave pgtype.Timestamptz `db:"start_leave"`
+	EndLeave   pgtype.Timestamptz `db:"end_leave"`
+	// Lampiran pengajuan, misalnya surat dokter
+	Attachment    pgtype.Text        `db:"attachment"`
+	Status        LeaveRequestStatus `db:"status"`
+	ReviewComment pgtype.Text        `db:"review_comment"`
+	// Izin santri yang dibuat saat pengajuan disetujui
+	SantriPermissionID pgtype.Int4        `db:"santri_permission_id"`
+	CreatedAt          pgtype.Timestamptz `db:"created_at"`
+	UpdatedAt          pgtype.Timestamptz `db:"updated_at"`
+}
+
+// Riwayat perubahan status pengajuan beserta user yang melakukannya
+type SantriLeaveRequestHistory struct {
+	ID             int32 `db:"id"`
+	LeaveRequestID int32 `db:"leave_request_id"`
+	// Kosong saat pengajuan dibuat
+	FromStatus NullLeaveRequestStatus `db:"from_status"`
+	ToStatus   LeaveRequestStatus     `db:"to_status"`
+	ActorID    pgtype.Int4            `db:"actor_id"`
+	Comment    pgtype.Text            `db:"comment"`
+	CreatedAt  pgtype.Timestamptz     `db:"created_at"`
+}
+
 type SantriOccupation struct {
 	ID          int32       `db:"id"`
 	Name        string      `db:"name"`
@@ -600,6 +1048,8 @@ type SantriPermission struct {
 	// Waktu berakhir, jika pulang, maka setting end permissionnya di akhir waktu berakhirnya schedule yang terakhir
 	EndPermission pgtype.Timestamptz `db:"end_permission"`
 	Excuse        string             `db:"excuse"`
+	// Waktu santri keluar, diisi oleh tap pertama di device mode izin
+	LeftAt pgtype.Timestamptz `db:"left_at"`
 }
 
 type SantriPresence struct {
@@ -633,11 +1083,51 @@ type SmartCard struct {
 	ID        int32              `db:"id"`
 	Uid       string             `db:"uid"`
 	CreatedAt pgtype.Timestamptz `db:"created_at"`
-	IsActive  bool               `db:"is_active"`
 	// Smart Card bisa milik santri
 	SantriID pgtype.Int4 `db:"santri_id"`
 	// Smart Card bisa milik employee
 	EmployeeID pgtype.Int4 `db:"employee_id"`
+	// suspended bisa diaktifkan lagi, lost dan retired melepas pemilik kartu
+	State SmartCardState `db:"state"`
+	// Kartu pengganti jika kartu ini diganti
+	ReplacedByID pgtype.Int4 `db:"replaced_by_id"`
+	// Sama dengan state active
+	IsActive bool `db:"is_active"`
+	// Kartu belum bisa dipakai sebelum waktu ini, kosong berarti tanpa batas
+	ValidFrom pgtype.Timestamptz `db:"valid_from"`
+	// Kartu tidak bisa dipakai setelah waktu ini dan ditangguhkan oleh job, kosong berarti tanpa batas
+	ValidUntil pgtype.Timestamptz `db:"valid_until"`
+	// Waktu kartu ditangguhkan oleh job karena valid_until terlewati, kosong jika kartu ditangguhkan karena hal lain
+	ExpiredAt pgtype.Timestamptz `db:"expired_at"`
+}
+
+// Riwayat pemilik kartu, valid_until NULL berarti masih dipegang
+type SmartCardAssignment struct {
+	ID          int32              `db:"id"`
+	SmartCardID int32              `db:"smart_card_id"`
+	SantriID    pgtype.Int4        `db:"santri_id"`
+	EmployeeID  pgtype.Int4        `db:"employee_id"`
+	ValidFrom   pgtype.Timestamptz `db:"valid_from"`
+	ValidUntil  pgtype.Timestamptz `db:"valid_until"`
+}
+
+type TapEvent struct {
+	ID         int32          `db:"id"`
+	DeviceID   pgtype.Int4    `db:"device_id"`
+	Mode       DeviceModeType `db:"mode"`
+	Uid        pgtype.Text    `db:"uid"`
+	SantriID   pgtype.Int4    `db:"santri_id"`
+	EmployeeID pgtype.Int4    `db:"employee_id"`
+	// Kode respon yang dikirim ke device, >= 300 berarti tap ditolak
+	Code    int32  `db:"code"`
+	Message string `db:"message"`
+	// Lama pemrosesan tap di server
+	LatencyMs int32 `db:"latency_ms"`
+	// Waktu tap di device, untuk mode batch bisa lebih awal dari created_at
+	TappedAt  pgtype.Timestamptz `db:"tapped_at"`
+	CreatedAt pgtype.Timestamptz `db:"created_at"`
+	// Arah gerbang device saat tap terjadi
+	GateDirection NullGateDirectionType `db:"gate_direction"`
 }
 
 type User struct {
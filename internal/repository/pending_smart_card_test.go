@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/adiubaidah/syafiiyah-main/pkg/random"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func clearPendingSmartCardTable(t *testing.T) {
+	_, err := sqlStore.db.Exec(context.Background(), `DELETE FROM "pending_smart_card"`)
+	require.NoError(t, err)
+}
+
+func TestPendingSmartCard(t *testing.T) {
+	clearPendingSmartCardTable(t)
+	deviceName := random.RandomString(10)
+	device, err := sqlStore.CreateDeviceWithModes(context.Background(), deviceName, random.RandomString(64), []CreateDeviceModesParams{
+		{
+			Mode:                 DeviceModeTypePresence,
+			InputTopic:           deviceName + "/input/presence",
+			AcknowledgementTopic: deviceName + "/acknowledgment/presence",
+		},
+	})
+	require.NoError(t, err)
+
+	uid := random.RandomString(12)
+	pending, err := testStore.UpsertPendingSmartCard(context.Background(), UpsertPendingSmartCardParams{Uid: uid, DeviceName: deviceName})
+	require.NoError(t, err)
+	require.Equal(t, uid, pending.Uid)
+	require.Equal(t, device.ID, pending.DeviceID.Int32)
+	require.Equal(t, int32(1), pending.TapCount)
+
+	t.Run("repeated tap is counted", func(t *testing.T) {
+		repeated, err := testStore.UpsertPendingSmartCard(context.Background(), UpsertPendingSmartCardParams{Uid: uid, DeviceName: deviceName})
+		require.NoError(t, err)
+		require.Equal(t, pending.ID, repeated.ID)
+		require.Equal(t, int32(2), repeated.TapCount)
+		require.Equal(t, pending.FirstTappedAt.Time, repeated.FirstTappedAt.Time)
+	})
+
+	t.Run("list with device", func(t *testing.T) {
+		list, err := testStore.ListPendingSmartCards(context.Background(), ListPendingSmartCardsParams{LimitNumber: 10})
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		require.Equal(t, deviceName, list[0].DeviceName.String)
+
+		count, err := testStore.CountPendingSmartCards(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, int64(1), count)
+	})
+
+	t.Run("claim", func(t *testing.T) {
+		santri := createRandomSantri(t)
+		smartCard, err := sqlStore.ClaimPendingSmartCard(context.Background(), pending.ID, CreateSmartCardParams{
+			State:    SmartCardStateActive,
+			SantriID: pgtype.Int4{Int32: santri.ID, Valid: true},
+		})
+		require.NoError(t, err)
+		require.Equal(t, uid, smartCard.Uid)
+		require.Equal(t, santri.ID, smartCard.SantriID.Int32)
+
+		_, err = testStore.DeletePendingSmartCard(context.Background(), pending.ID)
+		require.ErrorIs(t, err, pgx.ErrNoRows)
+	})
+}
@@ -11,76 +11,152 @@ import (
 )
 
 type Querier interface {
+	ApplySantriPermissionToPresences(ctx context.Context, arg ApplySantriPermissionToPresencesParams) ([]SantriPresence, error)
+	CancelEmployeePermission(ctx context.Context, arg CancelEmployeePermissionParams) (EmployeePermission, error)
+	CloseEnrollmentSession(ctx context.Context, arg CloseEnrollmentSessionParams) (EnrollmentSession, error)
+	CountDeviceCommands(ctx context.Context, arg CountDeviceCommandsParams) (int64, error)
+	CountEmployeePermissions(ctx context.Context, arg CountEmployeePermissionsParams) (int64, error)
 	CountEmployeePresences(ctx context.Context, arg CountEmployeePresencesParams) (int64, error)
 	CountEmployees(ctx context.Context, arg CountEmployeesParams) (int64, error)
+	CountExpiringSmartCards(ctx context.Context, days int32) (int64, error)
 	CountParents(ctx context.Context, arg CountParentsParams) (int64, error)
+	CountPendingSmartCards(ctx context.Context) (int64, error)
 	CountSantri(ctx context.Context, arg CountSantriParams) (int64, error)
+	CountSantriLeaveRequests(ctx context.Context, arg CountSantriLeaveRequestsParams) (int64, error)
+	CountSantriPermissions(ctx context.Context, arg CountSantriPermissionsParams) (int64, error)
 	CountSantriPresences(ctx context.Context, arg CountSantriPresencesParams) (int64, error)
 	CountSmartCards(ctx context.Context, arg CountSmartCardsParams) (int64, error)
+	CountTapEvents(ctx context.Context, arg CountTapEventsParams) (int64, error)
 	CountUsers(ctx context.Context, arg CountUsersParams) (int64, error)
-	CreateDevice(ctx context.Context, name string) (Device, error)
+	CreateDevice(ctx context.Context, arg CreateDeviceParams) (Device, error)
+	CreateDeviceAllowRules(ctx context.Context, arg []CreateDeviceAllowRulesParams) (int64, error)
+	CreateDeviceBatchTap(ctx context.Context, arg CreateDeviceBatchTapParams) (DeviceBatchTap, error)
+	CreateDeviceCommand(ctx context.Context, arg CreateDeviceCommandParams) (DeviceCommand, error)
 	CreateDeviceModes(ctx context.Context, arg []CreateDeviceModesParams) (int64, error)
 	CreateEmployee(ctx context.Context, arg CreateEmployeeParams) (Employee, error)
 	CreateEmployeeOccupation(ctx context.Context, arg CreateEmployeeOccupationParams) (EmployeeOccupation, error)
 	CreateEmployeePermission(ctx context.Context, arg CreateEmployeePermissionParams) (EmployeePermission, error)
 	CreateEmployeePresence(ctx context.Context, arg CreateEmployeePresenceParams) (EmployeePresence, error)
 	CreateEmployeePresences(ctx context.Context, arg []CreateEmployeePresencesParams) (int64, error)
+	CreateEnrollmentSession(ctx context.Context, arg CreateEnrollmentSessionParams) (EnrollmentSession, error)
+	CreateEnrollmentSessionItems(ctx context.Context, arg []CreateEnrollmentSessionItemsParams) (int64, error)
+	CreateHoliday(ctx context.Context, arg CreateHolidayParams) (Holiday, error)
+	CreateHolidayDates(ctx context.Context, arg []CreateHolidayDatesParams) (int64, error)
+	CreateLocation(ctx context.Context, arg CreateLocationParams) (Location, error)
 	CreateParent(ctx context.Context, arg CreateParentParams) (Parent, error)
 	CreateSantri(ctx context.Context, arg CreateSantriParams) (Santri, error)
+	CreateSantriLeaveRequest(ctx context.Context, arg CreateSantriLeaveRequestParams) (SantriLeaveRequest, error)
+	CreateSantriLeaveRequestHistory(ctx context.Context, arg CreateSantriLeaveRequestHistoryParams) (SantriLeaveRequestHistory, error)
 	CreateSantriOccupation(ctx context.Context, arg CreateSantriOccupationParams) (SantriOccupation, error)
 	CreateSantriPermission(ctx context.Context, arg CreateSantriPermissionParams) (SantriPermission, error)
 	CreateSantriPresence(ctx context.Context, arg CreateSantriPresenceParams) (SantriPresence, error)
 	CreateSantriPresences(ctx context.Context, arg []CreateSantriPresencesParams) (int64, error)
+	CreateSantriTapPresence(ctx context.Context, arg CreateSantriTapPresenceParams) (SantriPresence, error)
 	CreateSmartCard(ctx context.Context, arg CreateSmartCardParams) (SmartCard, error)
+	CreateSmartCardAssignment(ctx context.Context, arg CreateSmartCardAssignmentParams) (SmartCardAssignment, error)
+	CreateTapEvent(ctx context.Context, arg CreateTapEventParams) (TapEvent, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
 	DeleteDevice(ctx context.Context, id int32) (Device, error)
+	DeleteDeviceAllowRules(ctx context.Context, deviceID int32) error
 	DeleteDeviceModeByDeviceId(ctx context.Context, deviceID int32) error
 	DeleteEmployee(ctx context.Context, id int32) (Employee, error)
 	DeleteEmployeeOccupation(ctx context.Context, id int32) (EmployeeOccupation, error)
 	DeleteEmployeePermission(ctx context.Context, id int32) (EmployeePermission, error)
 	DeleteEmployeePresence(ctx context.Context, id int32) (EmployeePresence, error)
+	DeleteHoliday(ctx context.Context, id int32) (Holiday, error)
+	DeleteHolidayDateByHolidayId(ctx context.Context, holidayID int32) error
+	DeleteLocation(ctx context.Context, id int32) (Location, error)
 	DeleteParent(ctx context.Context, id int32) (Parent, error)
+	DeletePendingSmartCard(ctx context.Context, id int32) (PendingSmartCard, error)
 	DeleteSantri(ctx context.Context, id int32) (Santri, error)
 	DeleteSantriOccupation(ctx context.Context, id int32) (SantriOccupation, error)
 	DeleteSantriPermission(ctx context.Context, id int32) (SantriPermission, error)
 	DeleteSantriPresence(ctx context.Context, id int32) (SantriPresence, error)
 	DeleteSmartCard(ctx context.Context, id int32) (SmartCard, error)
 	DeleteUser(ctx context.Context, id int32) (User, error)
+	DetachSantriPermissionPresences(ctx context.Context, santriPermissionID pgtype.Int4) ([]SantriPresence, error)
+	EndSmartCardAssignment(ctx context.Context, smartCardID int32) error
+	ExpireEnrollmentSessions(ctx context.Context, deviceID int32) error
+	ExpireSmartCards(ctx context.Context) ([]SmartCard, error)
+	GetActiveSantriPermission(ctx context.Context, arg GetActiveSantriPermissionParams) (SantriPermission, error)
+	GetDevice(ctx context.Context, id int32) (Device, error)
+	GetDeviceBatchTap(ctx context.Context, arg GetDeviceBatchTapParams) (DeviceBatchTap, error)
+	GetDeviceByTopicName(ctx context.Context, topicName string) (Device, error)
 	GetEmployeeByID(ctx context.Context, id int32) (GetEmployeeByIDRow, error)
 	GetEmployeeByUserID(ctx context.Context, userID pgtype.Int4) (Employee, error)
 	GetEmployeePermission(ctx context.Context, id int32) (GetEmployeePermissionRow, error)
+	GetEnrollmentSession(ctx context.Context, id int32) (GetEnrollmentSessionRow, error)
+	GetHoliday(ctx context.Context, id int32) (Holiday, error)
+	GetHolidayByDate(ctx context.Context, date pgtype.Date) (Holiday, error)
+	GetLastGateDirection(ctx context.Context, uid pgtype.Text) (GateDirectionType, error)
+	GetLocation(ctx context.Context, id int32) (Location, error)
+	GetNextEnrollmentSessionItem(ctx context.Context, sessionID int32) (EnrollmentSessionItem, error)
+	GetOpenEnrollmentSessionByDeviceName(ctx context.Context, deviceName string) (EnrollmentSession, error)
+	GetOwnerSmartCard(ctx context.Context, arg GetOwnerSmartCardParams) (SmartCard, error)
 	GetParent(ctx context.Context, id int32) (GetParentRow, error)
 	GetParentByUserId(ctx context.Context, userID pgtype.Int4) (Parent, error)
 	GetSantri(ctx context.Context, id int32) (GetSantriRow, error)
+	GetSantriLeaveRequest(ctx context.Context, id int32) (GetSantriLeaveRequestRow, error)
 	GetSantriPermission(ctx context.Context, id int32) (GetSantriPermissionRow, error)
 	GetSmartCard(ctx context.Context, uid string) (GetSmartCardRow, error)
+	GetSmartCardByID(ctx context.Context, id int32) (SmartCard, error)
 	GetUserByEmail(ctx context.Context, email pgtype.Text) (GetUserByEmailRow, error)
 	GetUserById(ctx context.Context, id pgtype.Int4) (GetUserByIdRow, error)
 	GetUserByUsername(ctx context.Context, username pgtype.Text) (GetUserByUsernameRow, error)
+	IsHolidayDate(ctx context.Context, date pgtype.Date) (bool, error)
+	ListActiveDeviceModeSwitches(ctx context.Context) ([]ListActiveDeviceModeSwitchesRow, error)
+	ListActiveSantriPermissions(ctx context.Context, at pgtype.Timestamptz) ([]SantriPermission, error)
+	ListDeviceAllowRules(ctx context.Context, deviceID int32) ([]DeviceAllowRule, error)
+	ListDeviceCommands(ctx context.Context, arg ListDeviceCommandsParams) ([]DeviceCommand, error)
 	ListDeviceModes(ctx context.Context, deviceID int32) ([]DeviceMode, error)
 	ListDevices(ctx context.Context) ([]ListDevicesRow, error)
 	ListEmployeeOccupations(ctx context.Context) ([]ListEmployeeOccupationsRow, error)
 	ListEmployeePermissions(ctx context.Context, arg ListEmployeePermissionsParams) ([]ListEmployeePermissionsRow, error)
 	ListEmployeePresences(ctx context.Context, arg ListEmployeePresencesParams) ([]ListEmployeePresencesRow, error)
+	ListEnrollmentSessionItems(ctx context.Context, sessionID int32) ([]ListEnrollmentSessionItemsRow, error)
+	ListExpiringSmartCards(ctx context.Context, arg ListExpiringSmartCardsParams) ([]ListExpiringSmartCardsRow, error)
+	ListHolidayDatesByHolidayId(ctx context.Context, holidayID int32) ([]pgtype.Date, error)
+	ListHolidays(ctx context.Context, arg ListHolidaysParams) ([]ListHolidaysRow, error)
+	ListLocations(ctx context.Context) ([]Location, error)
 	ListMissingEmployeePresences(ctx context.Context, arg ListMissingEmployeePresencesParams) ([]ListMissingEmployeePresencesRow, error)
 	ListMissingSantriPresences(ctx context.Context, arg ListMissingSantriPresencesParams) ([]ListMissingSantriPresencesRow, error)
+	ListPendingEmployeePermissions(ctx context.Context, arg ListPendingEmployeePermissionsParams) ([]ListPendingEmployeePermissionsRow, error)
+	ListPendingSmartCards(ctx context.Context, arg ListPendingSmartCardsParams) ([]ListPendingSmartCardsRow, error)
+	ListSantriLeaveRequestHistories(ctx context.Context, leaveRequestID int32) ([]ListSantriLeaveRequestHistoriesRow, error)
+	ListSantriLeaveRequests(ctx context.Context, arg ListSantriLeaveRequestsParams) ([]ListSantriLeaveRequestsRow, error)
 	ListSantriOccupations(ctx context.Context) ([]ListSantriOccupationsRow, error)
 	ListSantriPermissions(ctx context.Context, arg ListSantriPermissionsParams) ([]ListSantriPermissionsRow, error)
 	ListSantriPresences(ctx context.Context, arg ListSantriPresencesParams) ([]ListSantriPresencesRow, error)
+	ListSmartCardAssignments(ctx context.Context, smartCardID int32) ([]ListSmartCardAssignmentsRow, error)
 	ListSmartCards(ctx context.Context, arg ListSmartCardsParams) ([]ListSmartCardsRow, error)
+	ListTapEvents(ctx context.Context, arg ListTapEventsParams) ([]ListTapEventsRow, error)
+	MarkSantriPermissionLeft(ctx context.Context, arg MarkSantriPermissionLeftParams) (SantriPermission, error)
+	RevertSantriPermissionPresences(ctx context.Context, santriPermissionID pgtype.Int4) ([]SantriPresence, error)
+	ReviewEmployeePermission(ctx context.Context, arg ReviewEmployeePermissionParams) (EmployeePermission, error)
+	SuspendSantriSmartCards(ctx context.Context, santriID pgtype.Int4) ([]SmartCard, error)
 	UpdateDevice(ctx context.Context, arg UpdateDeviceParams) (Device, error)
+	UpdateDeviceCommandStatus(ctx context.Context, arg UpdateDeviceCommandStatusParams) (DeviceCommand, error)
+	UpdateDeviceHeartbeat(ctx context.Context, arg UpdateDeviceHeartbeatParams) (Device, error)
+	UpdateDeviceLocation(ctx context.Context, arg UpdateDeviceLocationParams) (Device, error)
 	UpdateDeviceMode(ctx context.Context, arg UpdateDeviceModeParams) (DeviceMode, error)
+	UpdateDeviceSecret(ctx context.Context, arg UpdateDeviceSecretParams) (Device, error)
+	UpdateDeviceTapRule(ctx context.Context, arg UpdateDeviceTapRuleParams) (Device, error)
 	UpdateEmployee(ctx context.Context, arg UpdateEmployeeParams) (Employee, error)
 	UpdateEmployeeOccupation(ctx context.Context, arg UpdateEmployeeOccupationParams) (EmployeeOccupation, error)
 	UpdateEmployeePermission(ctx context.Context, arg UpdateEmployeePermissionParams) (EmployeePermission, error)
 	UpdateEmployeePresence(ctx context.Context, arg UpdateEmployeePresenceParams) (EmployeePresence, error)
+	UpdateEnrollmentSessionItemCard(ctx context.Context, arg UpdateEnrollmentSessionItemCardParams) (EnrollmentSessionItem, error)
+	UpdateHoliday(ctx context.Context, arg UpdateHolidayParams) (Holiday, error)
+	UpdateLocation(ctx context.Context, arg UpdateLocationParams) (Location, error)
 	UpdateParent(ctx context.Context, arg UpdateParentParams) (Parent, error)
 	UpdateSantri(ctx context.Context, arg UpdateSantriParams) (Santri, error)
+	UpdateSantriLeaveRequestStatus(ctx context.Context, arg UpdateSantriLeaveRequestStatusParams) (SantriLeaveRequest, error)
 	UpdateSantriOccupation(ctx context.Context, arg UpdateSantriOccupationParams) (SantriOccupation, error)
 	UpdateSantriPermission(ctx context.Context, arg UpdateSantriPermissionParams) (SantriPermission, error)
 	UpdateSantriPresence(ctx context.Context, arg UpdateSantriPresenceParams) (SantriPresence, error)
 	UpdateSmartCard(ctx context.Context, arg UpdateSmartCardParams) (SmartCard, error)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
+	UpsertPendingSmartCard(ctx context.Context, arg UpsertPendingSmartCardParams) (PendingSmartCard, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/adiubaidah/syafiiyah-main/pkg/random"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomSantriLeaveRequest(t *testing.T, start time.Time) (SantriLeaveRequest, User) {
+	santri, parent := createRandomSantriWithParent(t)
+	user := createRandomUser(t, RoleTypeParent)
+
+	arg := CreateSantriLeaveRequestParams{
+		SantriID:   santri.ID,
+		ParentID:   parent.ID,
+		Type:       PermissionTypeSick,
+		Reason:     random.RandomString(50),
+		StartLeave: pgtype.Timestamptz{Time: start, Valid: true},
+		EndLeave:   pgtype.Timestamptz{Time: start.Add(48 * time.Hour), Valid: true},
+		Attachment: pgtype.Text{String: random.RandomString(20), Valid: true},
+	}
+	leaveRequest, err := sqlStore.CreateSantriLeaveRequestWithHistory(context.Background(), arg, CreateSantriLeaveRequestHistoryParams{
+		ActorID: pgtype.Int4{Int32: user.ID, Valid: true},
+	})
+	require.NoError(t, err)
+	require.NotZero(t, leaveRequest.ID)
+	require.Equal(t, LeaveRequestStatusPending, leaveRequest.Status)
+	require.Equal(t, arg.Reason, leaveRequest.Reason)
+	require.False(t, leaveRequest.SantriPermissionID.Valid)
+
+	return leaveRequest, user
+}
+
+func TestCreateSantriLeaveRequest(t *testing.T) {
+	leaveRequest, user := createRandomSantriLeaveRequest(t, time.Now())
+
+	histories, err := testStore.ListSantriLeaveRequestHistories(context.Background(), leaveRequest.ID)
+	require.NoError(t, err)
+	require.Len(t, histories, 1)
+	require.False(t, histories[0].FromStatus.Valid)
+	require.Equal(t, LeaveRequestStatusPending, histories[0].ToStatus)
+	require.Equal(t, user.Username, histories[0].ActorUsername.String)
+
+	count, err := testStore.CountSantriLeaveRequests(context.Background(), CountSantriLeaveRequestsParams{
+		ParentID: pgtype.Int4{Int32: leaveRequest.ParentID, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+}
+
+func TestApproveAndCancelSantriLeaveRequest(t *testing.T) {
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Microsecond)
+	leaveRequest, _ := createRandomSantriLeaveRequest(t, start)
+	admin := createRandomUser(t, RoleTypeAdmin)
+
+	approved, err := sqlStore.ChangeSantriLeaveRequestStatus(context.Background(), UpdateSantriLeaveRequestStatusParams{
+		ID:            leaveRequest.ID,
+		Status:        LeaveRequestStatusApproved,
+		FromStatus:    LeaveRequestStatusPending,
+		ReviewComment: pgtype.Text{String: "Semoga lekas sembuh", Valid: true},
+	}, CreateSantriLeaveRequestHistoryParams{
+		ActorID: pgtype.Int4{Int32: admin.ID, Valid: true},
+		Comment: pgtype.Text{String: "Semoga lekas sembuh", Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, LeaveRequestStatusApproved, approved.Status)
+	require.Equal(t, "Semoga lekas sembuh", approved.ReviewComment.String)
+	require.True(t, approved.SantriPermissionID.Valid)
+
+	permission, err := testStore.GetSantriPermission(context.Background(), approved.SantriPermissionID.Int32)
+	require.NoError(t, err)
+	require.Equal(t, leaveRequest.SantriID, permission.SantriID)
+	require.Equal(t, PermissionTypeSick, permission.Type)
+	require.True(t, start.Equal(permission.StartPermission.Time))
+	require.Equal(t, leaveRequest.Reason, permission.Excuse)
+
+	t.Run("status is changed once", func(t *testing.T) {
+		_, err := sqlStore.ChangeSantriLeaveRequestStatus(context.Background(), UpdateSantriLeaveRequestStatusParams{
+			ID:         leaveRequest.ID,
+			Status:     LeaveRequestStatusRejected,
+			FromStatus: LeaveRequestStatusPending,
+		}, CreateSantriLeaveRequestHistoryParams{})
+		require.ErrorIs(t, err, pgx.ErrNoRows)
+	})
+
+	cancelled, err := sqlStore.ChangeSantriLeaveRequestStatus(context.Background(), UpdateSantriLeaveRequestStatusParams{
+		ID:         leaveRequest.ID,
+		Status:     LeaveRequestStatusCancelled,
+		FromStatus: LeaveRequestStatusApproved,
+	}, CreateSantriLeaveRequestHistoryParams{
+		ActorID: pgtype.Int4{Int32: admin.ID, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, LeaveRequestStatusCancelled, cancelled.Status)
+	require.False(t, cancelled.SantriPermissionID.Valid)
+
+	_, err = testStore.GetSantriPermission(context.Background(), permission.ID)
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+
+	histories, err := testStore.ListSantriLeaveRequestHistories(context.Background(), leaveRequest.ID)
+	require.NoError(t, err)
+	require.Len(t, histories, 3)
+	require.Equal(t, LeaveRequestStatusApproved, histories[1].ToStatus)
+	require.Equal(t, LeaveRequestStatusPending, histories[1].FromStatus.LeaveRequestStatus)
+	require.Equal(t, "Semoga lekas sembuh", histories[1].Comment.String)
+	require.Equal(t, LeaveRequestStatusCancelled, histories[2].ToStatus)
+	require.Equal(t, admin.ID, histories[2].ActorID.Int32)
+}
+
+func TestCompleteSantriLeaveRequest(t *testing.T) {
+	leaveRequest, _ := createRandomSantriLeaveRequest(t, time.Now().Add(-time.Hour))
+
+	approved, err := sqlStore.ChangeSantriLeaveRequestStatus(context.Background(), UpdateSantriLeaveRequestStatusParams{
+		ID:         leaveRequest.ID,
+		Status:     LeaveRequestStatusApproved,
+		FromStatus: LeaveRequestStatusPending,
+	}, CreateSantriLeaveRequestHistoryParams{})
+	require.NoError(t, err)
+
+	completed, err := sqlStore.ChangeSantriLeaveRequestStatus(context.Background(), UpdateSantriLeaveRequestStatusParams{
+		ID:         leaveRequest.ID,
+		Status:     LeaveRequestStatusCompleted,
+		FromStatus: LeaveRequestStatusApproved,
+	}, CreateSantriLeaveRequestHistoryParams{})
+	require.NoError(t, err)
+	require.Equal(t, LeaveRequestStatusCompleted, completed.Status)
+
+	// the santri came back early, the permission ends now instead of two days later
+	permission, err := testStore.GetSantriPermission(context.Background(), approved.SantriPermissionID.Int32)
+	require.NoError(t, err)
+	require.True(t, permission.EndPermission.Time.Before(leaveRequest.EndLeave.Time))
+	require.WithinDuration(t, time.Now(), permission.EndPermission.Time, time.Minute)
+}
@@ -11,6 +11,66 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countSantriPermissions = `-- name: CountSantriPermissions :one
+SELECT
+    COUNT(*)
+FROM
+    "santri_permission"
+    INNER JOIN "santri" ON "santri_permission"."santri_id" = "santri"."id"
+WHERE
+    ($1 :: text IS NULL
+    OR "santri"."name" ILIKE '%' || $1 || '%')
+    AND (
+        $2 :: integer IS NULL
+        OR "santri_id" = $2 :: integer
+    )
+    AND (
+        $3 :: permission_type IS NULL
+        OR "type" = $3 :: permission_type
+    )
+    AND (
+        $4 :: timestamptz IS NULL
+        OR "start_permission" >= $4 :: timestamptz
+    )
+    AND (
+        $5 :: timestamptz IS NULL
+        OR "end_permission" <= $5 :: timestamptz
+    )
+    AND (
+        $6 :: timestamptz IS NULL
+        OR (
+            "start_permission" <= $6 :: timestamptz
+            AND (
+                "end_permission" IS NULL
+                OR "end_permission" >= $6 :: timestamptz
+            )
+        )
+    )
+`
+
+type CountSantriPermissionsParams struct {
+	Q        pgtype.Text        `db:"q"`
+	SantriID pgtype.Int4        `db:"santri_id"`
+	Type     NullPermissionType `db:"type"`
+	FromDate pgtype.Timestamptz `db:"from_date"`
+	EndDate  pgtype.Timestamptz `db:"end_date"`
+	ActiveAt pgtype.Timestamptz `db:"active_at"`
+}
+
+func (q *Queries) CountSantriPermissions(ctx context.Context, arg CountSantriPermissionsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countSantriPermissions,
+		arg.Q,
+		arg.SantriID,
+		arg.Type,
+		arg.FromDate,
+		arg.EndDate,
+		arg.ActiveAt,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createSantriPermission = `-- name: CreateSantriPermission :one
 INSERT INTO
     "santri_permission" (
@@ -27,7 +87,7 @@ VALUES
         $3,
         $4 :: permission_type,
         $5
-    ) RETURNING id, santri_id, type, start_permission, end_permission, excuse
+    ) RETURNING id, santri_id, type, start_permission, end_permission, excuse, left_at
 `
 
 type CreateSantriPermissionParams struct {
@@ -54,6 +114,7 @@ func (q *Queries) CreateSantriPermission(ctx context.Context, arg CreateSantriPe
 		&i.StartPermission,
 		&i.EndPermission,
 		&i.Excuse,
+		&i.LeftAt,
 	)
 	return i, err
 }
@@ -62,7 +123,7 @@ const deleteSantriPermission = `-- name: DeleteSantriPermission :one
 DELETE FROM
     "santri_permission"
 WHERE
-    "id" = $1 RETURNING id, santri_id, type, start_permission, end_permission, excuse
+    "id" = $1 RETURNING id, santri_id, type, start_permission, end_permission, excuse, left_at
 `
 
 func (q *Queries) DeleteSantriPermission(ctx context.Context, id int32) (SantriPermission, error) {
@@ -75,13 +136,52 @@ func (q *Queries) DeleteSantriPermission(ctx context.Context, id int32) (SantriP
 		&i.StartPermission,
 		&i.EndPermission,
 		&i.Excuse,
+		&i.LeftAt,
+	)
+	return i, err
+}
+
+const getActiveSantriPermission = `-- name: GetActiveSantriPermission :one
+SELECT
+    id, santri_id, type, start_permission, end_permission, excuse, left_at
+FROM
+    "santri_permission"
+WHERE
+    "santri_id" = $1
+    AND "start_permission" <= $2 :: timestamptz
+    AND (
+        "end_permission" IS NULL
+        OR "end_permission" >= $2 :: timestamptz
+    )
+ORDER BY
+    "start_permission" DESC
+LIMIT
+    1
+`
+
+type GetActiveSantriPermissionParams struct {
+	SantriID int32              `db:"santri_id"`
+	At       pgtype.Timestamptz `db:"at"`
+}
+
+func (q *Queries) GetActiveSantriPermission(ctx context.Context, arg GetActiveSantriPermissionParams) (SantriPermission, error) {
+	row := q.db.QueryRow(ctx, getActiveSantriPermission, arg.SantriID, arg.At)
+	var i SantriPermission
+	err := row.Scan(
+		&i.ID,
+		&i.SantriID,
+		&i.Type,
+		&i.StartPermission,
+		&i.EndPermission,
+		&i.Excuse,
+		&i.LeftAt,
 	)
 	return i, err
 }
 
 const getSantriPermission = `-- name: GetSantriPermission :one
 SELECT
-    santri_permission.id, santri_permission.santri_id, santri_permission.type, santri_permission.start_permission, santri_permission.end_permission, santri_permission.excuse,
+    santri_permission.id, santri_permission.santri_id, santri_permission.type, santri_permission.start_permission, santri_permission.end_permission, santri_permission.excuse, santri_permission.left_at,
     "santri"."name" AS "santri_name"
 FROM
     "santri_permission"
@@ -97,6 +197,7 @@ type GetSantriPermissionRow struct {
 	StartPermission pgtype.Timestamptz `db:"start_permission"`
 	EndPermission   pgtype.Timestamptz `db:"end_permission"`
 	Excuse          string             `db:"excuse"`
+	LeftAt          pgtype.Timestamptz `db:"left_at"`
 	SantriName      string             `db:"santri_name"`
 }
 
@@ -110,14 +211,59 @@ func (q *Queries) GetSantriPermission(ctx context.Context, id int32) (GetSantriP
 		&i.StartPermission,
 		&i.EndPermission,
 		&i.Excuse,
+		&i.LeftAt,
 		&i.SantriName,
 	)
 	return i, err
 }
 
+const listActiveSantriPermissions = `-- name: ListActiveSantriPermissions :many
+SELECT
+    id, santri_id, type, start_permission, end_permission, excuse, left_at
+FROM
+    "santri_permission"
+WHERE
+    "start_permission" <= $1 :: timestamptz
+    AND (
+        "end_permission" IS NULL
+        OR "end_permission" >= $1 :: timestamptz
+    )
+ORDER BY
+    "santri_id",
+    "start_permission" DESC
+`
+
+func (q *Queries) ListActiveSantriPermissions(ctx context.Context, at pgtype.Timestamptz) ([]SantriPermission, error) {
+	rows, err := q.db.Query(ctx, listActiveSantriPermissions, at)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SantriPermission{}
+	for rows.Next() {
+		var i SantriPermission
+		if err := rows.Scan(
+			&i.ID,
+			&i.SantriID,
+			&i.Type,
+			&i.StartPermission,
+			&i.EndPermission,
+			&i.Excuse,
+			&i.LeftAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listSantriPermissions = `-- name: ListSantriPermissions :many
 SELECT
-    santri_permission.id, santri_permission.santri_id, santri_permission.type, santri_permission.start_permission, santri_permission.end_permission, santri_permission.excuse,
+    santri_permission.id, santri_permission.santri_id, santri_permission.type, santri_permission.start_permission, santri_permission.end_permission, santri_permission.excuse, santri_permission.left_at,
     "santri"."name" AS "santri_name"
 FROM
     "santri_permission"
@@ -141,8 +287,20 @@ WHERE
         $5 :: timestamptz IS NULL
         OR "end_permission" <= $5 :: timestamptz
     )
+    AND (
+        $6 :: timestamptz IS NULL
+        OR (
+            "start_permission" <= $6 :: timestamptz
+            AND (
+                "end_permission" IS NULL
+                OR "end_permission" >= $6 :: timestamptz
+            )
+        )
+    )
+ORDER BY
+    "start_permission" DESC
 LIMIT
-    $7 OFFSET $6
+    $8 OFFSET $7
 `
 
 type ListSantriPermissionsParams struct {
@@ -151,6 +309,7 @@ type ListSantriPermissionsParams struct {
 	Type         NullPermissionType `db:"type"`
 	FromDate     pgtype.Timestamptz `db:"from_date"`
 	EndDate      pgtype.Timestamptz `db:"end_date"`
+	ActiveAt     pgtype.Timestamptz `db:"active_at"`
 	OffsetNumber int32              `db:"offset_number"`
 	LimitNumber  int32              `db:"limit_number"`
 }
@@ -162,6 +321,7 @@ type ListSantriPermissionsRow struct {
 	StartPermission pgtype.Timestamptz `db:"start_permission"`
 	EndPermission   pgtype.Timestamptz `db:"end_permission"`
 	Excuse          string             `db:"excuse"`
+	LeftAt          pgtype.Timestamptz `db:"left_at"`
 	SantriName      string             `db:"santri_name"`
 }
 
@@ -172,6 +332,7 @@ func (q *Queries) ListSantriPermissions(ctx context.Context, arg ListSantriPermi
 		arg.Type,
 		arg.FromDate,
 		arg.EndDate,
+		arg.ActiveAt,
 		arg.OffsetNumber,
 		arg.LimitNumber,
 	)
@@ -189,6 +350,7 @@ func (q *Queries) ListSantriPermissions(ctx context.Context, arg ListSantriPermi
 			&i.StartPermission,
 			&i.EndPermission,
 			&i.Excuse,
+			&i.LeftAt,
 			&i.SantriName,
 		); err != nil {
 			return nil, err
@@ -201,6 +363,35 @@ func (q *Queries) ListSantriPermissions(ctx context.Context, arg ListSantriPermi
 	return items, nil
 }
 
+const markSantriPermissionLeft = `-- name: MarkSantriPermissionLeft :one
+UPDATE
+    "santri_permission"
+SET
+    "left_at" = $1
+WHERE
+    "id" = $2 RETURNING id, santri_id, type, start_permission, end_permission, excuse, left_at
+`
+
+type MarkSantriPermissionLeftParams struct {
+	LeftAt pgtype.Timestamptz `db:"left_at"`
+	ID     int32              `db:"id"`
+}
+
+func (q *Queries) MarkSantriPermissionLeft(ctx context.Context, arg MarkSantriPermissionLeftParams) (SantriPermission, error) {
+	row := q.db.QueryRow(ctx, markSantriPermissionLeft, arg.LeftAt, arg.ID)
+	var i SantriPermission
+	err := row.Scan(
+		&i.ID,
+		&i.SantriID,
+		&i.Type,
+		&i.StartPermission,
+		&i.EndPermission,
+		&i.Excuse,
+		&i.LeftAt,
+	)
+	return i, err
+}
+
 const updateSantriPermission = `-- name: UpdateSantriPermission :one
 UPDATE
     "santri_permission"
@@ -208,15 +399,17 @@ SET
     "santri_id" = COALESCE($1, santri_id),
     "start_permission" = COALESCE($2, start_permission),
     "end_permission" = $3,
-    "excuse" = COALESCE($4, excuse)
+    "type" = COALESCE($4 :: permission_type, "type"),
+    "excuse" = COALESCE($5, excuse)
 WHERE
-    "id" = $5 RETURNING id, santri_id, type, start_permission, end_permission, excuse
+    "id" = $6 RETURNING id, santri_id, type, start_permission, end_permission, excuse, left_at
 `
 
 type UpdateSantriPermissionParams struct {
 	SantriID        pgtype.Int4        `db:"santri_id"`
 	StartPermission pgtype.Timestamptz `db:"start_permission"`
 	EndPermission   pgtype.Timestamptz `db:"end_permission"`
+	Type            NullPermissionType `db:"type"`
 	Excuse          pgtype.Text        `db:"excuse"`
 	ID              int32              `db:"id"`
 }
@@ -226,6 +419,7 @@ func (q *Queries) UpdateSantriPermission(ctx context.Context, arg UpdateSantriPe
 		arg.SantriID,
 		arg.StartPermission,
 		arg.EndPermission,
+		arg.Type,
 		arg.Excuse,
 		arg.ID,
 	)
@@ -237,6 +431,7 @@ func (q *Queries) UpdateSantriPermission(ctx context.Context, arg UpdateSantriPe
 		&i.StartPermission,
 		&i.EndPermission,
 		&i.Excuse,
+		&i.LeftAt,
 	)
 	return i, err
 }
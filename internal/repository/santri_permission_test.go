@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/adiubaidah/syafiiyah-main/pkg/random"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/require"
 )
@@ -120,3 +121,186 @@ func TestListSantriPermission(t *testing.T) {
 	})
 
 }
+
+func TestGetActiveSantriPermission(t *testing.T) {
+	clearSantriPermissionTable(t)
+	clearSantriTable(t)
+	santri := createRandomSantri(t)
+	now := time.Now()
+
+	openPermission, err := testStore.CreateSantriPermission(context.Background(), CreateSantriPermissionParams{
+		SantriID:        santri.ID,
+		StartPermission: pgtype.Timestamptz{Time: now.Add(-time.Hour), Valid: true},
+		Type:            PermissionTypePermission,
+		Excuse:          random.RandomString(20),
+	})
+	require.NoError(t, err)
+
+	t.Run("open permission is active", func(t *testing.T) {
+		permission, err := testStore.GetActiveSantriPermission(context.Background(), GetActiveSantriPermissionParams{
+			SantriID: santri.ID,
+			At:       pgtype.Timestamptz{Time: now, Valid: true},
+		})
+		require.NoError(t, err)
+		require.Equal(t, openPermission.ID, permission.ID)
+		require.False(t, permission.EndPermission.Valid)
+	})
+
+	t.Run("permission not started yet is not active", func(t *testing.T) {
+		_, err := testStore.GetActiveSantriPermission(context.Background(), GetActiveSantriPermissionParams{
+			SantriID: santri.ID,
+			At:       pgtype.Timestamptz{Time: now.Add(-2 * time.Hour), Valid: true},
+		})
+		require.ErrorIs(t, err, pgx.ErrNoRows)
+	})
+
+	t.Run("leaving is kept on the active permission", func(t *testing.T) {
+		left, err := testStore.MarkSantriPermissionLeft(context.Background(), MarkSantriPermissionLeftParams{
+			ID:     openPermission.ID,
+			LeftAt: pgtype.Timestamptz{Time: now, Valid: true},
+		})
+		require.NoError(t, err)
+		require.True(t, left.LeftAt.Valid)
+
+		permission, err := testStore.GetActiveSantriPermission(context.Background(), GetActiveSantriPermissionParams{
+			SantriID: santri.ID,
+			At:       pgtype.Timestamptz{Time: now, Valid: true},
+		})
+		require.NoError(t, err)
+		require.WithinDuration(t, now, permission.LeftAt.Time, time.Second)
+		require.False(t, permission.EndPermission.Valid)
+	})
+}
+
+func TestListActiveSantriPermissions(t *testing.T) {
+	clearSantriPermissionTable(t)
+	clearSantriTable(t)
+	santri := createRandomSantri(t)
+	now := time.Now()
+
+	activePermission, err := testStore.CreateSantriPermission(context.Background(), CreateSantriPermissionParams{
+		SantriID:        santri.ID,
+		StartPermission: pgtype.Timestamptz{Time: now.Add(-time.Hour), Valid: true},
+		EndPermission:   pgtype.Timestamptz{Time: now.Add(time.Hour), Valid: true},
+		Type:            PermissionTypeSick,
+		Excuse:          random.RandomString(20),
+	})
+	require.NoError(t, err)
+	_, err = testStore.CreateSantriPermission(context.Background(), CreateSantriPermissionParams{
+		SantriID:        santri.ID,
+		StartPermission: pgtype.Timestamptz{Time: now.Add(-3 * time.Hour), Valid: true},
+		EndPermission:   pgtype.Timestamptz{Time: now.Add(-2 * time.Hour), Valid: true},
+		Type:            PermissionTypePermission,
+		Excuse:          random.RandomString(20),
+	})
+	require.NoError(t, err)
+
+	activeAt := pgtype.Timestamptz{Time: now, Valid: true}
+	santriPermissions, err := testStore.ListSantriPermissions(context.Background(), ListSantriPermissionsParams{
+		SantriID:    pgtype.Int4{Int32: santri.ID, Valid: true},
+		ActiveAt:    activeAt,
+		LimitNumber: 10,
+	})
+	require.NoError(t, err)
+	require.Len(t, santriPermissions, 1)
+	require.Equal(t, activePermission.ID, santriPermissions[0].ID)
+
+	count, err := testStore.CountSantriPermissions(context.Background(), CountSantriPermissionsParams{
+		SantriID: pgtype.Int4{Int32: santri.ID, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), count)
+
+	updatedPermission, err := testStore.UpdateSantriPermission(context.Background(), UpdateSantriPermissionParams{
+		ID:            activePermission.ID,
+		Type:          NullPermissionType{PermissionType: PermissionTypePermission, Valid: true},
+		EndPermission: activePermission.EndPermission,
+	})
+	require.NoError(t, err)
+	require.Equal(t, PermissionTypePermission, updatedPermission.Type)
+	require.Equal(t, activePermission.Excuse, updatedPermission.Excuse)
+}
+
+func TestSantriPermissionWithPresences(t *testing.T) {
+	santri := createRandomSantri(t)
+	start := time.Date(2024, 7, 1, 8, 0, 0, 0, time.Local)
+	createAlpha := func(at time.Time) SantriPresence {
+		presence, err := testStore.CreateSantriPresence(context.Background(), CreateSantriPresenceParams{
+			SantriID:     santri.ID,
+			ScheduleID:   1,
+			ScheduleName: random.RandomString(5),
+			Type:         PresenceTypeAlpha,
+			CreatedAt:    pgtype.Timestamptz{Time: at, Valid: true},
+			CreatedBy:    PresenceCreatedByTypeSystem,
+		})
+		require.NoError(t, err)
+		return presence
+	}
+	getPresence := func(id int32) SantriPresence {
+		var presence SantriPresence
+		err := sqlStore.db.QueryRow(context.Background(), "SELECT type, santri_permission_id FROM santri_presence WHERE id = $1", id).
+			Scan(&presence.Type, &presence.SantriPermissionID)
+		require.NoError(t, err)
+		return presence
+	}
+
+	covered := createAlpha(start.Add(time.Hour))
+	later := createAlpha(start.Add(26 * time.Hour))
+
+	permission, presences, err := sqlStore.CreateSantriPermissionWithPresences(context.Background(), CreateSantriPermissionParams{
+		SantriID:        santri.ID,
+		Type:            PermissionTypeSick,
+		StartPermission: pgtype.Timestamptz{Time: start, Valid: true},
+		EndPermission:   pgtype.Timestamptz{Time: start.Add(2 * time.Hour), Valid: true},
+		Excuse:          random.RandomString(20),
+	})
+	require.NoError(t, err)
+	require.Len(t, presences, 1)
+	require.Equal(t, covered.ID, presences[0].ID)
+	require.Equal(t, PresenceTypeSick, presences[0].Type)
+	require.Equal(t, permission.ID, presences[0].SantriPermissionID.Int32)
+	require.Equal(t, PresenceTypeAlpha, getPresence(later.ID).Type)
+
+	t.Run("presences follow the updated period", func(t *testing.T) {
+		_, err := sqlStore.UpdateSantriPermissionWithPresences(context.Background(), UpdateSantriPermissionParams{
+			ID:              permission.ID,
+			StartPermission: pgtype.Timestamptz{Time: start.Add(24 * time.Hour), Valid: true},
+			EndPermission:   pgtype.Timestamptz{Time: start.Add(48 * time.Hour), Valid: true},
+			Type:            NullPermissionType{PermissionType: PermissionTypePermission, Valid: true},
+		})
+		require.NoError(t, err)
+
+		require.Equal(t, PresenceTypeAlpha, getPresence(covered.ID).Type)
+		require.False(t, getPresence(covered.ID).SantriPermissionID.Valid)
+		require.Equal(t, PresenceTypePermission, getPresence(later.ID).Type)
+		require.Equal(t, permission.ID, getPresence(later.ID).SantriPermissionID.Int32)
+	})
+
+	t.Run("presences are alpha again after delete", func(t *testing.T) {
+		// permission given in the middle of an activity the santri attended
+		attended, err := testStore.CreateSantriPresence(context.Background(), CreateSantriPresenceParams{
+			SantriID:           santri.ID,
+			ScheduleID:         2,
+			ScheduleName:       random.RandomString(5),
+			Type:               PresenceTypePresent,
+			CreatedAt:          pgtype.Timestamptz{Time: start.Add(25 * time.Hour), Valid: true},
+			CreatedBy:          PresenceCreatedByTypeAdmin,
+			SantriPermissionID: pgtype.Int4{Int32: permission.ID, Valid: true},
+		})
+		require.NoError(t, err)
+
+		_, err = sqlStore.DeleteSantriPermissionWithPresences(context.Background(), permission.ID)
+		require.NoError(t, err)
+
+		presence := getPresence(later.ID)
+		require.Equal(t, PresenceTypeAlpha, presence.Type)
+		require.False(t, presence.SantriPermissionID.Valid)
+
+		presence = getPresence(attended.ID)
+		require.Equal(t, PresenceTypePresent, presence.Type)
+		require.False(t, presence.SantriPermissionID.Valid)
+
+		_, err = testStore.GetSantriPermission(context.Background(), permission.ID)
+		require.ErrorIs(t, err, pgx.ErrNoRows)
+	})
+}
@@ -11,6 +11,67 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const applySantriPermissionToPresences = `-- name: ApplySantriPermissionToPresences :many
+UPDATE
+    "santri_presence"
+SET
+    "type" = $1 :: presence_type,
+    "santri_permission_id" = $2
+WHERE
+    "santri_id" = $3
+    AND "type" = 'alpha'
+    AND "created_at" >= $4 :: timestamptz
+    AND (
+        $5 :: timestamptz IS NULL
+        OR "created_at" <= $5 :: timestamptz
+    ) RETURNING id, schedule_id, schedule_name, type, santri_id, created_at, created_by, notes, santri_permission_id, created_date
+`
+
+type ApplySantriPermissionToPresencesParams struct {
+	Type               PresenceType       `db:"type"`
+	SantriPermissionID pgtype.Int4        `db:"santri_permission_id"`
+	SantriID           int32              `db:"santri_id"`
+	StartPermission    pgtype.Timestamptz `db:"start_permission"`
+	EndPermission      pgtype.Timestamptz `db:"end_permission"`
+}
+
+func (q *Queries) ApplySantriPermissionToPresences(ctx context.Context, arg ApplySantriPermissionToPresencesParams) ([]SantriPresence, error) {
+	rows, err := q.db.Query(ctx, applySantriPermissionToPresences,
+		arg.Type,
+		arg.SantriPermissionID,
+		arg.SantriID,
+		arg.StartPermission,
+		arg.EndPermission,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SantriPresence{}
+	for rows.Next() {
+		var i SantriPresence
+		if err := rows.Scan(
+			&i.ID,
+			&i.ScheduleID,
+			&i.ScheduleName,
+			&i.Type,
+			&i.SantriID,
+			&i.CreatedAt,
+			&i.CreatedBy,
+			&i.Notes,
+			&i.SantriPermissionID,
+			&i.CreatedDate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const countSantriPresences = `-- name: CountSantriPresences :one
 SELECT
     COUNT(*)
@@ -75,6 +136,7 @@ INSERT INTO
         "type",
         "santri_id",
         "notes",
+        "created_at",
         "created_by",
         "santri_permission_id"
     )
@@ -85,8 +147,9 @@ VALUES
         $3 :: presence_type,
         $4,
         $5,
-        $6 :: presence_created_by_type,
-        $7
+        COALESCE($6 :: timestamptz, now()),
+        $7 :: presence_created_by_type,
+        $8
     ) RETURNING id, schedule_id, schedule_name, type, santri_id, created_at, created_by, notes, santri_permission_id, created_date
 `
 
@@ -96,6 +159,7 @@ type CreateSantriPresenceParams struct {
 	Type               PresenceType          `db:"type"`
 	SantriID           int32                 `db:"santri_id"`
 	Notes              pgtype.Text           `db:"notes"`
+	CreatedAt          pgtype.Timestamptz    `db:"created_at"`
 	CreatedBy          PresenceCreatedByType `db:"created_by"`
 	SantriPermissionID pgtype.Int4           `db:"santri_permission_id"`
 }
@@ -107,6 +171,7 @@ func (q *Queries) CreateSantriPresence(ctx context.Context, arg CreateSantriPres
 		arg.Type,
 		arg.SantriID,
 		arg.Notes,
+		arg.CreatedAt,
 		arg.CreatedBy,
 		arg.SantriPermissionID,
 	)
@@ -137,6 +202,78 @@ type CreateSantriPresencesParams struct {
 	SantriPermissionID pgtype.Int4           `db:"santri_permission_id"`
 }
 
+const createSantriTapPresence = `-- name: CreateSantriTapPresence :one
+INSERT INTO
+    "santri_presence" (
+        "schedule_id",
+        "schedule_name",
+        "type",
+        "santri_id",
+        "notes",
+        "created_at",
+        "created_by",
+        "santri_permission_id"
+    )
+VALUES
+    (
+        $1,
+        $2,
+        $3 :: presence_type,
+        $4,
+        $5,
+        COALESCE($6 :: timestamptz, now()),
+        'tap',
+        $7
+    ) ON CONFLICT ON CONSTRAINT unique_santri_schedule_date DO
+UPDATE
+SET
+    "schedule_name" = EXCLUDED."schedule_name",
+    "type" = EXCLUDED."type",
+    "notes" = EXCLUDED."notes",
+    "created_at" = EXCLUDED."created_at",
+    "created_by" = EXCLUDED."created_by",
+    "santri_permission_id" = EXCLUDED."santri_permission_id"
+WHERE
+    "santri_presence"."created_by" = 'system'
+    AND "santri_presence"."type" = 'alpha' RETURNING id, schedule_id, schedule_name, type, santri_id, created_at, created_by, notes, santri_permission_id, created_date
+`
+
+type CreateSantriTapPresenceParams struct {
+	ScheduleID         int32              `db:"schedule_id"`
+	ScheduleName       string             `db:"schedule_name"`
+	Type               PresenceType       `db:"type"`
+	SantriID           int32              `db:"santri_id"`
+	Notes              pgtype.Text        `db:"notes"`
+	CreatedAt          pgtype.Timestamptz `db:"created_at"`
+	SantriPermissionID pgtype.Int4        `db:"santri_permission_id"`
+}
+
+func (q *Queries) CreateSantriTapPresence(ctx context.Context, arg CreateSantriTapPresenceParams) (SantriPresence, error) {
+	row := q.db.QueryRow(ctx, createSantriTapPresence,
+		arg.ScheduleID,
+		arg.ScheduleName,
+		arg.Type,
+		arg.SantriID,
+		arg.Notes,
+		arg.CreatedAt,
+		arg.SantriPermissionID,
+	)
+	var i SantriPresence
+	err := row.Scan(
+		&i.ID,
+		&i.ScheduleID,
+		&i.ScheduleName,
+		&i.Type,
+		&i.SantriID,
+		&i.CreatedAt,
+		&i.CreatedBy,
+		&i.Notes,
+		&i.SantriPermissionID,
+		&i.CreatedDate,
+	)
+	return i, err
+}
+
 const deleteSantriPresence = `-- name: DeleteSantriPresence :one
 DELETE FROM
     "santri_presence"
@@ -163,13 +300,58 @@ func (q *Queries) DeleteSantriPresence(ctx context.Context, id int32) (SantriPre
 	return i, err
 }
 
+const detachSantriPermissionPresences = `-- name: DetachSantriPermissionPresences :many
+UPDATE
+    "santri_presence"
+SET
+    "type" = CASE
+        WHEN "type" IN ('permission', 'sick') THEN 'alpha'
+        ELSE "type"
+    END,
+    "santri_permission_id" = NULL
+WHERE
+    "santri_permission_id" = $1 RETURNING id, schedule_id, schedule_name, type, santri_id, created_at, created_by, notes, santri_permission_id, created_date
+`
+
+func (q *Queries) DetachSantriPermissionPresences(ctx context.Context, santriPermissionID pgtype.Int4) ([]SantriPresence, error) {
+	rows, err := q.db.Query(ctx, detachSantriPermissionPresences, santriPermissionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SantriPresence{}
+	for rows.Next() {
+		var i SantriPresence
+		if err := rows.Scan(
+			&i.ID,
+			&i.ScheduleID,
+			&i.ScheduleName,
+			&i.Type,
+			&i.SantriID,
+			&i.CreatedAt,
+			&i.CreatedBy,
+			&i.Notes,
+			&i.SantriPermissionID,
+			&i.CreatedDate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listMissingSantriPresences = `-- name: ListMissingSantriPresences :many
 SELECT 
     "santri"."id", "santri"."name"
 FROM
     "santri"
 WHERE
-    NOT EXISTS (
+    "santri"."is_active" IS NOT FALSE
+    AND NOT EXISTS (
         SELECT
             1
         FROM
@@ -315,6 +497,48 @@ func (q *Queries) ListSantriPresences(ctx context.Context, arg ListSantriPresenc
 	return items, nil
 }
 
+const revertSantriPermissionPresences = `-- name: RevertSantriPermissionPresences :many
+UPDATE
+    "santri_presence"
+SET
+    "type" = 'alpha',
+    "santri_permission_id" = NULL
+WHERE
+    "santri_permission_id" = $1
+    AND "type" IN ('permission', 'sick') RETURNING id, schedule_id, schedule_name, type, santri_id, created_at, created_by, notes, santri_permission_id, created_date
+`
+
+func (q *Queries) RevertSantriPermissionPresences(ctx context.Context, santriPermissionID pgtype.Int4) ([]SantriPresence, error) {
+	rows, err := q.db.Query(ctx, revertSantriPermissionPresences, santriPermissionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SantriPresence{}
+	for rows.Next() {
+		var i SantriPresence
+		if err := rows.Scan(
+			&i.ID,
+			&i.ScheduleID,
+			&i.ScheduleName,
+			&i.Type,
+			&i.SantriID,
+			&i.CreatedAt,
+			&i.CreatedBy,
+			&i.Notes,
+			&i.SantriPermissionID,
+			&i.CreatedDate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateSantriPresence = `-- name: UpdateSantriPresence :one
 UPDATE
     "santri_presence"
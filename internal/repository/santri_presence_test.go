@@ -3,8 +3,10 @@ package repository
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/adiubaidah/syafiiyah-main/pkg/random"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/require"
 )
@@ -83,6 +85,45 @@ func TestCreateSantriPresence(t *testing.T) {
 // 	require.Equal(t, int64(5), affected)
 // }
 
+func TestCreateSantriTapPresence(t *testing.T) {
+	clearSantriPermissionTable(t)
+	clearSantriPresenceTable(t)
+	clearSantriTable(t)
+	santri := createRandomSantri(t)
+	finishAt := time.Now().Truncate(time.Microsecond)
+
+	affected, err := testStore.CreateSantriPresences(context.Background(), []CreateSantriPresencesParams{{
+		ScheduleID:   1,
+		ScheduleName: "Subuh",
+		Type:         PresenceTypeAlpha,
+		SantriID:     santri.ID,
+		CreatedAt:    pgtype.Timestamptz{Time: finishAt, Valid: true},
+		CreatedBy:    PresenceCreatedByTypeSystem,
+	}})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), affected)
+
+	// the tap buffered by an offline device arrives after the alpha was marked
+	tappedAt := finishAt.Add(-time.Hour)
+	arg := CreateSantriTapPresenceParams{
+		ScheduleID:   1,
+		ScheduleName: "Subuh",
+		Type:         PresenceTypePresent,
+		SantriID:     santri.ID,
+		CreatedAt:    pgtype.Timestamptz{Time: tappedAt, Valid: true},
+	}
+	presence, err := testStore.CreateSantriTapPresence(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, PresenceTypePresent, presence.Type)
+	require.Equal(t, PresenceCreatedByTypeTap, presence.CreatedBy)
+	require.WithinDuration(t, tappedAt, presence.CreatedAt.Time, time.Second)
+
+	// a presence that is not a system alpha is kept
+	arg.Type = PresenceTypeLate
+	_, err = testStore.CreateSantriTapPresence(context.Background(), arg)
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+}
+
 func TestListSantriPresence(t *testing.T) {
 	clearSantriPresenceTable(t)
 	santriPresence := createRandomSantriPresence(t)
@@ -11,6 +11,24 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countExpiringSmartCards = `-- name: CountExpiringSmartCards :one
+SELECT
+    COUNT(*) AS "count"
+FROM
+    smart_card
+WHERE
+    "state" = 'active'
+    AND "valid_until" > now()
+    AND "valid_until" <= now() + make_interval(days => $1 :: integer)
+`
+
+func (q *Queries) CountExpiringSmartCards(ctx context.Context, days int32) (int64, error) {
+	row := q.db.QueryRow(ctx, countExpiringSmartCards, days)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const countSmartCards = `-- name: CountSmartCards :one
 SELECT
     COUNT(*) as "count"
@@ -29,25 +47,35 @@ WHERE
         $2::boolean IS NULL
         OR "smart_card"."is_active" = $2
     )
+    AND (
+        $3::smart_card_state IS NULL
+        OR "smart_card"."state" = $3
+    )
     AND (
         CASE
-            WHEN $3::card_owner = 'santri' THEN "smart_card"."santri_id" IS NOT NULL
-            WHEN $3::card_owner = 'employee' THEN "smart_card"."employee_id" IS NOT NULL
-            WHEN $3::card_owner = 'all' THEN "smart_card"."santri_id" IS NOT NULL OR "smart_card"."employee_id" IS NOT NULL
-            WHEN $3::card_owner = 'none' THEN "smart_card"."santri_id" IS NULL AND "smart_card"."employee_id" IS NULL
+            WHEN $4::card_owner = 'santri' THEN "smart_card"."santri_id" IS NOT NULL
+            WHEN $4::card_owner = 'employee' THEN "smart_card"."employee_id" IS NOT NULL
+            WHEN $4::card_owner = 'all' THEN "smart_card"."santri_id" IS NOT NULL OR "smart_card"."employee_id" IS NOT NULL
+            WHEN $4::card_owner = 'none' THEN "smart_card"."santri_id" IS NULL AND "smart_card"."employee_id" IS NULL
             ELSE TRUE
         END
     )
 `
 
 type CountSmartCardsParams struct {
-	Q         pgtype.Text   `db:"q"`
-	IsActive  pgtype.Bool   `db:"is_active"`
-	CardOwner NullCardOwner `db:"card_owner"`
+	Q         pgtype.Text        `db:"q"`
+	IsActive  pgtype.Bool        `db:"is_active"`
+	State     NullSmartCardState `db:"state"`
+	CardOwner NullCardOwner      `db:"card_owner"`
 }
 
 func (q *Queries) CountSmartCards(ctx context.Context, arg CountSmartCardsParams) (int64, error) {
-	row := q.db.QueryRow(ctx, countSmartCards, arg.Q, arg.IsActive, arg.CardOwner)
+	row := q.db.QueryRow(ctx, countSmartCards,
+		arg.Q,
+		arg.IsActive,
+		arg.State,
+		arg.CardOwner,
+	)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
@@ -55,38 +83,56 @@ func (q *Queries) CountSmartCards(ctx context.Context, arg CountSmartCardsParams
 
 const createSmartCard = `-- name: CreateSmartCard :one
 INSERT INTO
-    smart_card ("uid", "is_active", "santri_id", "employee_id")
+    smart_card (
+        "uid",
+        "state",
+        "santri_id",
+        "employee_id",
+        "valid_from",
+        "valid_until"
+    )
 VALUES
     (
         $1,
         $2,
         $3,
-        $4
-    ) RETURNING id, uid, created_at, is_active, santri_id, employee_id
+        $4,
+        $5,
+        $6
+    ) RETURNING id, uid, created_at, santri_id, employee_id, state, replaced_by_id, is_active, valid_from, valid_until, expired_at
 `
 
 type CreateSmartCardParams struct {
-	Uid        string      `db:"uid"`
-	IsActive   bool        `db:"is_active"`
-	SantriID   pgtype.Int4 `db:"santri_id"`
-	EmployeeID pgtype.Int4 `db:"employee_id"`
+	Uid        string             `db:"uid"`
+	State      SmartCardState     `db:"state"`
+	SantriID   pgtype.Int4        `db:"santri_id"`
+	EmployeeID pgtype.Int4        `db:"employee_id"`
+	ValidFrom  pgtype.Timestamptz `db:"valid_from"`
+	ValidUntil pgtype.Timestamptz `db:"valid_until"`
 }
 
 func (q *Queries) CreateSmartCard(ctx context.Context, arg CreateSmartCardParams) (SmartCard, error) {
 	row := q.db.QueryRow(ctx, createSmartCard,
 		arg.Uid,
-		arg.IsActive,
+		arg.State,
 		arg.SantriID,
 		arg.EmployeeID,
+		arg.ValidFrom,
+		arg.ValidUntil,
 	)
 	var i SmartCard
 	err := row.Scan(
 		&i.ID,
 		&i.Uid,
 		&i.CreatedAt,
-		&i.IsActive,
 		&i.SantriID,
 		&i.EmployeeID,
+		&i.State,
+		&i.ReplacedByID,
+		&i.IsActive,
+		&i.ValidFrom,
+		&i.ValidUntil,
+		&i.ExpiredAt,
 	)
 	return i, err
 }
@@ -95,7 +141,7 @@ const deleteSmartCard = `-- name: DeleteSmartCard :one
 DELETE FROM
     smart_card
 WHERE
-    "id" = $1 RETURNING id, uid, created_at, is_active, santri_id, employee_id
+    "id" = $1 RETURNING id, uid, created_at, santri_id, employee_id, state, replaced_by_id, is_active, valid_from, valid_until, expired_at
 `
 
 func (q *Queries) DeleteSmartCard(ctx context.Context, id int32) (SmartCard, error) {
@@ -105,18 +151,110 @@ func (q *Queries) DeleteSmartCard(ctx context.Context, id int32) (SmartCard, err
 		&i.ID,
 		&i.Uid,
 		&i.CreatedAt,
+		&i.SantriID,
+		&i.EmployeeID,
+		&i.State,
+		&i.ReplacedByID,
 		&i.IsActive,
+		&i.ValidFrom,
+		&i.ValidUntil,
+		&i.ExpiredAt,
+	)
+	return i, err
+}
+
+const expireSmartCards = `-- name: ExpireSmartCards :many
+UPDATE
+    smart_card
+SET
+    "state" = 'suspended',
+    "expired_at" = now()
+WHERE
+    "state" = 'active'
+    AND "valid_until" <= now() RETURNING id, uid, created_at, santri_id, employee_id, state, replaced_by_id, is_active, valid_from, valid_until, expired_at
+`
+
+func (q *Queries) ExpireSmartCards(ctx context.Context) ([]SmartCard, error) {
+	rows, err := q.db.Query(ctx, expireSmartCards)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SmartCard{}
+	for rows.Next() {
+		var i SmartCard
+		if err := rows.Scan(
+			&i.ID,
+			&i.Uid,
+			&i.CreatedAt,
+			&i.SantriID,
+			&i.EmployeeID,
+			&i.State,
+			&i.ReplacedByID,
+			&i.IsActive,
+			&i.ValidFrom,
+			&i.ValidUntil,
+			&i.ExpiredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOwnerSmartCard = `-- name: GetOwnerSmartCard :one
+SELECT
+    id, uid, created_at, santri_id, employee_id, state, replaced_by_id, is_active, valid_from, valid_until, expired_at
+FROM
+    smart_card
+WHERE
+    (
+        "santri_id" = $1
+        OR "employee_id" = $2
+    )
+    AND "state" IN ('active', 'suspended')
+ORDER BY
+    "state" = 'active' DESC,
+    "id" DESC
+LIMIT
+    1
+`
+
+type GetOwnerSmartCardParams struct {
+	SantriID   pgtype.Int4 `db:"santri_id"`
+	EmployeeID pgtype.Int4 `db:"employee_id"`
+}
+
+func (q *Queries) GetOwnerSmartCard(ctx context.Context, arg GetOwnerSmartCardParams) (SmartCard, error) {
+	row := q.db.QueryRow(ctx, getOwnerSmartCard, arg.SantriID, arg.EmployeeID)
+	var i SmartCard
+	err := row.Scan(
+		&i.ID,
+		&i.Uid,
+		&i.CreatedAt,
 		&i.SantriID,
 		&i.EmployeeID,
+		&i.State,
+		&i.ReplacedByID,
+		&i.IsActive,
+		&i.ValidFrom,
+		&i.ValidUntil,
+		&i.ExpiredAt,
 	)
 	return i, err
 }
 
 const getSmartCard = `-- name: GetSmartCard :one
 SELECT
-    smart_card.id, smart_card.uid, smart_card.created_at, smart_card.is_active, smart_card.santri_id, smart_card.employee_id,
+    smart_card.id, smart_card.uid, smart_card.created_at, smart_card.santri_id, smart_card.employee_id, smart_card.state, smart_card.replaced_by_id, smart_card.is_active, smart_card.valid_from, smart_card.valid_until, smart_card.expired_at,
     "santri"."name" as "santri_name",
-    "employee"."name" as "employee_name"
+    "santri"."occupation_id" as "santri_occupation_id",
+    "employee"."name" as "employee_name",
+    "employee"."occupation_id" as "employee_occupation_id"
 FROM
     smart_card
     LEFT JOIN "santri" ON "smart_card"."santri_id" = "santri"."id"
@@ -126,14 +264,21 @@ WHERE
 `
 
 type GetSmartCardRow struct {
-	ID           int32              `db:"id"`
-	Uid          string             `db:"uid"`
-	CreatedAt    pgtype.Timestamptz `db:"created_at"`
-	IsActive     bool               `db:"is_active"`
-	SantriID     pgtype.Int4        `db:"santri_id"`
-	EmployeeID   pgtype.Int4        `db:"employee_id"`
-	SantriName   pgtype.Text        `db:"santri_name"`
-	EmployeeName pgtype.Text        `db:"employee_name"`
+	ID                   int32              `db:"id"`
+	Uid                  string             `db:"uid"`
+	CreatedAt            pgtype.Timestamptz `db:"created_at"`
+	SantriID             pgtype.Int4        `db:"santri_id"`
+	EmployeeID           pgtype.Int4        `db:"employee_id"`
+	State                SmartCardState     `db:"state"`
+	ReplacedByID         pgtype.Int4        `db:"replaced_by_id"`
+	IsActive             bool               `db:"is_active"`
+	ValidFrom            pgtype.Timestamptz `db:"valid_from"`
+	ValidUntil           pgtype.Timestamptz `db:"valid_until"`
+	ExpiredAt            pgtype.Timestamptz `db:"expired_at"`
+	SantriName           pgtype.Text        `db:"santri_name"`
+	SantriOccupationID   pgtype.Int4        `db:"santri_occupation_id"`
+	EmployeeName         pgtype.Text        `db:"employee_name"`
+	EmployeeOccupationID pgtype.Int4        `db:"employee_occupation_id"`
 }
 
 func (q *Queries) GetSmartCard(ctx context.Context, uid string) (GetSmartCardRow, error) {
@@ -143,18 +288,128 @@ func (q *Queries) GetSmartCard(ctx context.Context, uid string) (GetSmartCardRow
 		&i.ID,
 		&i.Uid,
 		&i.CreatedAt,
-		&i.IsActive,
 		&i.SantriID,
 		&i.EmployeeID,
+		&i.State,
+		&i.ReplacedByID,
+		&i.IsActive,
+		&i.ValidFrom,
+		&i.ValidUntil,
+		&i.ExpiredAt,
 		&i.SantriName,
+		&i.SantriOccupationID,
 		&i.EmployeeName,
+		&i.EmployeeOccupationID,
 	)
 	return i, err
 }
 
+const getSmartCardByID = `-- name: GetSmartCardByID :one
+SELECT
+    id, uid, created_at, santri_id, employee_id, state, replaced_by_id, is_active, valid_from, valid_until, expired_at
+FROM
+    smart_card
+WHERE
+    "id" = $1 FOR UPDATE
+`
+
+func (q *Queries) GetSmartCardByID(ctx context.Context, id int32) (SmartCard, error) {
+	row := q.db.QueryRow(ctx, getSmartCardByID, id)
+	var i SmartCard
+	err := row.Scan(
+		&i.ID,
+		&i.Uid,
+		&i.CreatedAt,
+		&i.SantriID,
+		&i.EmployeeID,
+		&i.State,
+		&i.ReplacedByID,
+		&i.IsActive,
+		&i.ValidFrom,
+		&i.ValidUntil,
+		&i.ExpiredAt,
+	)
+	return i, err
+}
+
+const listExpiringSmartCards = `-- name: ListExpiringSmartCards :many
+SELECT
+    smart_card.id, smart_card.uid, smart_card.created_at, smart_card.santri_id, smart_card.employee_id, smart_card.state, smart_card.replaced_by_id, smart_card.is_active, smart_card.valid_from, smart_card.valid_until, smart_card.expired_at,
+    "santri"."name" AS "santri_name",
+    "employee"."name" AS "employee_name"
+FROM
+    smart_card
+    LEFT JOIN "santri" ON "smart_card"."santri_id" = "santri"."id"
+    LEFT JOIN "employee" ON "smart_card"."employee_id" = "employee"."id"
+WHERE
+    "smart_card"."state" = 'active'
+    AND "smart_card"."valid_until" > now()
+    AND "smart_card"."valid_until" <= now() + make_interval(days => $1 :: integer)
+ORDER BY
+    "smart_card"."valid_until" ASC
+LIMIT
+    $3 OFFSET $2
+`
+
+type ListExpiringSmartCardsParams struct {
+	Days         int32 `db:"days"`
+	OffsetNumber int32 `db:"offset_number"`
+	LimitNumber  int32 `db:"limit_number"`
+}
+
+type ListExpiringSmartCardsRow struct {
+	ID           int32              `db:"id"`
+	Uid          string             `db:"uid"`
+	CreatedAt    pgtype.Timestamptz `db:"created_at"`
+	SantriID     pgtype.Int4        `db:"santri_id"`
+	EmployeeID   pgtype.Int4        `db:"employee_id"`
+	State        SmartCardState     `db:"state"`
+	ReplacedByID pgtype.Int4        `db:"replaced_by_id"`
+	IsActive     bool               `db:"is_active"`
+	ValidFrom    pgtype.Timestamptz `db:"valid_from"`
+	ValidUntil   pgtype.Timestamptz `db:"valid_until"`
+	ExpiredAt    pgtype.Timestamptz `db:"expired_at"`
+	SantriName   pgtype.Text        `db:"santri_name"`
+	EmployeeName pgtype.Text        `db:"employee_name"`
+}
+
+func (q *Queries) ListExpiringSmartCards(ctx context.Context, arg ListExpiringSmartCardsParams) ([]ListExpiringSmartCardsRow, error) {
+	rows, err := q.db.Query(ctx, listExpiringSmartCards, arg.Days, arg.OffsetNumber, arg.LimitNumber)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListExpiringSmartCardsRow{}
+	for rows.Next() {
+		var i ListExpiringSmartCardsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Uid,
+			&i.CreatedAt,
+			&i.SantriID,
+			&i.EmployeeID,
+			&i.State,
+			&i.ReplacedByID,
+			&i.IsActive,
+			&i.ValidFrom,
+			&i.ValidUntil,
+			&i.ExpiredAt,
+			&i.SantriName,
+			&i.EmployeeName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listSmartCards = `-- name: ListSmartCards :many
 SELECT
-    smart_card.id, smart_card.uid, smart_card.created_at, smart_card.is_active, smart_card.santri_id, smart_card.employee_id,
+    smart_card.id, smart_card.uid, smart_card.created_at, smart_card.santri_id, smart_card.employee_id, smart_card.state, smart_card.replaced_by_id, smart_card.is_active, smart_card.valid_from, smart_card.valid_until, smart_card.expired_at,
     "santri"."name" AS "santri_name",
     "employee"."name" AS "employee_name"
 FROM
@@ -172,36 +427,46 @@ WHERE
         $2::boolean IS NULL
         OR "smart_card"."is_active" = $2
     )
+    AND (
+        $3::smart_card_state IS NULL
+        OR "smart_card"."state" = $3
+    )
     AND (
         CASE
-            WHEN $3::card_owner = 'santri' THEN "smart_card"."santri_id" IS NOT NULL
-            WHEN $3::card_owner = 'employee' THEN "smart_card"."employee_id" IS NOT NULL
-            WHEN $3::card_owner = 'all' THEN "smart_card"."santri_id" IS NOT NULL OR "smart_card"."employee_id" IS NOT NULL
-            WHEN $3::card_owner = 'none' THEN "smart_card"."santri_id" IS NULL AND "smart_card"."employee_id" IS NULL
+            WHEN $4::card_owner = 'santri' THEN "smart_card"."santri_id" IS NOT NULL
+            WHEN $4::card_owner = 'employee' THEN "smart_card"."employee_id" IS NOT NULL
+            WHEN $4::card_owner = 'all' THEN "smart_card"."santri_id" IS NOT NULL OR "smart_card"."employee_id" IS NOT NULL
+            WHEN $4::card_owner = 'none' THEN "smart_card"."santri_id" IS NULL AND "smart_card"."employee_id" IS NULL
             ELSE TRUE
         END
     )
 ORDER BY
     "smart_card"."id" ASC
 LIMIT
-    $5 OFFSET $4
+    $6 OFFSET $5
 `
 
 type ListSmartCardsParams struct {
-	Q            pgtype.Text   `db:"q"`
-	IsActive     pgtype.Bool   `db:"is_active"`
-	CardOwner    NullCardOwner `db:"card_owner"`
-	OffsetNumber int32         `db:"offset_number"`
-	LimitNumber  int32         `db:"limit_number"`
+	Q            pgtype.Text        `db:"q"`
+	IsActive     pgtype.Bool        `db:"is_active"`
+	State        NullSmartCardState `db:"state"`
+	CardOwner    NullCardOwner      `db:"card_owner"`
+	OffsetNumber int32              `db:"offset_number"`
+	LimitNumber  int32              `db:"limit_number"`
 }
 
 type ListSmartCardsRow struct {
 	ID           int32              `db:"id"`
 	Uid          string             `db:"uid"`
 	CreatedAt    pgtype.Timestamptz `db:"created_at"`
-	IsActive     bool               `db:"is_active"`
 	SantriID     pgtype.Int4        `db:"santri_id"`
 	EmployeeID   pgtype.Int4        `db:"employee_id"`
+	State        SmartCardState     `db:"state"`
+	ReplacedByID pgtype.Int4        `db:"replaced_by_id"`
+	IsActive     bool               `db:"is_active"`
+	ValidFrom    pgtype.Timestamptz `db:"valid_from"`
+	ValidUntil   pgtype.Timestamptz `db:"valid_until"`
+	ExpiredAt    pgtype.Timestamptz `db:"expired_at"`
 	SantriName   pgtype.Text        `db:"santri_name"`
 	EmployeeName pgtype.Text        `db:"employee_name"`
 }
@@ -210,6 +475,7 @@ func (q *Queries) ListSmartCards(ctx context.Context, arg ListSmartCardsParams)
 	rows, err := q.db.Query(ctx, listSmartCards,
 		arg.Q,
 		arg.IsActive,
+		arg.State,
 		arg.CardOwner,
 		arg.OffsetNumber,
 		arg.LimitNumber,
@@ -225,9 +491,14 @@ func (q *Queries) ListSmartCards(ctx context.Context, arg ListSmartCardsParams)
 			&i.ID,
 			&i.Uid,
 			&i.CreatedAt,
-			&i.IsActive,
 			&i.SantriID,
 			&i.EmployeeID,
+			&i.State,
+			&i.ReplacedByID,
+			&i.IsActive,
+			&i.ValidFrom,
+			&i.ValidUntil,
+			&i.ExpiredAt,
 			&i.SantriName,
 			&i.EmployeeName,
 		); err != nil {
@@ -241,32 +512,98 @@ func (q *Queries) ListSmartCards(ctx context.Context, arg ListSmartCardsParams)
 	return items, nil
 }
 
+const suspendSantriSmartCards = `-- name: SuspendSantriSmartCards :many
+UPDATE
+    smart_card
+SET
+    "state" = 'suspended',
+    "expired_at" = NULL
+WHERE
+    "santri_id" = $1
+    AND "state" IN ('active', 'suspended') RETURNING id, uid, created_at, santri_id, employee_id, state, replaced_by_id, is_active, valid_from, valid_until, expired_at
+`
+
+func (q *Queries) SuspendSantriSmartCards(ctx context.Context, santriID pgtype.Int4) ([]SmartCard, error) {
+	rows, err := q.db.Query(ctx, suspendSantriSmartCards, santriID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SmartCard{}
+	for rows.Next() {
+		var i SmartCard
+		if err := rows.Scan(
+			&i.ID,
+			&i.Uid,
+			&i.CreatedAt,
+			&i.SantriID,
+			&i.EmployeeID,
+			&i.State,
+			&i.ReplacedByID,
+			&i.IsActive,
+			&i.ValidFrom,
+			&i.ValidUntil,
+			&i.ExpiredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateSmartCard = `-- name: UpdateSmartCard :one
 UPDATE
     smart_card
 SET
     "uid" = COALESCE($1, uid),
-    "is_active" = COALESCE($2, is_active),
+    "state" = COALESCE($2, state),
     "santri_id" = $3,
-    "employee_id" = $4
+    "employee_id" = $4,
+    "replaced_by_id" = COALESCE($5, replaced_by_id),
+    "valid_from" = CASE
+        WHEN $6::bool THEN NULL
+        ELSE COALESCE($7, valid_from)
+    END,
+    "valid_until" = CASE
+        WHEN $8::bool THEN NULL
+        ELSE COALESCE($9, valid_until)
+    END,
+    "expired_at" = CASE
+        WHEN COALESCE($2, state) = state THEN expired_at
+        ELSE NULL
+    END
 WHERE
-    "id" = $5 RETURNING id, uid, created_at, is_active, santri_id, employee_id
+    "id" = $10 RETURNING id, uid, created_at, santri_id, employee_id, state, replaced_by_id, is_active, valid_from, valid_until, expired_at
 `
 
 type UpdateSmartCardParams struct {
-	Uid        pgtype.Text `db:"uid"`
-	IsActive   pgtype.Bool `db:"is_active"`
-	SantriID   pgtype.Int4 `db:"santri_id"`
-	EmployeeID pgtype.Int4 `db:"employee_id"`
-	ID         int32       `db:"id"`
+	Uid             pgtype.Text        `db:"uid"`
+	State           NullSmartCardState `db:"state"`
+	SantriID        pgtype.Int4        `db:"santri_id"`
+	EmployeeID      pgtype.Int4        `db:"employee_id"`
+	ReplacedByID    pgtype.Int4        `db:"replaced_by_id"`
+	ClearValidFrom  bool               `db:"clear_valid_from"`
+	ValidFrom       pgtype.Timestamptz `db:"valid_from"`
+	ClearValidUntil bool               `db:"clear_valid_until"`
+	ValidUntil      pgtype.Timestamptz `db:"valid_until"`
+	ID              int32              `db:"id"`
 }
 
 func (q *Queries) UpdateSmartCard(ctx context.Context, arg UpdateSmartCardParams) (SmartCard, error) {
 	row := q.db.QueryRow(ctx, updateSmartCard,
 		arg.Uid,
-		arg.IsActive,
+		arg.State,
 		arg.SantriID,
 		arg.EmployeeID,
+		arg.ReplacedByID,
+		arg.ClearValidFrom,
+		arg.ValidFrom,
+		arg.ClearValidUntil,
+		arg.ValidUntil,
 		arg.ID,
 	)
 	var i SmartCard
@@ -274,9 +611,14 @@ func (q *Queries) UpdateSmartCard(ctx context.Context, arg UpdateSmartCardParams
 		&i.ID,
 		&i.Uid,
 		&i.CreatedAt,
-		&i.IsActive,
 		&i.SantriID,
 		&i.EmployeeID,
+		&i.State,
+		&i.ReplacedByID,
+		&i.IsActive,
+		&i.ValidFrom,
+		&i.ValidUntil,
+		&i.ExpiredAt,
 	)
 	return i, err
 }
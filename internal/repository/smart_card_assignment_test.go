@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/adiubaidah/syafiiyah-main/pkg/random"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplaceSmartCard(t *testing.T) {
+	clearSmartCardTable(t)
+	clearSantriTable(t)
+
+	santri := createRandomSantri(t)
+	oldSmartCard, err := sqlStore.UpdateSmartCardWithAssignment(context.Background(), UpdateSmartCardParams{
+		ID:       createRandomSmartCardWithoutOwner(t).ID,
+		State:    NullSmartCardState{SmartCardState: SmartCardStateActive, Valid: true},
+		SantriID: pgtype.Int4{Int32: santri.ID, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, santri.ID, oldSmartCard.SantriID.Int32)
+
+	newUid := random.RandomString(12)
+	newSmartCard, err := sqlStore.ReplaceSmartCard(context.Background(), oldSmartCard.ID, SmartCardStateLost, newUid)
+	require.NoError(t, err)
+	require.Equal(t, newUid, newSmartCard.Uid)
+	require.Equal(t, SmartCardStateActive, newSmartCard.State)
+	require.Equal(t, santri.ID, newSmartCard.SantriID.Int32)
+
+	replaced, err := testStore.GetSmartCardByID(context.Background(), oldSmartCard.ID)
+	require.NoError(t, err)
+	require.Equal(t, SmartCardStateLost, replaced.State)
+	require.False(t, replaced.IsActive)
+	require.False(t, replaced.SantriID.Valid)
+	require.Equal(t, newSmartCard.ID, replaced.ReplacedByID.Int32)
+
+	oldAssignments, err := testStore.ListSmartCardAssignments(context.Background(), oldSmartCard.ID)
+	require.NoError(t, err)
+	require.Len(t, oldAssignments, 1)
+	require.Equal(t, santri.ID, oldAssignments[0].SantriID.Int32)
+	require.Equal(t, santri.Name, oldAssignments[0].SantriName.String)
+	require.True(t, oldAssignments[0].ValidUntil.Valid)
+
+	newAssignments, err := testStore.ListSmartCardAssignments(context.Background(), newSmartCard.ID)
+	require.NoError(t, err)
+	require.Len(t, newAssignments, 1)
+	require.Equal(t, santri.ID, newAssignments[0].SantriID.Int32)
+	require.False(t, newAssignments[0].ValidUntil.Valid)
+}
+
+func createRandomSmartCardWithoutOwner(t *testing.T) SmartCard {
+	smartCard, err := testStore.CreateSmartCard(context.Background(), CreateSmartCardParams{
+		Uid:   random.RandomString(12),
+		State: SmartCardStateActive,
+	})
+	require.NoError(t, err)
+	return smartCard
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/adiubaidah/syafiiyah-main/pkg/random"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/require"
 )
@@ -14,11 +15,18 @@ func clearSmartCardTable(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func randomSmartCardState() SmartCardState {
+	if random.RandomBool() {
+		return SmartCardStateActive
+	}
+	return SmartCardStateSuspended
+}
+
 func createRandomSmartCardWithSantri(t *testing.T) (SmartCard, Santri) {
 	santri := createRandomSantri(t)
 	arg := CreateSmartCardParams{
 		Uid:      random.RandomString(12),
-		IsActive: random.RandomBool(),
+		State:    randomSmartCardState(),
 		SantriID: pgtype.Int4{Int32: santri.ID, Valid: true},
 	}
 	smartCard, err := testStore.CreateSmartCard(context.Background(), arg)
@@ -26,7 +34,8 @@ func createRandomSmartCardWithSantri(t *testing.T) (SmartCard, Santri) {
 	require.NotEmpty(t, smartCard)
 
 	require.Equal(t, arg.Uid, smartCard.Uid)
-	require.Equal(t, arg.IsActive, smartCard.IsActive)
+	require.Equal(t, arg.State, smartCard.State)
+	require.Equal(t, arg.State == SmartCardStateActive, smartCard.IsActive)
 	require.Equal(t, arg.SantriID, smartCard.SantriID)
 	require.Equal(t, arg.EmployeeID, smartCard.EmployeeID)
 
@@ -40,7 +49,7 @@ func createRandomRfidWithEmployee(t *testing.T) (SmartCard, Employee) {
 	employee := createRandomEmployee(t)
 	arg := CreateSmartCardParams{
 		Uid:        random.RandomString(12),
-		IsActive:   random.RandomBool(),
+		State:      randomSmartCardState(),
 		EmployeeID: pgtype.Int4{Int32: employee.ID, Valid: true},
 	}
 	smartCard, err := testStore.CreateSmartCard(context.Background(), arg)
@@ -48,7 +57,8 @@ func createRandomRfidWithEmployee(t *testing.T) (SmartCard, Employee) {
 	require.NotEmpty(t, smartCard)
 
 	require.Equal(t, arg.Uid, smartCard.Uid)
-	require.Equal(t, arg.IsActive, smartCard.IsActive)
+	require.Equal(t, arg.State, smartCard.State)
+	require.Equal(t, arg.State == SmartCardStateActive, smartCard.IsActive)
 	require.Equal(t, arg.SantriID, smartCard.SantriID)
 	require.Equal(t, arg.EmployeeID, smartCard.EmployeeID)
 
@@ -145,6 +155,38 @@ func TestGetSmartCard(t *testing.T) {
 
 }
 
+func TestGetOwnerSmartCard(t *testing.T) {
+	clearSmartCardTable(t)
+	clearSantriTable(t)
+
+	santri := createRandomSantri(t)
+	owner := GetOwnerSmartCardParams{SantriID: pgtype.Int4{Int32: santri.ID, Valid: true}}
+	createOwnerSmartCard := func(state SmartCardState) SmartCard {
+		smartCard, err := testStore.CreateSmartCard(context.Background(), CreateSmartCardParams{
+			Uid:      random.RandomString(12),
+			State:    state,
+			SantriID: owner.SantriID,
+		})
+		require.NoError(t, err)
+		return smartCard
+	}
+
+	_, err := testStore.GetOwnerSmartCard(context.Background(), owner)
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+
+	suspended := createOwnerSmartCard(SmartCardStateSuspended)
+	ownerSmartCard, err := testStore.GetOwnerSmartCard(context.Background(), owner)
+	require.NoError(t, err)
+	require.Equal(t, suspended.ID, ownerSmartCard.ID)
+
+	// the active card comes before the suspended one
+	active := createOwnerSmartCard(SmartCardStateActive)
+	createOwnerSmartCard(SmartCardStateSuspended)
+	ownerSmartCard, err = testStore.GetOwnerSmartCard(context.Background(), owner)
+	require.NoError(t, err)
+	require.Equal(t, active.ID, ownerSmartCard.ID)
+}
+
 func TestUpdateSmartCard(t *testing.T) {
 	clearSmartCardTable(t)
 	clearSantriTable(t)
@@ -155,7 +197,7 @@ func TestUpdateSmartCard(t *testing.T) {
 	arg := UpdateSmartCardParams{
 		ID:         smartCard.ID,
 		Uid:        pgtype.Text{String: random.RandomString(12), Valid: true},
-		IsActive:   pgtype.Bool{Bool: random.RandomBool(), Valid: true},
+		State:      NullSmartCardState{SmartCardState: randomSmartCardState(), Valid: true},
 		SantriID:   pgtype.Int4{Int32: 0, Valid: false},
 		EmployeeID: pgtype.Int4{Int32: 0, Valid: false},
 	}
@@ -165,7 +207,7 @@ func TestUpdateSmartCard(t *testing.T) {
 
 	require.Equal(t, arg.ID, updatedRfid.ID)
 	require.Equal(t, arg.Uid.String, updatedRfid.Uid)
-	require.Equal(t, arg.IsActive.Bool, updatedRfid.IsActive)
+	require.Equal(t, arg.State.SmartCardState, updatedRfid.State)
 	require.Equal(t, arg.SantriID.Int32, updatedRfid.SantriID.Int32)
 	require.Equal(t, arg.EmployeeID.Int32, updatedRfid.EmployeeID.Int32)
 }
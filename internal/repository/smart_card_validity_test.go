@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/adiubaidah/syafiiyah-main/pkg/random"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func createSantriSmartCardValidUntil(t *testing.T, validUntil time.Time) (SmartCard, Santri) {
+	santri := createRandomSantri(t)
+	smartCard, err := testStore.CreateSmartCard(context.Background(), CreateSmartCardParams{
+		Uid:        random.RandomString(12),
+		State:      SmartCardStateActive,
+		SantriID:   pgtype.Int4{Int32: santri.ID, Valid: true},
+		ValidUntil: pgtype.Timestamptz{Time: validUntil, Valid: true},
+	})
+	require.NoError(t, err)
+	return smartCard, santri
+}
+
+func TestExpireSmartCards(t *testing.T) {
+	clearSmartCardTable(t)
+
+	expired, _ := createSantriSmartCardValidUntil(t, time.Now().Add(-time.Hour))
+	expiring, _ := createSantriSmartCardValidUntil(t, time.Now().Add(48*time.Hour))
+	createSantriSmartCardValidUntil(t, time.Now().Add(90*24*time.Hour))
+
+	t.Run("list expiring within days", func(t *testing.T) {
+		rows, err := testStore.ListExpiringSmartCards(context.Background(), ListExpiringSmartCardsParams{
+			Days:         7,
+			OffsetNumber: 0,
+			LimitNumber:  10,
+		})
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		require.Equal(t, expiring.ID, rows[0].ID)
+		require.NotEmpty(t, rows[0].SantriName.String)
+
+		count, err := testStore.CountExpiringSmartCards(context.Background(), 7)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), count)
+	})
+
+	suspended, err := testStore.ExpireSmartCards(context.Background())
+	require.NoError(t, err)
+	require.Len(t, suspended, 1)
+	require.Equal(t, expired.ID, suspended[0].ID)
+	require.Equal(t, SmartCardStateSuspended, suspended[0].State)
+	require.False(t, suspended[0].IsActive)
+	require.True(t, suspended[0].ExpiredAt.Valid)
+
+	suspended, err = testStore.ExpireSmartCards(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, suspended)
+
+	t.Run("only a state change forgets the expiry", func(t *testing.T) {
+		updated, err := testStore.UpdateSmartCard(context.Background(), UpdateSmartCardParams{
+			ID:       expired.ID,
+			State:    NullSmartCardState{SmartCardState: SmartCardStateSuspended, Valid: true},
+			SantriID: expired.SantriID,
+		})
+		require.NoError(t, err)
+		require.True(t, updated.ExpiredAt.Valid)
+
+		updated, err = testStore.UpdateSmartCard(context.Background(), UpdateSmartCardParams{
+			ID:       expired.ID,
+			State:    NullSmartCardState{SmartCardState: SmartCardStateActive, Valid: true},
+			SantriID: expired.SantriID,
+		})
+		require.NoError(t, err)
+		require.False(t, updated.ExpiredAt.Valid)
+	})
+}
+
+func TestUpdateSmartCardValidity(t *testing.T) {
+	clearSmartCardTable(t)
+
+	smartCard, santri := createSantriSmartCardValidUntil(t, time.Now().Add(time.Hour))
+	owner := pgtype.Int4{Int32: santri.ID, Valid: true}
+
+	kept, err := testStore.UpdateSmartCard(context.Background(), UpdateSmartCardParams{
+		ID:       smartCard.ID,
+		SantriID: owner,
+	})
+	require.NoError(t, err)
+	require.True(t, kept.ValidUntil.Valid)
+
+	cleared, err := testStore.UpdateSmartCard(context.Background(), UpdateSmartCardParams{
+		ID:              smartCard.ID,
+		SantriID:        owner,
+		ClearValidUntil: true,
+	})
+	require.NoError(t, err)
+	require.False(t, cleared.ValidUntil.Valid)
+}
+
+func TestDeactivateSantriSuspendsSmartCards(t *testing.T) {
+	clearSmartCardTable(t)
+
+	smartCard, santri := createSantriSmartCardValidUntil(t, time.Now().Add(time.Hour))
+
+	updatedSantri, err := sqlStore.DeactivateSantri(context.Background(), UpdateSantriParams{
+		ID:       santri.ID,
+		IsActive: pgtype.Bool{Bool: false, Valid: true},
+	})
+	require.NoError(t, err)
+	require.False(t, updatedSantri.IsActive.Bool)
+
+	updatedCard, err := testStore.GetSmartCard(context.Background(), smartCard.Uid)
+	require.NoError(t, err)
+	require.Equal(t, SmartCardStateSuspended, updatedCard.State)
+	require.Equal(t, santri.ID, updatedCard.SantriID.Int32)
+}
+
+func TestDeactivateSantriForgetsSmartCardExpiry(t *testing.T) {
+	clearSmartCardTable(t)
+
+	smartCard, santri := createSantriSmartCardValidUntil(t, time.Now().Add(-time.Hour))
+	_, err := testStore.ExpireSmartCards(context.Background())
+	require.NoError(t, err)
+
+	_, err = sqlStore.DeactivateSantri(context.Background(), UpdateSantriParams{
+		ID:       santri.ID,
+		IsActive: pgtype.Bool{Bool: false, Valid: true},
+	})
+	require.NoError(t, err)
+
+	updatedCard, err := testStore.GetSmartCard(context.Background(), smartCard.Uid)
+	require.NoError(t, err)
+	require.Equal(t, SmartCardStateSuspended, updatedCard.State)
+	require.False(t, updatedCard.ExpiredAt.Valid)
+}
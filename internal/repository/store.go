@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -13,6 +14,19 @@ type Store interface {
 	ListUsers(ctx context.Context, arg ListUserParams) ([]ListUserRow, error)
 	ListParents(ctx context.Context, arg ListParentParams) ([]ListParentRow, error)
 	ListEmployees(ctx context.Context, arg ListEmployeesParams) ([]ListEmployeesRow, error)
+	CreateSantriPermissionWithPresences(ctx context.Context, arg CreateSantriPermissionParams) (SantriPermission, []SantriPresence, error)
+	UpdateSantriPermissionWithPresences(ctx context.Context, arg UpdateSantriPermissionParams) (SantriPermission, error)
+	DeleteSantriPermissionWithPresences(ctx context.Context, id int32) (SantriPermission, error)
+	CreateSantriLeaveRequestWithHistory(ctx context.Context, arg CreateSantriLeaveRequestParams, history CreateSantriLeaveRequestHistoryParams) (SantriLeaveRequest, error)
+	ChangeSantriLeaveRequestStatus(ctx context.Context, arg UpdateSantriLeaveRequestStatusParams, history CreateSantriLeaveRequestHistoryParams) (SantriLeaveRequest, error)
+	DeactivateSantri(ctx context.Context, arg UpdateSantriParams) (Santri, error)
+	ClaimPendingSmartCard(ctx context.Context, pendingID int32, arg CreateSmartCardParams) (SmartCard, error)
+	UpdateSmartCardWithAssignment(ctx context.Context, arg UpdateSmartCardParams) (SmartCard, error)
+	ReplaceSmartCard(ctx context.Context, oldID int32, oldState SmartCardState, newUid string) (SmartCard, error)
+	CreateEnrollmentSessionWithItems(ctx context.Context, arg CreateEnrollmentSessionParams, itemParams []CreateEnrollmentSessionItemsParams) (EnrollmentSession, error)
+	EnrollSmartCard(ctx context.Context, sessionID int32, uid string) (SmartCard, bool, error)
+	CreateHolidayWithDates(ctx context.Context, arg CreateHolidayParams, dates []pgtype.Date) (Holiday, error)
+	UpdateHolidayWithDates(ctx context.Context, arg UpdateHolidayParams, dates []pgtype.Date) (Holiday, error)
 }
 
 type SQLStore struct {
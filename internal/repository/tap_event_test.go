@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/adiubaidah/syafiiyah-main/pkg/random"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func clearTapEventTable(t *testing.T) {
+	_, err := sqlStore.db.Exec(context.Background(), "DELETE FROM tap_event")
+	require.NoError(t, err)
+}
+
+func TestTapEvent(t *testing.T) {
+	clearTapEventTable(t)
+	clearDeviceTable(t)
+	nameRandom := random.RandomString(10)
+	device, err := sqlStore.CreateDeviceWithModes(context.Background(), nameRandom, random.RandomString(64), []CreateDeviceModesParams{
+		{
+			Mode:                 DeviceModeTypePresence,
+			InputTopic:           fmt.Sprintf("%s/input/%s", nameRandom, DeviceModeTypePresence),
+			AcknowledgementTopic: fmt.Sprintf("%s/acknowledgment/%s", nameRandom, DeviceModeTypePresence),
+		},
+	})
+	require.NoError(t, err)
+	santri := createRandomSantri(t)
+	uid := random.RandomString(10)
+
+	accepted, err := testStore.CreateTapEvent(context.Background(), CreateTapEventParams{
+		DeviceName: nameRandom,
+		Mode:       DeviceModeTypePresence,
+		Uid:        pgtype.Text{String: uid, Valid: true},
+		SantriID:   pgtype.Int4{Int32: santri.ID, Valid: true},
+		Code:       200,
+		Message:    "Presensi tercatat",
+		LatencyMs:  12,
+	})
+	require.NoError(t, err)
+	require.Equal(t, device.ID, accepted.DeviceID.Int32)
+	require.WithinDuration(t, time.Now(), accepted.TappedAt.Time, time.Minute)
+
+	tappedAt := time.Now().Add(-time.Hour)
+	rejected, err := testStore.CreateTapEvent(context.Background(), CreateTapEventParams{
+		DeviceName: nameRandom,
+		Mode:       DeviceModeTypeBatch,
+		Uid:        pgtype.Text{String: uid, Valid: true},
+		Code:       403,
+		Message:    "Smart card tidak aktif",
+		TappedAt:   pgtype.Timestamptz{Time: tappedAt, Valid: true},
+	})
+	require.NoError(t, err)
+	require.WithinDuration(t, tappedAt, rejected.TappedAt.Time, time.Second)
+
+	t.Run("filter by uid", func(t *testing.T) {
+		events, err := testStore.ListTapEvents(context.Background(), ListTapEventsParams{
+			Uid:          pgtype.Text{String: uid, Valid: true},
+			LimitNumber:  10,
+			OffsetNumber: 0,
+		})
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		require.Equal(t, accepted.ID, events[0].ID)
+		require.Equal(t, santri.Name, events[0].SantriName.String)
+		require.Equal(t, nameRandom, events[0].DeviceName.String)
+	})
+
+	t.Run("filter rejected", func(t *testing.T) {
+		events, err := testStore.ListTapEvents(context.Background(), ListTapEventsParams{
+			Success:      pgtype.Bool{Bool: false, Valid: true},
+			LimitNumber:  10,
+			OffsetNumber: 0,
+		})
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		require.Equal(t, rejected.ID, events[0].ID)
+
+		count, err := testStore.CountTapEvents(context.Background(), CountTapEventsParams{
+			SantriID: pgtype.Int4{Int32: santri.ID, Valid: true},
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, 1, count)
+	})
+}
+
+func TestGetLastGateDirection(t *testing.T) {
+	clearTapEventTable(t)
+	clearDeviceTable(t)
+	nameRandom := random.RandomString(10)
+	_, err := sqlStore.CreateDeviceWithModes(context.Background(), nameRandom, random.RandomString(64), []CreateDeviceModesParams{
+		{
+			Mode:                 DeviceModeTypePresence,
+			InputTopic:           fmt.Sprintf("%s/input/%s", nameRandom, DeviceModeTypePresence),
+			AcknowledgementTopic: fmt.Sprintf("%s/acknowledgment/%s", nameRandom, DeviceModeTypePresence),
+		},
+	})
+	require.NoError(t, err)
+	uid := pgtype.Text{String: random.RandomString(10), Valid: true}
+
+	_, err = testStore.GetLastGateDirection(context.Background(), uid)
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+
+	for _, tap := range []struct {
+		direction GateDirectionType
+		code      int32
+		tappedAt  time.Time
+	}{
+		{direction: GateDirectionTypeEntry, code: 200, tappedAt: time.Now().Add(-2 * time.Minute)},
+		// rejected passage does not count
+		{direction: GateDirectionTypeExit, code: 403, tappedAt: time.Now().Add(-time.Minute)},
+	} {
+		_, err := testStore.CreateTapEvent(context.Background(), CreateTapEventParams{
+			DeviceName:    nameRandom,
+			Mode:          DeviceModeTypePresence,
+			Uid:           uid,
+			Code:          tap.code,
+			Message:       "test",
+			TappedAt:      pgtype.Timestamptz{Time: tap.tappedAt, Valid: true},
+			GateDirection: NullGateDirectionType{GateDirectionType: tap.direction, Valid: true},
+		})
+		require.NoError(t, err)
+	}
+
+	direction, err := testStore.GetLastGateDirection(context.Background(), uid)
+	require.NoError(t, err)
+	require.Equal(t, GateDirectionTypeEntry, direction)
+}
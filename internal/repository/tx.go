@@ -2,17 +2,26 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
-func (store *SQLStore) CreateDeviceWithModes(ctx context.Context, arduinoName string, modeParams []CreateDeviceModesParams) (Device, error) {
+// ErrSmartCardOwned is returned when a card is given to an owner while it still belongs to someone else
+var ErrSmartCardOwned = errors.New("smart card already has another owner")
+
+func (store *SQLStore) CreateDeviceWithModes(ctx context.Context, arduinoName string, secret string, modeParams []CreateDeviceModesParams) (Device, error) {
 	var createdDevice Device
 
 	err := store.ExecTx(ctx, func(q *Queries) error {
 		var err error
 
-		device, err := q.CreateDevice(ctx, arduinoName)
+		device, err := q.CreateDevice(ctx, CreateDeviceParams{
+			Name:   arduinoName,
+			Secret: secret,
+		})
 		if err != nil {
 			return err
 		}
@@ -61,3 +70,494 @@ func (store *SQLStore) UpdateDeviceWithModes(ctx context.Context, deviceID int32
 	})
 	return updatedArduino, err
 }
+
+// ReplaceDeviceAccess binds the device to the location and replaces all of its allow rules
+func (store *SQLStore) ReplaceDeviceAccess(ctx context.Context, deviceID int32, locationID pgtype.Int4, ruleParams []CreateDeviceAllowRulesParams) (Device, error) {
+	var updatedDevice Device
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		device, err := q.UpdateDeviceLocation(ctx, UpdateDeviceLocationParams{
+			ID:         deviceID,
+			LocationID: locationID,
+		})
+		if err != nil {
+			return err
+		}
+		updatedDevice = device
+
+		if err := q.DeleteDeviceAllowRules(ctx, deviceID); err != nil {
+			return err
+		}
+
+		for i := range ruleParams {
+			ruleParams[i].DeviceID = deviceID
+		}
+		_, err = q.CreateDeviceAllowRules(ctx, ruleParams)
+		return err
+	})
+	return updatedDevice, err
+}
+
+// ClaimPendingSmartCard removes the card from the pending queue and registers it with the owner
+func (store *SQLStore) ClaimPendingSmartCard(ctx context.Context, pendingID int32, arg CreateSmartCardParams) (SmartCard, error) {
+	var createdSmartCard SmartCard
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		pending, err := q.DeletePendingSmartCard(ctx, pendingID)
+		if err != nil {
+			return err
+		}
+
+		arg.Uid = pending.Uid
+		createdSmartCard, err = q.CreateSmartCard(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		_, err = q.CreateSmartCardAssignment(ctx, CreateSmartCardAssignmentParams{
+			SmartCardID: createdSmartCard.ID,
+			SantriID:    createdSmartCard.SantriID,
+			EmployeeID:  createdSmartCard.EmployeeID,
+		})
+		return err
+	})
+	return createdSmartCard, err
+}
+
+// UpdateSmartCardWithAssignment updates the card and keeps its assignment history,
+// the assignment of the previous owner is ended and a new one is started when the owner changes
+func (store *SQLStore) UpdateSmartCardWithAssignment(ctx context.Context, arg UpdateSmartCardParams) (SmartCard, error) {
+	var updatedSmartCard SmartCard
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		current, err := q.GetSmartCardByID(ctx, arg.ID)
+		if err != nil {
+			return err
+		}
+
+		updatedSmartCard, err = q.UpdateSmartCard(ctx, arg)
+		if err != nil {
+			return err
+		}
+		if current.SantriID == updatedSmartCard.SantriID && current.EmployeeID == updatedSmartCard.EmployeeID {
+			return nil
+		}
+
+		return reassignSmartCard(ctx, q, updatedSmartCard)
+	})
+	return updatedSmartCard, err
+}
+
+// ReplaceSmartCard moves the owner of the old card to the card with newUid, which is created when it does not exist.
+// The old card is left in oldState, lost or retired, and points to its replacement.
+func (store *SQLStore) ReplaceSmartCard(ctx context.Context, oldID int32, oldState SmartCardState, newUid string) (SmartCard, error) {
+	var newSmartCard SmartCard
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		oldSmartCard, err := q.GetSmartCardByID(ctx, oldID)
+		if err != nil {
+			return err
+		}
+
+		// the replacement is valid as long as the old card was
+		existing, err := q.GetSmartCard(ctx, newUid)
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			newSmartCard, err = q.CreateSmartCard(ctx, CreateSmartCardParams{
+				Uid:        newUid,
+				State:      SmartCardStateActive,
+				SantriID:   oldSmartCard.SantriID,
+				EmployeeID: oldSmartCard.EmployeeID,
+				ValidFrom:  oldSmartCard.ValidFrom,
+				ValidUntil: oldSmartCard.ValidUntil,
+			})
+		case err == nil:
+			newSmartCard, err = q.UpdateSmartCard(ctx, UpdateSmartCardParams{
+				ID:         existing.ID,
+				State:      NullSmartCardState{SmartCardState: SmartCardStateActive, Valid: true},
+				SantriID:   oldSmartCard.SantriID,
+				EmployeeID: oldSmartCard.EmployeeID,
+				ValidFrom:  oldSmartCard.ValidFrom,
+				ValidUntil: oldSmartCard.ValidUntil,
+				// the card takes over the validity of the old one, also when it has none
+				ClearValidFrom:  !oldSmartCard.ValidFrom.Valid,
+				ClearValidUntil: !oldSmartCard.ValidUntil.Valid,
+			})
+		}
+		if err != nil {
+			return err
+		}
+
+		retiredSmartCard, err := q.UpdateSmartCard(ctx, UpdateSmartCardParams{
+			ID:           oldID,
+			State:        NullSmartCardState{SmartCardState: oldState, Valid: true},
+			ReplacedByID: pgtype.Int4{Int32: newSmartCard.ID, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := reassignSmartCard(ctx, q, retiredSmartCard); err != nil {
+			return err
+		}
+		return reassignSmartCard(ctx, q, newSmartCard)
+	})
+	return newSmartCard, err
+}
+
+// CreateEnrollmentSessionWithItems opens the session with the owners waiting for a card, in the given order
+func (store *SQLStore) CreateEnrollmentSessionWithItems(ctx context.Context, arg CreateEnrollmentSessionParams, itemParams []CreateEnrollmentSessionItemsParams) (EnrollmentSession, error) {
+	var createdSession EnrollmentSession
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		// an expired session still counts as open for the unique index until it is marked
+		if err := q.ExpireEnrollmentSessions(ctx, arg.DeviceID); err != nil {
+			return err
+		}
+
+		session, err := q.CreateEnrollmentSession(ctx, arg)
+		if err != nil {
+			return err
+		}
+		createdSession = session
+
+		for i := range itemParams {
+			itemParams[i].SessionID = session.ID
+			itemParams[i].Position = int32(i + 1)
+		}
+		_, err = q.CreateEnrollmentSessionItems(ctx, itemParams)
+		return err
+	})
+	return createdSession, err
+}
+
+// EnrollSmartCard assigns the card with uid to the next owner of the session, the card is created when it does not exist.
+// It returns ErrSmartCardOwned when the card belongs to someone else.
+// The session is completed once every owner has a card, completed reports whether this tap was the last one.
+func (store *SQLStore) EnrollSmartCard(ctx context.Context, sessionID int32, uid string) (smartCard SmartCard, completed bool, err error) {
+	err = store.ExecTx(ctx, func(q *Queries) error {
+		item, err := q.GetNextEnrollmentSessionItem(ctx, sessionID)
+		if err != nil {
+			return err
+		}
+
+		existing, err := q.GetSmartCard(ctx, uid)
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			smartCard, err = q.CreateSmartCard(ctx, CreateSmartCardParams{
+				Uid:        uid,
+				State:      SmartCardStateActive,
+				SantriID:   item.SantriID,
+				EmployeeID: item.EmployeeID,
+			})
+		case err == nil:
+			if (existing.SantriID.Valid || existing.EmployeeID.Valid) &&
+				(existing.SantriID != item.SantriID || existing.EmployeeID != item.EmployeeID) {
+				return ErrSmartCardOwned
+			}
+			smartCard, err = q.UpdateSmartCard(ctx, UpdateSmartCardParams{
+				ID:         existing.ID,
+				State:      NullSmartCardState{SmartCardState: SmartCardStateActive, Valid: true},
+				SantriID:   item.SantriID,
+				EmployeeID: item.EmployeeID,
+			})
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := reassignSmartCard(ctx, q, smartCard); err != nil {
+			return err
+		}
+
+		_, err = q.UpdateEnrollmentSessionItemCard(ctx, UpdateEnrollmentSessionItemCardParams{
+			ID:          item.ID,
+			SmartCardID: pgtype.Int4{Int32: smartCard.ID, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = q.GetNextEnrollmentSessionItem(ctx, sessionID)
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+		completed = true
+		_, err = q.CloseEnrollmentSession(ctx, CloseEnrollmentSessionParams{
+			ID:     sessionID,
+			Status: EnrollmentSessionStatusCompleted,
+		})
+		return err
+	})
+	return smartCard, completed, err
+}
+
+// DeactivateSantri updates the santri and suspends their cards, so a santri who left can not tap anymore.
+// A card already suspended by the expiry job is suspended again, so its buffered taps are not accepted either.
+func (store *SQLStore) DeactivateSantri(ctx context.Context, arg UpdateSantriParams) (Santri, error) {
+	var updatedSantri Santri
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		santri, err := q.UpdateSantri(ctx, arg)
+		if err != nil {
+			return err
+		}
+		updatedSantri = santri
+
+		_, err = q.SuspendSantriSmartCards(ctx, pgtype.Int4{Int32: santri.ID, Valid: true})
+		return err
+	})
+	return updatedSantri, err
+}
+
+// CreateHolidayWithDates creates the holiday with all of its dates
+func (store *SQLStore) CreateHolidayWithDates(ctx context.Context, arg CreateHolidayParams, dates []pgtype.Date) (Holiday, error) {
+	var createdHoliday Holiday
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		holiday, err := q.CreateHoliday(ctx, arg)
+		if err != nil {
+			return err
+		}
+		createdHoliday = holiday
+
+		_, err = q.CreateHolidayDates(ctx, holidayDateParams(holiday.ID, dates))
+		return err
+	})
+	return createdHoliday, err
+}
+
+// UpdateHolidayWithDates updates the holiday and replaces its dates
+func (store *SQLStore) UpdateHolidayWithDates(ctx context.Context, arg UpdateHolidayParams, dates []pgtype.Date) (Holiday, error) {
+	var updatedHoliday Holiday
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		holiday, err := q.UpdateHoliday(ctx, arg)
+		if err != nil {
+			return err
+		}
+		updatedHoliday = holiday
+
+		if err := q.DeleteHolidayDateByHolidayId(ctx, holiday.ID); err != nil {
+			return err
+		}
+		_, err = q.CreateHolidayDates(ctx, holidayDateParams(holiday.ID, dates))
+		return err
+	})
+	return updatedHoliday, err
+}
+
+func holidayDateParams(holidayID int32, dates []pgtype.Date) []CreateHolidayDatesParams {
+	params := make([]CreateHolidayDatesParams, 0, len(dates))
+	for _, date := range dates {
+		params = append(params, CreateHolidayDatesParams{Date: date, HolidayID: holidayID})
+	}
+	return params
+}
+
+// CreateSantriPermissionWithPresences creates the permission and turns the alpha presences it covers
+// into permission or sick presences, for a permission recorded after the fact
+func (store *SQLStore) CreateSantriPermissionWithPresences(ctx context.Context, arg CreateSantriPermissionParams) (SantriPermission, []SantriPresence, error) {
+	var createdPermission SantriPermission
+	var presences []SantriPresence
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		var err error
+		createdPermission, presences, err = createSantriPermissionWithPresences(ctx, q, arg)
+		return err
+	})
+	return createdPermission, presences, err
+}
+
+// UpdateSantriPermissionWithPresences updates the permission and the presences it covers,
+// presences no longer covered are alpha again
+func (store *SQLStore) UpdateSantriPermissionWithPresences(ctx context.Context, arg UpdateSantriPermissionParams) (SantriPermission, error) {
+	var updatedPermission SantriPermission
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		permission, err := q.UpdateSantriPermission(ctx, arg)
+		if err != nil {
+			return err
+		}
+		updatedPermission = permission
+
+		if _, err := q.RevertSantriPermissionPresences(ctx, pgtype.Int4{Int32: permission.ID, Valid: true}); err != nil {
+			return err
+		}
+		_, err = q.ApplySantriPermissionToPresences(ctx, santriPermissionPresenceParams(permission))
+		return err
+	})
+	return updatedPermission, err
+}
+
+// DeleteSantriPermissionWithPresences deletes the permission and keeps the presences linked to it,
+// see deleteSantriPermissionWithPresences
+func (store *SQLStore) DeleteSantriPermissionWithPresences(ctx context.Context, id int32) (SantriPermission, error) {
+	var deletedPermission SantriPermission
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		var err error
+		deletedPermission, err = deleteSantriPermissionWithPresences(ctx, q, id)
+		return err
+	})
+	return deletedPermission, err
+}
+
+func createSantriPermissionWithPresences(ctx context.Context, q *Queries, arg CreateSantriPermissionParams) (SantriPermission, []SantriPresence, error) {
+	permission, err := q.CreateSantriPermission(ctx, arg)
+	if err != nil {
+		return SantriPermission{}, nil, err
+	}
+
+	presences, err := q.ApplySantriPermissionToPresences(ctx, santriPermissionPresenceParams(permission))
+	return permission, presences, err
+}
+
+// deleteSantriPermissionWithPresences unlinks every presence of the permission before deleting it, the foreign key
+// would delete them otherwise. Permission and sick presences are alpha again, the others keep their type.
+func deleteSantriPermissionWithPresences(ctx context.Context, q *Queries, id int32) (SantriPermission, error) {
+	if _, err := q.DetachSantriPermissionPresences(ctx, pgtype.Int4{Int32: id, Valid: true}); err != nil {
+		return SantriPermission{}, err
+	}
+
+	return q.DeleteSantriPermission(ctx, id)
+}
+
+func santriPermissionPresenceParams(permission SantriPermission) ApplySantriPermissionToPresencesParams {
+	return ApplySantriPermissionToPresencesParams{
+		Type:               permission.Type.PresenceType(),
+		SantriPermissionID: pgtype.Int4{Int32: permission.ID, Valid: true},
+		SantriID:           permission.SantriID,
+		StartPermission:    permission.StartPermission,
+		EndPermission:      permission.EndPermission,
+	}
+}
+
+// PresenceType is the presence recorded for a santri away under a permission of the type
+func (t PermissionType) PresenceType() PresenceType {
+	if t == PermissionTypeSick {
+		return PresenceTypeSick
+	}
+	return PresenceTypePermission
+}
+
+// CreateSantriLeaveRequestWithHistory creates the pending leave request and the first entry of its history
+func (store *SQLStore) CreateSantriLeaveRequestWithHistory(ctx context.Context, arg CreateSantriLeaveRequestParams, history CreateSantriLeaveRequestHistoryParams) (SantriLeaveRequest, error) {
+	var createdRequest SantriLeaveRequest
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		request, err := q.CreateSantriLeaveRequest(ctx, arg)
+		if err != nil {
+			return err
+		}
+		createdRequest = request
+
+		history.LeaveRequestID = request.ID
+		history.FromStatus = NullLeaveRequestStatus{}
+		history.ToStatus = request.Status
+		_, err = q.CreateSantriLeaveRequestHistory(ctx, history)
+		return err
+	})
+	return createdRequest, err
+}
+
+// ChangeSantriLeaveRequestStatus moves the leave request from arg.FromStatus to arg.Status and records it in the history,
+// it returns pgx.ErrNoRows when the request is not in arg.FromStatus.
+// Approving creates the santri permission of the request, cancelling an approved request deletes it
+// and completing ends it now when it would run longer.
+func (store *SQLStore) ChangeSantriLeaveRequestStatus(ctx context.Context, arg UpdateSantriLeaveRequestStatusParams, history CreateSantriLeaveRequestHistoryParams) (SantriLeaveRequest, error) {
+	var updatedRequest SantriLeaveRequest
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		if arg.Status == LeaveRequestStatusApproved {
+			request, err := q.GetSantriLeaveRequest(ctx, arg.ID)
+			if err != nil {
+				return err
+			}
+			permission, _, err := createSantriPermissionWithPresences(ctx, q, CreateSantriPermissionParams{
+				SantriID:        request.SantriID,
+				StartPermission: request.StartLeave,
+				EndPermission:   request.EndLeave,
+				Type:            request.Type,
+				Excuse:          request.Reason,
+			})
+			if err != nil {
+				return err
+			}
+			arg.SantriPermissionID = pgtype.Int4{Int32: permission.ID, Valid: true}
+		}
+
+		request, err := q.UpdateSantriLeaveRequestStatus(ctx, arg)
+		if err != nil {
+			return err
+		}
+		updatedRequest = request
+
+		if request.SantriPermissionID.Valid {
+			permissionID := request.SantriPermissionID
+			switch {
+			case arg.FromStatus == LeaveRequestStatusApproved && arg.Status == LeaveRequestStatusCancelled:
+				if _, err := deleteSantriPermissionWithPresences(ctx, q, permissionID.Int32); err != nil {
+					return err
+				}
+				updatedRequest.SantriPermissionID = pgtype.Int4{}
+			case arg.Status == LeaveRequestStatusCompleted:
+				if err := endSantriPermission(ctx, q, permissionID.Int32, time.Now()); err != nil {
+					return err
+				}
+			}
+		}
+
+		history.LeaveRequestID = request.ID
+		history.FromStatus = NullLeaveRequestStatus{LeaveRequestStatus: arg.FromStatus, Valid: true}
+		history.ToStatus = arg.Status
+		_, err = q.CreateSantriLeaveRequestHistory(ctx, history)
+		return err
+	})
+	return updatedRequest, err
+}
+
+// endSantriPermission moves the end of the permission back to at, presences after at are alpha again
+func endSantriPermission(ctx context.Context, q *Queries, id int32, at time.Time) error {
+	permission, err := q.GetSantriPermission(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+	if permission.EndPermission.Valid && !permission.EndPermission.Time.After(at) {
+		return nil
+	}
+
+	updated, err := q.UpdateSantriPermission(ctx, UpdateSantriPermissionParams{
+		ID:              id,
+		StartPermission: permission.StartPermission,
+		EndPermission:   pgtype.Timestamptz{Time: at, Valid: true},
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := q.RevertSantriPermissionPresences(ctx, pgtype.Int4{Int32: id, Valid: true}); err != nil {
+		return err
+	}
+	_, err = q.ApplySantriPermissionToPresences(ctx, santriPermissionPresenceParams(updated))
+	return err
+}
+
+// reassignSmartCard ends the open assignment of the card and starts one for its current owner, if any
+func reassignSmartCard(ctx context.Context, q *Queries, smartCard SmartCard) error {
+	if err := q.EndSmartCardAssignment(ctx, smartCard.ID); err != nil {
+		return err
+	}
+	if !smartCard.SantriID.Valid && !smartCard.EmployeeID.Valid {
+		return nil
+	}
+
+	_, err := q.CreateSmartCardAssignment(ctx, CreateSmartCardAssignmentParams{
+		SmartCardID: smartCard.ID,
+		SantriID:    smartCard.SantriID,
+		EmployeeID:  smartCard.EmployeeID,
+	})
+	return err
+}
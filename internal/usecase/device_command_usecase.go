@@ -0,0 +1,206 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/exception"
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+	"github.com/adiubaidah/syafiiyah-main/pkg/util"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// defaultCommandDuration is how long a switched mode lasts when duration_seconds is not given
+const defaultCommandDuration = 5 * time.Minute
+
+// CreateCommand stores a pending command and returns it together with the message to be published to the device
+func (c *DeviceUseCase) CreateCommand(ctx context.Context, deviceId int32, userId int32, request *model.CreateDeviceCommandRequest) (*model.DeviceCommandResponse, *model.DeviceCommandMessage, error) {
+	params := repo.CreateDeviceCommandParams{
+		DeviceID:  deviceId,
+		Type:      request.Type,
+		CreatedBy: pgtype.Int4{Int32: userId, Valid: userId != 0},
+	}
+
+	switch request.Type {
+	case repo.DeviceCommandTypeSwitchMode:
+		if request.Mode == "" {
+			return nil, nil, exception.NewValidationError("Mode is required for switch_mode command")
+		}
+		duration := defaultCommandDuration
+		if request.DurationSeconds > 0 {
+			duration = time.Duration(request.DurationSeconds) * time.Second
+		}
+		params.Mode = repo.NullDeviceModeType{DeviceModeType: request.Mode, Valid: true}
+		params.ExpiresAt = pgtype.Timestamptz{Time: time.Now().Add(duration), Valid: true}
+	case repo.DeviceCommandTypeDisplayMessage:
+		if request.Message == "" {
+			return nil, nil, exception.NewValidationError("Message is required for display_message command")
+		}
+		params.Message = pgtype.Text{String: request.Message, Valid: true}
+	}
+
+	if _, err := c.store.GetDevice(ctx, deviceId); err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, nil, exception.NewNotFoundError("Device not found")
+		}
+		return nil, nil, err
+	}
+
+	command, err := c.store.CreateDeviceCommand(ctx, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	message := &model.DeviceCommandMessage{
+		ID:      command.ID,
+		Type:    command.Type,
+		Mode:    command.Mode.DeviceModeType,
+		Message: command.Message.String,
+	}
+	if command.ExpiresAt.Valid {
+		message.ExpiresAt = command.ExpiresAt.Time.Unix()
+	}
+
+	return toDeviceCommandResponse(command), message, nil
+}
+
+// GetCommandTarget returns name and secret of the device, used to sign the command before it is published
+func (c *DeviceUseCase) GetCommandTarget(ctx context.Context, deviceId int32) (*model.DeviceResponse, error) {
+	device, err := c.store.GetDevice(ctx, deviceId)
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("Device not found")
+		}
+		return nil, err
+	}
+
+	return &model.DeviceResponse{
+		ID:     device.ID,
+		Name:   device.Name,
+		Secret: device.Secret,
+	}, nil
+}
+
+func (c *DeviceUseCase) MarkCommandSent(ctx context.Context, commandId int32) (*model.DeviceCommandResponse, error) {
+	return c.updateCommandStatus(ctx, repo.UpdateDeviceCommandStatusParams{
+		ID:     commandId,
+		Status: repo.DeviceCommandStatusSent,
+		SentAt: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	})
+}
+
+func (c *DeviceUseCase) MarkCommandFailed(ctx context.Context, commandId int32, reason string) (*model.DeviceCommandResponse, error) {
+	return c.updateCommandStatus(ctx, repo.UpdateDeviceCommandStatusParams{
+		ID:     commandId,
+		Status: repo.DeviceCommandStatusFailed,
+		Error:  pgtype.Text{String: reason, Valid: true},
+	})
+}
+
+// AcknowledgeCommand records the result reported by the device, a command of another device is treated as not found
+func (c *DeviceUseCase) AcknowledgeCommand(ctx context.Context, topic string, request *model.DeviceCommandAckRequest) (*model.DeviceCommandResponse, error) {
+	device, err := c.store.GetDeviceByTopicName(ctx, util.GetDeviceName(topic))
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("Device not found")
+		}
+		return nil, err
+	}
+
+	params := repo.UpdateDeviceCommandStatusParams{
+		ID:             request.CommandID,
+		DeviceID:       pgtype.Int4{Int32: device.ID, Valid: true},
+		Status:         repo.DeviceCommandStatusAcknowledged,
+		AcknowledgedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	}
+	if request.Status == "error" {
+		params.Status = repo.DeviceCommandStatusFailed
+		params.Error = pgtype.Text{String: request.Message, Valid: true}
+	}
+
+	return c.updateCommandStatus(ctx, params)
+}
+
+func (c *DeviceUseCase) updateCommandStatus(ctx context.Context, params repo.UpdateDeviceCommandStatusParams) (*model.DeviceCommandResponse, error) {
+	command, err := c.store.UpdateDeviceCommandStatus(ctx, params)
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("Command not found")
+		}
+		return nil, err
+	}
+
+	return toDeviceCommandResponse(command), nil
+}
+
+func (c *DeviceUseCase) ListCommands(ctx context.Context, deviceId int32, request *model.ListDeviceCommandRequest) (*[]model.DeviceCommandResponse, error) {
+	commands, err := c.store.ListDeviceCommands(ctx, repo.ListDeviceCommandsParams{
+		DeviceID:     deviceId,
+		Status:       repo.NullDeviceCommandStatus{DeviceCommandStatus: request.Status, Valid: request.Status != ""},
+		LimitNumber:  request.Limit,
+		OffsetNumber: (request.Page - 1) * request.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]model.DeviceCommandResponse, 0, len(commands))
+	for _, command := range commands {
+		responses = append(responses, *toDeviceCommandResponse(command))
+	}
+
+	return &responses, nil
+}
+
+func (c *DeviceUseCase) CountCommands(ctx context.Context, deviceId int32, request *model.ListDeviceCommandRequest) (int64, error) {
+	return c.store.CountDeviceCommands(ctx, repo.CountDeviceCommandsParams{
+		DeviceID: deviceId,
+		Status:   repo.NullDeviceCommandStatus{DeviceCommandStatus: request.Status, Valid: request.Status != ""},
+	})
+}
+
+// ListTemporaryModeTopics returns input topics of modes switched on by a command that has not expired yet,
+// together with the earliest expiry so the caller knows when to refresh again. The expiry is zero without any switch.
+func (c *DeviceUseCase) ListTemporaryModeTopics(ctx context.Context) ([]string, time.Time, error) {
+	switches, err := c.store.ListActiveDeviceModeSwitches(ctx)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	topics := make([]string, 0, len(switches))
+	var nextExpiry time.Time
+	for _, modeSwitch := range switches {
+		topics = append(topics, fmt.Sprintf("%s/input/%s", util.ToSnakeCase(modeSwitch.DeviceName), modeSwitch.Mode.DeviceModeType))
+		if nextExpiry.IsZero() || modeSwitch.ExpiresAt.Time.Before(nextExpiry) {
+			nextExpiry = modeSwitch.ExpiresAt.Time
+		}
+	}
+
+	return topics, nextExpiry, nil
+}
+
+func toDeviceCommandResponse(command repo.DeviceCommand) *model.DeviceCommandResponse {
+	response := &model.DeviceCommandResponse{
+		ID:        command.ID,
+		DeviceID:  command.DeviceID,
+		Type:      command.Type,
+		Mode:      command.Mode.DeviceModeType,
+		Message:   command.Message.String,
+		Status:    command.Status,
+		Error:     command.Error.String,
+		CreatedAt: command.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+	if command.ExpiresAt.Valid {
+		response.ExpiresAt = command.ExpiresAt.Time.Format("2006-01-02 15:04:05")
+	}
+	if command.SentAt.Valid {
+		response.SentAt = command.SentAt.Time.Format("2006-01-02 15:04:05")
+	}
+	if command.AcknowledgedAt.Valid {
+		response.AcknowledgedAt = command.AcknowledgedAt.Time.Format("2006-01-02 15:04:05")
+	}
+	return response
+}
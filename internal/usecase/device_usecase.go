@@ -4,19 +4,28 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/adiubaidah/syafiiyah-main/internal/constant/exception"
 	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
 	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
 	"github.com/adiubaidah/syafiiyah-main/pkg/util"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
+// defaultDeviceOfflineAfter is used when DEVICE_OFFLINE_AFTER is not configured
+const defaultDeviceOfflineAfter = 2 * time.Minute
+
 type DeviceUseCase struct {
-	store repo.Store
+	store        repo.Store
+	offlineAfter time.Duration
 }
 
-func NewDeviceUseCase(store repo.Store) *DeviceUseCase {
-	return &DeviceUseCase{store: store}
+func NewDeviceUseCase(store repo.Store, offlineAfter time.Duration) *DeviceUseCase {
+	if offlineAfter <= 0 {
+		offlineAfter = defaultDeviceOfflineAfter
+	}
+	return &DeviceUseCase{store: store, offlineAfter: offlineAfter}
 }
 
 func (c *DeviceUseCase) CreateDevice(ctx context.Context, request *model.CreateDeviceRequest) (*model.DeviceResponse, error) {
@@ -31,14 +40,20 @@ func (c *DeviceUseCase) CreateDevice(ctx context.Context, request *model.CreateD
 		})
 	}
 
-	device, err := sqlStore.CreateDeviceWithModes(ctx, request.Name, modeParams)
+	secret := util.Generate32ByteKey()
+	if secret == "" {
+		return nil, errors.New("failed to generate device secret")
+	}
+
+	device, err := sqlStore.CreateDeviceWithModes(ctx, request.Name, secret, modeParams)
 	if err != nil {
 		return nil, err
 	}
 
 	return &model.DeviceResponse{
-		ID:   device.ID,
-		Name: device.Name,
+		ID:     device.ID,
+		Name:   device.Name,
+		Secret: device.Secret,
 	}, nil
 }
 
@@ -49,13 +64,24 @@ func (c *DeviceUseCase) ListDevices(ctx context.Context) (*[]model.DeviceWithMod
 	}
 
 	deviceMap := make(map[int32]*model.DeviceWithModesResponse)
+	now := time.Now()
 
 	for _, device := range devices {
 		if _, exists := deviceMap[device.ID]; !exists {
 			deviceMap[device.ID] = &model.DeviceWithModesResponse{
-				ID:    device.ID,
-				Name:  device.Name,
-				Modes: []model.DeviceMode{},
+				ID:              device.ID,
+				Name:            device.Name,
+				Status:          c.deviceStatus(device.LastSeenAt, now),
+				FirmwareVersion: device.FirmwareVersion.String,
+				IPAddress:       device.IpAddress.String,
+				TapRule:         toDeviceTapRule(device.DebounceSeconds, device.GateDirection, device.AntiPassback),
+				Modes:           []model.DeviceMode{},
+			}
+			if device.LastSeenAt.Valid {
+				deviceMap[device.ID].LastSeenAt = device.LastSeenAt.Time.Format("2006-01-02 15:04:05")
+			}
+			if device.LocationID.Valid {
+				deviceMap[device.ID].Location = &model.IdAndName{Id: device.LocationID.Int32, Name: device.LocationName.String}
 			}
 		}
 
@@ -113,3 +139,308 @@ func (c *DeviceUseCase) DeleteDevice(ctx context.Context, deviceId int32) (*mode
 		Name: device.Name,
 	}, nil
 }
+
+// RotateSecret replace the device secret, the old secret stops working immediately
+func (c *DeviceUseCase) RotateSecret(ctx context.Context, deviceId int32) (*model.DeviceResponse, error) {
+	secret := util.Generate32ByteKey()
+	if secret == "" {
+		return nil, errors.New("failed to generate device secret")
+	}
+
+	device, err := c.store.UpdateDeviceSecret(ctx, repo.UpdateDeviceSecretParams{
+		ID:     deviceId,
+		Secret: secret,
+	})
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("Device not found")
+		}
+		return nil, err
+	}
+
+	return &model.DeviceResponse{
+		ID:     device.ID,
+		Name:   device.Name,
+		Secret: device.Secret,
+	}, nil
+}
+
+// GetSecretByTopic returns secret of the device that owns the topic, the device is resolved from the first topic segment
+func (c *DeviceUseCase) GetSecretByTopic(ctx context.Context, topic string) (string, error) {
+	device, err := c.store.GetDeviceByTopicName(ctx, util.GetDeviceName(topic))
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return "", exception.NewNotFoundError("Device not found")
+		}
+		return "", err
+	}
+
+	return device.Secret, nil
+}
+
+func (c *DeviceUseCase) UpdateTapRule(ctx context.Context, deviceId int32, request *model.UpdateDeviceTapRuleRequest) (*model.DeviceTapRuleResponse, error) {
+	if request.AntiPassback && request.GateDirection == "" {
+		return nil, exception.NewValidationError("Anti-passback requires gate direction")
+	}
+
+	params := repo.UpdateDeviceTapRuleParams{
+		ID:           deviceId,
+		AntiPassback: request.AntiPassback,
+		GateDirection: repo.NullGateDirectionType{
+			GateDirectionType: request.GateDirection,
+			Valid:             request.GateDirection != "",
+		},
+	}
+	if request.DebounceSeconds != nil {
+		params.DebounceSeconds = pgtype.Int4{Int32: *request.DebounceSeconds, Valid: true}
+	}
+
+	device, err := c.store.UpdateDeviceTapRule(ctx, params)
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("Device not found")
+		}
+		return nil, err
+	}
+
+	return &model.DeviceTapRuleResponse{
+		ID:      device.ID,
+		Name:    device.Name,
+		TapRule: toDeviceTapRule(device.DebounceSeconds, device.GateDirection, device.AntiPassback),
+	}, nil
+}
+
+// GetTapRule returns the tap rule of the device that owns the topic
+func (c *DeviceUseCase) GetTapRule(ctx context.Context, topic string) (*model.DeviceTapRule, error) {
+	device, err := c.store.GetDeviceByTopicName(ctx, util.GetDeviceName(topic))
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("Device not found")
+		}
+		return nil, err
+	}
+
+	rule := toDeviceTapRule(device.DebounceSeconds, device.GateDirection, device.AntiPassback)
+	return &rule, nil
+}
+
+func toDeviceTapRule(debounceSeconds pgtype.Int4, gateDirection repo.NullGateDirectionType, antiPassback bool) model.DeviceTapRule {
+	rule := model.DeviceTapRule{
+		GateDirection: gateDirection.GateDirectionType,
+		AntiPassback:  antiPassback,
+	}
+	if debounceSeconds.Valid {
+		rule.DebounceSeconds = &debounceSeconds.Int32
+	}
+	return rule
+}
+
+// UpdateAccess binds the device to a location and replaces its allow rules, an empty location unbinds it
+func (c *DeviceUseCase) UpdateAccess(ctx context.Context, deviceId int32, request *model.UpdateDeviceAccessRequest) (*model.DeviceAccessResponse, error) {
+	ruleParams := make([]repo.CreateDeviceAllowRulesParams, 0, len(request.Rules))
+	for _, rule := range request.Rules {
+		if rule.OwnerRole == "" && (rule.OccupationID != 0 || rule.ScheduleID != 0) {
+			return nil, exception.NewValidationError("Allow rule with occupation or schedule requires owner role")
+		}
+		ruleParams = append(ruleParams, repo.CreateDeviceAllowRulesParams{
+			OwnerRole:    repo.NullRoleType{RoleType: rule.OwnerRole, Valid: rule.OwnerRole != ""},
+			Gender:       repo.NullGenderType{GenderType: rule.Gender, Valid: rule.Gender != ""},
+			OccupationID: pgtype.Int4{Int32: rule.OccupationID, Valid: rule.OccupationID != 0},
+			ScheduleID:   pgtype.Int4{Int32: rule.ScheduleID, Valid: rule.ScheduleID != 0},
+		})
+	}
+
+	var location *model.IdAndName
+	if request.LocationID != 0 {
+		result, err := c.store.GetLocation(ctx, request.LocationID)
+		if err != nil {
+			if errors.Is(err, exception.ErrNotFound) {
+				return nil, exception.NewNotFoundError("Location not found")
+			}
+			return nil, err
+		}
+		location = &model.IdAndName{Id: result.ID, Name: result.Name}
+	}
+
+	sqlStore := c.store.(*repo.SQLStore)
+	device, err := sqlStore.ReplaceDeviceAccess(ctx, deviceId, pgtype.Int4{Int32: request.LocationID, Valid: request.LocationID != 0}, ruleParams)
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("Device not found")
+		}
+		return nil, err
+	}
+
+	rules := request.Rules
+	if rules == nil {
+		rules = []model.DeviceAllowRule{}
+	}
+	return &model.DeviceAccessResponse{
+		ID:     device.ID,
+		Name:   device.Name,
+		Access: model.DeviceAccess{Location: location, Rules: rules},
+	}, nil
+}
+
+func (c *DeviceUseCase) GetAccess(ctx context.Context, deviceId int32) (*model.DeviceAccessResponse, error) {
+	device, err := c.store.GetDevice(ctx, deviceId)
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("Device not found")
+		}
+		return nil, err
+	}
+
+	access, err := c.deviceAccess(ctx, device)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.DeviceAccessResponse{
+		ID:     device.ID,
+		Name:   device.Name,
+		Access: *access,
+	}, nil
+}
+
+// GetAccessByTopic returns the location and allow rules of the device that owns the topic
+func (c *DeviceUseCase) GetAccessByTopic(ctx context.Context, topic string) (*model.DeviceAccess, error) {
+	device, err := c.store.GetDeviceByTopicName(ctx, util.GetDeviceName(topic))
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("Device not found")
+		}
+		return nil, err
+	}
+
+	return c.deviceAccess(ctx, device)
+}
+
+func (c *DeviceUseCase) deviceAccess(ctx context.Context, device repo.Device) (*model.DeviceAccess, error) {
+	access := &model.DeviceAccess{Rules: []model.DeviceAllowRule{}}
+	if device.LocationID.Valid {
+		location, err := c.store.GetLocation(ctx, device.LocationID.Int32)
+		if err != nil {
+			return nil, err
+		}
+		access.Location = &model.IdAndName{Id: location.ID, Name: location.Name}
+	}
+
+	rules, err := c.store.ListDeviceAllowRules(ctx, device.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, rule := range rules {
+		access.Rules = append(access.Rules, model.DeviceAllowRule{
+			OwnerRole:    rule.OwnerRole.RoleType,
+			Gender:       rule.Gender.GenderType,
+			OccupationID: rule.OccupationID.Int32,
+			ScheduleID:   rule.ScheduleID.Int32,
+		})
+	}
+	return access, nil
+}
+
+// AllowTap checks the card owner against the allow rules of the device, the tap is allowed
+// when the device has no rules or one of them matches
+func AllowTap(access *model.DeviceAccess, subject model.TapSubject) error {
+	if access == nil || len(access.Rules) == 0 {
+		return nil
+	}
+
+	for _, rule := range access.Rules {
+		if rule.OwnerRole != "" && rule.OwnerRole != subject.Role {
+			continue
+		}
+		if rule.Gender != "" && rule.Gender != subject.Gender {
+			continue
+		}
+		if rule.OccupationID != 0 && rule.OccupationID != subject.OccupationID {
+			continue
+		}
+		if rule.ScheduleID != 0 && rule.ScheduleID != subject.ScheduleID {
+			continue
+		}
+		return nil
+	}
+
+	if access.Location != nil {
+		return exception.NewForbiddenError(fmt.Sprintf("Kartu tidak diizinkan presensi di %s", access.Location.Name))
+	}
+	return exception.NewForbiddenError("Kartu tidak diizinkan presensi di device ini")
+}
+
+// RecordHeartbeat store the last seen time of the device that owns the ping topic
+func (c *DeviceUseCase) RecordHeartbeat(ctx context.Context, inputTopic string, request *model.DevicePingRequest) (*model.DevicePingResponse, error) {
+	now := time.Now()
+	device, err := c.store.UpdateDeviceHeartbeat(ctx, repo.UpdateDeviceHeartbeatParams{
+		LastSeenAt:      pgtype.Timestamptz{Time: now, Valid: true},
+		FirmwareVersion: pgtype.Text{String: request.FirmwareVersion, Valid: request.FirmwareVersion != ""},
+		IpAddress:       pgtype.Text{String: request.IPAddress, Valid: request.IPAddress != ""},
+		InputTopic:      inputTopic,
+	})
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("Device not found")
+		}
+		return nil, err
+	}
+
+	return &model.DevicePingResponse{
+		ID:         device.ID,
+		Name:       device.Name,
+		ServerTime: now.Format("2006-01-02 15:04:05"),
+	}, nil
+}
+
+func (c *DeviceUseCase) deviceStatus(lastSeenAt pgtype.Timestamptz, now time.Time) model.DeviceStatus {
+	if lastSeenAt.Valid && now.Sub(lastSeenAt.Time) <= c.offlineAfter {
+		return model.DeviceStatusOnline
+	}
+	return model.DeviceStatusOffline
+}
+
+// GetBatchTap returns result of a batch tap that already processed before, so retried upload is not recorded twice
+func (c *DeviceUseCase) GetBatchTap(ctx context.Context, inputTopic string, tapID string) (*model.BatchTapResult, error) {
+	batchTap, err := c.store.GetDeviceBatchTap(ctx, repo.GetDeviceBatchTapParams{
+		InputTopic: inputTopic,
+		TapID:      tapID,
+	})
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("Batch tap not found")
+		}
+		return nil, err
+	}
+
+	return toBatchTapResult(batchTap), nil
+}
+
+func (c *DeviceUseCase) SaveBatchTap(ctx context.Context, inputTopic string, tap *model.BatchTap, result *model.BatchTapResult) error {
+	_, err := c.store.CreateDeviceBatchTap(ctx, repo.CreateDeviceBatchTapParams{
+		InputTopic: inputTopic,
+		TapID:      tap.TapID,
+		Uid:        tap.Uid,
+		TappedAt:   pgtype.Timestamptz{Time: time.Unix(tap.TappedAt, 0), Valid: true},
+		Code:       int32(result.Code),
+		Message:    result.Message,
+	})
+	// conflict means the same tap is saved by another upload in the meantime
+	if err != nil && !errors.Is(err, exception.ErrNotFound) {
+		return err
+	}
+	return nil
+}
+
+func toBatchTapResult(batchTap repo.DeviceBatchTap) *model.BatchTapResult {
+	status := "success"
+	if batchTap.Code >= 300 {
+		status = "error"
+	}
+	return &model.BatchTapResult{
+		TapID:   batchTap.TapID,
+		Code:    int(batchTap.Code),
+		Status:  status,
+		Message: batchTap.Message,
+	}
+}
@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/exception"
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllowTap(t *testing.T) {
+	femaleDormitory := &model.DeviceAccess{
+		Location: &model.IdAndName{Id: 1, Name: "Asrama Putri"},
+		Rules: []model.DeviceAllowRule{
+			{OwnerRole: repo.RoleTypeSantri, Gender: repo.GenderTypeFemale},
+			{OwnerRole: repo.RoleTypeEmployee, OccupationID: 3, ScheduleID: 7},
+		},
+	}
+
+	testCases := []struct {
+		name    string
+		access  *model.DeviceAccess
+		subject model.TapSubject
+		allowed bool
+	}{
+		{
+			name:    "device without rules",
+			access:  &model.DeviceAccess{},
+			subject: model.TapSubject{Role: repo.RoleTypeSantri, Gender: repo.GenderTypeMale},
+			allowed: true,
+		},
+		{
+			name:    "matching santri",
+			access:  femaleDormitory,
+			subject: model.TapSubject{Role: repo.RoleTypeSantri, Gender: repo.GenderTypeFemale, OccupationID: 2, ScheduleID: 5},
+			allowed: true,
+		},
+		{
+			name:    "santri of other gender",
+			access:  femaleDormitory,
+			subject: model.TapSubject{Role: repo.RoleTypeSantri, Gender: repo.GenderTypeMale},
+		},
+		{
+			name:    "matching employee",
+			access:  femaleDormitory,
+			subject: model.TapSubject{Role: repo.RoleTypeEmployee, Gender: repo.GenderTypeMale, OccupationID: 3, ScheduleID: 7},
+			allowed: true,
+		},
+		{
+			name:    "employee on other schedule",
+			access:  femaleDormitory,
+			subject: model.TapSubject{Role: repo.RoleTypeEmployee, Gender: repo.GenderTypeMale, OccupationID: 3, ScheduleID: 8},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := AllowTap(tc.access, tc.subject)
+			if tc.allowed {
+				require.NoError(t, err)
+				return
+			}
+			appErr, ok := err.(*exception.AppError)
+			require.True(t, ok)
+			require.Equal(t, 403, appErr.Code)
+			require.Equal(t, "Kartu tidak diizinkan presensi di Asrama Putri", appErr.Message)
+		})
+	}
+}
@@ -1,4 +1,353 @@
 package usecase
 
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/exception"
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
 type EmployeePermissionUseCase interface {
+	CreateEmployeePermission(ctx context.Context, userID int32, request *model.CreateEmployeePermissionRequest) (*model.EmployeePermissionResponse, error)
+	SubmitEmployeePermission(ctx context.Context, user *model.User, request *model.SubmitEmployeePermissionRequest) (*model.EmployeePermissionResponse, error)
+	ListEmployeePermissions(ctx context.Context, request *model.ListEmployeePermissionRequest) (*[]model.EmployeePermissionResponse, error)
+	ListOwnEmployeePermissions(ctx context.Context, user *model.User, request *model.ListEmployeePermissionRequest) (*[]model.EmployeePermissionResponse, error)
+	ListEmployeePermissionQueue(ctx context.Context, request *model.ListEmployeePermissionRequest) (*[]model.EmployeePermissionResponse, error)
+	CountEmployeePermissions(ctx context.Context, request *model.ListEmployeePermissionRequest) (int64, error)
+	GetEmployeePermission(ctx context.Context, employeePermissionID int32) (*model.EmployeePermissionResponse, error)
+	UpdateEmployeePermission(ctx context.Context, request *model.UpdateEmployeePermissionRequest, employeePermissionID int32) (*model.EmployeePermissionResponse, error)
+	ReviewEmployeePermission(ctx context.Context, userID int32, request *model.ReviewEmployeePermissionRequest, employeePermissionID int32) (*model.EmployeePermissionResponse, error)
+	CancelOwnEmployeePermission(ctx context.Context, user *model.User, employeePermissionID int32) (*model.EmployeePermissionResponse, error)
+	DeleteEmployeePermission(ctx context.Context, employeePermissionID int32) (*model.EmployeePermissionResponse, error)
+}
+
+type employeePermissionService struct {
+	store repo.Store
+}
+
+func NewEmployeePermissionUseCase(store repo.Store) EmployeePermissionUseCase {
+	return &employeePermissionService{store: store}
+}
+
+// CreateEmployeePermission records a permission on behalf of the employee, it needs no review
+func (s *employeePermissionService) CreateEmployeePermission(ctx context.Context, userID int32, request *model.CreateEmployeePermissionRequest) (*model.EmployeePermissionResponse, error) {
+	if request.EndPermission != nil && !request.EndPermission.After(request.StartPermission) {
+		return nil, exception.NewValidationError("end_permission must be after start_permission")
+	}
+
+	if _, err := s.store.GetEmployeeByID(ctx, request.EmployeeID); err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("Employee not found")
+		}
+		return nil, err
+	}
+
+	return s.create(ctx, repo.CreateEmployeePermissionParams{
+		EmployeeID:      request.EmployeeID,
+		StartPermission: pgtype.Timestamptz{Time: request.StartPermission, Valid: true},
+		EndPermission:   optionalTimestamptz(request.EndPermission),
+		Type:            request.Type,
+		Excuse:          request.Excuse,
+		Status:          repo.EmployeePermissionStatusApproved,
+		CreatedBy:       pgtype.Int4{Int32: userID, Valid: userID != 0},
+	})
+}
+
+// SubmitEmployeePermission files a request for the employee of the user, it stays pending until reviewed
+func (s *employeePermissionService) SubmitEmployeePermission(ctx context.Context, user *model.User, request *model.SubmitEmployeePermissionRequest) (*model.EmployeePermissionResponse, error) {
+	if request.EndPermission != nil && !request.EndPermission.After(request.StartPermission) {
+		return nil, exception.NewValidationError("end_permission must be after start_permission")
+	}
+
+	employee, err := s.userEmployee(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.create(ctx, repo.CreateEmployeePermissionParams{
+		EmployeeID:      employee.ID,
+		StartPermission: pgtype.Timestamptz{Time: request.StartPermission, Valid: true},
+		EndPermission:   optionalTimestamptz(request.EndPermission),
+		Type:            request.Type,
+		Excuse:          request.Excuse,
+		Status:          repo.EmployeePermissionStatusPending,
+		CreatedBy:       pgtype.Int4{Int32: user.ID, Valid: true},
+	})
+}
+
+func (s *employeePermissionService) create(ctx context.Context, arg repo.CreateEmployeePermissionParams) (*model.EmployeePermissionResponse, error) {
+	createdPermission, err := s.store.CreateEmployeePermission(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetEmployeePermission(ctx, createdPermission.ID)
+}
+
+func (s *employeePermissionService) ListEmployeePermissions(ctx context.Context, request *model.ListEmployeePermissionRequest) (*[]model.EmployeePermissionResponse, error) {
+	filter, err := employeePermissionFilter(request)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions, err := s.store.ListEmployeePermissions(ctx, repo.ListEmployeePermissionsParams{
+		Q:            filter.Q,
+		EmployeeID:   filter.EmployeeID,
+		Type:         filter.Type,
+		Status:       filter.Status,
+		FromDate:     filter.FromDate,
+		EndDate:      filter.EndDate,
+		OffsetNumber: (request.Page - 1) * request.Limit,
+		LimitNumber:  request.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]model.EmployeePermissionResponse, 0, len(permissions))
+	for _, permission := range permissions {
+		response = append(response, *toEmployeePermissionRowResponse(permission))
+	}
+
+	return &response, nil
+}
+
+// ListOwnEmployeePermissions lists the permissions of the employee of the user,
+// request.EmployeeID is set to that employee so the count matches the list
+func (s *employeePermissionService) ListOwnEmployeePermissions(ctx context.Context, user *model.User, request *model.ListEmployeePermissionRequest) (*[]model.EmployeePermissionResponse, error) {
+	employee, err := s.userEmployee(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	request.EmployeeID = employee.ID
+	return s.ListEmployeePermissions(ctx, request)
+}
+
+// ListEmployeePermissionQueue lists the pending requests, the oldest first
+func (s *employeePermissionService) ListEmployeePermissionQueue(ctx context.Context, request *model.ListEmployeePermissionRequest) (*[]model.EmployeePermissionResponse, error) {
+	permissions, err := s.store.ListPendingEmployeePermissions(ctx, repo.ListPendingEmployeePermissionsParams{
+		OffsetNumber: (request.Page - 1) * request.Limit,
+		LimitNumber:  request.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]model.EmployeePermissionResponse, 0, len(permissions))
+	for _, permission := range permissions {
+		response = append(response, *toEmployeePermissionRowResponse(repo.ListEmployeePermissionsRow(permission)))
+	}
+
+	return &response, nil
+}
+
+func (s *employeePermissionService) CountEmployeePermissions(ctx context.Context, request *model.ListEmployeePermissionRequest) (int64, error) {
+	filter, err := employeePermissionFilter(request)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.store.CountEmployeePermissions(ctx, *filter)
+}
+
+func (s *employeePermissionService) GetEmployeePermission(ctx context.Context, employeePermissionID int32) (*model.EmployeePermissionResponse, error) {
+	permission, err := s.store.GetEmployeePermission(ctx, employeePermissionID)
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("Employee permission not found")
+		}
+		return nil, err
+	}
+
+	return toEmployeePermissionRowResponse(repo.ListEmployeePermissionsRow(permission)), nil
+}
+
+func (s *employeePermissionService) UpdateEmployeePermission(ctx context.Context, request *model.UpdateEmployeePermissionRequest, employeePermissionID int32) (*model.EmployeePermissionResponse, error) {
+	current, err := s.store.GetEmployeePermission(ctx, employeePermissionID)
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("Employee permission not found")
+		}
+		return nil, err
+	}
+
+	arg := repo.UpdateEmployeePermissionParams{
+		ID:              employeePermissionID,
+		EmployeeID:      pgtype.Int4{Int32: request.EmployeeID, Valid: request.EmployeeID != 0},
+		Type:            repo.NullPermissionType{PermissionType: request.Type, Valid: request.Type != ""},
+		Excuse:          pgtype.Text{String: request.Excuse, Valid: request.Excuse != ""},
+		StartPermission: current.StartPermission,
+		EndPermission:   current.EndPermission,
+	}
+	if request.StartPermission != nil {
+		arg.StartPermission = pgtype.Timestamptz{Time: *request.StartPermission, Valid: true}
+	}
+	if request.EndPermission != nil {
+		arg.EndPermission = pgtype.Timestamptz{Time: *request.EndPermission, Valid: true}
+	}
+	if arg.EndPermission.Valid && !arg.EndPermission.Time.After(arg.StartPermission.Time) {
+		return nil, exception.NewValidationError("end_permission must be after start_permission")
+	}
+
+	if arg.EmployeeID.Valid && arg.EmployeeID.Int32 != current.EmployeeID {
+		if _, err := s.store.GetEmployeeByID(ctx, arg.EmployeeID.Int32); err != nil {
+			if errors.Is(err, exception.ErrNotFound) {
+				return nil, exception.NewNotFoundError("Employee not found")
+			}
+			return nil, err
+		}
+	}
+
+	if _, err := s.store.UpdateEmployeePermission(ctx, arg); err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("Employee permission not found")
+		}
+		return nil, err
+	}
+
+	return s.GetEmployeePermission(ctx, employeePermissionID)
+}
+
+// ReviewEmployeePermission approves or rejects a pending request, a request is reviewed once
+func (s *employeePermissionService) ReviewEmployeePermission(ctx context.Context, userID int32, request *model.ReviewEmployeePermissionRequest, employeePermissionID int32) (*model.EmployeePermissionResponse, error) {
+	_, err := s.store.ReviewEmployeePermission(ctx, repo.ReviewEmployeePermissionParams{
+		ID:         employeePermissionID,
+		Status:     request.Status,
+		ReviewedBy: pgtype.Int4{Int32: userID, Valid: userID != 0},
+		ReviewNote: pgtype.Text{String: request.Note, Valid: request.Note != ""},
+	})
+	if err != nil {
+		if !errors.Is(err, exception.ErrNotFound) {
+			return nil, err
+		}
+		if _, err := s.GetEmployeePermission(ctx, employeePermissionID); err != nil {
+			return nil, err
+		}
+		return nil, exception.NewValidationError("Employee permission is not pending")
+	}
+
+	return s.GetEmployeePermission(ctx, employeePermissionID)
+}
+
+// CancelOwnEmployeePermission cancels a pending request of the employee of the user,
+// a request of another employee is not found and a reviewed one can not be cancelled anymore
+func (s *employeePermissionService) CancelOwnEmployeePermission(ctx context.Context, user *model.User, employeePermissionID int32) (*model.EmployeePermissionResponse, error) {
+	employee, err := s.userEmployee(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.store.CancelEmployeePermission(ctx, repo.CancelEmployeePermissionParams{
+		ID:         employeePermissionID,
+		EmployeeID: employee.ID,
+	})
+	if err != nil {
+		if !errors.Is(err, exception.ErrNotFound) {
+			return nil, err
+		}
+		current, err := s.GetEmployeePermission(ctx, employeePermissionID)
+		if err != nil {
+			return nil, err
+		}
+		if current.Employee.Id != employee.ID {
+			return nil, exception.NewNotFoundError("Employee permission not found")
+		}
+		return nil, exception.NewValidationError("Only a pending employee permission can be cancelled")
+	}
+
+	return s.GetEmployeePermission(ctx, employeePermissionID)
+}
+
+func (s *employeePermissionService) DeleteEmployeePermission(ctx context.Context, employeePermissionID int32) (*model.EmployeePermissionResponse, error) {
+	deleted, err := s.store.DeleteEmployeePermission(ctx, employeePermissionID)
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("Employee permission not found")
+		}
+		return nil, err
+	}
+
+	return toEmployeePermissionResponse(deleted), nil
+}
+
+func (s *employeePermissionService) userEmployee(ctx context.Context, user *model.User) (*repo.Employee, error) {
+	employee, err := s.store.GetEmployeeByUserID(ctx, pgtype.Int4{Int32: user.ID, Valid: true})
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewForbiddenError("User has no employee profile")
+		}
+		return nil, err
+	}
+	return &employee, nil
+}
+
+// employeePermissionFilter turns the list request into the filter shared by the list and the count query.
+// The dates are local days and To covers the whole day.
+func employeePermissionFilter(request *model.ListEmployeePermissionRequest) (*repo.CountEmployeePermissionsParams, error) {
+	filter := &repo.CountEmployeePermissionsParams{
+		Q:          pgtype.Text{String: request.Q, Valid: request.Q != ""},
+		EmployeeID: pgtype.Int4{Int32: request.EmployeeID, Valid: request.EmployeeID != 0},
+		Type:       repo.NullPermissionType{PermissionType: request.Type, Valid: request.Type != ""},
+		Status:     repo.NullEmployeePermissionStatus{EmployeePermissionStatus: request.Status, Valid: request.Status != ""},
+	}
+
+	fromDate, endDate, err := permissionDateRange(request.From, request.To)
+	if err != nil {
+		return nil, err
+	}
+	filter.FromDate, filter.EndDate = fromDate, endDate
+
+	return filter, nil
+}
+
+func optionalTimestamptz(value *time.Time) pgtype.Timestamptz {
+	if value == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: *value, Valid: true}
+}
+
+func toEmployeePermissionRowResponse(row repo.ListEmployeePermissionsRow) *model.EmployeePermissionResponse {
+	response := toEmployeePermissionResponse(repo.EmployeePermission{
+		ID:              row.ID,
+		EmployeeID:      row.EmployeeID,
+		Type:            row.Type,
+		StartPermission: row.StartPermission,
+		EndPermission:   row.EndPermission,
+		Excuse:          row.Excuse,
+		Status:          row.Status,
+		CreatedBy:       row.CreatedBy,
+		CreatedAt:       row.CreatedAt,
+		ReviewedBy:      row.ReviewedBy,
+		ReviewedAt:      row.ReviewedAt,
+		ReviewNote:      row.ReviewNote,
+	})
+	response.Employee.Name = row.EmployeeName
+	return response
+}
+
+func toEmployeePermissionResponse(permission repo.EmployeePermission) *model.EmployeePermissionResponse {
+	response := &model.EmployeePermissionResponse{
+		ID:              permission.ID,
+		Employee:        model.IdAndName{Id: permission.EmployeeID},
+		Type:            permission.Type,
+		StartPermission: permission.StartPermission.Time.Format("2006-01-02 15:04:05"),
+		Excuse:          permission.Excuse,
+		Status:          permission.Status,
+		CreatedAt:       permission.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+		ReviewedBy:      permission.ReviewedBy.Int32,
+		ReviewNote:      permission.ReviewNote.String,
+	}
+	if permission.EndPermission.Valid {
+		response.EndPermission = permission.EndPermission.Time.Format("2006-01-02 15:04:05")
+	}
+	if permission.ReviewedAt.Valid {
+		response.ReviewedAt = permission.ReviewedAt.Time.Format("2006-01-02 15:04:05")
+	}
+	return response
 }
@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/exception"
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+	mocks "github.com/adiubaidah/syafiiyah-main/internal/repository/mocks"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmployeePermissionUseCase_Submit(t *testing.T) {
+	ctx := context.Background()
+	user := &model.User{ID: 4, Role: repo.RoleTypeEmployee}
+	start := time.Date(2024, 7, 1, 8, 0, 0, 0, time.Local)
+
+	t.Run("user without employee profile is refused", func(t *testing.T) {
+		mockStore := new(mocks.MockStore)
+		uc := NewEmployeePermissionUseCase(mockStore)
+		mockStore.On("GetEmployeeByUserID", ctx, pgtype.Int4{Int32: 4, Valid: true}).Return(repo.Employee{}, pgx.ErrNoRows)
+
+		_, err := uc.SubmitEmployeePermission(ctx, user, &model.SubmitEmployeePermissionRequest{
+			Type:            repo.PermissionTypeSick,
+			StartPermission: start,
+			Excuse:          "Demam",
+		})
+		require.IsType(t, &exception.AppError{}, err)
+		require.Equal(t, 403, err.(*exception.AppError).Code)
+	})
+
+	t.Run("request of the employee waits for review", func(t *testing.T) {
+		mockStore := new(mocks.MockStore)
+		uc := NewEmployeePermissionUseCase(mockStore)
+		mockStore.On("GetEmployeeByUserID", ctx, pgtype.Int4{Int32: 4, Valid: true}).Return(repo.Employee{ID: 9, Name: "Ustadz Hasan"}, nil)
+		mockStore.On("CreateEmployeePermission", ctx, mock.MatchedBy(func(arg repo.CreateEmployeePermissionParams) bool {
+			return arg.EmployeeID == 9 && arg.Status == repo.EmployeePermissionStatusPending && arg.CreatedBy.Int32 == 4
+		})).Return(repo.EmployeePermission{ID: 12}, nil)
+		mockStore.On("GetEmployeePermission", ctx, int32(12)).Return(repo.GetEmployeePermissionRow{
+			ID:           12,
+			EmployeeID:   9,
+			Status:       repo.EmployeePermissionStatusPending,
+			EmployeeName: "Ustadz Hasan",
+		}, nil)
+
+		permission, err := uc.SubmitEmployeePermission(ctx, user, &model.SubmitEmployeePermissionRequest{
+			Type:            repo.PermissionTypePermission,
+			StartPermission: start,
+			Excuse:          "Acara keluarga",
+		})
+		require.NoError(t, err)
+		require.Equal(t, repo.EmployeePermissionStatusPending, permission.Status)
+		require.Equal(t, model.IdAndName{Id: 9, Name: "Ustadz Hasan"}, permission.Employee)
+		mockStore.AssertExpectations(t)
+	})
+}
+
+func TestEmployeePermissionUseCase_Review(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("reviewed request can not be reviewed again", func(t *testing.T) {
+		mockStore := new(mocks.MockStore)
+		uc := NewEmployeePermissionUseCase(mockStore)
+		mockStore.On("ReviewEmployeePermission", ctx, mock.Anything).Return(repo.EmployeePermission{}, pgx.ErrNoRows)
+		mockStore.On("GetEmployeePermission", ctx, int32(12)).Return(repo.GetEmployeePermissionRow{
+			ID:     12,
+			Status: repo.EmployeePermissionStatusApproved,
+		}, nil)
+
+		_, err := uc.ReviewEmployeePermission(ctx, 1, &model.ReviewEmployeePermissionRequest{Status: repo.EmployeePermissionStatusRejected}, 12)
+		require.IsType(t, &exception.AppError{}, err)
+		require.Equal(t, 400, err.(*exception.AppError).Code)
+	})
+
+	t.Run("unknown request is not found", func(t *testing.T) {
+		mockStore := new(mocks.MockStore)
+		uc := NewEmployeePermissionUseCase(mockStore)
+		mockStore.On("ReviewEmployeePermission", ctx, mock.Anything).Return(repo.EmployeePermission{}, pgx.ErrNoRows)
+		mockStore.On("GetEmployeePermission", ctx, int32(13)).Return(repo.GetEmployeePermissionRow{}, pgx.ErrNoRows)
+
+		_, err := uc.ReviewEmployeePermission(ctx, 1, &model.ReviewEmployeePermissionRequest{Status: repo.EmployeePermissionStatusApproved}, 13)
+		require.IsType(t, &exception.AppError{}, err)
+		require.Equal(t, 404, err.(*exception.AppError).Code)
+	})
+}
+
+func TestEmployeePermissionUseCase_CancelOwn(t *testing.T) {
+	ctx := context.Background()
+	user := &model.User{ID: 4, Role: repo.RoleTypeEmployee}
+	cancelArg := repo.CancelEmployeePermissionParams{ID: 12, EmployeeID: 9}
+
+	t.Run("pending request of the employee is cancelled", func(t *testing.T) {
+		mockStore := new(mocks.MockStore)
+		uc := NewEmployeePermissionUseCase(mockStore)
+		mockStore.On("GetEmployeeByUserID", ctx, pgtype.Int4{Int32: 4, Valid: true}).Return(repo.Employee{ID: 9}, nil)
+		mockStore.On("CancelEmployeePermission", ctx, cancelArg).Return(repo.EmployeePermission{ID: 12}, nil)
+		mockStore.On("GetEmployeePermission", ctx, int32(12)).Return(repo.GetEmployeePermissionRow{
+			ID:         12,
+			EmployeeID: 9,
+			Status:     repo.EmployeePermissionStatusCancelled,
+		}, nil)
+
+		permission, err := uc.CancelOwnEmployeePermission(ctx, user, 12)
+		require.NoError(t, err)
+		require.Equal(t, repo.EmployeePermissionStatusCancelled, permission.Status)
+	})
+
+	t.Run("reviewed request can not be cancelled", func(t *testing.T) {
+		mockStore := new(mocks.MockStore)
+		uc := NewEmployeePermissionUseCase(mockStore)
+		mockStore.On("GetEmployeeByUserID", ctx, pgtype.Int4{Int32: 4, Valid: true}).Return(repo.Employee{ID: 9}, nil)
+		mockStore.On("CancelEmployeePermission", ctx, cancelArg).Return(repo.EmployeePermission{}, pgx.ErrNoRows)
+		mockStore.On("GetEmployeePermission", ctx, int32(12)).Return(repo.GetEmployeePermissionRow{
+			ID:         12,
+			EmployeeID: 9,
+			Status:     repo.EmployeePermissionStatusApproved,
+		}, nil)
+
+		_, err := uc.CancelOwnEmployeePermission(ctx, user, 12)
+		require.IsType(t, &exception.AppError{}, err)
+		require.Equal(t, 400, err.(*exception.AppError).Code)
+	})
+
+	t.Run("request of another employee is not found", func(t *testing.T) {
+		mockStore := new(mocks.MockStore)
+		uc := NewEmployeePermissionUseCase(mockStore)
+		mockStore.On("GetEmployeeByUserID", ctx, pgtype.Int4{Int32: 4, Valid: true}).Return(repo.Employee{ID: 9}, nil)
+		mockStore.On("CancelEmployeePermission", ctx, cancelArg).Return(repo.EmployeePermission{}, pgx.ErrNoRows)
+		mockStore.On("GetEmployeePermission", ctx, int32(12)).Return(repo.GetEmployeePermissionRow{
+			ID:         12,
+			EmployeeID: 10,
+			Status:     repo.EmployeePermissionStatusPending,
+		}, nil)
+
+		_, err := uc.CancelOwnEmployeePermission(ctx, user, 12)
+		require.IsType(t, &exception.AppError{}, err)
+		require.Equal(t, 404, err.(*exception.AppError).Code)
+	})
+}
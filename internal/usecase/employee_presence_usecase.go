@@ -27,7 +27,7 @@ func (s *EmployeePresenceUseCase) CreatePresence(ctx context.Context, request *m
 	getEmployee, err := s.store.GetEmployeeByID(ctx, request.EmployeeID)
 	if err != nil {
 		if errors.Is(err, exception.ErrNotFound) {
-			return nil, exception.NewNotFoundError("Santri not found")
+			return nil, exception.NewNotFoundError("Employee not found")
 		}
 		return nil, err
 	}
@@ -37,6 +37,7 @@ func (s *EmployeePresenceUseCase) CreatePresence(ctx context.Context, request *m
 		ScheduleName:         request.ScheduleName,
 		Type:                 request.Type,
 		Notes:                pgtype.Text{String: request.Notes, Valid: request.Notes != ""},
+		CreatedAt:            pgtype.Timestamptz{Time: request.CreatedAt, Valid: !request.CreatedAt.IsZero()},
 		CreatedBy:            request.CreatedBy,
 		EmployeePermissionID: pgtype.Int4{Int32: request.EmployeePermissionID, Valid: request.EmployeePermissionID != 0},
 	})
@@ -53,7 +54,7 @@ func (s *EmployeePresenceUseCase) CreatePresence(ctx context.Context, request *m
 		EmployeeID:           result.EmployeeID,
 		CreatedAt:            result.CreatedAt.Time.Format("2006-01-02 15:04:05"),
 		Notes:                result.Notes.String,
-		EmployeePermissionID: result.EmployeeID,
+		EmployeePermissionID: result.EmployeePermissionID.Int32,
 		Schedule: model.IdAndName{
 			Id:   result.ScheduleID,
 			Name: result.ScheduleName,
@@ -87,9 +87,11 @@ func (s *EmployeeUseCase) GetByID(ctx context.Context, employeeId int32) (*model
 	}
 
 	return &model.Employee{
-		ID:   employee.ID,
-		Name: employee.Name,
-		NIP:  employee.Nip.String,
+		ID:           employee.ID,
+		Name:         employee.Name,
+		NIP:          employee.Nip.String,
+		Gender:       employee.Gender,
+		OccupationID: employee.OccupationID,
 	}, nil
 }
 
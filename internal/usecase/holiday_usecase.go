@@ -0,0 +1,237 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/exception"
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+	"github.com/adiubaidah/syafiiyah-main/pkg/util"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// maxHolidayDates keeps a mistyped range, e.g. a wrong year, from creating thousands of dates
+const maxHolidayDates = 366
+
+type HolidayUseCase struct {
+	store repo.Store
+}
+
+func NewHolidayUseCase(store repo.Store) *HolidayUseCase {
+	return &HolidayUseCase{store: store}
+}
+
+func (c *HolidayUseCase) CreateHoliday(ctx context.Context, request *model.CreateHolidayRequest) (*model.HolidayResponse, error) {
+	dates, err := holidayDates(request)
+	if err != nil {
+		return nil, err
+	}
+
+	holiday, err := c.store.CreateHolidayWithDates(ctx, repo.CreateHolidayParams{
+		Name:        request.Name,
+		Color:       pgtype.Text{String: request.Color, Valid: request.Color != ""},
+		Description: pgtype.Text{String: request.Description, Valid: request.Description != ""},
+	}, dates)
+	if err != nil {
+		return nil, err
+	}
+
+	return toHolidayResponse(holiday, dates), nil
+}
+
+// ListHolidays returns the holidays with their dates, ordered by their first date
+func (c *HolidayUseCase) ListHolidays(ctx context.Context, request *model.ListHolidayRequest) (*[]model.HolidayResponse, error) {
+	rows, err := c.store.ListHolidays(ctx, repo.ListHolidaysParams{
+		Q:     pgtype.Text{String: "%" + request.Q + "%", Valid: request.Q != ""},
+		Month: pgtype.Int4{Int32: request.Month, Valid: request.Month != 0},
+		Year:  pgtype.Int4{Int32: request.Year, Valid: request.Year != 0},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]model.HolidayResponse, 0)
+	indexes := make(map[int32]int)
+	for _, row := range rows {
+		index, ok := indexes[row.ID]
+		if !ok {
+			index = len(responses)
+			indexes[row.ID] = index
+			responses = append(responses, *toHolidayResponse(repo.Holiday{
+				ID:          row.ID,
+				Name:        row.Name,
+				Color:       row.Color,
+				Description: row.Description,
+			}, nil))
+		}
+		if row.HolidayDate.Valid {
+			responses[index].Dates = append(responses[index].Dates, row.HolidayDate.Time.Format("2006-01-02"))
+		}
+	}
+
+	return &responses, nil
+}
+
+func (c *HolidayUseCase) GetHoliday(ctx context.Context, id int32) (*model.HolidayResponse, error) {
+	holiday, err := c.store.GetHoliday(ctx, id)
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("Holiday not found")
+		}
+		return nil, err
+	}
+
+	dates, err := c.store.ListHolidayDatesByHolidayId(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toHolidayResponse(holiday, dates), nil
+}
+
+// UpdateHoliday replaces the holiday and all of its dates
+func (c *HolidayUseCase) UpdateHoliday(ctx context.Context, request *model.UpdateHolidayRequest, id int32) (*model.HolidayResponse, error) {
+	dates, err := holidayDates(request)
+	if err != nil {
+		return nil, err
+	}
+
+	holiday, err := c.store.UpdateHolidayWithDates(ctx, repo.UpdateHolidayParams{
+		ID:          id,
+		Name:        pgtype.Text{String: request.Name, Valid: request.Name != ""},
+		Color:       pgtype.Text{String: request.Color, Valid: request.Color != ""},
+		Description: pgtype.Text{String: request.Description, Valid: request.Description != ""},
+	}, dates)
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("Holiday not found")
+		}
+		return nil, err
+	}
+
+	return toHolidayResponse(holiday, dates), nil
+}
+
+func (c *HolidayUseCase) DeleteHoliday(ctx context.Context, id int32) (*model.HolidayResponse, error) {
+	holiday, err := c.store.DeleteHoliday(ctx, id)
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("Holiday not found")
+		}
+		return nil, err
+	}
+
+	return toHolidayResponse(holiday, nil), nil
+}
+
+// Calendar returns the holiday dates of the month, each with the holidays falling on it
+func (c *HolidayUseCase) Calendar(ctx context.Context, request *model.HolidayCalendarRequest) (*[]model.HolidayCalendarDay, error) {
+	rows, err := c.store.ListHolidays(ctx, repo.ListHolidaysParams{
+		Month: pgtype.Int4{Int32: request.Month, Valid: true},
+		Year:  pgtype.Int4{Int32: request.Year, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	days := make([]model.HolidayCalendarDay, 0)
+	for _, row := range rows {
+		date := row.HolidayDate.Time.Format("2006-01-02")
+		if len(days) == 0 || days[len(days)-1].Date != date {
+			days = append(days, model.HolidayCalendarDay{Date: date})
+		}
+		day := &days[len(days)-1]
+		day.Holidays = append(day.Holidays, model.HolidayCalendarItem{
+			ID:    row.ID,
+			Name:  row.Name,
+			Color: row.Color.String,
+		})
+	}
+
+	return &days, nil
+}
+
+// GetHolidayAt returns the holiday on the date of at, nil when it is not a holiday
+func (c *HolidayUseCase) GetHolidayAt(ctx context.Context, at time.Time) (*model.HolidayResponse, error) {
+	holiday, err := c.store.GetHolidayByDate(ctx, pgtype.Date{Time: at, Valid: true})
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return toHolidayResponse(holiday, nil), nil
+}
+
+// holidayDates expands the dates and ranges of the request to sorted dates without duplicates
+func holidayDates(request *model.CreateHolidayRequest) ([]pgtype.Date, error) {
+	seen := make(map[time.Time]struct{})
+	add := func(date time.Time) error {
+		seen[date] = struct{}{}
+		if len(seen) > maxHolidayDates {
+			return exception.NewValidationError(fmt.Sprintf("A holiday has at most %d dates", maxHolidayDates))
+		}
+		return nil
+	}
+
+	for _, value := range request.Dates {
+		date, err := util.ParseDate(value)
+		if err != nil {
+			return nil, exception.NewValidationError("Date is not valid")
+		}
+		if err := add(date); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, dateRange := range request.Ranges {
+		from, err := util.ParseDate(dateRange.From)
+		if err != nil {
+			return nil, exception.NewValidationError("From date is not valid")
+		}
+		to, err := util.ParseDate(dateRange.To)
+		if err != nil {
+			return nil, exception.NewValidationError("To date is not valid")
+		}
+		if to.Before(from) {
+			return nil, exception.NewValidationError("From date must not be after to date")
+		}
+		for date := from; !date.After(to); date = date.AddDate(0, 0, 1) {
+			if err := add(date); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if len(seen) == 0 {
+		return nil, exception.NewValidationError("A holiday needs at least one date")
+	}
+
+	dates := make([]pgtype.Date, 0, len(seen))
+	for date := range seen {
+		dates = append(dates, pgtype.Date{Time: date, Valid: true})
+	}
+	sort.Slice(dates, func(i, j int) bool {
+		return dates[i].Time.Before(dates[j].Time)
+	})
+	return dates, nil
+}
+
+func toHolidayResponse(holiday repo.Holiday, dates []pgtype.Date) *model.HolidayResponse {
+	response := &model.HolidayResponse{
+		ID:          holiday.ID,
+		Name:        holiday.Name,
+		Color:       holiday.Color.String,
+		Description: holiday.Description.String,
+		Dates:       make([]string, 0, len(dates)),
+	}
+	for _, date := range dates {
+		response.Dates = append(response.Dates, date.Time.Format("2006-01-02"))
+	}
+	return response
+}
@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/exception"
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+	mocks "github.com/adiubaidah/syafiiyah-main/internal/repository/mocks"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHolidayDates(t *testing.T) {
+	dates, err := holidayDates(&model.CreateHolidayRequest{
+		Dates:  []string{"2024-04-12", "2024-04-09"},
+		Ranges: []model.HolidayDateRange{{From: "2024-04-09", To: "2024-04-11"}},
+	})
+	require.NoError(t, err)
+	require.Len(t, dates, 4)
+	for i, expected := range []string{"2024-04-09", "2024-04-10", "2024-04-11", "2024-04-12"} {
+		require.Equal(t, expected, dates[i].Time.Format("2006-01-02"))
+	}
+
+	_, err = holidayDates(&model.CreateHolidayRequest{
+		Ranges: []model.HolidayDateRange{{From: "2024-04-11", To: "2024-04-09"}},
+	})
+	require.IsType(t, &exception.AppError{}, err)
+
+	_, err = holidayDates(&model.CreateHolidayRequest{
+		Ranges: []model.HolidayDateRange{{From: "2024-01-01", To: "2025-12-31"}},
+	})
+	require.IsType(t, &exception.AppError{}, err)
+}
+
+func TestHolidayUseCase_Calendar(t *testing.T) {
+	mockStore := new(mocks.MockStore)
+	uc := NewHolidayUseCase(mockStore)
+	ctx := context.Background()
+
+	date := func(value string) pgtype.Date {
+		parsed, _ := time.Parse("2006-01-02", value)
+		return pgtype.Date{Time: parsed, Valid: true}
+	}
+	mockStore.On("ListHolidays", ctx, repo.ListHolidaysParams{
+		Month: pgtype.Int4{Int32: 4, Valid: true},
+		Year:  pgtype.Int4{Int32: 2024, Valid: true},
+	}).Return([]repo.ListHolidaysRow{
+		{ID: 1, Name: "Idul Fitri", HolidayDate: date("2024-04-10")},
+		{ID: 2, Name: "Libur Pondok", HolidayDate: date("2024-04-10")},
+		{ID: 2, Name: "Libur Pondok", HolidayDate: date("2024-04-11")},
+	}, nil)
+
+	days, err := uc.Calendar(ctx, &model.HolidayCalendarRequest{Month: 4, Year: 2024})
+	require.NoError(t, err)
+	require.Len(t, *days, 2)
+	require.Equal(t, "2024-04-10", (*days)[0].Date)
+	require.Len(t, (*days)[0].Holidays, 2)
+	require.Equal(t, "Libur Pondok", (*days)[1].Holidays[0].Name)
+}
+
+func TestHolidayUseCase_CreateHoliday(t *testing.T) {
+	mockStore := new(mocks.MockStore)
+	uc := NewHolidayUseCase(mockStore)
+	ctx := context.Background()
+
+	mockStore.On("CreateHolidayWithDates", ctx, repo.CreateHolidayParams{Name: "Idul Adha"}, mock.MatchedBy(func(dates []pgtype.Date) bool {
+		return len(dates) == 2
+	})).Return(repo.Holiday{ID: 1, Name: "Idul Adha"}, nil)
+
+	holiday, err := uc.CreateHoliday(ctx, &model.CreateHolidayRequest{
+		Name:   "Idul Adha",
+		Ranges: []model.HolidayDateRange{{From: "2024-06-17", To: "2024-06-18"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), holiday.ID)
+	require.Len(t, holiday.Dates, 2)
+}
+
+func TestHolidayUseCase_ListHolidays(t *testing.T) {
+	mockStore := new(mocks.MockStore)
+	uc := NewHolidayUseCase(mockStore)
+	ctx := context.Background()
+
+	mockStore.On("ListHolidays", ctx, mock.Anything).Return([]repo.ListHolidaysRow{
+		{ID: 2, Name: "Libur Pondok", HolidayDate: pgtype.Date{Time: time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC), Valid: true}},
+		{ID: 2, Name: "Libur Pondok", HolidayDate: pgtype.Date{Time: time.Date(2024, 4, 11, 0, 0, 0, 0, time.UTC), Valid: true}},
+		{ID: 3, Name: "Belum ada tanggal"},
+	}, nil)
+
+	holidays, err := uc.ListHolidays(ctx, &model.ListHolidayRequest{})
+	require.NoError(t, err)
+	require.Len(t, *holidays, 2)
+	require.Equal(t, []string{"2024-04-10", "2024-04-11"}, (*holidays)[0].Dates)
+	require.Empty(t, (*holidays)[1].Dates)
+}
@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/exception"
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type LocationUseCase struct {
+	store repo.Store
+}
+
+func NewLocationUseCase(store repo.Store) *LocationUseCase {
+	return &LocationUseCase{store: store}
+}
+
+func (c *LocationUseCase) CreateLocation(ctx context.Context, request *model.CreateLocationRequest) (*model.LocationResponse, error) {
+	location, err := c.store.CreateLocation(ctx, repo.CreateLocationParams{
+		Name:        request.Name,
+		Description: pgtype.Text{String: request.Description, Valid: request.Description != ""},
+	})
+	if err != nil {
+		if exception.DatabaseErrorCode(err) == exception.ErrCodeUniqueViolation {
+			return nil, exception.NewUniqueViolationError("Location name already exists", err)
+		}
+		return nil, err
+	}
+
+	return toLocationResponse(location), nil
+}
+
+func (c *LocationUseCase) ListLocations(ctx context.Context) (*[]model.LocationResponse, error) {
+	locations, err := c.store.ListLocations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]model.LocationResponse, 0, len(locations))
+	for _, location := range locations {
+		responses = append(responses, *toLocationResponse(location))
+	}
+
+	return &responses, nil
+}
+
+func (c *LocationUseCase) UpdateLocation(ctx context.Context, request *model.UpdateLocationRequest, locationId int32) (*model.LocationResponse, error) {
+	location, err := c.store.UpdateLocation(ctx, repo.UpdateLocationParams{
+		ID:          locationId,
+		Name:        pgtype.Text{String: request.Name, Valid: request.Name != ""},
+		Description: pgtype.Text{String: request.Description, Valid: request.Description != ""},
+	})
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("Location not found")
+		}
+		if exception.DatabaseErrorCode(err) == exception.ErrCodeUniqueViolation {
+			return nil, exception.NewUniqueViolationError("Location name already exists", err)
+		}
+		return nil, err
+	}
+
+	return toLocationResponse(location), nil
+}
+
+// DeleteLocation removes the location, devices bound to it are left without location
+func (c *LocationUseCase) DeleteLocation(ctx context.Context, locationId int32) (*model.LocationResponse, error) {
+	location, err := c.store.DeleteLocation(ctx, locationId)
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("Location not found")
+		}
+		return nil, err
+	}
+
+	return toLocationResponse(location), nil
+}
+
+func toLocationResponse(location repo.Location) *model.LocationResponse {
+	return &model.LocationResponse{
+		ID:          location.ID,
+		Name:        location.Name,
+		Description: location.Description.String,
+	}
+}
@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/exception"
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+	"github.com/adiubaidah/syafiiyah-main/pkg/token"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// defaultQRCredentialDuration is used when QR_TOKEN_DURATION is not configured
+const defaultQRCredentialDuration = time.Minute
+
+type QRCredentialUseCase struct {
+	store repo.Store
+	// maker is nil when QR credentials are not enabled
+	maker    *token.QRMaker
+	duration time.Duration
+}
+
+func NewQRCredentialUseCase(store repo.Store, maker *token.QRMaker, duration time.Duration) *QRCredentialUseCase {
+	if duration <= 0 {
+		duration = defaultQRCredentialDuration
+	}
+	return &QRCredentialUseCase{store: store, maker: maker, duration: duration}
+}
+
+// Issue signs a short lived QR credential of the owner, after checking the user may show it
+func (c *QRCredentialUseCase) Issue(ctx context.Context, user *model.User, request *model.QRCredentialRequest) (*model.QRCredentialResponse, error) {
+	if c.maker == nil {
+		return nil, exception.NewForbiddenError("QR credential is not enabled")
+	}
+
+	var owner *model.OwenerDetails
+	var err error
+	switch request.OwnerRole {
+	case repo.RoleTypeSantri:
+		owner, err = c.santriOwner(ctx, user, request.OwnerID)
+	case repo.RoleTypeEmployee:
+		owner, err = c.employeeOwner(ctx, user, request.OwnerID)
+	default:
+		owner, err = c.userEmployeeOwner(ctx, user)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	qrToken, payload, err := c.maker.CreateToken(owner.Role, owner.ID, c.duration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.QRCredentialResponse{
+		Token:     qrToken,
+		Owner:     *owner,
+		ExpiresAt: payload.ExpiresAt.Time.Format("2006-01-02 15:04:05"),
+	}, nil
+}
+
+func (c *QRCredentialUseCase) santriOwner(ctx context.Context, user *model.User, santriID int32) (*model.OwenerDetails, error) {
+	santri, err := c.store.GetSantri(ctx, santriID)
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("Santri not found")
+		}
+		return nil, err
+	}
+
+	switch user.Role {
+	case repo.RoleTypeSuperadmin, repo.RoleTypeAdmin:
+	case repo.RoleTypeParent:
+		parent, err := c.store.GetParentByUserId(ctx, pgtype.Int4{Int32: user.ID, Valid: true})
+		if err != nil {
+			if errors.Is(err, exception.ErrNotFound) {
+				return nil, exception.NewForbiddenError("Santri is not your child")
+			}
+			return nil, err
+		}
+		if !santri.ParentID.Valid || santri.ParentID.Int32 != parent.ID {
+			return nil, exception.NewForbiddenError("Santri is not your child")
+		}
+	default:
+		return nil, exception.NewForbiddenError("You are not allowed to get the QR credential of this santri")
+	}
+
+	if santri.IsActive.Valid && !santri.IsActive.Bool {
+		return nil, exception.NewForbiddenError("Santri is not active")
+	}
+
+	return &model.OwenerDetails{
+		ID:           santri.ID,
+		Role:         repo.RoleTypeSantri,
+		Name:         santri.Name,
+		OccupationID: santri.OccupationID.Int32,
+	}, nil
+}
+
+func (c *QRCredentialUseCase) employeeOwner(ctx context.Context, user *model.User, employeeID int32) (*model.OwenerDetails, error) {
+	employee, err := c.store.GetEmployeeByID(ctx, employeeID)
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("Employee not found")
+		}
+		return nil, err
+	}
+
+	if user.Role != repo.RoleTypeSuperadmin && user.Role != repo.RoleTypeAdmin && employee.UserID.Int32 != user.ID {
+		return nil, exception.NewForbiddenError("You are not allowed to get the QR credential of this employee")
+	}
+
+	return &model.OwenerDetails{
+		ID:           employee.ID,
+		Role:         repo.RoleTypeEmployee,
+		Name:         employee.Name,
+		OccupationID: employee.OccupationID,
+	}, nil
+}
+
+func (c *QRCredentialUseCase) userEmployeeOwner(ctx context.Context, user *model.User) (*model.OwenerDetails, error) {
+	employee, err := c.store.GetEmployeeByUserID(ctx, pgtype.Int4{Int32: user.ID, Valid: true})
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("User has no employee profile, owner-role and owner-id are required")
+		}
+		return nil, err
+	}
+
+	return &model.OwenerDetails{
+		ID:           employee.ID,
+		Role:         repo.RoleTypeEmployee,
+		Name:         employee.Name,
+		OccupationID: employee.OccupationID,
+	}, nil
+}
@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/exception"
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+	mocks "github.com/adiubaidah/syafiiyah-main/internal/repository/mocks"
+	"github.com/adiubaidah/syafiiyah-main/pkg/random"
+	"github.com/adiubaidah/syafiiyah-main/pkg/token"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQRCredentialUseCase_Issue(t *testing.T) {
+	maker, err := token.NewQRMaker(random.RandomString(32))
+	require.NoError(t, err)
+
+	mockStore := new(mocks.MockStore)
+	uc := NewQRCredentialUseCase(mockStore, maker, time.Minute)
+	ctx := context.Background()
+
+	parentUser := &model.User{ID: 5, Role: repo.RoleTypeParent}
+	mockStore.On("GetParentByUserId", ctx, pgtype.Int4{Int32: 5, Valid: true}).Return(repo.Parent{ID: 2}, nil)
+	mockStore.On("GetSantri", ctx, int32(10)).Return(repo.GetSantriRow{
+		ID:       10,
+		Name:     "Ahmad",
+		IsActive: pgtype.Bool{Bool: true, Valid: true},
+		ParentID: pgtype.Int4{Int32: 2, Valid: true},
+	}, nil)
+	mockStore.On("GetSantri", ctx, int32(11)).Return(repo.GetSantriRow{
+		ID:       11,
+		Name:     "Fatimah",
+		IsActive: pgtype.Bool{Bool: true, Valid: true},
+		ParentID: pgtype.Int4{Int32: 3, Valid: true},
+	}, nil)
+
+	t.Run("parent gets the credential of their santri", func(t *testing.T) {
+		result, err := uc.Issue(ctx, parentUser, &model.QRCredentialRequest{OwnerRole: repo.RoleTypeSantri, OwnerID: 10})
+		require.NoError(t, err)
+		require.Equal(t, "Ahmad", result.Owner.Name)
+
+		payload, err := maker.VerifyToken(result.Token)
+		require.NoError(t, err)
+		require.Equal(t, repo.RoleTypeSantri, payload.OwnerRole)
+		require.Equal(t, int32(10), payload.OwnerID)
+	})
+
+	t.Run("parent of another santri", func(t *testing.T) {
+		_, err := uc.Issue(ctx, parentUser, &model.QRCredentialRequest{OwnerRole: repo.RoleTypeSantri, OwnerID: 11})
+		appErr, ok := err.(*exception.AppError)
+		require.True(t, ok)
+		require.Equal(t, 403, appErr.Code)
+	})
+
+	t.Run("employee gets their own credential", func(t *testing.T) {
+		mockStore.On("GetEmployeeByUserID", ctx, pgtype.Int4{Int32: 7, Valid: true}).Return(repo.Employee{ID: 4, Name: "Umar", OccupationID: 1}, nil)
+
+		result, err := uc.Issue(ctx, &model.User{ID: 7, Role: repo.RoleTypeEmployee}, &model.QRCredentialRequest{})
+		require.NoError(t, err)
+		require.Equal(t, repo.RoleTypeEmployee, result.Owner.Role)
+		require.Equal(t, int32(4), result.Owner.ID)
+	})
+}
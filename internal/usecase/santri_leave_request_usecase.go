@@ -0,0 +1,289 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/exception"
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// santriLeaveRequestTransitions lists the statuses a leave request can move to, rejected, cancelled and completed are final
+var santriLeaveRequestTransitions = map[repo.LeaveRequestStatus][]repo.LeaveRequestStatus{
+	repo.LeaveRequestStatusPending:  {repo.LeaveRequestStatusApproved, repo.LeaveRequestStatusRejected, repo.LeaveRequestStatusCancelled},
+	repo.LeaveRequestStatusApproved: {repo.LeaveRequestStatusCancelled, repo.LeaveRequestStatusCompleted},
+}
+
+type SantriLeaveRequestUseCase interface {
+	SubmitSantriLeaveRequest(ctx context.Context, user *model.User, request *model.SubmitSantriLeaveRequest) (*model.SantriLeaveRequestResponse, error)
+	ListSantriLeaveRequests(ctx context.Context, user *model.User, request *model.ListSantriLeaveRequestRequest) (*[]model.SantriLeaveRequestResponse, error)
+	CountSantriLeaveRequests(ctx context.Context, user *model.User, request *model.ListSantriLeaveRequestRequest) (int64, error)
+	GetSantriLeaveRequest(ctx context.Context, user *model.User, leaveRequestID int32) (*model.SantriLeaveRequestResponse, error)
+	ReviewSantriLeaveRequest(ctx context.Context, user *model.User, request *model.ReviewSantriLeaveRequest, leaveRequestID int32) (*model.SantriLeaveRequestResponse, error)
+	CancelSantriLeaveRequest(ctx context.Context, user *model.User, request *model.SantriLeaveRequestComment, leaveRequestID int32) (*model.SantriLeaveRequestResponse, error)
+	CompleteSantriLeaveRequest(ctx context.Context, user *model.User, request *model.SantriLeaveRequestComment, leaveRequestID int32) (*model.SantriLeaveRequestResponse, error)
+}
+
+type santriLeaveRequestService struct {
+	store repo.Store
+}
+
+func NewSantriLeaveRequestUseCase(store repo.Store) SantriLeaveRequestUseCase {
+	return &santriLeaveRequestService{store: store}
+}
+
+// SubmitSantriLeaveRequest files a leave request of the parent of the user for one of their santri,
+// it stays pending until an admin reviews it
+func (s *santriLeaveRequestService) SubmitSantriLeaveRequest(ctx context.Context, user *model.User, request *model.SubmitSantriLeaveRequest) (*model.SantriLeaveRequestResponse, error) {
+	if !request.EndLeave.After(request.StartLeave) {
+		return nil, exception.NewValidationError("end_leave must be after start_leave")
+	}
+
+	parent, err := s.userParent(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	santri, err := s.store.GetSantri(ctx, request.SantriID)
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("Santri not found")
+		}
+		return nil, err
+	}
+	if !santri.ParentID.Valid || santri.ParentID.Int32 != parent.ID {
+		return nil, exception.NewForbiddenError("Santri is not linked to the parent")
+	}
+
+	created, err := s.store.CreateSantriLeaveRequestWithHistory(ctx, repo.CreateSantriLeaveRequestParams{
+		SantriID:   request.SantriID,
+		ParentID:   parent.ID,
+		Type:       request.Type,
+		Reason:     request.Reason,
+		StartLeave: pgtype.Timestamptz{Time: request.StartLeave, Valid: true},
+		EndLeave:   pgtype.Timestamptz{Time: request.EndLeave, Valid: true},
+		Attachment: pgtype.Text{String: request.Attachment, Valid: request.Attachment != ""},
+	}, repo.CreateSantriLeaveRequestHistoryParams{
+		ActorID: pgtype.Int4{Int32: user.ID, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetSantriLeaveRequest(ctx, user, created.ID)
+}
+
+// ListSantriLeaveRequests lists every leave request for admins, a parent only gets their own
+func (s *santriLeaveRequestService) ListSantriLeaveRequests(ctx context.Context, user *model.User, request *model.ListSantriLeaveRequestRequest) (*[]model.SantriLeaveRequestResponse, error) {
+	filter, err := s.santriLeaveRequestFilter(ctx, user, request)
+	if err != nil {
+		return nil, err
+	}
+
+	leaveRequests, err := s.store.ListSantriLeaveRequests(ctx, repo.ListSantriLeaveRequestsParams{
+		Q:            filter.Q,
+		SantriID:     filter.SantriID,
+		ParentID:     filter.ParentID,
+		Status:       filter.Status,
+		OffsetNumber: (request.Page - 1) * request.Limit,
+		LimitNumber:  request.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]model.SantriLeaveRequestResponse, 0, len(leaveRequests))
+	for _, leaveRequest := range leaveRequests {
+		response = append(response, *toSantriLeaveRequestResponse(leaveRequest))
+	}
+
+	return &response, nil
+}
+
+func (s *santriLeaveRequestService) CountSantriLeaveRequests(ctx context.Context, user *model.User, request *model.ListSantriLeaveRequestRequest) (int64, error) {
+	filter, err := s.santriLeaveRequestFilter(ctx, user, request)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.store.CountSantriLeaveRequests(ctx, *filter)
+}
+
+// GetSantriLeaveRequest returns the leave request with its history,
+// the leave request of another parent is not found for a parent
+func (s *santriLeaveRequestService) GetSantriLeaveRequest(ctx context.Context, user *model.User, leaveRequestID int32) (*model.SantriLeaveRequestResponse, error) {
+	leaveRequest, err := s.get(ctx, user, leaveRequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	histories, err := s.store.ListSantriLeaveRequestHistories(ctx, leaveRequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := toSantriLeaveRequestResponse(repo.ListSantriLeaveRequestsRow(*leaveRequest))
+	response.History = make([]model.SantriLeaveRequestHistoryResponse, 0, len(histories))
+	for _, history := range histories {
+		response.History = append(response.History, model.SantriLeaveRequestHistoryResponse{
+			FromStatus:    history.FromStatus.LeaveRequestStatus,
+			ToStatus:      history.ToStatus,
+			ActorID:       history.ActorID.Int32,
+			ActorUsername: history.ActorUsername.String,
+			Comment:       history.Comment.String,
+			CreatedAt:     history.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return response, nil
+}
+
+// ReviewSantriLeaveRequest approves or rejects a pending leave request,
+// approving creates the santri permission that covers the leave
+func (s *santriLeaveRequestService) ReviewSantriLeaveRequest(ctx context.Context, user *model.User, request *model.ReviewSantriLeaveRequest, leaveRequestID int32) (*model.SantriLeaveRequestResponse, error) {
+	leaveRequest, err := s.get(ctx, user, leaveRequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.changeStatus(ctx, user, leaveRequest, request.Status, request.Comment, true)
+}
+
+// CancelSantriLeaveRequest withdraws the leave request. A parent can cancel their request while it is pending,
+// an admin can also cancel an approved one, the santri permission is then deleted.
+func (s *santriLeaveRequestService) CancelSantriLeaveRequest(ctx context.Context, user *model.User, request *model.SantriLeaveRequestComment, leaveRequestID int32) (*model.SantriLeaveRequestResponse, error) {
+	leaveRequest, err := s.get(ctx, user, leaveRequestID)
+	if err != nil {
+		return nil, err
+	}
+	if user.Role == repo.RoleTypeParent && leaveRequest.Status != repo.LeaveRequestStatusPending {
+		return nil, exception.NewValidationError("Only a pending leave request can be cancelled")
+	}
+
+	return s.changeStatus(ctx, user, leaveRequest, repo.LeaveRequestStatusCancelled, request.Comment, false)
+}
+
+// CompleteSantriLeaveRequest records that the santri is back, the santri permission ends now when it would run longer
+func (s *santriLeaveRequestService) CompleteSantriLeaveRequest(ctx context.Context, user *model.User, request *model.SantriLeaveRequestComment, leaveRequestID int32) (*model.SantriLeaveRequestResponse, error) {
+	leaveRequest, err := s.get(ctx, user, leaveRequestID)
+	if err != nil {
+		return nil, err
+	}
+	if leaveRequest.Status == repo.LeaveRequestStatusApproved && leaveRequest.StartLeave.Time.After(time.Now()) {
+		return nil, exception.NewValidationError("Leave has not started yet, cancel it instead")
+	}
+
+	return s.changeStatus(ctx, user, leaveRequest, repo.LeaveRequestStatusCompleted, request.Comment, false)
+}
+
+func (s *santriLeaveRequestService) get(ctx context.Context, user *model.User, leaveRequestID int32) (*repo.GetSantriLeaveRequestRow, error) {
+	leaveRequest, err := s.store.GetSantriLeaveRequest(ctx, leaveRequestID)
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("Leave request not found")
+		}
+		return nil, err
+	}
+
+	if user.Role == repo.RoleTypeParent {
+		parent, err := s.userParent(ctx, user)
+		if err != nil {
+			return nil, err
+		}
+		if leaveRequest.ParentID != parent.ID {
+			return nil, exception.NewNotFoundError("Leave request not found")
+		}
+	}
+
+	return &leaveRequest, nil
+}
+
+// changeStatus moves the leave request to the status when santriLeaveRequestTransitions allows it,
+// the comment goes to the history and, for a review, to the leave request as well
+func (s *santriLeaveRequestService) changeStatus(ctx context.Context, user *model.User, leaveRequest *repo.GetSantriLeaveRequestRow, status repo.LeaveRequestStatus, comment string, review bool) (*model.SantriLeaveRequestResponse, error) {
+	allowed := false
+	for _, next := range santriLeaveRequestTransitions[leaveRequest.Status] {
+		if next == status {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return nil, exception.NewValidationError(fmt.Sprintf("Leave request cannot move from %s to %s", leaveRequest.Status, status))
+	}
+
+	arg := repo.UpdateSantriLeaveRequestStatusParams{
+		ID:         leaveRequest.ID,
+		Status:     status,
+		FromStatus: leaveRequest.Status,
+	}
+	if review {
+		arg.ReviewComment = pgtype.Text{String: comment, Valid: comment != ""}
+	}
+
+	_, err := s.store.ChangeSantriLeaveRequestStatus(ctx, arg, repo.CreateSantriLeaveRequestHistoryParams{
+		ActorID: pgtype.Int4{Int32: user.ID, Valid: user.ID != 0},
+		Comment: pgtype.Text{String: comment, Valid: comment != ""},
+	})
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewValidationError(fmt.Sprintf("Leave request is no longer %s", leaveRequest.Status))
+		}
+		return nil, err
+	}
+
+	return s.GetSantriLeaveRequest(ctx, user, leaveRequest.ID)
+}
+
+func (s *santriLeaveRequestService) userParent(ctx context.Context, user *model.User) (*repo.Parent, error) {
+	parent, err := s.store.GetParentByUserId(ctx, pgtype.Int4{Int32: user.ID, Valid: true})
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewForbiddenError("User has no parent profile")
+		}
+		return nil, err
+	}
+	return &parent, nil
+}
+
+// santriLeaveRequestFilter turns the list request into the filter shared by the list and the count query,
+// a parent is limited to their own leave requests
+func (s *santriLeaveRequestService) santriLeaveRequestFilter(ctx context.Context, user *model.User, request *model.ListSantriLeaveRequestRequest) (*repo.CountSantriLeaveRequestsParams, error) {
+	filter := &repo.CountSantriLeaveRequestsParams{
+		Q:        pgtype.Text{String: request.Q, Valid: request.Q != ""},
+		SantriID: pgtype.Int4{Int32: request.SantriID, Valid: request.SantriID != 0},
+		Status:   repo.NullLeaveRequestStatus{LeaveRequestStatus: request.Status, Valid: request.Status != ""},
+	}
+
+	if user.Role == repo.RoleTypeParent {
+		parent, err := s.userParent(ctx, user)
+		if err != nil {
+			return nil, err
+		}
+		filter.ParentID = pgtype.Int4{Int32: parent.ID, Valid: true}
+	}
+
+	return filter, nil
+}
+
+func toSantriLeaveRequestResponse(row repo.ListSantriLeaveRequestsRow) *model.SantriLeaveRequestResponse {
+	return &model.SantriLeaveRequestResponse{
+		ID:                 row.ID,
+		Santri:             model.IdAndName{Id: row.SantriID, Name: row.SantriName},
+		Parent:             model.IdAndName{Id: row.ParentID, Name: row.ParentName},
+		Type:               row.Type,
+		Reason:             row.Reason,
+		StartLeave:         row.StartLeave.Time.Format("2006-01-02 15:04:05"),
+		EndLeave:           row.EndLeave.Time.Format("2006-01-02 15:04:05"),
+		Attachment:         row.Attachment.String,
+		Status:             row.Status,
+		ReviewComment:      row.ReviewComment.String,
+		SantriPermissionID: row.SantriPermissionID.Int32,
+		CreatedAt:          row.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+		UpdatedAt:          row.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+}
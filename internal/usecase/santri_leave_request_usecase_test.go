@@ -0,0 +1,159 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/exception"
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+	mocks "github.com/adiubaidah/syafiiyah-main/internal/repository/mocks"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSantriLeaveRequestUseCase_Submit(t *testing.T) {
+	ctx := context.Background()
+	user := &model.User{ID: 4, Role: repo.RoleTypeParent}
+	start := time.Date(2024, 7, 1, 8, 0, 0, 0, time.Local)
+	request := &model.SubmitSantriLeaveRequest{
+		SantriID:   1,
+		Type:       repo.PermissionTypePermission,
+		Reason:     "Acara keluarga",
+		StartLeave: start,
+		EndLeave:   start.Add(48 * time.Hour),
+	}
+
+	t.Run("end before start is refused", func(t *testing.T) {
+		mockStore := new(mocks.MockStore)
+		uc := NewSantriLeaveRequestUseCase(mockStore)
+
+		_, err := uc.SubmitSantriLeaveRequest(ctx, user, &model.SubmitSantriLeaveRequest{
+			SantriID:   1,
+			Type:       repo.PermissionTypeSick,
+			Reason:     "Demam",
+			StartLeave: start,
+			EndLeave:   start,
+		})
+		require.IsType(t, &exception.AppError{}, err)
+		require.Equal(t, 400, err.(*exception.AppError).Code)
+		mockStore.AssertNotCalled(t, "GetParentByUserId", mock.Anything, mock.Anything)
+	})
+
+	t.Run("user without parent profile is refused", func(t *testing.T) {
+		mockStore := new(mocks.MockStore)
+		uc := NewSantriLeaveRequestUseCase(mockStore)
+		mockStore.On("GetParentByUserId", ctx, pgtype.Int4{Int32: 4, Valid: true}).Return(repo.Parent{}, pgx.ErrNoRows)
+
+		_, err := uc.SubmitSantriLeaveRequest(ctx, user, request)
+		require.IsType(t, &exception.AppError{}, err)
+		require.Equal(t, 403, err.(*exception.AppError).Code)
+	})
+
+	t.Run("santri of another parent is refused", func(t *testing.T) {
+		mockStore := new(mocks.MockStore)
+		uc := NewSantriLeaveRequestUseCase(mockStore)
+		mockStore.On("GetParentByUserId", ctx, pgtype.Int4{Int32: 4, Valid: true}).Return(repo.Parent{ID: 7}, nil)
+		mockStore.On("GetSantri", ctx, int32(1)).Return(repo.GetSantriRow{ID: 1, ParentID: pgtype.Int4{Int32: 8, Valid: true}}, nil)
+
+		_, err := uc.SubmitSantriLeaveRequest(ctx, user, request)
+		require.IsType(t, &exception.AppError{}, err)
+		require.Equal(t, 403, err.(*exception.AppError).Code)
+		mockStore.AssertNotCalled(t, "CreateSantriLeaveRequest", mock.Anything, mock.Anything)
+	})
+}
+
+func TestSantriLeaveRequestUseCase_Status(t *testing.T) {
+	ctx := context.Background()
+	parentUser := &model.User{ID: 4, Role: repo.RoleTypeParent}
+	adminUser := &model.User{ID: 1, Role: repo.RoleTypeAdmin}
+	leaveRequest := func(status repo.LeaveRequestStatus, start time.Time) repo.GetSantriLeaveRequestRow {
+		return repo.GetSantriLeaveRequestRow{
+			ID:         3,
+			SantriID:   1,
+			ParentID:   7,
+			Type:       repo.PermissionTypePermission,
+			StartLeave: pgtype.Timestamptz{Time: start, Valid: true},
+			EndLeave:   pgtype.Timestamptz{Time: start.Add(48 * time.Hour), Valid: true},
+			Status:     status,
+		}
+	}
+
+	t.Run("leave request of another parent is not found", func(t *testing.T) {
+		mockStore := new(mocks.MockStore)
+		uc := NewSantriLeaveRequestUseCase(mockStore)
+		mockStore.On("GetSantriLeaveRequest", ctx, int32(3)).Return(leaveRequest(repo.LeaveRequestStatusPending, time.Now()), nil)
+		mockStore.On("GetParentByUserId", ctx, pgtype.Int4{Int32: 4, Valid: true}).Return(repo.Parent{ID: 8}, nil)
+
+		_, err := uc.GetSantriLeaveRequest(ctx, parentUser, 3)
+		require.IsType(t, &exception.AppError{}, err)
+		require.Equal(t, 404, err.(*exception.AppError).Code)
+	})
+
+	t.Run("parent cannot cancel an approved leave request", func(t *testing.T) {
+		mockStore := new(mocks.MockStore)
+		uc := NewSantriLeaveRequestUseCase(mockStore)
+		mockStore.On("GetSantriLeaveRequest", ctx, int32(3)).Return(leaveRequest(repo.LeaveRequestStatusApproved, time.Now()), nil)
+		mockStore.On("GetParentByUserId", ctx, pgtype.Int4{Int32: 4, Valid: true}).Return(repo.Parent{ID: 7}, nil)
+
+		_, err := uc.CancelSantriLeaveRequest(ctx, parentUser, &model.SantriLeaveRequestComment{}, 3)
+		require.IsType(t, &exception.AppError{}, err)
+		require.Equal(t, 400, err.(*exception.AppError).Code)
+	})
+
+	t.Run("reviewed leave request cannot be reviewed again", func(t *testing.T) {
+		mockStore := new(mocks.MockStore)
+		uc := NewSantriLeaveRequestUseCase(mockStore)
+		mockStore.On("GetSantriLeaveRequest", ctx, int32(3)).Return(leaveRequest(repo.LeaveRequestStatusRejected, time.Now()), nil)
+
+		_, err := uc.ReviewSantriLeaveRequest(ctx, adminUser, &model.ReviewSantriLeaveRequest{Status: repo.LeaveRequestStatusApproved}, 3)
+		require.IsType(t, &exception.AppError{}, err)
+		require.Equal(t, "Leave request cannot move from rejected to approved", err.(*exception.AppError).Message)
+	})
+
+	t.Run("pending leave request is approved with the comment", func(t *testing.T) {
+		mockStore := new(mocks.MockStore)
+		uc := NewSantriLeaveRequestUseCase(mockStore)
+		mockStore.On("GetSantriLeaveRequest", ctx, int32(3)).Return(leaveRequest(repo.LeaveRequestStatusPending, time.Now()), nil)
+		mockStore.On("ChangeSantriLeaveRequestStatus", ctx, repo.UpdateSantriLeaveRequestStatusParams{
+			ID:            3,
+			Status:        repo.LeaveRequestStatusApproved,
+			FromStatus:    repo.LeaveRequestStatusPending,
+			ReviewComment: pgtype.Text{String: "Hati-hati di jalan", Valid: true},
+		}, repo.CreateSantriLeaveRequestHistoryParams{
+			ActorID: pgtype.Int4{Int32: 1, Valid: true},
+			Comment: pgtype.Text{String: "Hati-hati di jalan", Valid: true},
+		}).Return(repo.SantriLeaveRequest{ID: 3}, nil)
+		mockStore.On("ListSantriLeaveRequestHistories", ctx, int32(3)).Return([]repo.ListSantriLeaveRequestHistoriesRow{}, nil)
+
+		_, err := uc.ReviewSantriLeaveRequest(ctx, adminUser, &model.ReviewSantriLeaveRequest{
+			Status:  repo.LeaveRequestStatusApproved,
+			Comment: "Hati-hati di jalan",
+		}, 3)
+		require.NoError(t, err)
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("pending leave request cannot be completed", func(t *testing.T) {
+		mockStore := new(mocks.MockStore)
+		uc := NewSantriLeaveRequestUseCase(mockStore)
+		mockStore.On("GetSantriLeaveRequest", ctx, int32(3)).Return(leaveRequest(repo.LeaveRequestStatusPending, time.Now().Add(-time.Hour)), nil)
+
+		_, err := uc.CompleteSantriLeaveRequest(ctx, adminUser, &model.SantriLeaveRequestComment{}, 3)
+		require.IsType(t, &exception.AppError{}, err)
+		require.Equal(t, 400, err.(*exception.AppError).Code)
+	})
+
+	t.Run("leave that has not started cannot be completed", func(t *testing.T) {
+		mockStore := new(mocks.MockStore)
+		uc := NewSantriLeaveRequestUseCase(mockStore)
+		mockStore.On("GetSantriLeaveRequest", ctx, int32(3)).Return(leaveRequest(repo.LeaveRequestStatusApproved, time.Now().Add(time.Hour)), nil)
+
+		_, err := uc.CompleteSantriLeaveRequest(ctx, adminUser, &model.SantriLeaveRequestComment{}, 3)
+		require.IsType(t, &exception.AppError{}, err)
+		require.Equal(t, "Leave has not started yet, cancel it instead", err.(*exception.AppError).Message)
+	})
+}
@@ -1 +1,308 @@
 package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/exception"
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type SantriPermissionUseCase interface {
+	CreateSantriPermission(ctx context.Context, request *model.CreateSantriPermissionRequest) (*model.SantriPermissionResponse, error)
+	ListSantriPermissions(ctx context.Context, request *model.ListSantriPermissionRequest) (*[]model.SantriPermissionResponse, error)
+	CountSantriPermissions(ctx context.Context, request *model.ListSantriPermissionRequest) (int64, error)
+	GetSantriPermission(ctx context.Context, santriPermissionID int32) (*model.SantriPermissionResponse, error)
+	UpdateSantriPermission(ctx context.Context, request *model.UpdateSantriPermissionRequest, santriPermissionID int32) (*model.SantriPermissionResponse, error)
+	DeleteSantriPermission(ctx context.Context, santriPermissionID int32) (*model.SantriPermissionResponse, error)
+	GetActiveSantriPermission(ctx context.Context, santriID int32, at time.Time) (*model.SantriPermissionResponse, error)
+	CloseSantriPermission(ctx context.Context, santriPermissionID int32, at time.Time) (*model.SantriPermissionResponse, error)
+	MarkSantriPermissionLeft(ctx context.Context, santriPermissionID int32, at time.Time) (*model.SantriPermissionResponse, error)
+}
+
+type santriPermissionService struct {
+	store repo.Store
+}
+
+func NewSantriPermissionUseCase(store repo.Store) SantriPermissionUseCase {
+	return &santriPermissionService{store: store}
+}
+
+// CreateSantriPermission creates the permission, alpha presences of the santri already recorded
+// within the permission become permission or sick presences
+func (s *santriPermissionService) CreateSantriPermission(ctx context.Context, request *model.CreateSantriPermissionRequest) (*model.SantriPermissionResponse, error) {
+	if request.EndPermission != nil && !request.EndPermission.After(request.StartPermission) {
+		return nil, exception.NewValidationError("end_permission must be after start_permission")
+	}
+
+	santri, err := s.store.GetSantri(ctx, request.SantriID)
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("Santri not found")
+		}
+		return nil, err
+	}
+
+	arg := repo.CreateSantriPermissionParams{
+		SantriID:        request.SantriID,
+		StartPermission: pgtype.Timestamptz{Time: request.StartPermission, Valid: true},
+		Type:            request.Type,
+		Excuse:          request.Excuse,
+	}
+	if request.EndPermission != nil {
+		arg.EndPermission = pgtype.Timestamptz{Time: *request.EndPermission, Valid: true}
+	}
+
+	createdPermission, _, err := s.store.CreateSantriPermissionWithPresences(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+
+	response := toSantriPermissionResponse(createdPermission)
+	response.Santri.Name = santri.Name
+	return response, nil
+}
+
+func (s *santriPermissionService) ListSantriPermissions(ctx context.Context, request *model.ListSantriPermissionRequest) (*[]model.SantriPermissionResponse, error) {
+	filter, err := santriPermissionFilter(request)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions, err := s.store.ListSantriPermissions(ctx, repo.ListSantriPermissionsParams{
+		Q:            filter.Q,
+		SantriID:     filter.SantriID,
+		Type:         filter.Type,
+		FromDate:     filter.FromDate,
+		EndDate:      filter.EndDate,
+		ActiveAt:     filter.ActiveAt,
+		OffsetNumber: (request.Page - 1) * request.Limit,
+		LimitNumber:  request.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]model.SantriPermissionResponse, 0, len(permissions))
+	for _, permission := range permissions {
+		item := toSantriPermissionResponse(repo.SantriPermission{
+			ID:              permission.ID,
+			SantriID:        permission.SantriID,
+			Type:            permission.Type,
+			StartPermission: permission.StartPermission,
+			EndPermission:   permission.EndPermission,
+			Excuse:          permission.Excuse,
+			LeftAt:          permission.LeftAt,
+		})
+		item.Santri.Name = permission.SantriName
+		response = append(response, *item)
+	}
+
+	return &response, nil
+}
+
+func (s *santriPermissionService) CountSantriPermissions(ctx context.Context, request *model.ListSantriPermissionRequest) (int64, error) {
+	filter, err := santriPermissionFilter(request)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.store.CountSantriPermissions(ctx, *filter)
+}
+
+func (s *santriPermissionService) GetSantriPermission(ctx context.Context, santriPermissionID int32) (*model.SantriPermissionResponse, error) {
+	permission, err := s.store.GetSantriPermission(ctx, santriPermissionID)
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("Santri permission not found")
+		}
+		return nil, err
+	}
+
+	response := toSantriPermissionResponse(repo.SantriPermission{
+		ID:              permission.ID,
+		SantriID:        permission.SantriID,
+		Type:            permission.Type,
+		StartPermission: permission.StartPermission,
+		EndPermission:   permission.EndPermission,
+		Excuse:          permission.Excuse,
+		LeftAt:          permission.LeftAt,
+	})
+	response.Santri.Name = permission.SantriName
+	return response, nil
+}
+
+// UpdateSantriPermission updates the permission, the presences it covers follow the new period, santri and type
+func (s *santriPermissionService) UpdateSantriPermission(ctx context.Context, request *model.UpdateSantriPermissionRequest, santriPermissionID int32) (*model.SantriPermissionResponse, error) {
+	current, err := s.store.GetSantriPermission(ctx, santriPermissionID)
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("Santri permission not found")
+		}
+		return nil, err
+	}
+
+	arg := repo.UpdateSantriPermissionParams{
+		ID:              santriPermissionID,
+		SantriID:        pgtype.Int4{Int32: request.SantriID, Valid: request.SantriID != 0},
+		Type:            repo.NullPermissionType{PermissionType: request.Type, Valid: request.Type != ""},
+		Excuse:          pgtype.Text{String: request.Excuse, Valid: request.Excuse != ""},
+		StartPermission: current.StartPermission,
+		EndPermission:   current.EndPermission,
+	}
+	if request.StartPermission != nil {
+		arg.StartPermission = pgtype.Timestamptz{Time: *request.StartPermission, Valid: true}
+	}
+	if request.EndPermission != nil {
+		arg.EndPermission = pgtype.Timestamptz{Time: *request.EndPermission, Valid: true}
+	}
+	if arg.EndPermission.Valid && !arg.EndPermission.Time.After(arg.StartPermission.Time) {
+		return nil, exception.NewValidationError("end_permission must be after start_permission")
+	}
+
+	if arg.SantriID.Valid && arg.SantriID.Int32 != current.SantriID {
+		if _, err := s.store.GetSantri(ctx, arg.SantriID.Int32); err != nil {
+			if errors.Is(err, exception.ErrNotFound) {
+				return nil, exception.NewNotFoundError("Santri not found")
+			}
+			return nil, err
+		}
+	}
+
+	if _, err := s.store.UpdateSantriPermissionWithPresences(ctx, arg); err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("Santri permission not found")
+		}
+		return nil, err
+	}
+
+	return s.GetSantriPermission(ctx, santriPermissionID)
+}
+
+// DeleteSantriPermission deletes the permission, the presences it covered are alpha again
+func (s *santriPermissionService) DeleteSantriPermission(ctx context.Context, santriPermissionID int32) (*model.SantriPermissionResponse, error) {
+	deleted, err := s.store.DeleteSantriPermissionWithPresences(ctx, santriPermissionID)
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("Santri permission not found")
+		}
+		return nil, err
+	}
+
+	return toSantriPermissionResponse(deleted), nil
+}
+
+// GetActiveSantriPermission returns the latest permission of the santri that already started
+// and is still open (end_permission is null) or not yet finished at the given time.
+func (s *santriPermissionService) GetActiveSantriPermission(ctx context.Context, santriID int32, at time.Time) (*model.SantriPermissionResponse, error) {
+	permission, err := s.store.GetActiveSantriPermission(ctx, repo.GetActiveSantriPermissionParams{
+		SantriID: santriID,
+		At:       pgtype.Timestamptz{Time: at, Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("No active permission for santri")
+		}
+		return nil, err
+	}
+
+	return toSantriPermissionResponse(permission), nil
+}
+
+// CloseSantriPermission sets end_permission of an open permission, used when the santri is back.
+func (s *santriPermissionService) CloseSantriPermission(ctx context.Context, santriPermissionID int32, at time.Time) (*model.SantriPermissionResponse, error) {
+	permission, err := s.store.UpdateSantriPermission(ctx, repo.UpdateSantriPermissionParams{
+		ID:            santriPermissionID,
+		EndPermission: pgtype.Timestamptz{Time: at, Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("Santri permission not found")
+		}
+		return nil, err
+	}
+
+	return toSantriPermissionResponse(permission), nil
+}
+
+// MarkSantriPermissionLeft records when the santri left under the permission, the first tap in permission mode.
+func (s *santriPermissionService) MarkSantriPermissionLeft(ctx context.Context, santriPermissionID int32, at time.Time) (*model.SantriPermissionResponse, error) {
+	permission, err := s.store.MarkSantriPermissionLeft(ctx, repo.MarkSantriPermissionLeftParams{
+		ID:     santriPermissionID,
+		LeftAt: pgtype.Timestamptz{Time: at, Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("Santri permission not found")
+		}
+		return nil, err
+	}
+
+	return toSantriPermissionResponse(permission), nil
+}
+
+func toSantriPermissionResponse(permission repo.SantriPermission) *model.SantriPermissionResponse {
+	response := &model.SantriPermissionResponse{
+		ID:              permission.ID,
+		SantriID:        permission.SantriID,
+		Santri:          model.IdAndName{Id: permission.SantriID},
+		Type:            permission.Type,
+		StartPermission: permission.StartPermission.Time.Format("2006-01-02 15:04:05"),
+		Excuse:          permission.Excuse,
+	}
+	if permission.EndPermission.Valid {
+		response.EndPermission = permission.EndPermission.Time.Format("2006-01-02 15:04:05")
+	}
+	if permission.LeftAt.Valid {
+		response.LeftAt = permission.LeftAt.Time.Format("2006-01-02 15:04:05")
+	}
+	return response
+}
+
+// santriPermissionFilter turns the list request into the filter shared by the list and the count query.
+// The dates are local days and To covers the whole day, Active lists the permissions running now.
+func santriPermissionFilter(request *model.ListSantriPermissionRequest) (*repo.CountSantriPermissionsParams, error) {
+	filter := &repo.CountSantriPermissionsParams{
+		Q:        pgtype.Text{String: request.Q, Valid: request.Q != ""},
+		SantriID: pgtype.Int4{Int32: request.SantriID, Valid: request.SantriID != 0},
+		Type:     repo.NullPermissionType{PermissionType: request.Type, Valid: request.Type != ""},
+		ActiveAt: pgtype.Timestamptz{Time: time.Now(), Valid: request.Active},
+	}
+
+	fromDate, endDate, err := permissionDateRange(request.From, request.To)
+	if err != nil {
+		return nil, err
+	}
+	filter.FromDate, filter.EndDate = fromDate, endDate
+
+	return filter, nil
+}
+
+// permissionDateRange parses the from and to local days of a permission list, the end covers the whole to day.
+// A day that is not given stays null.
+func permissionDateRange(from string, to string) (pgtype.Timestamptz, pgtype.Timestamptz, error) {
+	var fromDate, endDate pgtype.Timestamptz
+	if from != "" {
+		date, err := time.ParseInLocation("2006-01-02", from, time.Local)
+		if err != nil {
+			return fromDate, endDate, exception.NewValidationError("From date is not valid")
+		}
+		fromDate = pgtype.Timestamptz{Time: date, Valid: true}
+	}
+
+	if to != "" {
+		date, err := time.ParseInLocation("2006-01-02", to, time.Local)
+		if err != nil {
+			return fromDate, endDate, exception.NewValidationError("To date is not valid")
+		}
+		endDate = pgtype.Timestamptz{Time: date.AddDate(0, 0, 1).Add(-time.Microsecond), Valid: true}
+	}
+
+	if fromDate.Valid && endDate.Valid && endDate.Time.Before(fromDate.Time) {
+		return fromDate, endDate, exception.NewValidationError("To date must not be before from date")
+	}
+	return fromDate, endDate, nil
+}
@@ -0,0 +1,175 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/exception"
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+	mocks "github.com/adiubaidah/syafiiyah-main/internal/repository/mocks"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSantriPermissionUseCase_Create(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2024, 7, 1, 8, 0, 0, 0, time.Local)
+
+	t.Run("end before start is refused", func(t *testing.T) {
+		mockStore := new(mocks.MockStore)
+		uc := NewSantriPermissionUseCase(mockStore)
+
+		end := start.Add(-time.Hour)
+		_, err := uc.CreateSantriPermission(ctx, &model.CreateSantriPermissionRequest{
+			SantriID:        1,
+			Type:            repo.PermissionTypePermission,
+			StartPermission: start,
+			EndPermission:   &end,
+			Excuse:          "Pulang",
+		})
+		require.IsType(t, &exception.AppError{}, err)
+		require.Equal(t, 400, err.(*exception.AppError).Code)
+		mockStore.AssertNotCalled(t, "CreateSantriPermission", mock.Anything, mock.Anything)
+	})
+
+	t.Run("unknown santri is not found", func(t *testing.T) {
+		mockStore := new(mocks.MockStore)
+		uc := NewSantriPermissionUseCase(mockStore)
+
+		mockStore.On("GetSantri", ctx, int32(1)).Return(repo.GetSantriRow{}, exception.ErrNotFound)
+
+		_, err := uc.CreateSantriPermission(ctx, &model.CreateSantriPermissionRequest{
+			SantriID:        1,
+			Type:            repo.PermissionTypeSick,
+			StartPermission: start,
+			Excuse:          "Demam",
+		})
+		require.IsType(t, &exception.AppError{}, err)
+		require.Equal(t, 404, err.(*exception.AppError).Code)
+	})
+
+	t.Run("open permission is created for the santri", func(t *testing.T) {
+		mockStore := new(mocks.MockStore)
+		uc := NewSantriPermissionUseCase(mockStore)
+
+		mockStore.On("GetSantri", ctx, int32(1)).Return(repo.GetSantriRow{ID: 1, Name: "Ahmad"}, nil)
+		mockStore.On("CreateSantriPermissionWithPresences", ctx, repo.CreateSantriPermissionParams{
+			SantriID:        1,
+			StartPermission: pgtype.Timestamptz{Time: start, Valid: true},
+			Type:            repo.PermissionTypeSick,
+			Excuse:          "Demam",
+		}).Return(repo.SantriPermission{
+			ID:              5,
+			SantriID:        1,
+			Type:            repo.PermissionTypeSick,
+			StartPermission: pgtype.Timestamptz{Time: start, Valid: true},
+			Excuse:          "Demam",
+		}, []repo.SantriPresence{}, nil)
+
+		permission, err := uc.CreateSantriPermission(ctx, &model.CreateSantriPermissionRequest{
+			SantriID:        1,
+			Type:            repo.PermissionTypeSick,
+			StartPermission: start,
+			Excuse:          "Demam",
+		})
+		require.NoError(t, err)
+		require.Equal(t, model.IdAndName{Id: 1, Name: "Ahmad"}, permission.Santri)
+		require.Empty(t, permission.EndPermission)
+	})
+}
+
+func TestSantriPermissionUseCase_Update(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2024, 7, 1, 8, 0, 0, 0, time.Local)
+
+	mockStore := new(mocks.MockStore)
+	uc := NewSantriPermissionUseCase(mockStore)
+	mockStore.On("GetSantriPermission", ctx, int32(5)).Return(repo.GetSantriPermissionRow{
+		ID:              5,
+		SantriID:        1,
+		Type:            repo.PermissionTypePermission,
+		StartPermission: pgtype.Timestamptz{Time: start, Valid: true},
+		SantriName:      "Ahmad",
+	}, nil)
+
+	t.Run("end is checked against the current start", func(t *testing.T) {
+		end := start.Add(-time.Minute)
+		_, err := uc.UpdateSantriPermission(ctx, &model.UpdateSantriPermissionRequest{EndPermission: &end}, 5)
+		require.IsType(t, &exception.AppError{}, err)
+		mockStore.AssertNotCalled(t, "UpdateSantriPermissionWithPresences", mock.Anything, mock.Anything)
+	})
+
+	t.Run("fields not given keep their value", func(t *testing.T) {
+		end := start.Add(2 * time.Hour)
+		mockStore.On("UpdateSantriPermissionWithPresences", ctx, repo.UpdateSantriPermissionParams{
+			ID:              5,
+			StartPermission: pgtype.Timestamptz{Time: start, Valid: true},
+			EndPermission:   pgtype.Timestamptz{Time: end, Valid: true},
+		}).Return(repo.SantriPermission{ID: 5}, nil).Once()
+
+		_, err := uc.UpdateSantriPermission(ctx, &model.UpdateSantriPermissionRequest{EndPermission: &end}, 5)
+		require.NoError(t, err)
+		mockStore.AssertExpectations(t)
+	})
+}
+
+func TestSantriPermissionUseCase_Delete(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("unknown permission is not found", func(t *testing.T) {
+		mockStore := new(mocks.MockStore)
+		uc := NewSantriPermissionUseCase(mockStore)
+		mockStore.On("DeleteSantriPermissionWithPresences", ctx, int32(5)).Return(repo.SantriPermission{}, exception.ErrNotFound)
+
+		_, err := uc.DeleteSantriPermission(ctx, 5)
+		require.IsType(t, &exception.AppError{}, err)
+		require.Equal(t, 404, err.(*exception.AppError).Code)
+	})
+
+	t.Run("deleted permission is returned", func(t *testing.T) {
+		mockStore := new(mocks.MockStore)
+		uc := NewSantriPermissionUseCase(mockStore)
+		mockStore.On("DeleteSantriPermissionWithPresences", ctx, int32(5)).Return(repo.SantriPermission{ID: 5, SantriID: 1, Type: repo.PermissionTypeSick}, nil)
+
+		permission, err := uc.DeleteSantriPermission(ctx, 5)
+		require.NoError(t, err)
+		require.Equal(t, int32(5), permission.ID)
+		mockStore.AssertExpectations(t)
+	})
+}
+
+func TestSantriPermissionFilter(t *testing.T) {
+	filter, err := santriPermissionFilter(&model.ListSantriPermissionRequest{
+		From:   "2024-07-01",
+		To:     "2024-07-03",
+		Active: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, 7, 1, 0, 0, 0, 0, time.Local), filter.FromDate.Time)
+	require.Equal(t, time.Date(2024, 7, 3, 23, 59, 59, 999999000, time.Local), filter.EndDate.Time)
+	require.True(t, filter.ActiveAt.Valid)
+
+	_, err = santriPermissionFilter(&model.ListSantriPermissionRequest{From: "2024-07-03", To: "2024-07-01"})
+	require.IsType(t, &exception.AppError{}, err)
+}
+
+func TestPermissionDateRange(t *testing.T) {
+	fromDate, endDate, err := permissionDateRange("2024-07-01", "2024-07-01")
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, 7, 1, 0, 0, 0, 0, time.Local), fromDate.Time)
+	require.Equal(t, time.Date(2024, 7, 1, 23, 59, 59, 999999000, time.Local), endDate.Time)
+
+	fromDate, endDate, err = permissionDateRange("", "")
+	require.NoError(t, err)
+	require.False(t, fromDate.Valid)
+	require.False(t, endDate.Valid)
+
+	_, _, err = permissionDateRange("2024-07-02", "2024-07-01")
+	require.Equal(t, "To date must not be before from date", err.(*exception.AppError).Message)
+
+	_, _, err = permissionDateRange("01-07-2024", "")
+	require.Equal(t, "From date is not valid", err.(*exception.AppError).Message)
+}
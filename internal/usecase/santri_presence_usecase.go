@@ -17,6 +17,7 @@ type SantriPresenceUseCase interface {
 	BulkCreateSantriPresence(ctx context.Context, args []repo.CreateSantriPresencesParams) (int64, error)
 	ListSantriPresences(ctx context.Context, request *model.ListSantriPresenceRequest) (*[]model.SantriPresenceResponse, error)
 	ListMissingSantriPresences(ctx context.Context, request *model.ListMissingSantriPresenceRequest) (*[]model.IdAndName, error)
+	MarkAlpha(ctx context.Context, schedule model.IdAndName, finishAt time.Time) (int64, error)
 	CountSantriPresences(ctx context.Context, request *model.ListSantriPresenceRequest) (int64, error)
 	UpdateSantriPresence(ctx context.Context, request *model.UpdateSantriPresenceRequest, santriPresenceID int32) (*model.SantriPresenceResponse, error)
 	DeleteSantriPresence(ctx context.Context, santriPresenceID int32) (*model.SantriPresenceResponse, error)
@@ -32,6 +33,9 @@ func NewSantriPresenceUseCase(store repo.Store) SantriPresenceUseCase {
 	}
 }
 
+// CreateSantriPresence records a presence, one per santri, schedule and day.
+// A tap replaces the alpha marked by the system, so a tap buffered by a device that was offline
+// until the schedule finished is still counted.
 func (s *santriPresenceService) CreateSantriPresence(ctx context.Context, request *model.CreateSantriPresenceRequest) (*model.SantriPresenceResponse, error) {
 
 	getSantri, err := s.store.GetSantri(ctx, request.SantriID)
@@ -41,17 +45,34 @@ func (s *santriPresenceService) CreateSantriPresence(ctx context.Context, reques
 		}
 		return nil, err
 	}
-	createdSantriPresence, err := s.store.CreateSantriPresence(ctx, repo.CreateSantriPresenceParams{
+	arg := repo.CreateSantriPresenceParams{
 		ScheduleID:         request.ScheduleID,
 		SantriID:           request.SantriID,
 		ScheduleName:       request.ScheduleName,
 		Type:               request.Type,
 		Notes:              pgtype.Text{String: request.Notes, Valid: request.Notes != ""},
+		CreatedAt:          pgtype.Timestamptz{Time: request.CreatedAt, Valid: !request.CreatedAt.IsZero()},
 		CreatedBy:          request.CreatedBy,
 		SantriPermissionID: pgtype.Int4{Int32: request.SantriPermissionID, Valid: request.SantriPermissionID != 0},
-	})
+	}
+
+	var createdSantriPresence repo.SantriPresence
+	if request.CreatedBy == repo.PresenceCreatedByTypeTap {
+		createdSantriPresence, err = s.store.CreateSantriTapPresence(ctx, repo.CreateSantriTapPresenceParams{
+			ScheduleID:         arg.ScheduleID,
+			ScheduleName:       arg.ScheduleName,
+			Type:               arg.Type,
+			SantriID:           arg.SantriID,
+			Notes:              arg.Notes,
+			CreatedAt:          arg.CreatedAt,
+			SantriPermissionID: arg.SantriPermissionID,
+		})
+	} else {
+		createdSantriPresence, err = s.store.CreateSantriPresence(ctx, arg)
+	}
 	if err != nil {
-		if exception.DatabaseErrorCode(err) == exception.ErrCodeUniqueViolation {
+		// the tap presence returns no row when the existing presence is not a system alpha
+		if errors.Is(err, exception.ErrNotFound) || exception.DatabaseErrorCode(err) == exception.ErrCodeUniqueViolation {
 			return nil, exception.NewUniqueViolationError("Santri Presence today already exist", err)
 		}
 		return nil, err
@@ -197,6 +218,64 @@ func (s *santriPresenceService) ListMissingSantriPresences(ctx context.Context,
 	return &response, nil
 }
 
+// MarkAlpha records alpha for every active santri without a presence in the schedule on the day of finishAt.
+// A santri under a permission at finishAt gets a permission or sick presence linked to it instead.
+// Santri already having a presence are skipped, so running it again for the same day records nothing.
+// The alpha is replaced when a tap buffered by an offline device is uploaded later.
+// Nothing is recorded on a holiday.
+func (s *santriPresenceService) MarkAlpha(ctx context.Context, schedule model.IdAndName, finishAt time.Time) (int64, error) {
+	date := pgtype.Date{Time: finishAt, Valid: true}
+	isHoliday, err := s.store.IsHolidayDate(ctx, date)
+	if err != nil {
+		return 0, err
+	}
+	if isHoliday {
+		return 0, nil
+	}
+
+	missingSantriPresences, err := s.store.ListMissingSantriPresences(ctx, repo.ListMissingSantriPresencesParams{
+		Date:       date,
+		ScheduleID: pgtype.Int4{Int32: schedule.Id, Valid: true},
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(missingSantriPresences) == 0 {
+		return 0, nil
+	}
+
+	activePermissions, err := s.store.ListActiveSantriPermissions(ctx, pgtype.Timestamptz{Time: finishAt, Valid: true})
+	if err != nil {
+		return 0, err
+	}
+	// the latest permission of the santri comes first
+	permissions := make(map[int32]repo.SantriPermission, len(activePermissions))
+	for _, permission := range activePermissions {
+		if _, ok := permissions[permission.SantriID]; !ok {
+			permissions[permission.SantriID] = permission
+		}
+	}
+
+	args := make([]repo.CreateSantriPresencesParams, 0, len(missingSantriPresences))
+	for _, missingSantriPresence := range missingSantriPresences {
+		arg := repo.CreateSantriPresencesParams{
+			ScheduleID:   schedule.Id,
+			ScheduleName: schedule.Name,
+			Type:         repo.PresenceTypeAlpha,
+			SantriID:     missingSantriPresence.ID,
+			CreatedAt:    pgtype.Timestamptz{Time: finishAt, Valid: true},
+			CreatedBy:    repo.PresenceCreatedByTypeSystem,
+		}
+		if permission, ok := permissions[missingSantriPresence.ID]; ok {
+			arg.Type = permission.Type.PresenceType()
+			arg.SantriPermissionID = pgtype.Int4{Int32: permission.ID, Valid: true}
+		}
+		args = append(args, arg)
+	}
+
+	return s.BulkCreateSantriPresence(ctx, args)
+}
+
 func (s *santriPresenceService) UpdateSantriPresence(ctx context.Context, request *model.UpdateSantriPresenceRequest, santriPresenceID int32) (*model.SantriPresenceResponse, error) {
 
 	getSantri, err := s.store.GetSantri(ctx, request.SantriID)
@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/exception"
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+	mocks "github.com/adiubaidah/syafiiyah-main/internal/repository/mocks"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSantriPresenceUseCase_MarkAlpha(t *testing.T) {
+	ctx := context.Background()
+	schedule := model.IdAndName{Id: 3, Name: "Subuh"}
+	finishAt := time.Date(2024, 7, 1, 6, 0, 0, 0, time.Local)
+	date := pgtype.Date{Time: finishAt, Valid: true}
+
+	t.Run("missing santri are marked alpha by the system", func(t *testing.T) {
+		mockStore := new(mocks.MockStore)
+		uc := NewSantriPresenceUseCase(mockStore)
+
+		mockStore.On("IsHolidayDate", ctx, date).Return(false, nil)
+		mockStore.On("ListMissingSantriPresences", ctx, repo.ListMissingSantriPresencesParams{
+			Date:       date,
+			ScheduleID: pgtype.Int4{Int32: 3, Valid: true},
+		}).Return([]repo.ListMissingSantriPresencesRow{{ID: 1, Name: "Ahmad"}, {ID: 2, Name: "Umar"}}, nil)
+		mockStore.On("ListActiveSantriPermissions", ctx, pgtype.Timestamptz{Time: finishAt, Valid: true}).Return([]repo.SantriPermission{}, nil)
+		mockStore.On("CreateSantriPresences", ctx, mock.MatchedBy(func(args []repo.CreateSantriPresencesParams) bool {
+			for _, arg := range args {
+				if arg.Type != repo.PresenceTypeAlpha || arg.CreatedBy != repo.PresenceCreatedByTypeSystem ||
+					arg.ScheduleID != 3 || !arg.CreatedAt.Time.Equal(finishAt) {
+					return false
+				}
+			}
+			return len(args) == 2
+		})).Return(int64(2), nil)
+
+		count, err := uc.MarkAlpha(ctx, schedule, finishAt)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), count)
+	})
+
+	t.Run("santri under permission get the permission type", func(t *testing.T) {
+		mockStore := new(mocks.MockStore)
+		uc := NewSantriPresenceUseCase(mockStore)
+
+		mockStore.On("IsHolidayDate", ctx, date).Return(false, nil)
+		mockStore.On("ListMissingSantriPresences", ctx, mock.Anything).Return([]repo.ListMissingSantriPresencesRow{{ID: 1, Name: "Ahmad"}, {ID: 2, Name: "Umar"}}, nil)
+		mockStore.On("ListActiveSantriPermissions", ctx, pgtype.Timestamptz{Time: finishAt, Valid: true}).Return([]repo.SantriPermission{
+			{ID: 9, SantriID: 2, Type: repo.PermissionTypeSick},
+			{ID: 4, SantriID: 2, Type: repo.PermissionTypePermission},
+		}, nil)
+		mockStore.On("CreateSantriPresences", ctx, []repo.CreateSantriPresencesParams{
+			{
+				ScheduleID:   3,
+				ScheduleName: "Subuh",
+				Type:         repo.PresenceTypeAlpha,
+				SantriID:     1,
+				CreatedAt:    pgtype.Timestamptz{Time: finishAt, Valid: true},
+				CreatedBy:    repo.PresenceCreatedByTypeSystem,
+			},
+			{
+				ScheduleID:         3,
+				ScheduleName:       "Subuh",
+				Type:               repo.PresenceTypeSick,
+				SantriID:           2,
+				CreatedAt:          pgtype.Timestamptz{Time: finishAt, Valid: true},
+				CreatedBy:          repo.PresenceCreatedByTypeSystem,
+				SantriPermissionID: pgtype.Int4{Int32: 9, Valid: true},
+			},
+		}).Return(int64(2), nil)
+
+		count, err := uc.MarkAlpha(ctx, schedule, finishAt)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), count)
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("nothing is marked when every santri has a presence", func(t *testing.T) {
+		mockStore := new(mocks.MockStore)
+		uc := NewSantriPresenceUseCase(mockStore)
+
+		mockStore.On("IsHolidayDate", ctx, date).Return(false, nil)
+		mockStore.On("ListMissingSantriPresences", ctx, mock.Anything).Return([]repo.ListMissingSantriPresencesRow{}, nil)
+
+		count, err := uc.MarkAlpha(ctx, schedule, finishAt)
+		require.NoError(t, err)
+		require.Zero(t, count)
+		mockStore.AssertNotCalled(t, "CreateSantriPresences", mock.Anything, mock.Anything)
+	})
+
+	t.Run("holiday is skipped", func(t *testing.T) {
+		mockStore := new(mocks.MockStore)
+		uc := NewSantriPresenceUseCase(mockStore)
+
+		mockStore.On("IsHolidayDate", ctx, date).Return(true, nil)
+
+		count, err := uc.MarkAlpha(ctx, schedule, finishAt)
+		require.NoError(t, err)
+		require.Zero(t, count)
+		mockStore.AssertNotCalled(t, "ListMissingSantriPresences", mock.Anything, mock.Anything)
+	})
+}
+
+func TestSantriPresenceUseCase_CreateTapAfterMarkAlpha(t *testing.T) {
+	ctx := context.Background()
+	tappedAt := time.Date(2024, 7, 1, 4, 30, 0, 0, time.Local)
+	request := &model.CreateSantriPresenceRequest{
+		ScheduleID:   3,
+		ScheduleName: "Subuh",
+		SantriID:     1,
+		Type:         repo.PresenceTypePresent,
+		CreatedAt:    tappedAt,
+		CreatedBy:    repo.PresenceCreatedByTypeTap,
+	}
+	tapArg := repo.CreateSantriTapPresenceParams{
+		ScheduleID:   3,
+		ScheduleName: "Subuh",
+		Type:         repo.PresenceTypePresent,
+		SantriID:     1,
+		CreatedAt:    pgtype.Timestamptz{Time: tappedAt, Valid: true},
+	}
+
+	t.Run("buffered tap replaces the alpha marked by the system", func(t *testing.T) {
+		mockStore := new(mocks.MockStore)
+		uc := NewSantriPresenceUseCase(mockStore)
+
+		mockStore.On("GetSantri", ctx, int32(1)).Return(repo.GetSantriRow{ID: 1, Name: "Ahmad"}, nil)
+		mockStore.On("CreateSantriTapPresence", ctx, tapArg).Return(repo.SantriPresence{
+			ID:           7,
+			ScheduleID:   3,
+			ScheduleName: "Subuh",
+			Type:         repo.PresenceTypePresent,
+			SantriID:     1,
+			CreatedAt:    pgtype.Timestamptz{Time: tappedAt, Valid: true},
+			CreatedBy:    repo.PresenceCreatedByTypeTap,
+		}, nil)
+
+		presence, err := uc.CreateSantriPresence(ctx, request)
+		require.NoError(t, err)
+		require.Equal(t, repo.PresenceTypePresent, presence.Type)
+		mockStore.AssertNotCalled(t, "CreateSantriPresence", mock.Anything, mock.Anything)
+	})
+
+	t.Run("tap on a presence that is not a system alpha is a conflict", func(t *testing.T) {
+		mockStore := new(mocks.MockStore)
+		uc := NewSantriPresenceUseCase(mockStore)
+
+		mockStore.On("GetSantri", ctx, int32(1)).Return(repo.GetSantriRow{ID: 1, Name: "Ahmad"}, nil)
+		mockStore.On("CreateSantriTapPresence", ctx, tapArg).Return(repo.SantriPresence{}, pgx.ErrNoRows)
+
+		_, err := uc.CreateSantriPresence(ctx, request)
+		require.IsType(t, &exception.AppError{}, err)
+		require.Equal(t, 409, err.(*exception.AppError).Code)
+	})
+}
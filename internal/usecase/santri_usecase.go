@@ -158,7 +158,7 @@ func (c *santriService) UpdateSantri(ctx context.Context, request *model.UpdateS
 	if err != nil {
 		return nil, err
 	}
-	createdSantri, err := c.store.UpdateSantri(ctx, repo.UpdateSantriParams{
+	arg := repo.UpdateSantriParams{
 		ID:           santriId,
 		Nis:          pgtype.Text{String: request.Nis, Valid: true},
 		Name:         pgtype.Text{String: request.Name, Valid: request.Name != ""},
@@ -168,7 +168,15 @@ func (c *santriService) UpdateSantri(ctx context.Context, request *model.UpdateS
 		OccupationID: pgtype.Int4{Int32: request.OccupationID, Valid: request.OccupationID != 0},
 		ParentID:     pgtype.Int4{Int32: request.ParentID, Valid: request.ParentID != 0},
 		Gender:       repo.NullGenderType{GenderType: request.Gender, Valid: true},
-	})
+	}
+
+	var createdSantri repo.Santri
+	if isActive {
+		createdSantri, err = c.store.UpdateSantri(ctx, arg)
+	} else {
+		// an inactive santri keeps the cards, but they are suspended
+		createdSantri, err = c.store.DeactivateSantri(ctx, arg)
+	}
 	if err != nil {
 		if errors.Is(err, exception.ErrNotFound) {
 			return nil, exception.NewNotFoundError("Santri not found")
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+	mocks "github.com/adiubaidah/syafiiyah-main/internal/repository/mocks"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSantriUseCase_Update(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("inactive santri get their cards suspended", func(t *testing.T) {
+		mockStore := new(mocks.MockStore)
+		uc := NewSantriUseCase(mockStore)
+
+		mockStore.On("DeactivateSantri", ctx, mock.MatchedBy(func(arg repo.UpdateSantriParams) bool {
+			return arg.ID == 1 && arg.IsActive == pgtype.Bool{Bool: false, Valid: true}
+		})).Return(repo.Santri{ID: 1, Name: "Ahmad", IsActive: pgtype.Bool{Bool: false, Valid: true}}, nil)
+
+		result, err := uc.UpdateSantri(ctx, &model.UpdateSantriRequest{Name: "Ahmad", Gender: repo.GenderTypeMale, IsActive: "false"}, 1)
+		require.NoError(t, err)
+		require.False(t, result.IsActive)
+		mockStore.AssertNotCalled(t, "UpdateSantri", mock.Anything, mock.Anything)
+	})
+
+	t.Run("active santri keep their cards", func(t *testing.T) {
+		mockStore := new(mocks.MockStore)
+		uc := NewSantriUseCase(mockStore)
+
+		mockStore.On("UpdateSantri", ctx, mock.Anything).Return(repo.Santri{ID: 1, Name: "Ahmad", IsActive: pgtype.Bool{Bool: true, Valid: true}}, nil)
+
+		result, err := uc.UpdateSantri(ctx, &model.UpdateSantriRequest{Name: "Ahmad", Gender: repo.GenderTypeMale, IsActive: "true"}, 1)
+		require.NoError(t, err)
+		require.True(t, result.IsActive)
+		mockStore.AssertNotCalled(t, "DeactivateSantri", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,221 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/exception"
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// defaultEnrollmentDuration is how long a session accepts taps when duration_seconds is not given
+const defaultEnrollmentDuration = 15 * time.Minute
+
+// OpenEnrollment opens an enrollment session on the device for the owners, in the given order.
+// A device has at most one open session, and it must listen to record mode since only record taps are enrolled.
+func (c *SmartCardUseCase) OpenEnrollment(ctx context.Context, userId int32, request *model.CreateEnrollmentSessionRequest) (*model.EnrollmentSessionResponse, error) {
+	if _, err := c.store.GetDevice(ctx, request.DeviceID); err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("Device not found")
+		}
+		return nil, err
+	}
+	recording, err := c.deviceRecords(ctx, request.DeviceID)
+	if err != nil {
+		return nil, err
+	}
+	if !recording {
+		return nil, exception.NewValidationError("Device has no record mode, add it or switch the device to record mode first")
+	}
+
+	itemParams := make([]repo.CreateEnrollmentSessionItemsParams, 0, len(request.Owners))
+	seen := make(map[model.EnrollmentOwner]struct{}, len(request.Owners))
+	for _, owner := range request.Owners {
+		if _, exists := seen[owner]; exists {
+			return nil, exception.NewValidationError(fmt.Sprintf("Owner %s %d is listed more than once", owner.OwnerRole, owner.OwnerID))
+		}
+		seen[owner] = struct{}{}
+
+		item := repo.CreateEnrollmentSessionItemsParams{}
+		if owner.OwnerRole == repo.RoleTypeSantri {
+			if _, err := c.store.GetSantri(ctx, owner.OwnerID); err != nil {
+				if errors.Is(err, exception.ErrNotFound) {
+					return nil, exception.NewNotFoundError(fmt.Sprintf("Santri %d not found", owner.OwnerID))
+				}
+				return nil, err
+			}
+			item.SantriID = pgtype.Int4{Int32: owner.OwnerID, Valid: true}
+		} else {
+			if _, err := c.store.GetEmployeeByID(ctx, owner.OwnerID); err != nil {
+				if errors.Is(err, exception.ErrNotFound) {
+					return nil, exception.NewNotFoundError(fmt.Sprintf("Employee %d not found", owner.OwnerID))
+				}
+				return nil, err
+			}
+			item.EmployeeID = pgtype.Int4{Int32: owner.OwnerID, Valid: true}
+		}
+		itemParams = append(itemParams, item)
+	}
+
+	duration := defaultEnrollmentDuration
+	if request.DurationSeconds > 0 {
+		duration = time.Duration(request.DurationSeconds) * time.Second
+	}
+
+	session, err := c.store.CreateEnrollmentSessionWithItems(ctx, repo.CreateEnrollmentSessionParams{
+		DeviceID:  request.DeviceID,
+		ExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(duration), Valid: true},
+		CreatedBy: pgtype.Int4{Int32: userId, Valid: userId != 0},
+	}, itemParams)
+	if err != nil {
+		if exception.DatabaseErrorCode(err) == exception.ErrCodeUniqueViolation {
+			return nil, exception.NewUniqueViolationError("Device already has an open enrollment session", err)
+		}
+		return nil, err
+	}
+
+	return c.GetEnrollment(ctx, session.ID)
+}
+
+// deviceRecords reports whether the device has record mode, or is switched to it by a command that has not expired
+func (c *SmartCardUseCase) deviceRecords(ctx context.Context, deviceId int32) (bool, error) {
+	modes, err := c.store.ListDeviceModes(ctx, deviceId)
+	if err != nil {
+		return false, err
+	}
+	for _, mode := range modes {
+		if mode.Mode == repo.DeviceModeTypeRecord {
+			return true, nil
+		}
+	}
+
+	switches, err := c.store.ListActiveDeviceModeSwitches(ctx)
+	if err != nil {
+		return false, err
+	}
+	for _, modeSwitch := range switches {
+		if modeSwitch.DeviceID == deviceId && modeSwitch.Mode.DeviceModeType == repo.DeviceModeTypeRecord {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// GetEnrollment returns the session with its owners and the cards enrolled so far
+func (c *SmartCardUseCase) GetEnrollment(ctx context.Context, id int32) (*model.EnrollmentSessionResponse, error) {
+	session, err := c.store.GetEnrollmentSession(ctx, id)
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("Enrollment session not found")
+		}
+		return nil, err
+	}
+
+	items, err := c.store.ListEnrollmentSessionItems(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &model.EnrollmentSessionResponse{
+		ID:        session.ID,
+		Device:    model.IdAndName{Id: session.DeviceID, Name: session.DeviceName},
+		Status:    session.Status,
+		ExpiresAt: session.ExpiresAt.Time.Format("2006-01-02 15:04:05"),
+		CreatedAt: session.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+		Items:     make([]model.EnrollmentSessionItem, 0, len(items)),
+	}
+	// the session is only marked expired when the device gets a new one
+	if response.Status == repo.EnrollmentSessionStatusOpen && !session.ExpiresAt.Time.After(time.Now()) {
+		response.Status = repo.EnrollmentSessionStatusExpired
+	}
+	if session.ClosedAt.Valid {
+		response.ClosedAt = session.ClosedAt.Time.Format("2006-01-02 15:04:05")
+	}
+
+	for _, item := range items {
+		enrollmentItem := model.EnrollmentSessionItem{
+			Position:     item.Position,
+			SmartCardUid: item.SmartCardUid.String,
+		}
+		if item.SantriID.Valid {
+			enrollmentItem.Owner = model.OwenerDetails{ID: item.SantriID.Int32, Role: repo.RoleTypeSantri, Name: item.SantriName.String}
+		} else {
+			enrollmentItem.Owner = model.OwenerDetails{ID: item.EmployeeID.Int32, Role: repo.RoleTypeEmployee, Name: item.EmployeeName.String}
+		}
+		if item.EnrolledAt.Valid {
+			enrollmentItem.EnrolledAt = item.EnrolledAt.Time.Format("2006-01-02 15:04:05")
+		}
+		response.Items = append(response.Items, enrollmentItem)
+	}
+
+	return response, nil
+}
+
+// CancelEnrollment closes the open session, the cards enrolled so far keep their owners
+func (c *SmartCardUseCase) CancelEnrollment(ctx context.Context, id int32) (*model.EnrollmentSessionResponse, error) {
+	_, err := c.store.CloseEnrollmentSession(ctx, repo.CloseEnrollmentSessionParams{
+		ID:     id,
+		Status: repo.EnrollmentSessionStatusCancelled,
+	})
+	if err != nil {
+		if !errors.Is(err, exception.ErrNotFound) {
+			return nil, err
+		}
+		if _, err := c.store.GetEnrollmentSession(ctx, id); err != nil {
+			if errors.Is(err, exception.ErrNotFound) {
+				return nil, exception.NewNotFoundError("Enrollment session not found")
+			}
+			return nil, err
+		}
+		return nil, exception.NewValidationError("Enrollment session is already closed")
+	}
+
+	return c.GetEnrollment(ctx, id)
+}
+
+// Enroll registers the tapped card for the next owner of the open session on the device.
+// It returns nil without error when the device has no open session, the tap is then recorded as usual.
+func (c *SmartCardUseCase) Enroll(ctx context.Context, deviceName string, uid string) (*model.EnrollmentTapResponse, error) {
+	session, err := c.store.GetOpenEnrollmentSessionByDeviceName(ctx, deviceName)
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	existing, err := c.store.GetSmartCard(ctx, uid)
+	if err == nil {
+		if existing.State == repo.SmartCardStateLost || existing.State == repo.SmartCardStateRetired {
+			return nil, exception.NewForbiddenError("Smart Card is lost or retired")
+		}
+	} else if !errors.Is(err, exception.ErrNotFound) {
+		return nil, err
+	}
+
+	_, completed, err := c.store.EnrollSmartCard(ctx, session.ID, uid)
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("Enrollment session has no owner left")
+		}
+		if errors.Is(err, repo.ErrSmartCardOwned) {
+			return nil, exception.NewUniqueViolationError("Smart Card already has an owner", err)
+		}
+		return nil, err
+	}
+
+	smartCard, err := c.Get(ctx, &model.SmartCardRequest{Uid: uid})
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.EnrollmentTapResponse{
+		SmartCardComplete: *smartCard,
+		SessionID:         session.ID,
+		Completed:         completed,
+	}, nil
+}
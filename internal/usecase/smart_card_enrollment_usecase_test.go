@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/exception"
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+	mocks "github.com/adiubaidah/syafiiyah-main/internal/repository/mocks"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSmartCardUseCase_OpenEnrollment(t *testing.T) {
+	ctx := context.Background()
+	request := &model.CreateEnrollmentSessionRequest{
+		DeviceID: 1,
+		Owners:   []model.EnrollmentOwner{{OwnerRole: repo.RoleTypeSantri, OwnerID: 3}},
+	}
+
+	t.Run("device without record mode is refused", func(t *testing.T) {
+		mockStore := new(mocks.MockStore)
+		uc := NewSmartCardUseCase(mockStore)
+		mockStore.On("GetDevice", ctx, int32(1)).Return(repo.Device{ID: 1, Name: "gate_a"}, nil)
+		mockStore.On("ListDeviceModes", ctx, int32(1)).Return([]repo.DeviceMode{{Mode: repo.DeviceModeTypePresence, DeviceID: 1}}, nil)
+		mockStore.On("ListActiveDeviceModeSwitches", ctx).Return([]repo.ListActiveDeviceModeSwitchesRow{
+			{DeviceID: 2, Mode: repo.NullDeviceModeType{DeviceModeType: repo.DeviceModeTypeRecord, Valid: true}},
+		}, nil)
+
+		_, err := uc.OpenEnrollment(ctx, 1, request)
+		require.IsType(t, &exception.AppError{}, err)
+		require.Equal(t, 400, err.(*exception.AppError).Code)
+		mockStore.AssertNotCalled(t, "GetSantri", mock.Anything, mock.Anything)
+	})
+
+	t.Run("device switched to record mode is accepted", func(t *testing.T) {
+		mockStore := new(mocks.MockStore)
+		uc := NewSmartCardUseCase(mockStore)
+		mockStore.On("ListDeviceModes", ctx, int32(1)).Return([]repo.DeviceMode{{Mode: repo.DeviceModeTypePresence, DeviceID: 1}}, nil)
+		mockStore.On("ListActiveDeviceModeSwitches", ctx).Return([]repo.ListActiveDeviceModeSwitchesRow{
+			{DeviceID: 1, Mode: repo.NullDeviceModeType{DeviceModeType: repo.DeviceModeTypeRecord, Valid: true}},
+		}, nil)
+
+		recording, err := uc.deviceRecords(ctx, 1)
+		require.NoError(t, err)
+		require.True(t, recording)
+	})
+}
+
+func TestSmartCardUseCase_Enroll(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("card of another owner is a conflict", func(t *testing.T) {
+		mockStore := new(mocks.MockStore)
+		uc := NewSmartCardUseCase(mockStore)
+		mockStore.On("GetOpenEnrollmentSessionByDeviceName", ctx, "gate_a").Return(repo.EnrollmentSession{ID: 5}, nil)
+		mockStore.On("GetSmartCard", ctx, "A1B2C3").Return(repo.GetSmartCardRow{
+			ID:       2,
+			Uid:      "A1B2C3",
+			State:    repo.SmartCardStateActive,
+			SantriID: pgtype.Int4{Int32: 9, Valid: true},
+		}, nil)
+		mockStore.On("EnrollSmartCard", ctx, int32(5), "A1B2C3").Return(repo.SmartCard{}, false, repo.ErrSmartCardOwned)
+
+		_, err := uc.Enroll(ctx, "gate_a", "A1B2C3")
+		require.IsType(t, &exception.AppError{}, err)
+		require.Equal(t, 409, err.(*exception.AppError).Code)
+	})
+
+	t.Run("lost card is refused", func(t *testing.T) {
+		mockStore := new(mocks.MockStore)
+		uc := NewSmartCardUseCase(mockStore)
+		mockStore.On("GetOpenEnrollmentSessionByDeviceName", ctx, "gate_a").Return(repo.EnrollmentSession{ID: 5}, nil)
+		mockStore.On("GetSmartCard", ctx, "A1B2C3").Return(repo.GetSmartCardRow{ID: 2, Uid: "A1B2C3", State: repo.SmartCardStateLost}, nil)
+
+		_, err := uc.Enroll(ctx, "gate_a", "A1B2C3")
+		require.IsType(t, &exception.AppError{}, err)
+		require.Equal(t, 403, err.(*exception.AppError).Code)
+		mockStore.AssertNotCalled(t, "EnrollSmartCard", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -21,7 +21,7 @@ func NewSmartCardUseCase(store repo.Store) *SmartCardUseCase {
 func (c *SmartCardUseCase) Create(ctx context.Context, request *model.SmartCardRequest) (*model.SmartCard, error) {
 	createdSmartCard, err := c.store.CreateSmartCard(ctx, repo.CreateSmartCardParams{
 		Uid:        request.Uid,
-		IsActive:   true,
+		State:      repo.SmartCardStateActive,
 		SantriID:   pgtype.Int4{Valid: false},
 		EmployeeID: pgtype.Int4{Valid: false},
 	})
@@ -38,6 +38,7 @@ func (c *SmartCardUseCase) Create(ctx context.Context, request *model.SmartCardR
 		Uid:       createdSmartCard.Uid,
 		CreatedAt: createdSmartCard.CreatedAt.Time.Format("2006-01-02 15:04:05"),
 		IsActive:  createdSmartCard.IsActive,
+		State:     createdSmartCard.State,
 	}, nil
 }
 
@@ -45,6 +46,7 @@ func (c *SmartCardUseCase) List(ctx context.Context, request *model.ListSmartCar
 	listSmartCard, err := c.store.ListSmartCards(ctx, repo.ListSmartCardsParams{
 		Q:            pgtype.Text{String: request.Q, Valid: request.Q != ""},
 		IsActive:     pgtype.Bool{Bool: request.IsActive == 1, Valid: request.IsActive != 0},
+		State:        repo.NullSmartCardState{SmartCardState: request.State, Valid: request.State != ""},
 		CardOwner:    repo.NullCardOwner{CardOwner: request.CardOwner, Valid: request.CardOwner != ""},
 		OffsetNumber: request.Limit * (request.Page - 1),
 		LimitNumber:  request.Limit,
@@ -72,12 +74,14 @@ func (c *SmartCardUseCase) List(ctx context.Context, request *model.ListSmartCar
 		}
 
 		result = append(result, model.SmartCardComplete{
-			SmartCard: model.SmartCard{
-				ID:        smartCard.ID,
-				Uid:       smartCard.Uid,
-				CreatedAt: smartCard.CreatedAt.Time.Format("2006-01-02 15:04:05"),
-				IsActive:  smartCard.IsActive,
-			},
+			SmartCard: withSmartCardValidity(model.SmartCard{
+				ID:           smartCard.ID,
+				Uid:          smartCard.Uid,
+				CreatedAt:    smartCard.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+				IsActive:     smartCard.IsActive,
+				State:        smartCard.State,
+				ReplacedByID: smartCard.ReplacedByID.Int32,
+			}, smartCard.ValidFrom, smartCard.ValidUntil, smartCard.ExpiredAt),
 			Owner: model.OwenerDetails{
 				ID:   detailsId,
 				Role: repo.RoleType(ownerRole),
@@ -93,6 +97,7 @@ func (c *SmartCardUseCase) Count(ctx context.Context, request *model.ListSmartCa
 	count, err := c.store.CountSmartCards(ctx, repo.CountSmartCardsParams{
 		Q:         pgtype.Text{String: request.Q, Valid: request.Q != ""},
 		IsActive:  pgtype.Bool{Bool: true, Valid: true},
+		State:     repo.NullSmartCardState{SmartCardState: request.State, Valid: request.State != ""},
 		CardOwner: repo.NullCardOwner{CardOwner: request.CardOwner, Valid: request.CardOwner != ""},
 	})
 
@@ -115,15 +120,18 @@ func (c *SmartCardUseCase) Get(ctx context.Context, request *model.SmartCardRequ
 	var ownerRole string
 	var ownerId int32
 	var ownerName string
+	var ownerOccupationId int32
 
 	if smartCard.SantriID.Valid {
 		ownerRole = "santri"
 		ownerId = smartCard.SantriID.Int32
 		ownerName = smartCard.SantriName.String
+		ownerOccupationId = smartCard.SantriOccupationID.Int32
 	} else if smartCard.EmployeeID.Valid {
+		ownerRole = "employee"
 		ownerId = smartCard.EmployeeID.Int32
 		ownerName = smartCard.EmployeeName.String
-
+		ownerOccupationId = smartCard.EmployeeOccupationID.Int32
 	} else {
 		ownerRole = ""
 		ownerId = 0
@@ -131,79 +139,415 @@ func (c *SmartCardUseCase) Get(ctx context.Context, request *model.SmartCardRequ
 	}
 
 	return &model.SmartCardComplete{
-		SmartCard: model.SmartCard{
-			ID:        smartCard.ID,
-			Uid:       smartCard.Uid,
-			CreatedAt: smartCard.CreatedAt.Time.Format("2006-01-02 15:04:05"),
-			IsActive:  smartCard.IsActive,
-		},
+		SmartCard: withSmartCardValidity(model.SmartCard{
+			ID:           smartCard.ID,
+			Uid:          smartCard.Uid,
+			CreatedAt:    smartCard.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+			IsActive:     smartCard.IsActive,
+			State:        smartCard.State,
+			ReplacedByID: smartCard.ReplacedByID.Int32,
+		}, smartCard.ValidFrom, smartCard.ValidUntil, smartCard.ExpiredAt),
 		Owner: model.OwenerDetails{
-			ID:   ownerId,
-			Role: repo.RoleType(ownerRole),
-			Name: ownerName,
+			ID:           ownerId,
+			Role:         repo.RoleType(ownerRole),
+			Name:         ownerName,
+			OccupationID: ownerOccupationId,
 		},
 	}, nil
 }
 
+// GetOwnerSmartCard returns the card held by the owner, an active card before a suspended one.
+// Lost and retired cards are left out, an owner holding no other card is not found.
+func (c *SmartCardUseCase) GetOwnerSmartCard(ctx context.Context, owner model.OwenerDetails) (*model.SmartCardComplete, error) {
+	arg := repo.GetOwnerSmartCardParams{}
+	if owner.Role == repo.RoleTypeSantri {
+		arg.SantriID = pgtype.Int4{Int32: owner.ID, Valid: true}
+	} else {
+		arg.EmployeeID = pgtype.Int4{Int32: owner.ID, Valid: true}
+	}
+
+	smartCard, err := c.store.GetOwnerSmartCard(ctx, arg)
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("Smart Card not found")
+		}
+		return nil, err
+	}
+
+	return &model.SmartCardComplete{
+		SmartCard: withSmartCardValidity(model.SmartCard{
+			ID:           smartCard.ID,
+			Uid:          smartCard.Uid,
+			CreatedAt:    smartCard.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+			IsActive:     smartCard.IsActive,
+			State:        smartCard.State,
+			ReplacedByID: smartCard.ReplacedByID.Int32,
+		}, smartCard.ValidFrom, smartCard.ValidUntil, smartCard.ExpiredAt),
+		Owner: owner,
+	}, nil
+}
+
+// IsOwnerActive reports whether the owner may still tap, a santri who is no longer active may not
+func (c *SmartCardUseCase) IsOwnerActive(ctx context.Context, owner model.OwenerDetails) (bool, error) {
+	if owner.Role == repo.RoleTypeSantri {
+		santri, err := c.store.GetSantri(ctx, owner.ID)
+		if err != nil {
+			if errors.Is(err, exception.ErrNotFound) {
+				return false, exception.NewNotFoundError("Santri not found")
+			}
+			return false, err
+		}
+		return !santri.IsActive.Valid || santri.IsActive.Bool, nil
+	}
+
+	if _, err := c.store.GetEmployeeByID(ctx, owner.ID); err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return false, exception.NewNotFoundError("Employee not found")
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// Update changes the state and owner of the card, the previous owner stays in the assignment history
 func (c *SmartCardUseCase) Update(ctx context.Context, request *model.UpdateSmartCardRequest, id int32) (*model.SmartCardComplete, error) {
-	var ownerRole string
-	var detailsId int32
-	var detailsName string
+	state := request.State
+	if state == "" {
+		state = repo.SmartCardStateSuspended
+		if request.IsActive {
+			state = repo.SmartCardStateActive
+		}
+	}
+
+	current, err := c.store.GetSmartCardByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("Smart Card not found")
+		}
+		return nil, err
+	}
+	if current.State == repo.SmartCardStateRetired {
+		return nil, exception.NewValidationError("Retired smart card can not be changed")
+	}
+
+	var owner model.OwenerDetails
+	arg := repo.UpdateSmartCardParams{
+		ID:    id,
+		State: repo.NullSmartCardState{SmartCardState: state, Valid: true},
+	}
+
+	validFrom, validUntil := current.ValidFrom, current.ValidUntil
+	if request.ValidFrom.Set {
+		validFrom = optionalTimestamptz(request.ValidFrom.Time)
+		arg.ValidFrom = validFrom
+		arg.ClearValidFrom = !validFrom.Valid
+	}
+	if request.ValidUntil.Set {
+		validUntil = optionalTimestamptz(request.ValidUntil.Time)
+		arg.ValidUntil = validUntil
+		arg.ClearValidUntil = !validUntil.Valid
+	}
+	if validFrom.Valid && validUntil.Valid && !validFrom.Time.Before(validUntil.Time) {
+		return nil, exception.NewValidationError("valid_from must be before valid_until")
+	}
+
+	// a lost or retired card no longer belongs to anyone
+	if state == repo.SmartCardStateActive || state == repo.SmartCardStateSuspended {
+		if request.OwnerRole == repo.RoleTypeSantri {
+			santri, err := c.store.GetSantri(ctx, request.OwnerID)
+			if err != nil {
+				return nil, err
+			}
+			owner = model.OwenerDetails{ID: santri.ID, Role: repo.RoleTypeSantri, Name: santri.Name}
+			arg.SantriID = pgtype.Int4{Int32: santri.ID, Valid: true}
+		} else if request.OwnerRole != "" {
+			employee, err := c.store.GetEmployeeByID(ctx, request.OwnerID)
+			if err != nil {
+				return nil, err
+			}
+			owner = model.OwenerDetails{ID: employee.ID, Role: repo.RoleTypeEmployee, Name: employee.Name}
+			arg.EmployeeID = pgtype.Int4{Int32: employee.ID, Valid: true}
+		}
+	}
+
+	updatedSmartCard, err := c.store.UpdateSmartCardWithAssignment(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.SmartCardComplete{
+		SmartCard: withSmartCardValidity(model.SmartCard{
+			ID:           updatedSmartCard.ID,
+			Uid:          updatedSmartCard.Uid,
+			CreatedAt:    updatedSmartCard.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+			IsActive:     updatedSmartCard.IsActive,
+			State:        updatedSmartCard.State,
+			ReplacedByID: updatedSmartCard.ReplacedByID.Int32,
+		}, updatedSmartCard.ValidFrom, updatedSmartCard.ValidUntil, updatedSmartCard.ExpiredAt),
+		Owner: owner,
+	}, nil
+}
+
+// Replace retires the card, or marks it lost, and gives its owner the card with the new UID in one transaction
+func (c *SmartCardUseCase) Replace(ctx context.Context, id int32, request *model.ReplaceSmartCardRequest) (*model.SmartCardComplete, error) {
+	oldSmartCard, err := c.store.GetSmartCardByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("Smart Card not found")
+		}
+		return nil, err
+	}
+	if oldSmartCard.State == repo.SmartCardStateRetired || oldSmartCard.State == repo.SmartCardStateLost {
+		return nil, exception.NewValidationError("Smart card is already replaced or lost")
+	}
+	if !oldSmartCard.SantriID.Valid && !oldSmartCard.EmployeeID.Valid {
+		return nil, exception.NewValidationError("Smart card has no owner to move")
+	}
+
+	newSmartCard, err := c.store.GetSmartCard(ctx, request.Uid)
+	if err == nil {
+		if newSmartCard.ID == id {
+			return nil, exception.NewValidationError("New smart card must differ from the old one")
+		}
+		if newSmartCard.SantriID.Valid || newSmartCard.EmployeeID.Valid {
+			return nil, exception.NewUniqueViolationError("New smart card already has an owner", nil)
+		}
+		if newSmartCard.State == repo.SmartCardStateRetired || newSmartCard.State == repo.SmartCardStateLost {
+			return nil, exception.NewValidationError("New smart card is lost or retired")
+		}
+	} else if !errors.Is(err, exception.ErrNotFound) {
+		return nil, err
+	}
+
+	if _, err := c.store.ReplaceSmartCard(ctx, id, request.Reason, request.Uid); err != nil {
+		return nil, err
+	}
+
+	return c.Get(ctx, &model.SmartCardRequest{Uid: request.Uid})
+}
+
+// ListAssignments returns who held the card and when, most recent first
+func (c *SmartCardUseCase) ListAssignments(ctx context.Context, id int32) (*[]model.SmartCardAssignment, error) {
+	if _, err := c.store.GetSmartCardByID(ctx, id); err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("Smart Card not found")
+		}
+		return nil, err
+	}
+
+	assignments, err := c.store.ListSmartCardAssignments(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]model.SmartCardAssignment, 0, len(assignments))
+	for _, assignment := range assignments {
+		item := model.SmartCardAssignment{
+			ID:        assignment.ID,
+			ValidFrom: assignment.ValidFrom.Time.Format("2006-01-02 15:04:05"),
+		}
+		if assignment.ValidUntil.Valid {
+			item.ValidUntil = assignment.ValidUntil.Time.Format("2006-01-02 15:04:05")
+		}
+		if assignment.SantriID.Valid {
+			item.Owner = model.OwenerDetails{ID: assignment.SantriID.Int32, Role: repo.RoleTypeSantri, Name: assignment.SantriName.String}
+		} else if assignment.EmployeeID.Valid {
+			item.Owner = model.OwenerDetails{ID: assignment.EmployeeID.Int32, Role: repo.RoleTypeEmployee, Name: assignment.EmployeeName.String}
+		}
+		result = append(result, item)
+	}
+
+	return &result, nil
+}
+
+func (c *SmartCardUseCase) Delete(ctx context.Context, id int32) (*model.SmartCard, error) {
+	deletedSmartCard, err := c.store.DeleteSmartCard(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.SmartCard{
+		ID:        deletedSmartCard.ID,
+		Uid:       deletedSmartCard.Uid,
+		CreatedAt: deletedSmartCard.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+		IsActive:  deletedSmartCard.IsActive,
+		State:     deletedSmartCard.State,
+	}, nil
+}
+
+// QueuePending remembers an unregistered card tapped on the device, so an admin can claim it later
+func (c *SmartCardUseCase) QueuePending(ctx context.Context, uid string, deviceName string) error {
+	_, err := c.store.UpsertPendingSmartCard(ctx, repo.UpsertPendingSmartCardParams{
+		Uid:        uid,
+		DeviceName: deviceName,
+	})
+	return err
+}
+
+func (c *SmartCardUseCase) ListPending(ctx context.Context, request *model.ListPendingSmartCardRequest) (*[]model.PendingSmartCard, error) {
+	listPending, err := c.store.ListPendingSmartCards(ctx, repo.ListPendingSmartCardsParams{
+		OffsetNumber: request.Limit * (request.Page - 1),
+		LimitNumber:  request.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]model.PendingSmartCard, 0, len(listPending))
+	for _, pending := range listPending {
+		item := model.PendingSmartCard{
+			ID:            pending.ID,
+			Uid:           pending.Uid,
+			TapCount:      pending.TapCount,
+			FirstTappedAt: pending.FirstTappedAt.Time.Format("2006-01-02 15:04:05"),
+			LastTappedAt:  pending.LastTappedAt.Time.Format("2006-01-02 15:04:05"),
+		}
+		if pending.DeviceID.Valid {
+			item.Device = &model.IdAndName{Id: pending.DeviceID.Int32, Name: pending.DeviceName.String}
+		}
+		result = append(result, item)
+	}
+
+	return &result, nil
+}
+
+func (c *SmartCardUseCase) CountPending(ctx context.Context) (int64, error) {
+	return c.store.CountPendingSmartCards(ctx)
+}
+
+// ClaimPending registers the pending card with the owner in one step
+func (c *SmartCardUseCase) ClaimPending(ctx context.Context, id int32, request *model.ClaimPendingSmartCardRequest) (*model.SmartCardComplete, error) {
+	owner := model.OwenerDetails{ID: request.OwnerID, Role: request.OwnerRole}
+	arg := repo.CreateSmartCardParams{State: repo.SmartCardStateActive}
+	if request.IsActive != nil && !*request.IsActive {
+		arg.State = repo.SmartCardStateSuspended
+	}
 
 	if request.OwnerRole == repo.RoleTypeSantri {
 		santri, err := c.store.GetSantri(ctx, request.OwnerID)
 		if err != nil {
+			if errors.Is(err, exception.ErrNotFound) {
+				return nil, exception.NewNotFoundError("Santri not found")
+			}
 			return nil, err
 		}
-		detailsId = santri.ID
-		detailsName = santri.Name
-		ownerRole = "santri"
+		owner.Name = santri.Name
+		owner.OccupationID = santri.OccupationID.Int32
+		arg.SantriID = pgtype.Int4{Int32: santri.ID, Valid: true}
 	} else {
 		employee, err := c.store.GetEmployeeByID(ctx, request.OwnerID)
 		if err != nil {
+			if errors.Is(err, exception.ErrNotFound) {
+				return nil, exception.NewNotFoundError("Employee not found")
+			}
 			return nil, err
 		}
-		detailsId = employee.ID
-		detailsName = employee.Name
-		ownerRole = "employee"
+		owner.Name = employee.Name
+		owner.OccupationID = employee.OccupationID
+		arg.EmployeeID = pgtype.Int4{Int32: employee.ID, Valid: true}
 	}
 
-	updatedSmartCard, err := c.store.UpdateSmartCard(ctx, repo.UpdateSmartCardParams{
-		ID:         id,
-		IsActive:   pgtype.Bool{Bool: request.IsActive, Valid: true},
-		SantriID:   pgtype.Int4{Int32: request.OwnerID, Valid: ownerRole == "santri"},
-		EmployeeID: pgtype.Int4{Int32: request.OwnerID, Valid: ownerRole == "employee"},
-	})
-
+	createdSmartCard, err := c.store.ClaimPendingSmartCard(ctx, id, arg)
 	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("Pending smart card not found")
+		}
+		if exception.DatabaseErrorCode(err) == exception.ErrCodeUniqueViolation {
+			return nil, exception.NewUniqueViolationError("Smart Card already exists", err)
+		}
 		return nil, err
 	}
 
 	return &model.SmartCardComplete{
 		SmartCard: model.SmartCard{
-			ID:        updatedSmartCard.ID,
-			Uid:       updatedSmartCard.Uid,
-			CreatedAt: updatedSmartCard.CreatedAt.Time.Format("2006-01-02 15:04:05"),
-			IsActive:  updatedSmartCard.IsActive,
-		},
-		Owner: model.OwenerDetails{
-			ID:   detailsId,
-			Role: repo.RoleType(ownerRole),
-			Name: detailsName,
+			ID:        createdSmartCard.ID,
+			Uid:       createdSmartCard.Uid,
+			CreatedAt: createdSmartCard.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+			IsActive:  createdSmartCard.IsActive,
+			State:     createdSmartCard.State,
 		},
+		Owner: owner,
 	}, nil
 }
 
-func (c *SmartCardUseCase) Delete(ctx context.Context, id int32) (*model.SmartCard, error) {
-	deletedSmartCard, err := c.store.DeleteSmartCard(ctx, id)
+// DeletePending dismisses a pending card, e.g. a card of a visitor
+func (c *SmartCardUseCase) DeletePending(ctx context.Context, id int32) (*model.PendingSmartCard, error) {
+	deletedPending, err := c.store.DeletePendingSmartCard(ctx, id)
 	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return nil, exception.NewNotFoundError("Pending smart card not found")
+		}
 		return nil, err
 	}
 
-	return &model.SmartCard{
-		ID:        deletedSmartCard.ID,
-		Uid:       deletedSmartCard.Uid,
-		CreatedAt: deletedSmartCard.CreatedAt.Time.Format("2006-01-02 15:04:05"),
-		IsActive:  deletedSmartCard.IsActive,
+	return &model.PendingSmartCard{
+		ID:            deletedPending.ID,
+		Uid:           deletedPending.Uid,
+		TapCount:      deletedPending.TapCount,
+		FirstTappedAt: deletedPending.FirstTappedAt.Time.Format("2006-01-02 15:04:05"),
+		LastTappedAt:  deletedPending.LastTappedAt.Time.Format("2006-01-02 15:04:05"),
 	}, nil
 }
+
+// ListExpiring returns active cards whose validity ends within the coming days, the soonest first
+func (c *SmartCardUseCase) ListExpiring(ctx context.Context, request *model.ListExpiringSmartCardRequest) (*[]model.SmartCardComplete, error) {
+	listSmartCard, err := c.store.ListExpiringSmartCards(ctx, repo.ListExpiringSmartCardsParams{
+		Days:         request.Days,
+		OffsetNumber: request.Limit * (request.Page - 1),
+		LimitNumber:  request.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]model.SmartCardComplete, 0, len(listSmartCard))
+	for _, smartCard := range listSmartCard {
+		var owner model.OwenerDetails
+		if smartCard.SantriID.Valid {
+			owner = model.OwenerDetails{ID: smartCard.SantriID.Int32, Role: repo.RoleTypeSantri, Name: smartCard.SantriName.String}
+		} else if smartCard.EmployeeID.Valid {
+			owner = model.OwenerDetails{ID: smartCard.EmployeeID.Int32, Role: repo.RoleTypeEmployee, Name: smartCard.EmployeeName.String}
+		}
+
+		result = append(result, model.SmartCardComplete{
+			SmartCard: withSmartCardValidity(model.SmartCard{
+				ID:        smartCard.ID,
+				Uid:       smartCard.Uid,
+				CreatedAt: smartCard.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+				IsActive:  smartCard.IsActive,
+				State:     smartCard.State,
+			}, smartCard.ValidFrom, smartCard.ValidUntil, smartCard.ExpiredAt),
+			Owner: owner,
+		})
+	}
+
+	return &result, nil
+}
+
+func (c *SmartCardUseCase) CountExpiring(ctx context.Context, request *model.ListExpiringSmartCardRequest) (int64, error) {
+	return c.store.CountExpiringSmartCards(ctx, request.Days)
+}
+
+// ExpireCards suspends active cards past their valid_until and returns how many were suspended
+func (c *SmartCardUseCase) ExpireCards(ctx context.Context) (int, error) {
+	expired, err := c.store.ExpireSmartCards(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return len(expired), nil
+}
+
+func withSmartCardValidity(smartCard model.SmartCard, validFrom pgtype.Timestamptz, validUntil pgtype.Timestamptz, expiredAt pgtype.Timestamptz) model.SmartCard {
+	if validFrom.Valid {
+		smartCard.ValidFrom = validFrom.Time.Format("2006-01-02 15:04:05")
+		smartCard.ValidFromTime = validFrom.Time
+	}
+	if validUntil.Valid {
+		smartCard.ValidUntil = validUntil.Time.Format("2006-01-02 15:04:05")
+		smartCard.ValidUntilTime = validUntil.Time
+	}
+	if expiredAt.Valid {
+		smartCard.ExpiredAtTime = expiredAt.Time
+	}
+	return smartCard
+}
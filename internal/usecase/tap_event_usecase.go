@@ -0,0 +1,171 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/exception"
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+	"github.com/adiubaidah/syafiiyah-main/pkg/util"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type TapEventUseCase struct {
+	store repo.Store
+}
+
+func NewTapEventUseCase(store repo.Store) *TapEventUseCase {
+	return &TapEventUseCase{store: store}
+}
+
+// CreateTapEvent saves the tap and returns it as listed by /tap-event
+func (c *TapEventUseCase) CreateTapEvent(ctx context.Context, request *model.CreateTapEventRequest) (*model.TapEventResponse, error) {
+	params := repo.CreateTapEventParams{
+		DeviceName: request.DeviceName,
+		Mode:       request.Mode,
+		Uid:        pgtype.Text{String: request.Uid, Valid: request.Uid != ""},
+		Code:       int32(request.Code),
+		Message:    request.Message,
+		LatencyMs:  int32(request.Latency.Milliseconds()),
+		GateDirection: repo.NullGateDirectionType{
+			GateDirectionType: request.GateDirection,
+			Valid:             request.GateDirection != "",
+		},
+	}
+	switch request.Owner.Role {
+	case repo.RoleTypeSantri:
+		params.SantriID = pgtype.Int4{Int32: request.Owner.ID, Valid: true}
+	case repo.RoleTypeEmployee:
+		params.EmployeeID = pgtype.Int4{Int32: request.Owner.ID, Valid: true}
+	}
+	if request.TappedAt != nil {
+		params.TappedAt = pgtype.Timestamptz{Time: *request.TappedAt, Valid: true}
+	}
+
+	tapEvent, err := c.store.CreateTapEvent(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &model.TapEventResponse{
+		ID:        tapEvent.ID,
+		Mode:      tapEvent.Mode,
+		Uid:       tapEvent.Uid.String,
+		Code:      tapEvent.Code,
+		Message:   tapEvent.Message,
+		LatencyMs: tapEvent.LatencyMs,
+		TappedAt:  tapEvent.TappedAt.Time.Format("2006-01-02 15:04:05"),
+		CreatedAt: tapEvent.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+	if tapEvent.DeviceID.Valid {
+		response.Device = &model.IdAndName{Id: tapEvent.DeviceID.Int32, Name: request.DeviceName}
+	}
+	if tapEvent.SantriID.Valid {
+		response.Santri = &model.IdAndName{Id: tapEvent.SantriID.Int32, Name: request.Owner.Name}
+	}
+	if tapEvent.EmployeeID.Valid {
+		response.Employee = &model.IdAndName{Id: tapEvent.EmployeeID.Int32, Name: request.Owner.Name}
+	}
+	return response, nil
+}
+
+// GetLastGateDirection returns the direction of the last accepted gate tap of the card, empty when it never passed a gate
+func (c *TapEventUseCase) GetLastGateDirection(ctx context.Context, uid string) (repo.GateDirectionType, error) {
+	direction, err := c.store.GetLastGateDirection(ctx, pgtype.Text{String: uid, Valid: true})
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+	return direction, nil
+}
+
+func (c *TapEventUseCase) ListTapEvents(ctx context.Context, request *model.ListTapEventRequest) (*[]model.TapEventResponse, error) {
+	filter, err := toTapEventFilter(request)
+	if err != nil {
+		return nil, err
+	}
+
+	tapEvents, err := c.store.ListTapEvents(ctx, repo.ListTapEventsParams{
+		DeviceID:     filter.DeviceID,
+		Mode:         filter.Mode,
+		Uid:          filter.Uid,
+		SantriID:     filter.SantriID,
+		EmployeeID:   filter.EmployeeID,
+		Success:      filter.Success,
+		FromDate:     filter.FromDate,
+		ToDate:       filter.ToDate,
+		OffsetNumber: (request.Page - 1) * request.Limit,
+		LimitNumber:  request.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]model.TapEventResponse, 0, len(tapEvents))
+	for _, tapEvent := range tapEvents {
+		item := model.TapEventResponse{
+			ID:        tapEvent.ID,
+			Mode:      tapEvent.Mode,
+			Uid:       tapEvent.Uid.String,
+			Code:      tapEvent.Code,
+			Message:   tapEvent.Message,
+			LatencyMs: tapEvent.LatencyMs,
+			TappedAt:  tapEvent.TappedAt.Time.Format("2006-01-02 15:04:05"),
+			CreatedAt: tapEvent.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+		}
+		if tapEvent.DeviceID.Valid {
+			item.Device = &model.IdAndName{Id: tapEvent.DeviceID.Int32, Name: tapEvent.DeviceName.String}
+		}
+		if tapEvent.SantriID.Valid {
+			item.Santri = &model.IdAndName{Id: tapEvent.SantriID.Int32, Name: tapEvent.SantriName.String}
+		}
+		if tapEvent.EmployeeID.Valid {
+			item.Employee = &model.IdAndName{Id: tapEvent.EmployeeID.Int32, Name: tapEvent.EmployeeName.String}
+		}
+		response = append(response, item)
+	}
+
+	return &response, nil
+}
+
+func (c *TapEventUseCase) CountTapEvents(ctx context.Context, request *model.ListTapEventRequest) (int64, error) {
+	filter, err := toTapEventFilter(request)
+	if err != nil {
+		return 0, err
+	}
+
+	return c.store.CountTapEvents(ctx, filter)
+}
+
+func toTapEventFilter(request *model.ListTapEventRequest) (repo.CountTapEventsParams, error) {
+	var fromDate, toDate time.Time
+	var err error
+	if request.From != "" {
+		fromDate, err = util.ParseDate(request.From)
+		if err != nil {
+			return repo.CountTapEventsParams{}, exception.NewValidationError("From date is not valid")
+		}
+	}
+
+	if request.To != "" {
+		toDate, err = util.ParseDate(request.To)
+		if err != nil {
+			return repo.CountTapEventsParams{}, exception.NewValidationError("To date is not valid")
+		}
+	}
+
+	return repo.CountTapEventsParams{
+		DeviceID:   pgtype.Int4{Int32: request.DeviceID, Valid: request.DeviceID != 0},
+		Mode:       repo.NullDeviceModeType{DeviceModeType: request.Mode, Valid: request.Mode != ""},
+		Uid:        pgtype.Text{String: request.Uid, Valid: request.Uid != ""},
+		SantriID:   pgtype.Int4{Int32: request.SantriID, Valid: request.SantriID != 0},
+		EmployeeID: pgtype.Int4{Int32: request.EmployeeID, Valid: request.EmployeeID != 0},
+		Success:    pgtype.Bool{Bool: request.Outcome == "success", Valid: request.Outcome != ""},
+		FromDate:   pgtype.Date{Time: fromDate, Valid: request.From != ""},
+		ToDate:     pgtype.Date{Time: toDate, Valid: request.To != ""},
+	}, nil
+}
@@ -0,0 +1,130 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
+	pb "github.com/adiubaidah/syafiiyah-main/internal/protobuf"
+	"github.com/adiubaidah/syafiiyah-main/internal/usecase"
+	"github.com/adiubaidah/syafiiyah-main/pkg/util"
+	"github.com/sirupsen/logrus"
+)
+
+// santriAlphaCheckInterval is how often the worker looks for schedules whose presence window closed
+const santriAlphaCheckInterval = time.Minute
+
+// santriAlphaCatchUpDays is how many days before today are marked again when the worker starts,
+// so schedules that finished while the service was down are not left without alpha
+const santriAlphaCatchUpDays = 3
+
+// SantriAlphaWorker marks santri without a presence as alpha once the finish_time of a schedule is passed.
+// Schedules already finished when the worker starts are marked right away, together with the ones of
+// the previous santriAlphaCatchUpDays days. Marking is idempotent.
+type SantriAlphaWorker struct {
+	logger          *logrus.Logger
+	schedule        pb.SantriScheduleServiceClient
+	presenceUseCase usecase.SantriPresenceUseCase
+	// marked holds the schedules already marked today
+	marked map[santriAlphaMark]struct{}
+}
+
+type santriAlphaMark struct {
+	scheduleID int32
+	date       string
+}
+
+func NewSantriAlphaWorker(logger *logrus.Logger, schedule pb.SantriScheduleServiceClient, presenceUseCase usecase.SantriPresenceUseCase) *SantriAlphaWorker {
+	return &SantriAlphaWorker{
+		logger:          logger,
+		schedule:        schedule,
+		presenceUseCase: presenceUseCase,
+		marked:          make(map[santriAlphaMark]struct{}),
+	}
+}
+
+// Run catches up the previous days, marks finished schedules right away and then every minute, until ctx is done
+func (w *SantriAlphaWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(santriAlphaCheckInterval)
+	defer ticker.Stop()
+
+	w.catchUp(ctx, time.Now())
+
+	for {
+		w.markFinished(ctx, time.Now())
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *SantriAlphaWorker) markFinished(ctx context.Context, now time.Time) {
+	schedules, err := w.schedule.ListSantriSchedule(ctx, &pb.ListSantriScheduleRequest{})
+	if err != nil {
+		w.logger.Errorf("Error listing santri schedule: %v", err)
+		return
+	}
+
+	today := now.Format("2006-01-02")
+	for mark := range w.marked {
+		if mark.date != today {
+			delete(w.marked, mark)
+		}
+	}
+
+	for _, schedule := range schedules.Schedules {
+		finishTime, err := util.ParseHHMMWithDate(schedule.FinishTime, now)
+		if err != nil {
+			w.logger.Errorf("Error parsing finish time of santri schedule %d: %v", schedule.Id, err)
+			continue
+		}
+		mark := santriAlphaMark{scheduleID: schedule.Id, date: today}
+		if now.Before(finishTime) {
+			continue
+		}
+		if _, ok := w.marked[mark]; ok {
+			continue
+		}
+
+		// a failed schedule is not remembered, it is marked again on the next check
+		if err := w.markSchedule(ctx, schedule, finishTime); err != nil {
+			continue
+		}
+		w.marked[mark] = struct{}{}
+	}
+}
+
+// catchUp marks every schedule of the previous days, the days before the oldest one are left as they are
+func (w *SantriAlphaWorker) catchUp(ctx context.Context, now time.Time) {
+	schedules, err := w.schedule.ListSantriSchedule(ctx, &pb.ListSantriScheduleRequest{})
+	if err != nil {
+		w.logger.Errorf("Error listing santri schedule: %v", err)
+		return
+	}
+
+	for days := santriAlphaCatchUpDays; days >= 1; days-- {
+		day := now.AddDate(0, 0, -days)
+		for _, schedule := range schedules.Schedules {
+			finishTime, err := util.ParseHHMMWithDate(schedule.FinishTime, day)
+			if err != nil {
+				w.logger.Errorf("Error parsing finish time of santri schedule %d: %v", schedule.Id, err)
+				continue
+			}
+			w.markSchedule(ctx, schedule, finishTime)
+		}
+	}
+}
+
+func (w *SantriAlphaWorker) markSchedule(ctx context.Context, schedule *pb.SantriSchedule, finishTime time.Time) error {
+	count, err := w.presenceUseCase.MarkAlpha(ctx, model.IdAndName{Id: schedule.Id, Name: schedule.Name}, finishTime)
+	if err != nil {
+		w.logger.Errorf("Error marking alpha for santri schedule %d on %s: %v", schedule.Id, finishTime.Format("2006-01-02"), err)
+		return err
+	}
+	if count > 0 {
+		w.logger.Infof("Marked %d santri alpha for schedule %s on %s", count, schedule.Name, finishTime.Format("2006-01-02"))
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/usecase"
+	"github.com/sirupsen/logrus"
+)
+
+// defaultSmartCardExpiryInterval is used when SMART_CARD_EXPIRY_INTERVAL is not configured
+const defaultSmartCardExpiryInterval = time.Hour
+
+// SmartCardExpiryWorker suspends cards past their valid_until. Taps are refused at the end of the validity anyway,
+// the worker makes the state visible to admins.
+type SmartCardExpiryWorker struct {
+	logger   *logrus.Logger
+	useCase  *usecase.SmartCardUseCase
+	interval time.Duration
+}
+
+func NewSmartCardExpiryWorker(logger *logrus.Logger, useCase *usecase.SmartCardUseCase, interval time.Duration) *SmartCardExpiryWorker {
+	if interval <= 0 {
+		interval = defaultSmartCardExpiryInterval
+	}
+	return &SmartCardExpiryWorker{
+		logger:   logger,
+		useCase:  useCase,
+		interval: interval,
+	}
+}
+
+// Run expires cards right away and then every interval, until ctx is done
+func (w *SmartCardExpiryWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.expire(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *SmartCardExpiryWorker) expire(ctx context.Context) {
+	count, err := w.useCase.ExpireCards(ctx)
+	if err != nil {
+		w.logger.Errorf("Error expiring smart cards: %v", err)
+		return
+	}
+	if count > 0 {
+		w.logger.Infof("Suspended %d expired smart cards", count)
+	}
+}
@@ -17,10 +17,10 @@ type worker struct {
 	client          *redis.Client
 	logger          *logrus.Logger
 	schedule        pb.EmployeeScheduleServiceClient
-	presenceUseCase usecase.EmployeePresenceUseCase
+	presenceUseCase *usecase.EmployeePresenceUseCase
 }
 
-func NewEmployeeWorker(logger *logrus.Logger, redisClient *redis.Client, schedule pb.EmployeeScheduleServiceClient, usecase usecase.EmployeePresenceUseCase) EmployeeWorker {
+func NewEmployeeWorker(logger *logrus.Logger, redisClient *redis.Client, schedule pb.EmployeeScheduleServiceClient, usecase *usecase.EmployeePresenceUseCase) EmployeeWorker {
 	w := &worker{
 		logger:          logger,
 		client:          redisClient,
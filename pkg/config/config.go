@@ -19,6 +19,15 @@ type Config struct {
 	RefreshTokenDuration   time.Duration `mapstructure:"REFRESH_TOKEN_DURATION"`
 	GoogleOauthClient      string        `mapstructure:"GOOGLE_OAUTH_CLIENT"`
 	MQTTBroker             string        `mapstructure:"MQTT_BROKER"`
+	MQTTClientID           string        `mapstructure:"MQTT_CLIENT_ID"`
+	MQTTInputQoS           byte          `mapstructure:"MQTT_INPUT_QOS"`
+	MQTTAckQoS             byte          `mapstructure:"MQTT_ACK_QOS"`
+	MQTTSignatureMaxAge    time.Duration `mapstructure:"MQTT_SIGNATURE_MAX_AGE"`
+	MQTTWorkers            int           `mapstructure:"MQTT_WORKERS"`
+	MQTTQueueSize          int           `mapstructure:"MQTT_QUEUE_SIZE"`
+	MQTTOverflowPolicy     string        `mapstructure:"MQTT_OVERFLOW_POLICY"`
+	MQTTDrainTimeout       time.Duration `mapstructure:"MQTT_DRAIN_TIMEOUT"`
+	HTTPShutdownTimeout    time.Duration `mapstructure:"HTTP_SHUTDOWN_TIMEOUT"`
 	RedisAddress           string        `mapstructure:"REDIS_ADDRESS"`
 	DBRedis                int           `mapstructure:"DB_REDIS"`
 	AWSRegion              string        `mapstructure:"AWS_REGION"`
@@ -26,6 +35,11 @@ type Config struct {
 	AWSSecretKey           string        `mapstructure:"AWS_SECRET_KEY"`
 	AWSBucketName          string        `mapstructure:"AWS_BUCKET_NAME"`
 	ScheduleServiceAddress string        `mapstructure:"SCHEDULE_SERVICE_ADDRESS"`
+	DeviceOfflineAfter     time.Duration `mapstructure:"DEVICE_OFFLINE_AFTER"`
+	TapDebounceWindow      time.Duration `mapstructure:"TAP_DEBOUNCE_WINDOW"`
+	CardExpiryInterval     time.Duration `mapstructure:"SMART_CARD_EXPIRY_INTERVAL"`
+	QRTokenSymmetricKey    string        `mapstructure:"QR_TOKEN_SYMMETRIC_KEY"`
+	QRTokenDuration        time.Duration `mapstructure:"QR_TOKEN_DURATION"`
 }
 
 const PathPhoto = "internal/storage/photo"
@@ -35,6 +49,13 @@ func Load(path string) (config Config, err error) {
 	viper.SetConfigName("app")
 	viper.SetConfigType("env")
 
+	viper.SetDefault("MQTT_INPUT_QOS", 1)
+	viper.SetDefault("MQTT_ACK_QOS", 1)
+	viper.SetDefault("MQTT_OVERFLOW_POLICY", "block")
+	viper.SetDefault("MQTT_DRAIN_TIMEOUT", "30s")
+	viper.SetDefault("HTTP_SHUTDOWN_TIMEOUT", "15s")
+	viper.SetDefault("QR_TOKEN_DURATION", "1m")
+
 	viper.AutomaticEnv()
 
 	err = viper.ReadInConfig()
@@ -0,0 +1,142 @@
+package devicesim
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+)
+
+// Load describes the traffic fired by every registered device
+type Load struct {
+	// Modes are used in turn for taps, every mode must be registered on the device
+	Modes []repo.DeviceModeType
+	// UIDs are tapped in turn
+	UIDs []string
+	// Rate is taps per second of each device, a device never has more than one message in flight
+	Rate float64
+	// Count stops a device after that many taps, 0 means until Duration or the context is done
+	Count int
+	// Duration stops the load, 0 means until Count or the context is done
+	Duration time.Duration
+	// PingInterval sends heartbeats besides taps, 0 disables them
+	PingInterval time.Duration
+	// FirmwareVersion is reported in heartbeats
+	FirmwareVersion string
+}
+
+// Result is a message fired by the load, Ack is nil when Err is set
+type Result struct {
+	Device string
+	Mode   repo.DeviceModeType
+	Uid    string
+	Ack    *Ack
+	Err    error
+}
+
+// Report summarizes the acknowledgments of a load
+type Report struct {
+	Sent       int                         `json:"sent"`
+	Acked      int                         `json:"acked"`
+	TimedOut   int                         `json:"timed_out"`
+	Failed     int                         `json:"failed"`
+	Codes      map[int]int                 `json:"codes"`
+	Modes      map[repo.DeviceModeType]int `json:"modes"`
+	AvgLatency time.Duration               `json:"avg_latency"`
+	MaxLatency time.Duration               `json:"max_latency"`
+}
+
+func (r *Report) add(result Result) {
+	r.Sent++
+	r.Modes[result.Mode]++
+	switch {
+	case errors.Is(result.Err, ErrAckTimeout):
+		r.TimedOut++
+	case result.Err != nil:
+		r.Failed++
+	default:
+		r.AvgLatency = (r.AvgLatency*time.Duration(r.Acked) + result.Ack.Latency) / time.Duration(r.Acked+1)
+		r.Acked++
+		r.Codes[result.Ack.Code]++
+		if result.Ack.Latency > r.MaxLatency {
+			r.MaxLatency = result.Ack.Latency
+		}
+	}
+}
+
+// Run fires the load from every registered device until it is finished and reports the outcome.
+// onResult, when set, is called for every message and may be called concurrently.
+func (s *Simulator) Run(ctx context.Context, load Load, onResult func(Result)) Report {
+	if load.Duration > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, load.Duration)
+		defer cancel()
+	}
+
+	report := Report{Codes: make(map[int]int), Modes: make(map[repo.DeviceModeType]int)}
+	var mu sync.Mutex
+	collect := func(result Result) {
+		mu.Lock()
+		report.add(result)
+		mu.Unlock()
+		if onResult != nil {
+			onResult(result)
+		}
+	}
+
+	var wg sync.WaitGroup
+	for i, device := range s.Devices() {
+		wg.Add(1)
+		go func(offset int, device *Device) {
+			defer wg.Done()
+			device.run(ctx, load, offset, collect)
+		}(i, device)
+	}
+	wg.Wait()
+
+	return report
+}
+
+func (d *Device) run(ctx context.Context, load Load, offset int, collect func(Result)) {
+	var taps <-chan time.Time
+	if load.Rate > 0 && len(load.Modes) > 0 && len(load.UIDs) > 0 {
+		ticker := time.NewTicker(time.Duration(float64(time.Second) / load.Rate))
+		defer ticker.Stop()
+		taps = ticker.C
+	}
+
+	var pings <-chan time.Time
+	if load.PingInterval > 0 {
+		ticker := time.NewTicker(load.PingInterval)
+		defer ticker.Stop()
+		pings = ticker.C
+	}
+
+	if taps == nil && pings == nil {
+		return
+	}
+
+	for sent := 0; load.Count == 0 || sent < load.Count; {
+		select {
+		case <-ctx.Done():
+			return
+		case <-pings:
+			ack, err := d.Ping(ctx, model.DevicePingRequest{FirmwareVersion: load.FirmwareVersion})
+			if ctx.Err() == nil {
+				collect(Result{Device: d.Name, Mode: repo.DeviceModeTypePing, Ack: ack, Err: err})
+			}
+		case <-taps:
+			// devices start on different cards, like readers at different doors
+			mode := load.Modes[sent%len(load.Modes)]
+			uid := load.UIDs[(sent+offset)%len(load.UIDs)]
+			ack, err := d.Tap(ctx, mode, uid)
+			if ctx.Err() == nil {
+				collect(Result{Device: d.Name, Mode: mode, Uid: uid, Ack: ack, Err: err})
+			}
+			sent++
+		}
+	}
+}
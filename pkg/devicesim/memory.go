@@ -0,0 +1,257 @@
+package devicesim
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+)
+
+// MemoryBroker is an in-process stand-in of the MQTT broker. Clients created by it implement mqtt.Client,
+// so the server and the simulator can talk to each other in tests without network services.
+// Retained messages, QoS and persistent sessions are not supported.
+type MemoryBroker struct {
+	mu      sync.RWMutex
+	clients map[*memoryClient]struct{}
+}
+
+func NewMemoryBroker() *MemoryBroker {
+	return &MemoryBroker{clients: make(map[*memoryClient]struct{})}
+}
+
+// NewClient has the same signature as mqtt.NewClient, the client has to be connected before use like a real one.
+// Only the client id, default publish handler and on connect handler of the options are used.
+func (b *MemoryBroker) NewClient(options *mqtt.ClientOptions) mqtt.Client {
+	client := &memoryClient{
+		broker:        b,
+		options:       options,
+		subscriptions: make(map[string]mqtt.MessageHandler),
+	}
+	client.cond = sync.NewCond(&client.mu)
+	return client
+}
+
+func (b *MemoryBroker) publish(message *memoryMessage) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for client := range b.clients {
+		client.deliver(message)
+	}
+}
+
+func (b *MemoryBroker) attach(client *memoryClient) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.clients[client] = struct{}{}
+}
+
+func (b *MemoryBroker) detach(client *memoryClient) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.clients, client)
+}
+
+// memoryClient delivers messages to its handlers one by one on its own goroutine, in publish order,
+// the same way the paho client does with ordered routing
+type memoryClient struct {
+	broker  *MemoryBroker
+	options *mqtt.ClientOptions
+
+	mu            sync.Mutex
+	cond          *sync.Cond
+	connected     bool
+	session       int
+	subscriptions map[string]mqtt.MessageHandler
+	inbox         []*memoryMessage
+}
+
+func (c *memoryClient) IsConnected() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.connected
+}
+
+func (c *memoryClient) IsConnectionOpen() bool {
+	return c.IsConnected()
+}
+
+func (c *memoryClient) Connect() mqtt.Token {
+	c.mu.Lock()
+	if c.connected {
+		c.mu.Unlock()
+		return completedToken(nil)
+	}
+	c.connected = true
+	c.session++
+	session := c.session
+	c.mu.Unlock()
+
+	c.broker.attach(c)
+	go c.run(session)
+	if c.options.OnConnect != nil {
+		go c.options.OnConnect(c)
+	}
+	return completedToken(nil)
+}
+
+func (c *memoryClient) Disconnect(quiesce uint) {
+	c.broker.detach(c)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.connected = false
+	c.inbox = nil
+	c.cond.Broadcast()
+}
+
+func (c *memoryClient) Publish(topic string, qos byte, retained bool, payload interface{}) mqtt.Token {
+	if !c.IsConnected() {
+		return completedToken(mqtt.ErrNotConnected)
+	}
+
+	var body []byte
+	switch p := payload.(type) {
+	case []byte:
+		body = append([]byte(nil), p...)
+	case string:
+		body = []byte(p)
+	default:
+		return completedToken(fmt.Errorf("unknown payload type %T", payload))
+	}
+
+	c.broker.publish(&memoryMessage{topic: topic, qos: qos, payload: body})
+	return completedToken(nil)
+}
+
+func (c *memoryClient) Subscribe(topic string, qos byte, callback mqtt.MessageHandler) mqtt.Token {
+	if !c.IsConnected() {
+		return completedToken(mqtt.ErrNotConnected)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.subscriptions[topic] = callback
+	return completedToken(nil)
+}
+
+func (c *memoryClient) SubscribeMultiple(filters map[string]byte, callback mqtt.MessageHandler) mqtt.Token {
+	for topic, qos := range filters {
+		if token := c.Subscribe(topic, qos, callback); token.Error() != nil {
+			return token
+		}
+	}
+	return completedToken(nil)
+}
+
+func (c *memoryClient) Unsubscribe(topics ...string) mqtt.Token {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, topic := range topics {
+		delete(c.subscriptions, topic)
+	}
+	return completedToken(nil)
+}
+
+func (c *memoryClient) AddRoute(topic string, callback mqtt.MessageHandler) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.subscriptions[topic] = callback
+}
+
+func (c *memoryClient) OptionsReader() mqtt.ClientOptionsReader {
+	return mqtt.NewOptionsReader(c.options)
+}
+
+func (c *memoryClient) deliver(message *memoryMessage) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.connected {
+		return
+	}
+	c.inbox = append(c.inbox, message)
+	c.cond.Signal()
+}
+
+// run delivers messages of a connection, it stops when the connection it was started for is closed
+func (c *memoryClient) run(session int) {
+	for {
+		c.mu.Lock()
+		for c.connected && c.session == session && len(c.inbox) == 0 {
+			c.cond.Wait()
+		}
+		if !c.connected || c.session != session {
+			c.mu.Unlock()
+			return
+		}
+		message := c.inbox[0]
+		c.inbox = c.inbox[1:]
+
+		var handlers []mqtt.MessageHandler
+		for filter, handler := range c.subscriptions {
+			if !topicMatches(filter, message.topic) {
+				continue
+			}
+			if handler == nil {
+				handler = c.options.DefaultPublishHandler
+			}
+			if handler != nil {
+				handlers = append(handlers, handler)
+			}
+		}
+		c.mu.Unlock()
+
+		for _, handler := range handlers {
+			handler(c, message)
+		}
+	}
+}
+
+// topicMatches supports the + and # wildcards of MQTT topic filters
+func topicMatches(filter string, topic string) bool {
+	filterLevels := strings.Split(filter, "/")
+	topicLevels := strings.Split(topic, "/")
+	for i, level := range filterLevels {
+		if level == "#" {
+			return true
+		}
+		if i >= len(topicLevels) {
+			return false
+		}
+		if level != "+" && level != topicLevels[i] {
+			return false
+		}
+	}
+	return len(filterLevels) == len(topicLevels)
+}
+
+type memoryMessage struct {
+	topic   string
+	qos     byte
+	payload []byte
+}
+
+func (m *memoryMessage) Duplicate() bool   { return false }
+func (m *memoryMessage) Qos() byte         { return m.qos }
+func (m *memoryMessage) Retained() bool    { return false }
+func (m *memoryMessage) Topic() string     { return m.topic }
+func (m *memoryMessage) MessageID() uint16 { return 0 }
+func (m *memoryMessage) Payload() []byte   { return m.payload }
+func (m *memoryMessage) Ack()              {}
+
+// memoryToken is already completed when it is returned, the memory broker never waits on the network
+type memoryToken struct {
+	err  error
+	done chan struct{}
+}
+
+func completedToken(err error) mqtt.Token {
+	done := make(chan struct{})
+	close(done)
+	return &memoryToken{err: err, done: done}
+}
+
+func (t *memoryToken) Wait() bool                     { return true }
+func (t *memoryToken) WaitTimeout(time.Duration) bool { return true }
+func (t *memoryToken) Done() <-chan struct{}          { return t.done }
+func (t *memoryToken) Error() error                   { return t.err }
@@ -0,0 +1,233 @@
+// Package devicesim simulates smart card readers talking to the server over MQTT.
+// It signs messages the same way the firmware does and waits for the acknowledgment of each of them,
+// so it can be used to test the tap pipeline locally or in automated tests.
+package devicesim
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+	"github.com/adiubaidah/syafiiyah-main/pkg/util"
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+)
+
+const defaultAckTimeout = 5 * time.Second
+
+// ErrAckTimeout is returned when the server does not acknowledge a message in time
+var ErrAckTimeout = errors.New("acknowledgment timeout")
+
+// Ack is the acknowledgment published by the server for a device message.
+// Status, Message and Data are set in the legacy format, the other fields in the compact format
+// of taps sent with an ack version, see model.DeviceAck.
+type Ack struct {
+	Topic   string             `json:"-"`
+	Version int                `json:"v"`
+	Code    int                `json:"code"`
+	Status  string             `json:"status"`
+	Message string             `json:"message"`
+	Data    json.RawMessage    `json:"data"`
+	Result  model.TapResult    `json:"result"`
+	Display string             `json:"display"`
+	Name    string             `json:"name"`
+	Icon    model.DeviceIcon   `json:"icon"`
+	Signal  model.DeviceSignal `json:"signal"`
+	Latency time.Duration      `json:"-"`
+}
+
+// Decode unmarshals the data of the acknowledgment
+func (a *Ack) Decode(v any) error {
+	if len(a.Data) == 0 {
+		return fmt.Errorf("acknowledgment on %s has no data", a.Topic)
+	}
+	return json.Unmarshal(a.Data, v)
+}
+
+type Simulator struct {
+	client     mqtt.Client
+	ackTimeout time.Duration
+
+	mu      sync.Mutex
+	devices map[string]*Device
+}
+
+// New returns a simulator publishing through a connected client, a zero timeout means 5 seconds
+func New(client mqtt.Client, ackTimeout time.Duration) *Simulator {
+	if ackTimeout <= 0 {
+		ackTimeout = defaultAckTimeout
+	}
+	return &Simulator{
+		client:     client,
+		ackTimeout: ackTimeout,
+		devices:    make(map[string]*Device),
+	}
+}
+
+// Register adds a virtual device and subscribes to its acknowledgment topics.
+// The name and secret must be the ones of a device created on the server.
+func (s *Simulator) Register(name string, secret string, modes ...repo.DeviceModeType) (*Device, error) {
+	device := &Device{
+		simulator: s,
+		Name:      name,
+		Secret:    secret,
+		Modes:     modes,
+		acks:      make(map[repo.DeviceModeType]chan *Ack),
+	}
+
+	for _, mode := range modes {
+		acks := make(chan *Ack, 16)
+		device.acks[mode] = acks
+
+		topic := device.acknowledgmentTopic(mode)
+		token := s.client.Subscribe(topic, 1, func(client mqtt.Client, msg mqtt.Message) {
+			var ack Ack
+			if err := json.Unmarshal(msg.Payload(), &ack); err != nil {
+				ack = Ack{Code: -1, Status: "error", Message: "invalid acknowledgment: " + err.Error()}
+			}
+			ack.Topic = msg.Topic()
+			select {
+			case acks <- &ack:
+			default:
+				// nobody waits for it, e.g. a late acknowledgment of a timed out message
+			}
+		})
+		if !token.WaitTimeout(s.ackTimeout) {
+			return nil, fmt.Errorf("timeout subscribing %s", topic)
+		}
+		if err := token.Error(); err != nil {
+			return nil, err
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.devices[name] = device
+	return device, nil
+}
+
+// Device returns a registered device by name
+func (s *Simulator) Device(name string) (*Device, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	device, exists := s.devices[name]
+	return device, exists
+}
+
+// Devices returns every registered device
+func (s *Simulator) Devices() []*Device {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	devices := make([]*Device, 0, len(s.devices))
+	for _, device := range s.devices {
+		devices = append(devices, device)
+	}
+	return devices
+}
+
+// Device is a virtual reader. Like the firmware it waits for the acknowledgment of a message
+// before sending the next one, so messages of a device never overlap.
+type Device struct {
+	simulator *Simulator
+	Name      string
+	Secret    string
+	Modes     []repo.DeviceModeType
+	// AckVersion asks the server for the compact acknowledgment of taps, 0 keeps the legacy format
+	AckVersion int
+
+	mu   sync.Mutex
+	acks map[repo.DeviceModeType]chan *Ack
+}
+
+func (d *Device) inputTopic(mode repo.DeviceModeType) string {
+	return fmt.Sprintf("%s/input/%s", util.ToSnakeCase(d.Name), mode)
+}
+
+func (d *Device) acknowledgmentTopic(mode repo.DeviceModeType) string {
+	return fmt.Sprintf("%s/acknowledgment/%s", util.ToSnakeCase(d.Name), mode)
+}
+
+// Tap sends a card tap in record, presence or permission mode
+func (d *Device) Tap(ctx context.Context, mode repo.DeviceModeType, uid string) (*Ack, error) {
+	return d.Send(ctx, mode, model.SmartCardRequest{Uid: uid})
+}
+
+// ShowQR sends a QR credential read by the camera in presence or permission mode
+func (d *Device) ShowQR(ctx context.Context, mode repo.DeviceModeType, qrToken string) (*Ack, error) {
+	return d.Send(ctx, mode, model.SmartCardRequest{QrToken: qrToken})
+}
+
+// Ping sends a heartbeat
+func (d *Device) Ping(ctx context.Context, request model.DevicePingRequest) (*Ack, error) {
+	return d.Send(ctx, repo.DeviceModeTypePing, request)
+}
+
+// Batch sends taps buffered while the device was offline
+func (d *Device) Batch(ctx context.Context, taps []model.BatchTap) (*Ack, error) {
+	return d.Send(ctx, repo.DeviceModeTypeBatch, model.BatchTapRequest{Taps: taps})
+}
+
+// Send signs the payload with the device secret, publishes it and waits for the acknowledgment
+func (d *Device) Send(ctx context.Context, mode repo.DeviceModeType, payload any) (*Ack, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	topic := d.inputTopic(mode)
+	timestamp := time.Now().Unix()
+	nonce := util.Generate32ByteKey()
+	message, err := json.Marshal(model.SignedDeviceMessage{
+		Payload:    body,
+		Nonce:      nonce,
+		Timestamp:  timestamp,
+		Signature:  util.SignDevicePayload(d.Secret, topic, timestamp, nonce, body),
+		AckVersion: d.AckVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return d.SendRaw(ctx, mode, message)
+}
+
+// SendRaw publishes the message as it is, e.g. to test unsigned or tampered messages
+func (d *Device) SendRaw(ctx context.Context, mode repo.DeviceModeType, message []byte) (*Ack, error) {
+	acks, registered := d.acks[mode]
+	if !registered {
+		return nil, fmt.Errorf("device %s is not registered with mode %s", d.Name, mode)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	// drop acknowledgments of messages that timed out before
+	for len(acks) > 0 {
+		<-acks
+	}
+
+	sentAt := time.Now()
+	token := d.simulator.client.Publish(d.inputTopic(mode), 1, false, message)
+	if !token.WaitTimeout(d.simulator.ackTimeout) {
+		return nil, fmt.Errorf("timeout publishing to %s", d.inputTopic(mode))
+	}
+	if err := token.Error(); err != nil {
+		return nil, err
+	}
+
+	timer := time.NewTimer(d.simulator.ackTimeout)
+	defer timer.Stop()
+	select {
+	case ack := <-acks:
+		ack.Latency = time.Since(sentAt)
+		return ack, nil
+	case <-timer.C:
+		return nil, ErrAckTimeout
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
@@ -0,0 +1,92 @@
+package devicesim
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTopicMatches(t *testing.T) {
+	require.True(t, topicMatches("gate_a/input/presence", "gate_a/input/presence"))
+	require.True(t, topicMatches("+/input/+", "gate_a/input/presence"))
+	require.True(t, topicMatches("gate_a/#", "gate_a/input/presence"))
+	require.False(t, topicMatches("gate_a/input", "gate_a/input/presence"))
+	require.False(t, topicMatches("+/acknowledgment/+", "gate_a/input/presence"))
+}
+
+// echoServer acknowledges every input message with code 200 and the signed payload as data
+func echoServer(t *testing.T, broker *MemoryBroker) {
+	client := broker.NewClient(mqtt.NewClientOptions().SetClientID("server"))
+	require.NoError(t, client.Connect().Error())
+	t.Cleanup(func() { client.Disconnect(0) })
+
+	token := client.Subscribe("+/input/+", 1, func(client mqtt.Client, msg mqtt.Message) {
+		var message model.SignedDeviceMessage
+		if err := json.Unmarshal(msg.Payload(), &message); err != nil {
+			t.Errorf("unsigned message on %s: %v", msg.Topic(), err)
+			return
+		}
+
+		ack, _ := json.Marshal(model.ResponseData[json.RawMessage]{Code: 200, Status: "success", Data: message.Payload})
+		client.Publish(strings.Replace(msg.Topic(), "/input/", "/acknowledgment/", 1), 1, false, ack)
+	})
+	require.NoError(t, token.Error())
+}
+
+func TestDeviceTap(t *testing.T) {
+	broker := NewMemoryBroker()
+	echoServer(t, broker)
+	simulator := NewTestSimulator(t, broker)
+	gate := RegisterTestDevice(t, simulator, "gate_a", "secret", repo.DeviceModeTypePresence)
+
+	ack := RequireAck(t, 200, func(ctx context.Context) (*Ack, error) {
+		return gate.Tap(ctx, repo.DeviceModeTypePresence, "04A1B2C3")
+	})
+	require.Equal(t, "gate_a/acknowledgment/presence", ack.Topic)
+
+	var request model.SmartCardRequest
+	require.NoError(t, ack.Decode(&request))
+	require.Equal(t, "04A1B2C3", request.Uid)
+
+	_, err := gate.Tap(context.Background(), repo.DeviceModeTypeRecord, "04A1B2C3")
+	require.ErrorContains(t, err, "not registered")
+}
+
+func TestRunLoad(t *testing.T) {
+	broker := NewMemoryBroker()
+	echoServer(t, broker)
+	simulator := NewTestSimulator(t, broker)
+	modes := []repo.DeviceModeType{repo.DeviceModeTypePresence, repo.DeviceModeTypePermission, repo.DeviceModeTypePing}
+	RegisterTestDevice(t, simulator, "gate_a", "secret", modes...)
+	RegisterTestDevice(t, simulator, "gate_b", "secret", modes...)
+
+	report := simulator.Run(context.Background(), Load{
+		Modes:        modes[:2],
+		UIDs:         []string{"04A1B2C3", "04D4E5F6"},
+		Rate:         200,
+		Count:        4,
+		PingInterval: time.Hour,
+	}, nil)
+
+	require.Equal(t, 8, report.Sent)
+	require.Equal(t, 8, report.Acked)
+	require.Equal(t, 8, report.Codes[200])
+	require.Equal(t, 4, report.Modes[repo.DeviceModeTypePresence])
+	require.Equal(t, 4, report.Modes[repo.DeviceModeTypePermission])
+}
+
+func TestAckTimeout(t *testing.T) {
+	simulator := NewTestSimulator(t, NewMemoryBroker())
+	simulator.ackTimeout = 20 * time.Millisecond
+	gate := RegisterTestDevice(t, simulator, "gate_a", "secret", repo.DeviceModeTypePresence)
+
+	_, err := gate.Tap(context.Background(), repo.DeviceModeTypePresence, "04A1B2C3")
+	require.ErrorIs(t, err, ErrAckTimeout)
+}
@@ -0,0 +1,44 @@
+package devicesim
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+)
+
+// NewTestSimulator connects a simulator to the memory broker, it is disconnected when the test ends
+func NewTestSimulator(t testing.TB, broker *MemoryBroker) *Simulator {
+	t.Helper()
+	client := broker.NewClient(mqtt.NewClientOptions().SetClientID("devicesim"))
+	if token := client.Connect(); token.Error() != nil {
+		t.Fatalf("connecting simulator: %v", token.Error())
+	}
+	t.Cleanup(func() { client.Disconnect(0) })
+	return New(client, 2*time.Second)
+}
+
+// RegisterTestDevice registers a virtual device and fails the test when it can not be registered
+func RegisterTestDevice(t testing.TB, simulator *Simulator, name string, secret string, modes ...repo.DeviceModeType) *Device {
+	t.Helper()
+	device, err := simulator.Register(name, secret, modes...)
+	if err != nil {
+		t.Fatalf("registering device %s: %v", name, err)
+	}
+	return device
+}
+
+// RequireAck sends the message and fails the test unless it is acknowledged with the code
+func RequireAck(t testing.TB, code int, send func(ctx context.Context) (*Ack, error)) *Ack {
+	t.Helper()
+	ack, err := send(context.Background())
+	if err != nil {
+		t.Fatalf("expected acknowledgment %d, got error: %v", code, err)
+	}
+	if ack.Code != code {
+		t.Fatalf("expected acknowledgment %d, got %d on %s: %s", code, ack.Code, ack.Topic, ack.Message)
+	}
+	return ack
+}
@@ -0,0 +1,81 @@
+package token
+
+import (
+	"fmt"
+	"time"
+
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// qrSubject keeps a QR credential from being accepted anywhere else, and the other way around
+const qrSubject = "qr"
+
+// QRPayload is the credential shown as QR code by a santri or employee instead of a smart card.
+// The claims are kept short, the whole token has to fit in a QR code read by a phone camera.
+type QRPayload struct {
+	OwnerRole repo.RoleType `json:"role"`
+	OwnerID   int32         `json:"oid"`
+	jwt.RegisteredClaims
+}
+
+// QRMaker signs and verifies QR credentials. It uses its own key, a QR credential is not an access token.
+type QRMaker struct {
+	secretKey string
+}
+
+func NewQRMaker(secretKey string) (*QRMaker, error) {
+	if len(secretKey) < minSecretKeyLength {
+		return nil, fmt.Errorf("invalid key size: must be at least %d characters", minSecretKeyLength)
+	}
+	return &QRMaker{secretKey}, nil
+}
+
+// CreateToken creates a credential of the owner valid for duration, the app shows a new one before it expires
+func (maker *QRMaker) CreateToken(ownerRole repo.RoleType, ownerID int32, duration time.Duration) (string, *QRPayload, error) {
+	tokenID, err := uuid.NewRandom()
+	if err != nil {
+		return "", nil, err
+	}
+
+	now := time.Now()
+	payload := &QRPayload{
+		OwnerRole: ownerRole,
+		OwnerID:   ownerID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        tokenID.String(),
+			Subject:   qrSubject,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(duration)),
+		},
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, payload).SignedString([]byte(maker.secretKey))
+
+	return token, payload, err
+}
+
+// VerifyToken checks the signature and the expiry of the credential
+func (maker *QRMaker) VerifyToken(token string) (*QRPayload, error) {
+	keyFunc := func(token *jwt.Token) (interface{}, error) {
+		_, ok := token.Method.(*jwt.SigningMethodHMAC)
+		if !ok {
+			return nil, jwt.ErrTokenMalformed
+		}
+		return []byte(maker.secretKey), nil
+	}
+	jwtToken, err := jwt.ParseWithClaims(token, &QRPayload{}, keyFunc, jwt.WithSubject(qrSubject), jwt.WithExpirationRequired())
+	if err != nil {
+		return nil, err
+	}
+
+	payload, ok := jwtToken.Claims.(*QRPayload)
+	if !ok {
+		return nil, jwt.ErrTokenInvalidClaims
+	}
+	if payload.OwnerRole != repo.RoleTypeSantri && payload.OwnerRole != repo.RoleTypeEmployee {
+		return nil, jwt.ErrTokenInvalidClaims
+	}
+
+	return payload, nil
+}
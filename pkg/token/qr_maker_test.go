@@ -0,0 +1,59 @@
+package token
+
+import (
+	"testing"
+	"time"
+
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+	"github.com/adiubaidah/syafiiyah-main/pkg/random"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQRMaker(t *testing.T) {
+	maker, err := NewQRMaker(random.RandomString(32))
+	require.NoError(t, err)
+
+	ownerID := int32(random.RandomInt(1, 1000))
+	token, payload, err := maker.CreateToken(repo.RoleTypeSantri, ownerID, time.Minute)
+	require.NoError(t, err)
+	require.NotEmpty(t, token)
+	require.NotEmpty(t, payload.ID)
+
+	payload, err = maker.VerifyToken(token)
+	require.NoError(t, err)
+	require.Equal(t, repo.RoleTypeSantri, payload.OwnerRole)
+	require.Equal(t, ownerID, payload.OwnerID)
+	require.WithinDuration(t, time.Now().Add(time.Minute), payload.ExpiresAt.Time, time.Second)
+
+	other, err := NewQRMaker(random.RandomString(32))
+	require.NoError(t, err)
+	_, err = other.VerifyToken(token)
+	require.ErrorIs(t, err, jwt.ErrTokenSignatureInvalid)
+}
+
+func TestQRMakerExpiredToken(t *testing.T) {
+	maker, err := NewQRMaker(random.RandomString(32))
+	require.NoError(t, err)
+
+	token, _, err := maker.CreateToken(repo.RoleTypeEmployee, 1, -time.Minute)
+	require.NoError(t, err)
+
+	_, err = maker.VerifyToken(token)
+	require.ErrorIs(t, err, jwt.ErrTokenExpired)
+}
+
+func TestQRMakerRejectsAccessToken(t *testing.T) {
+	secretKey := random.RandomString(32)
+	maker, err := NewQRMaker(secretKey)
+	require.NoError(t, err)
+
+	accessToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		Subject:   "access",
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+	}).SignedString([]byte(secretKey))
+	require.NoError(t, err)
+
+	_, err = maker.VerifyToken(accessToken)
+	require.ErrorIs(t, err, jwt.ErrTokenInvalidSubject)
+}
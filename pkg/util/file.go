@@ -39,6 +39,38 @@ func ValidatePhoto(photo *multipart.FileHeader) error {
 
 	return nil
 }
+
+// ValidateAttachment accepts a JPG or PNG image or a PDF document
+func ValidateAttachment(attachment *multipart.FileHeader) error {
+	attachmentFile, err := attachment.Open()
+	if err != nil {
+		return fmt.Errorf("failed to open attachment file")
+	}
+	defer attachmentFile.Close()
+
+	buffer := make([]byte, 512)
+	if _, err := attachmentFile.Read(buffer); err != nil {
+		return fmt.Errorf("failed to read attachment file")
+	}
+
+	contentType := http.DetectContentType(buffer)
+	ext := strings.ToLower(filepath.Ext(attachment.Filename))
+	switch contentType {
+	case "image/jpeg", "image/png":
+		if ext != ".jpg" && ext != ".jpeg" && ext != ".png" {
+			return fmt.Errorf("invalid file extension")
+		}
+	case "application/pdf":
+		if ext != ".pdf" {
+			return fmt.Errorf("invalid file extension")
+		}
+	default:
+		return fmt.Errorf("file must be a JPG, JPEG, or PNG image or a PDF document")
+	}
+
+	return nil
+}
+
 func GetFileExtension(photo *multipart.FileHeader) string {
 	return strings.ToLower(filepath.Ext(photo.Filename))
 }
@@ -0,0 +1,28 @@
+package util
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+)
+
+// SignDevicePayload returns hex encoded HMAC-SHA256 of a device message using the device secret.
+// Topic, timestamp, nonce and the raw payload are signed, joined by new line.
+func SignDevicePayload(secret string, topic string, timestamp int64, nonce string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(topic))
+	mac.Write([]byte("\n"))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("\n"))
+	mac.Write([]byte(nonce))
+	mac.Write([]byte("\n"))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyDevicePayload checks the signature created by SignDevicePayload in constant time
+func VerifyDevicePayload(secret string, topic string, timestamp int64, nonce string, payload []byte, signature string) bool {
+	expected := SignDevicePayload(secret, topic, timestamp, nonce, payload)
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
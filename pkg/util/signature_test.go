@@ -0,0 +1,21 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyDevicePayload(t *testing.T) {
+	secret := Generate32ByteKey()
+	topic := "gate_1/input/presence"
+	payload := []byte(`{"uid":"04A1B2C3"}`)
+	signature := SignDevicePayload(secret, topic, 1723856400, "nonce-1", payload)
+
+	require.True(t, VerifyDevicePayload(secret, topic, 1723856400, "nonce-1", payload, signature))
+	require.False(t, VerifyDevicePayload(secret, "gate_1/input/record", 1723856400, "nonce-1", payload, signature))
+	require.False(t, VerifyDevicePayload(secret, topic, 1723856401, "nonce-1", payload, signature))
+	require.False(t, VerifyDevicePayload(secret, topic, 1723856400, "nonce-2", payload, signature))
+	require.False(t, VerifyDevicePayload(secret, topic, 1723856400, "nonce-1", []byte(`{"uid":"FFFFFFFF"}`), signature))
+	require.False(t, VerifyDevicePayload(Generate32ByteKey(), topic, 1723856400, "nonce-1", payload, signature))
+}
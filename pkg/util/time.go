@@ -34,6 +34,21 @@ func ParseHHMMWithCurrentDate(timeString string) (time.Time, error) {
 	return fullTime, nil
 }
 
+// ParseHHMMWithDate parses a time string in "HH:MM" format and returns it on the calendar date of the given time.
+// Like ParseHHMMWithCurrentDate, the clock is read as UTC.
+func ParseHHMMWithDate(timeString string, date time.Time) (time.Time, error) {
+	if timeString == "" {
+		return time.Time{}, errors.New("time string is empty")
+	}
+
+	parsedTime, err := time.Parse("15:04", timeString)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Date(date.Year(), date.Month(), date.Day(), parsedTime.Hour(), parsedTime.Minute(), 0, 0, time.UTC), nil
+}
+
 // ParseDate parses a date string in "YYYY-MM-DD" format and returns a time.Time object.
 func ParseDate(dateString string) (time.Time, error) {
 	return time.Parse("2006-01-02", dateString)
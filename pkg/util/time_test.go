@@ -0,0 +1,38 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseHHMMWithCurrentDate(t *testing.T) {
+	parsed, err := ParseHHMMWithCurrentDate("07:30")
+	require.NoError(t, err)
+	require.Equal(t, time.UTC, parsed.Location())
+	require.Equal(t, time.Now().Format("2006-01-02")+" 07:30", parsed.Format("2006-01-02 15:04"))
+
+	_, err = ParseHHMMWithCurrentDate("")
+	require.Error(t, err)
+	_, err = ParseHHMMWithCurrentDate("7.30")
+	require.Error(t, err)
+}
+
+func TestParseHHMMWithDate(t *testing.T) {
+	jakarta := time.FixedZone("WIB", 7*60*60)
+	date := time.Date(2024, 8, 17, 1, 15, 0, 0, jakarta)
+
+	parsed, err := ParseHHMMWithDate("07:30", date)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, 8, 17, 7, 30, 0, 0, time.UTC), parsed)
+
+	withCurrentDate, err := ParseHHMMWithCurrentDate("07:30")
+	require.NoError(t, err)
+	withDate, err := ParseHHMMWithDate("07:30", time.Now())
+	require.NoError(t, err)
+	require.Equal(t, withCurrentDate, withDate)
+
+	_, err = ParseHHMMWithDate("", date)
+	require.Error(t, err)
+}
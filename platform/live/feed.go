@@ -0,0 +1,147 @@
+// Package live fans out tap and presence events to the browsers watching the live feed.
+// Recent events are kept in memory so a reconnecting client can resume from its last event ID.
+package live
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
+)
+
+const (
+	defaultBacklogSize = 1000
+	subscriberBuffer   = 64
+)
+
+// Feed keeps the last events in a ring buffer. Event IDs are "<epoch>-<sequence>",
+// the epoch changes on every restart so IDs of a previous process are recognized as lost.
+type Feed struct {
+	mu          sync.Mutex
+	epoch       string
+	sequence    uint64
+	backlog     []*model.LiveEvent
+	next        int
+	size        int
+	subscribers map[*Subscription]struct{}
+	closed      bool
+}
+
+// Subscription receives the events published after it was created.
+// Events is closed when the subscriber is too slow or unsubscribed, the client should reconnect and resume.
+type Subscription struct {
+	Events <-chan *model.LiveEvent
+	events chan *model.LiveEvent
+}
+
+// NewFeed returns a feed remembering the last backlogSize events, 0 means 1000
+func NewFeed(backlogSize int) *Feed {
+	if backlogSize <= 0 {
+		backlogSize = defaultBacklogSize
+	}
+	return &Feed{
+		epoch:       strconv.FormatInt(time.Now().UnixNano(), 36),
+		backlog:     make([]*model.LiveEvent, backlogSize),
+		subscribers: make(map[*Subscription]struct{}),
+	}
+}
+
+// Publish assigns the ID and time of the event and sends it to every subscriber without blocking
+func (f *Feed) Publish(event *model.LiveEvent) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.sequence++
+	event.ID = f.epoch + "-" + strconv.FormatUint(f.sequence, 10)
+	if event.CreatedAt == "" {
+		event.CreatedAt = time.Now().Format("2006-01-02 15:04:05")
+	}
+
+	f.backlog[f.next] = event
+	f.next = (f.next + 1) % len(f.backlog)
+	if f.size < len(f.backlog) {
+		f.size++
+	}
+
+	for subscription := range f.subscribers {
+		select {
+		case subscription.events <- event:
+		default:
+			// a slow client must not hold up the devices, it resumes from its last event on reconnect
+			delete(f.subscribers, subscription)
+			close(subscription.events)
+		}
+	}
+}
+
+// Subscribe starts a subscription and returns the events published after lastEventID.
+// reset is true when those events can not be replayed, because lastEventID comes from a previous
+// process or is older than the backlog, then the client should reload its data.
+func (f *Feed) Subscribe(lastEventID string) (subscription *Subscription, missed []*model.LiveEvent, reset bool) {
+	events := make(chan *model.LiveEvent, subscriberBuffer)
+	subscription = &Subscription{Events: events, events: events}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.closed {
+		close(events)
+		return subscription, nil, false
+	}
+	f.subscribers[subscription] = struct{}{}
+
+	if lastEventID == "" {
+		return subscription, nil, false
+	}
+
+	epoch, sequenceText, _ := strings.Cut(lastEventID, "-")
+	sequence, err := strconv.ParseUint(sequenceText, 10, 64)
+	if err != nil || epoch != f.epoch || sequence > f.sequence {
+		return subscription, nil, true
+	}
+	if f.sequence-sequence > uint64(f.size) {
+		return subscription, nil, true
+	}
+
+	for i := f.sequence - sequence; i > 0; i-- {
+		index := (f.next - int(i) + len(f.backlog)) % len(f.backlog)
+		missed = append(missed, f.backlog[index])
+	}
+	return subscription, missed, false
+}
+
+// Unsubscribe stops the subscription, it is safe to call after the feed dropped it
+func (f *Feed) Unsubscribe(subscription *Subscription) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, exists := f.subscribers[subscription]; exists {
+		delete(f.subscribers, subscription)
+		close(subscription.events)
+	}
+}
+
+// Close ends every subscription so the streams return on shutdown, later subscriptions end right away
+func (f *Feed) Close() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.closed = true
+	for subscription := range f.subscribers {
+		delete(f.subscribers, subscription)
+		close(subscription.events)
+	}
+}
+
+// Match reports whether the event passes the filter of the request, zero fields match everything
+func Match(filter *model.LiveFeedRequest, event *model.LiveEvent) bool {
+	if filter.DeviceID != 0 && filter.DeviceID != event.DeviceID {
+		return false
+	}
+	if filter.ScheduleID != 0 && filter.ScheduleID != event.ScheduleID {
+		return false
+	}
+	if filter.OccupationID != 0 && filter.OccupationID != event.OccupationID {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,94 @@
+package live
+
+import (
+	"testing"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
+	"github.com/stretchr/testify/require"
+)
+
+func publishTaps(feed *Feed, count int) []*model.LiveEvent {
+	events := make([]*model.LiveEvent, 0, count)
+	for i := 0; i < count; i++ {
+		event := &model.LiveEvent{Type: model.LiveEventTypeTap, DeviceID: int32(i%2 + 1)}
+		feed.Publish(event)
+		events = append(events, event)
+	}
+	return events
+}
+
+func TestFeedResume(t *testing.T) {
+	feed := NewFeed(10)
+	events := publishTaps(feed, 5)
+
+	subscription, missed, reset := feed.Subscribe(events[1].ID)
+	defer feed.Unsubscribe(subscription)
+	require.False(t, reset)
+	require.Equal(t, events[2:], missed)
+
+	_, missed, reset = feed.Subscribe(events[4].ID)
+	require.False(t, reset)
+	require.Empty(t, missed)
+
+	next := publishTaps(feed, 1)[0]
+	require.Equal(t, next, <-subscription.Events)
+}
+
+func TestFeedReset(t *testing.T) {
+	feed := NewFeed(3)
+	events := publishTaps(feed, 5)
+
+	_, missed, reset := feed.Subscribe(events[0].ID)
+	require.True(t, reset)
+	require.Empty(t, missed)
+
+	_, missed, reset = feed.Subscribe(events[1].ID)
+	require.False(t, reset)
+	require.Equal(t, events[2:], missed)
+
+	// the ID of another process
+	_, _, reset = NewFeed(3).Subscribe(events[4].ID)
+	require.True(t, reset)
+
+	_, _, reset = feed.Subscribe("garbage")
+	require.True(t, reset)
+}
+
+func TestFeedDropsSlowSubscriber(t *testing.T) {
+	feed := NewFeed(0)
+	subscription, _, _ := feed.Subscribe("")
+
+	publishTaps(feed, subscriberBuffer+1)
+	received := 0
+	for range subscription.Events {
+		received++
+	}
+	require.Equal(t, subscriberBuffer, received)
+
+	// unsubscribing a dropped subscription is harmless
+	feed.Unsubscribe(subscription)
+}
+
+func TestFeedClose(t *testing.T) {
+	feed := NewFeed(0)
+	subscription, _, _ := feed.Subscribe("")
+
+	feed.Close()
+	_, open := <-subscription.Events
+	require.False(t, open)
+	// unsubscribing after close is harmless
+	feed.Unsubscribe(subscription)
+
+	late, _, _ := feed.Subscribe("")
+	_, open = <-late.Events
+	require.False(t, open)
+}
+
+func TestMatch(t *testing.T) {
+	event := &model.LiveEvent{DeviceID: 1, ScheduleID: 2, OccupationID: 3}
+	require.True(t, Match(&model.LiveFeedRequest{}, event))
+	require.True(t, Match(&model.LiveFeedRequest{DeviceID: 1, ScheduleID: 2, OccupationID: 3}, event))
+	require.False(t, Match(&model.LiveFeedRequest{DeviceID: 2}, event))
+	require.False(t, Match(&model.LiveFeedRequest{ScheduleID: 1}, event))
+	require.False(t, Match(&model.LiveFeedRequest{OccupationID: 1}, event))
+}
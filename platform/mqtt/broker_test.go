@@ -0,0 +1,218 @@
+package mqtt
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+	mocks "github.com/adiubaidah/syafiiyah-main/internal/repository/mocks"
+	"github.com/adiubaidah/syafiiyah-main/internal/usecase"
+	"github.com/adiubaidah/syafiiyah-main/pkg/devicesim"
+	"github.com/adiubaidah/syafiiyah-main/platform/live"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testDeviceSecret = "0123456789abcdef0123456789abcdef"
+
+// newTestBroker runs the broker on an in-process MQTT broker with a single device gate_a
+// listening on record, presence and ping mode
+func newTestBroker(t *testing.T) (*mocks.MockStore, *devicesim.Simulator, *live.Feed) {
+	store := new(mocks.MockStore)
+	device := repo.Device{ID: 1, Name: "gate_a", Secret: testDeviceSecret}
+	var rows []repo.ListDevicesRow
+	for _, mode := range []repo.DeviceModeType{repo.DeviceModeTypeRecord, repo.DeviceModeTypePresence, repo.DeviceModeTypePing} {
+		rows = append(rows, repo.ListDevicesRow{
+			ID:                             device.ID,
+			Name:                           device.Name,
+			DeviceModeID:                   pgtype.Int4{Int32: 1, Valid: true},
+			DeviceModeMode:                 repo.NullDeviceModeType{DeviceModeType: mode, Valid: true},
+			DeviceModeInputTopic:           pgtype.Text{String: "gate_a/input/" + string(mode), Valid: true},
+			DeviceModeAcknowledgementTopic: pgtype.Text{String: "gate_a/acknowledgment/" + string(mode), Valid: true},
+		})
+	}
+	store.On("ListDevices", mock.Anything).Return(rows, nil)
+	store.On("ListActiveDeviceModeSwitches", mock.Anything).Return([]repo.ListActiveDeviceModeSwitchesRow{}, nil)
+	store.On("GetDeviceByTopicName", mock.Anything, "gate_a").Return(device, nil)
+	store.On("GetOpenEnrollmentSessionByDeviceName", mock.Anything, "gate_a").Return(repo.EnrollmentSession{}, pgx.ErrNoRows)
+	store.On("CreateTapEvent", mock.Anything, mock.Anything).Return(repo.TapEvent{ID: 1, DeviceID: pgtype.Int4{Int32: device.ID, Valid: true}}, nil)
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	memoryBroker := devicesim.NewMemoryBroker()
+	feed := live.NewFeed(0)
+	broker, err := NewMQTTBroker(&MQTTBrokerConfig{
+		Logger:           logger,
+		DeviceUseCase:    usecase.NewDeviceUseCase(store, 0),
+		SmartCardUseCase: usecase.NewSmartCardUseCase(store),
+		TapEventUseCase:  usecase.NewTapEventUseCase(store),
+		NewClient:        memoryBroker.NewClient,
+		LiveFeed:         feed,
+		HolidayUseCase:   usecase.NewHolidayUseCase(store),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, broker.Shutdown(context.Background()))
+	})
+
+	return store, devicesim.NewTestSimulator(t, memoryBroker), feed
+}
+
+func TestNewMQTTBrokerReturnsTopicError(t *testing.T) {
+	store := new(mocks.MockStore)
+	store.On("ListDevices", mock.Anything).Return([]repo.ListDevicesRow{}, errors.New("database is down"))
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	broker, err := NewMQTTBroker(&MQTTBrokerConfig{
+		Logger:        logger,
+		DeviceUseCase: usecase.NewDeviceUseCase(store, 0),
+		NewClient:     devicesim.NewMemoryBroker().NewClient,
+	})
+	require.ErrorContains(t, err, "database is down")
+	require.Nil(t, broker)
+}
+
+func TestBrokerPing(t *testing.T) {
+	store, simulator, _ := newTestBroker(t)
+	store.On("UpdateDeviceHeartbeat", mock.Anything, mock.MatchedBy(func(arg repo.UpdateDeviceHeartbeatParams) bool {
+		return arg.InputTopic == "gate_a/input/ping" && arg.FirmwareVersion.String == "1.2.0"
+	})).Return(repo.Device{ID: 1, Name: "gate_a"}, nil)
+
+	gate := devicesim.RegisterTestDevice(t, simulator, "gate_a", testDeviceSecret, repo.DeviceModeTypePing)
+	ack := devicesim.RequireAck(t, 200, func(ctx context.Context) (*devicesim.Ack, error) {
+		return gate.Ping(ctx, model.DevicePingRequest{FirmwareVersion: "1.2.0"})
+	})
+
+	var heartbeat model.DevicePingResponse
+	require.NoError(t, ack.Decode(&heartbeat))
+	require.Equal(t, "gate_a", heartbeat.Name)
+}
+
+func TestBrokerRejectsUnsignedMessage(t *testing.T) {
+	_, simulator, _ := newTestBroker(t)
+
+	impostor := devicesim.RegisterTestDevice(t, simulator, "gate_a", "wrong-secret", repo.DeviceModeTypeRecord)
+	ack := devicesim.RequireAck(t, 401, func(ctx context.Context) (*devicesim.Ack, error) {
+		return impostor.Tap(ctx, repo.DeviceModeTypeRecord, "04A1B2C3")
+	})
+	require.Equal(t, "Signature tidak valid", ack.Message)
+
+	ack = devicesim.RequireAck(t, 401, func(ctx context.Context) (*devicesim.Ack, error) {
+		return impostor.SendRaw(ctx, repo.DeviceModeTypeRecord, []byte(`{"uid":"04A1B2C3"}`))
+	})
+	require.Equal(t, "Pesan tidak ditandatangani", ack.Message)
+}
+
+func TestBrokerRecordIsDebounced(t *testing.T) {
+	store, simulator, feed := newTestBroker(t)
+	subscription, _, _ := feed.Subscribe("")
+	defer feed.Unsubscribe(subscription)
+	store.On("CreateSmartCard", mock.Anything, mock.Anything).Return(repo.SmartCard{ID: 7, Uid: "04A1B2C3", IsActive: true}, nil).Once()
+
+	gate := devicesim.RegisterTestDevice(t, simulator, "gate_a", testDeviceSecret, repo.DeviceModeTypeRecord)
+	tap := func(ctx context.Context) (*devicesim.Ack, error) {
+		return gate.Tap(ctx, repo.DeviceModeTypeRecord, "04A1B2C3")
+	}
+
+	ack := devicesim.RequireAck(t, 200, tap)
+	var smartCard model.SmartCard
+	require.NoError(t, ack.Decode(&smartCard))
+	require.Equal(t, "04A1B2C3", smartCard.Uid)
+
+	ack = devicesim.RequireAck(t, 208, tap)
+	require.Equal(t, "Tap sudah tercatat", ack.Message)
+	store.AssertNumberOfCalls(t, "CreateSmartCard", 1)
+
+	// both taps are streamed, the tap event is saved after the device is answered
+	for i := 0; i < 2; i++ {
+		select {
+		case event := <-subscription.Events:
+			require.Equal(t, model.LiveEventTypeTap, event.Type)
+			require.Equal(t, int32(1), event.DeviceID)
+		case <-time.After(time.Second):
+			t.Fatal("tap is not streamed")
+		}
+	}
+}
+
+func TestBrokerQueuesUnknownCard(t *testing.T) {
+	store, simulator, _ := newTestBroker(t)
+	store.On("GetSmartCard", mock.Anything, "04DEADBE").Return(repo.GetSmartCardRow{}, pgx.ErrNoRows)
+	store.On("UpsertPendingSmartCard", mock.Anything, repo.UpsertPendingSmartCardParams{Uid: "04DEADBE", DeviceName: "gate_a"}).
+		Return(repo.PendingSmartCard{ID: 1, Uid: "04DEADBE"}, nil).Once()
+
+	gate := devicesim.RegisterTestDevice(t, simulator, "gate_a", testDeviceSecret, repo.DeviceModeTypePresence)
+	devicesim.RequireAck(t, 404, func(ctx context.Context) (*devicesim.Ack, error) {
+		return gate.Tap(ctx, repo.DeviceModeTypePresence, "04DEADBE")
+	})
+	store.AssertCalled(t, "UpsertPendingSmartCard", mock.Anything, repo.UpsertPendingSmartCardParams{Uid: "04DEADBE", DeviceName: "gate_a"})
+}
+
+func TestBrokerRefusesExpiredCard(t *testing.T) {
+	store, simulator, _ := newTestBroker(t)
+	store.On("GetSmartCard", mock.Anything, "04A1B2C3").Return(repo.GetSmartCardRow{
+		ID:         7,
+		Uid:        "04A1B2C3",
+		SantriID:   pgtype.Int4{Int32: 3, Valid: true},
+		State:      repo.SmartCardStateActive,
+		IsActive:   true,
+		ValidUntil: pgtype.Timestamptz{Time: time.Now().Add(-time.Hour), Valid: true},
+	}, nil)
+
+	gate := devicesim.RegisterTestDevice(t, simulator, "gate_a", testDeviceSecret, repo.DeviceModeTypePresence)
+	gate.AckVersion = model.DeviceAckVersion
+	ack := devicesim.RequireAck(t, 403, func(ctx context.Context) (*devicesim.Ack, error) {
+		return gate.Tap(ctx, repo.DeviceModeTypePresence, "04A1B2C3")
+	})
+	require.Equal(t, model.TapResultCardExpired, ack.Result)
+	require.Equal(t, "Kartu tidak berlaku", ack.Display)
+}
+
+func TestBrokerRefusesInvalidQRCredential(t *testing.T) {
+	_, simulator, _ := newTestBroker(t)
+
+	gate := devicesim.RegisterTestDevice(t, simulator, "gate_a", testDeviceSecret, repo.DeviceModeTypePresence, repo.DeviceModeTypeRecord)
+	gate.AckVersion = model.DeviceAckVersion
+	ack := devicesim.RequireAck(t, 403, func(ctx context.Context) (*devicesim.Ack, error) {
+		return gate.ShowQR(ctx, repo.DeviceModeTypePresence, "not-a-token")
+	})
+	require.Equal(t, model.TapResultQRInvalid, ack.Result)
+	require.Equal(t, "QR tidak berlaku", ack.Display)
+
+	devicesim.RequireAck(t, 400, func(ctx context.Context) (*devicesim.Ack, error) {
+		return gate.ShowQR(ctx, repo.DeviceModeTypeRecord, "not-a-token")
+	})
+}
+
+func TestBrokerRefusesPresenceOnHoliday(t *testing.T) {
+	store, simulator, _ := newTestBroker(t)
+	store.On("GetSmartCard", mock.Anything, "04A1B2C3").Return(repo.GetSmartCardRow{
+		ID:       7,
+		Uid:      "04A1B2C3",
+		SantriID: pgtype.Int4{Int32: 3, Valid: true},
+		State:    repo.SmartCardStateActive,
+		IsActive: true,
+	}, nil)
+	store.On("GetHolidayByDate", mock.Anything, mock.Anything).Return(repo.Holiday{ID: 1, Name: "Idul Fitri"}, nil)
+
+	gate := devicesim.RegisterTestDevice(t, simulator, "gate_a", testDeviceSecret, repo.DeviceModeTypePresence)
+	ack := devicesim.RequireAck(t, 403, func(ctx context.Context) (*devicesim.Ack, error) {
+		return gate.Tap(ctx, repo.DeviceModeTypePresence, "04A1B2C3")
+	})
+	require.Equal(t, "Hari libur Idul Fitri, presensi tidak dicatat", ack.Message)
+
+	gate.AckVersion = model.DeviceAckVersion
+	ack = devicesim.RequireAck(t, 403, func(ctx context.Context) (*devicesim.Ack, error) {
+		return gate.Tap(ctx, repo.DeviceModeTypePresence, "04A1B2C3")
+	})
+	require.Equal(t, model.TapResultHoliday, ack.Result)
+	require.Equal(t, "Hari libur", ack.Display)
+}
@@ -0,0 +1,112 @@
+package mqtt
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+	"github.com/adiubaidah/syafiiyah-main/pkg/util"
+)
+
+const commandAckTopicSuffix = "/command/ack"
+
+func commandTopic(deviceName string) string {
+	return util.ToSnakeCase(deviceName) + "/command"
+}
+
+func commandAckTopic(deviceName string) string {
+	return util.ToSnakeCase(deviceName) + commandAckTopicSuffix
+}
+
+func isCommandAckTopic(topic string) bool {
+	return strings.HasSuffix(topic, commandAckTopicSuffix)
+}
+
+// SendCommand signs the command with the device secret and publishes it to <device>/command.
+// The command is marked as sent when the broker accepts it, or failed otherwise.
+func (h *MQTTBroker) SendCommand(ctx context.Context, deviceId int32, message *model.DeviceCommandMessage) (*model.DeviceCommandResponse, error) {
+	device, err := h.deviceUseCase.GetCommandTarget(ctx, deviceId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.publishSigned(commandTopic(device.Name), device.Secret, message); err != nil {
+		h.logger.Errorf("Error sending command %d: %v\n", message.ID, err)
+		return h.deviceUseCase.MarkCommandFailed(ctx, message.ID, err.Error())
+	}
+
+	command, err := h.deviceUseCase.MarkCommandSent(ctx, message.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// input topic of the temporary mode is only listened to until the command expires
+	if message.Type == repo.DeviceCommandTypeSwitchMode {
+		if err := h.RefreshTopics(); err != nil {
+			h.logger.Errorf("Error refreshing topics after command %d: %v\n", message.ID, err)
+		}
+	}
+
+	return command, nil
+}
+
+// publishSigned wraps the payload in the same signed envelope the devices use
+func (h *MQTTBroker) publishSigned(topic string, secret string, data any) error {
+	if !h.Client.IsConnectionOpen() {
+		return errors.New("MQTT broker is not connected")
+	}
+
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	timestamp := time.Now().Unix()
+	nonce := util.Generate32ByteKey()
+	message, err := json.Marshal(model.SignedDeviceMessage{
+		Payload:   payload,
+		Nonce:     nonce,
+		Timestamp: timestamp,
+		Signature: util.SignDevicePayload(secret, topic, timestamp, nonce, payload),
+	})
+	if err != nil {
+		return err
+	}
+
+	token := h.Client.Publish(topic, h.ackQoS, false, message)
+	if !token.WaitTimeout(5 * time.Second) {
+		return errors.New("timeout publishing command")
+	}
+	return token.Error()
+}
+
+// handleCommandAck records the execution result reported by the device, nothing is published back
+func (h *MQTTBroker) handleCommandAck(topic string, raw []byte) {
+	payload, err := h.verifyMessage(topic, raw)
+	if err != nil {
+		h.logger.Warnf("Rejected command ack from topic %s: %v\n", topic, err)
+		return
+	}
+
+	var request model.DeviceCommandAckRequest
+	if err := json.Unmarshal(payload, &request); err != nil {
+		h.logger.Errorf("Error unmarshaling payload: %v\n", err)
+		return
+	}
+	if err := h.validator.Struct(request); err != nil {
+		h.logger.Errorf("Error validating request: %v\n", err)
+		return
+	}
+
+	command, err := h.deviceUseCase.AcknowledgeCommand(context.Background(), topic, &request)
+	if err != nil {
+		h.logger.Errorf("Error acknowledging command %d: %v\n", request.CommandID, err)
+		return
+	}
+
+	h.logger.Printf("Command %d of device %d is %s\n", command.ID, command.DeviceID, command.Status)
+}
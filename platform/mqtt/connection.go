@@ -0,0 +1,91 @@
+package mqtt
+
+import (
+	"sync"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+)
+
+// ConnectionState is the state of connection to the MQTT broker, shown on the health endpoint
+type ConnectionState struct {
+	Connected          bool   `json:"connected"`
+	Reconnecting       bool   `json:"reconnecting"`
+	LastConnectedAt    string `json:"last_connected_at,omitempty"`
+	LastDisconnectedAt string `json:"last_disconnected_at,omitempty"`
+	LastError          string `json:"last_error,omitempty"`
+	ReconnectCount     int    `json:"reconnect_count"`
+	SubscribedTopics   int    `json:"subscribed_topics"`
+}
+
+type connectionState struct {
+	mu    sync.RWMutex
+	state ConnectionState
+}
+
+func (s *connectionState) update(fn func(state *ConnectionState)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fn(&s.state)
+}
+
+func (s *connectionState) get() ConnectionState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.state
+}
+
+// onConnect is called on the first connection and after every reconnect.
+// The broker may have lost the session, so every device topic is subscribed again.
+func (h *MQTTBroker) onConnect(client mqtt.Client) {
+	h.logger.Println("Connected to MQTT broker")
+
+	h.mu.Lock()
+	topics := make([]string, 0, len(h.Topics))
+	for topic := range h.Topics {
+		topics = append(topics, topic)
+	}
+	h.mu.Unlock()
+
+	for _, topic := range topics {
+		h.subscribe(client, topic)
+	}
+
+	h.state.update(func(state *ConnectionState) {
+		if !state.Connected && state.LastConnectedAt != "" {
+			state.ReconnectCount++
+		}
+		state.Connected = true
+		state.Reconnecting = false
+		state.LastConnectedAt = time.Now().Format("2006-01-02 15:04:05")
+		state.SubscribedTopics = len(topics)
+	})
+}
+
+func (h *MQTTBroker) onConnectionLost(client mqtt.Client, err error) {
+	h.logger.Errorf("Connection to MQTT broker lost: %v\n", err)
+	h.state.update(func(state *ConnectionState) {
+		state.Connected = false
+		state.LastDisconnectedAt = time.Now().Format("2006-01-02 15:04:05")
+		state.LastError = err.Error()
+	})
+}
+
+func (h *MQTTBroker) onReconnecting(client mqtt.Client, opts *mqtt.ClientOptions) {
+	h.logger.Warn("Reconnecting to MQTT broker...")
+	h.state.update(func(state *ConnectionState) {
+		state.Reconnecting = true
+	})
+}
+
+func (h *MQTTBroker) subscribe(client mqtt.Client, topic string) {
+	token := client.Subscribe(topic, h.inputQoS, h.defaultMessageHandler())
+	if token.WaitTimeout(5*time.Second) && token.Error() != nil {
+		h.logger.Errorf("Error subscribing topic %s: %v\n", topic, token.Error())
+	}
+}
+
+// ConnectionState returns the current state of connection to the MQTT broker
+func (h *MQTTBroker) ConnectionState() ConnectionState {
+	return h.state.get()
+}
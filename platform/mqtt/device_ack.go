@@ -0,0 +1,158 @@
+package mqtt
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+	"github.com/adiubaidah/syafiiyah-main/pkg/util"
+)
+
+var tapResultDisplays = map[model.TapResult]string{
+	model.TapResultRepeated:     "Tap sudah tercatat",
+	model.TapResultCardUnknown:  "Kartu tidak dikenal",
+	model.TapResultCardInactive: "Kartu tidak aktif",
+	model.TapResultCardExpired:  "Kartu tidak berlaku",
+	model.TapResultQRInvalid:    "QR tidak berlaku",
+	model.TapResultNoOwner:      "Kartu belum ada pemilik",
+	model.TapResultNoSchedule:   "Tidak ada jadwal",
+	model.TapResultHoliday:      "Hari libur",
+	model.TapResultDenied:       "Akses ditolak",
+	model.TapResultInvalid:      "Pesan tidak valid",
+	model.TapResultUnauthorized: "Device tidak sah",
+	model.TapResultBusy:         "Server sibuk, coba lagi",
+	model.TapResultError:        "Terjadi kesalahan",
+}
+
+// ackVersionOf reads the acknowledgment format asked by the device, even from a message failing verification.
+// Versions newer than the server knows are answered with the newest one, the device sees it in v.
+func ackVersionOf(raw []byte) int {
+	var message struct {
+		AckVersion int `json:"ack_version"`
+	}
+	if err := json.Unmarshal(raw, &message); err != nil || message.AckVersion < 0 {
+		return 0
+	}
+	return min(message.AckVersion, model.DeviceAckVersion)
+}
+
+// isTapTopic reports whether the topic carries card taps, only their acknowledgments follow ack_version.
+// Ping, batch and command acknowledgments keep their payloads.
+func isTapTopic(topic string) bool {
+	switch repo.DeviceModeType(util.GetDeviceMode(topic)) {
+	case repo.DeviceModeTypeRecord, repo.DeviceModeTypePresence, repo.DeviceModeTypePermission:
+		return !isCommandAckTopic(topic)
+	default:
+		return false
+	}
+}
+
+func tapResultOf(code int) model.TapResult {
+	switch {
+	case code < 300:
+		if code == http.StatusAlreadyReported {
+			return model.TapResultRepeated
+		}
+		return model.TapResultAccepted
+	case code == http.StatusBadRequest:
+		return model.TapResultInvalid
+	case code == http.StatusUnauthorized:
+		return model.TapResultUnauthorized
+	case code == http.StatusForbidden:
+		return model.TapResultDenied
+	case code == http.StatusNotFound:
+		return model.TapResultCardUnknown
+	case code == http.StatusConflict:
+		return model.TapResultDuplicate
+	case code == http.StatusServiceUnavailable:
+		return model.TapResultBusy
+	default:
+		return model.TapResultError
+	}
+}
+
+// newDeviceAck builds the compact acknowledgment of a tap, data is the result of an accepted tap
+func newDeviceAck(event *tapEvent, code int, data any) model.DeviceAck {
+	ack := model.DeviceAck{
+		Version: event.ackVersion,
+		Result:  event.result,
+		Code:    code,
+		Name:    truncateDisplay(event.owner.Name),
+		Signal:  model.DeviceSignalError,
+	}
+	if ack.Result == "" {
+		ack.Result = tapResultOf(code)
+	}
+
+	switch ack.Result {
+	case model.TapResultAccepted:
+		ack.Display, ack.Icon = acceptedDisplay(event.mode, data)
+		ack.Signal = model.DeviceSignalOk
+		if ack.Icon == model.DeviceIconLate {
+			ack.Signal = model.DeviceSignalWarn
+		}
+	case model.TapResultRepeated, model.TapResultDuplicate:
+		ack.Display = tapResultDisplays[ack.Result]
+		if ack.Result == model.TapResultDuplicate {
+			ack.Display = duplicateDisplay(event.mode)
+		}
+		ack.Signal = model.DeviceSignalWarn
+	case model.TapResultPassback:
+		// the last passage went through the same gate direction
+		ack.Display = "Belum tap keluar"
+		if event.gateDirection == repo.GateDirectionTypeExit {
+			ack.Display = "Belum tap masuk"
+		}
+	default:
+		ack.Display = tapResultDisplays[ack.Result]
+	}
+	return ack
+}
+
+func acceptedDisplay(mode repo.DeviceModeType, data any) (string, model.DeviceIcon) {
+	var presenceType repo.PresenceType
+	switch result := data.(type) {
+	case *model.SantriPresenceResponse:
+		presenceType = result.Type
+	case *model.EmployeePresenceResponse:
+		presenceType = result.Type
+	case *model.EnrollmentTapResponse:
+		return "Kartu terdaftar", model.DeviceIconCard
+	case *model.SantriPermissionTapResponse:
+		if result.Action == model.SantriPermissionTapCheckIn {
+			return "Selamat datang kembali", model.DeviceIconPermission
+		}
+		return "Izin keluar tercatat", model.DeviceIconPermission
+	}
+
+	switch {
+	case presenceType == repo.PresenceTypeLate:
+		return "Terlambat", model.DeviceIconLate
+	case presenceType != "":
+		return "Hadir", model.DeviceIconPresent
+	case mode == repo.DeviceModeTypeRecord:
+		return "Kartu tercatat", model.DeviceIconCard
+	default:
+		return "Tap tercatat", ""
+	}
+}
+
+func duplicateDisplay(mode repo.DeviceModeType) string {
+	switch mode {
+	case repo.DeviceModeTypeRecord:
+		return "Kartu sudah terdaftar"
+	case repo.DeviceModeTypePermission:
+		return "Izin sudah tercatat"
+	default:
+		return "Sudah presensi"
+	}
+}
+
+func truncateDisplay(text string) string {
+	runes := []rune(text)
+	if len(runes) <= model.DeviceAckDisplayMax {
+		return text
+	}
+	return string(runes[:model.DeviceAckDisplayMax])
+}
@@ -0,0 +1,166 @@
+package mqtt
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+	"github.com/adiubaidah/syafiiyah-main/pkg/devicesim"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAckVersionOf(t *testing.T) {
+	require.Equal(t, 0, ackVersionOf([]byte(`{"payload":{}}`)))
+	require.Equal(t, 0, ackVersionOf([]byte(`not json`)))
+	require.Equal(t, 1, ackVersionOf([]byte(`{"ack_version":1}`)))
+	// newer firmware is answered with the newest known format
+	require.Equal(t, model.DeviceAckVersion, ackVersionOf([]byte(`{"ack_version":99}`)))
+}
+
+func TestNewDeviceAck(t *testing.T) {
+	owner := model.OwenerDetails{ID: 1, Role: repo.RoleTypeSantri, Name: "Muhammad Abdullah bin Abdurrahman Al-Fatih"}
+	testCases := []struct {
+		name    string
+		event   tapEvent
+		code    int
+		data    any
+		result  model.TapResult
+		display string
+		icon    model.DeviceIcon
+		signal  model.DeviceSignal
+	}{
+		{
+			name:    "late presence",
+			event:   tapEvent{mode: repo.DeviceModeTypePresence, owner: owner},
+			code:    200,
+			data:    &model.SantriPresenceResponse{Type: repo.PresenceTypeLate},
+			result:  model.TapResultAccepted,
+			display: "Terlambat",
+			icon:    model.DeviceIconLate,
+			signal:  model.DeviceSignalWarn,
+		},
+		{
+			name:    "employee presence",
+			event:   tapEvent{mode: repo.DeviceModeTypePresence, owner: owner},
+			code:    200,
+			data:    &model.EmployeePresenceResponse{Type: repo.PresenceTypePresent},
+			result:  model.TapResultAccepted,
+			display: "Hadir",
+			icon:    model.DeviceIconPresent,
+			signal:  model.DeviceSignalOk,
+		},
+		{
+			name:    "permission check in",
+			event:   tapEvent{mode: repo.DeviceModeTypePermission, owner: owner},
+			code:    200,
+			data:    &model.SantriPermissionTapResponse{Action: model.SantriPermissionTapCheckIn},
+			result:  model.TapResultAccepted,
+			display: "Selamat datang kembali",
+			icon:    model.DeviceIconPermission,
+			signal:  model.DeviceSignalOk,
+		},
+		{
+			name:    "already present",
+			event:   tapEvent{mode: repo.DeviceModeTypePresence, owner: owner},
+			code:    409,
+			result:  model.TapResultDuplicate,
+			display: "Sudah presensi",
+			signal:  model.DeviceSignalWarn,
+		},
+		{
+			name:    "card enrolled for the owner",
+			event:   tapEvent{mode: repo.DeviceModeTypeRecord, owner: owner},
+			code:    200,
+			data:    &model.EnrollmentTapResponse{SessionID: 1},
+			result:  model.TapResultAccepted,
+			display: "Kartu terdaftar",
+			icon:    model.DeviceIconCard,
+			signal:  model.DeviceSignalOk,
+		},
+		{
+			name:    "card already recorded",
+			event:   tapEvent{mode: repo.DeviceModeTypeRecord},
+			code:    409,
+			result:  model.TapResultDuplicate,
+			display: "Kartu sudah terdaftar",
+			signal:  model.DeviceSignalWarn,
+		},
+		{
+			name:    "inactive card",
+			event:   tapEvent{mode: repo.DeviceModeTypePresence, owner: owner, result: model.TapResultCardInactive},
+			code:    403,
+			result:  model.TapResultCardInactive,
+			display: "Kartu tidak aktif",
+			signal:  model.DeviceSignalError,
+		},
+		{
+			name:    "anti-passback on exit gate",
+			event:   tapEvent{mode: repo.DeviceModeTypePresence, result: model.TapResultPassback, gateDirection: repo.GateDirectionTypeExit},
+			code:    403,
+			result:  model.TapResultPassback,
+			display: "Belum tap masuk",
+			signal:  model.DeviceSignalError,
+		},
+		{
+			name:    "server error",
+			event:   tapEvent{mode: repo.DeviceModeTypePresence},
+			code:    500,
+			result:  model.TapResultError,
+			display: "Terjadi kesalahan",
+			signal:  model.DeviceSignalError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.event.ackVersion = 1
+			ack := newDeviceAck(&tc.event, tc.code, tc.data)
+			require.Equal(t, 1, ack.Version)
+			require.Equal(t, tc.code, ack.Code)
+			require.Equal(t, tc.result, ack.Result)
+			require.Equal(t, tc.display, ack.Display)
+			require.Equal(t, tc.icon, ack.Icon)
+			require.Equal(t, tc.signal, ack.Signal)
+			require.LessOrEqual(t, len([]rune(ack.Display)), model.DeviceAckDisplayMax)
+			require.LessOrEqual(t, len([]rune(ack.Name)), model.DeviceAckDisplayMax)
+			if tc.event.owner.Name != "" {
+				require.True(t, strings.HasPrefix(tc.event.owner.Name, ack.Name))
+			}
+		})
+	}
+}
+
+func TestBrokerCompactAck(t *testing.T) {
+	store, simulator, _ := newTestBroker(t)
+	store.On("CreateSmartCard", mock.Anything, mock.Anything).Return(repo.SmartCard{ID: 7, Uid: "04A1B2C3", IsActive: true}, nil).Once()
+
+	gate := devicesim.RegisterTestDevice(t, simulator, "gate_a", testDeviceSecret, repo.DeviceModeTypeRecord)
+	gate.AckVersion = model.DeviceAckVersion
+	tap := func(ctx context.Context) (*devicesim.Ack, error) {
+		return gate.Tap(ctx, repo.DeviceModeTypeRecord, "04A1B2C3")
+	}
+
+	ack := devicesim.RequireAck(t, 200, tap)
+	require.Equal(t, model.DeviceAckVersion, ack.Version)
+	require.Equal(t, model.TapResultAccepted, ack.Result)
+	require.Equal(t, "Kartu tercatat", ack.Display)
+	require.Equal(t, model.DeviceIconCard, ack.Icon)
+	require.Equal(t, model.DeviceSignalOk, ack.Signal)
+	require.Empty(t, ack.Data)
+
+	ack = devicesim.RequireAck(t, 208, tap)
+	require.Equal(t, model.TapResultRepeated, ack.Result)
+	require.Equal(t, model.DeviceSignalWarn, ack.Signal)
+
+	// the format is chosen before the signature is checked, so a rejected tap is answered the same way
+	impostor := devicesim.RegisterTestDevice(t, simulator, "gate_a", "wrong-secret", repo.DeviceModeTypeRecord)
+	impostor.AckVersion = model.DeviceAckVersion
+	ack = devicesim.RequireAck(t, 401, func(ctx context.Context) (*devicesim.Ack, error) {
+		return impostor.Tap(ctx, repo.DeviceModeTypeRecord, "04A1B2C3")
+	})
+	require.Equal(t, model.TapResultUnauthorized, ack.Result)
+	require.Equal(t, "Device tidak sah", ack.Display)
+}
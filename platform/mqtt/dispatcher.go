@@ -0,0 +1,202 @@
+package mqtt
+
+import (
+	"context"
+	"hash/fnv"
+	"sync"
+	"sync/atomic"
+)
+
+// OverflowPolicy decides what happens to a message when the queue of its worker is full
+type OverflowPolicy string
+
+const (
+	// OverflowPolicyBlock waits for room in the queue, stalling the MQTT client until workers catch up
+	OverflowPolicyBlock OverflowPolicy = "block"
+	// OverflowPolicyDropNewest rejects the incoming message
+	OverflowPolicyDropNewest OverflowPolicy = "drop_newest"
+	// OverflowPolicyDropOldest rejects the oldest queued message of the worker to make room
+	OverflowPolicyDropOldest OverflowPolicy = "drop_oldest"
+)
+
+const (
+	defaultDispatcherWorkers   = 8
+	defaultDispatcherQueueSize = 100
+)
+
+func (p OverflowPolicy) valid() bool {
+	switch p {
+	case OverflowPolicyBlock, OverflowPolicyDropNewest, OverflowPolicyDropOldest:
+		return true
+	}
+	return false
+}
+
+// DispatcherStats is the state of the worker pool, shown on the health endpoint
+type DispatcherStats struct {
+	Workers        int            `json:"workers"`
+	QueueCapacity  int            `json:"queue_capacity"`
+	QueueDepth     int            `json:"queue_depth"`
+	QueueDepths    []int          `json:"queue_depths"`
+	InFlight       int64          `json:"in_flight"`
+	Processed      int64          `json:"processed"`
+	Dropped        int64          `json:"dropped"`
+	OverflowPolicy OverflowPolicy `json:"overflow_policy"`
+	Draining       bool           `json:"draining"`
+}
+
+// task is a unit of work for the pool, reject is called instead of run when the task is dropped
+type task struct {
+	run    func()
+	reject func()
+}
+
+// dispatcher runs tasks on a fixed number of workers, each with its own bounded queue.
+// Tasks with the same key always go to the same worker, so they are processed in order.
+type dispatcher struct {
+	queues    []chan task
+	queueSize int
+	policy    OverflowPolicy
+
+	// mu guards closed, submit holds the read lock while sending so the queues are not closed under it
+	mu     sync.RWMutex
+	closed bool
+	wg     sync.WaitGroup
+
+	inFlight  atomic.Int64
+	processed atomic.Int64
+	dropped   atomic.Int64
+}
+
+func newDispatcher(workers int, queueSize int, policy OverflowPolicy) *dispatcher {
+	if workers <= 0 {
+		workers = defaultDispatcherWorkers
+	}
+	if queueSize <= 0 {
+		queueSize = defaultDispatcherQueueSize
+	}
+	if !policy.valid() {
+		policy = OverflowPolicyBlock
+	}
+
+	d := &dispatcher{
+		queues:    make([]chan task, workers),
+		queueSize: queueSize,
+		policy:    policy,
+	}
+	for i := range d.queues {
+		d.queues[i] = make(chan task, queueSize)
+		d.wg.Add(1)
+		go d.work(d.queues[i])
+	}
+	return d
+}
+
+func (d *dispatcher) work(queue chan task) {
+	defer d.wg.Done()
+	for t := range queue {
+		d.inFlight.Add(1)
+		t.run()
+		d.inFlight.Add(-1)
+		d.processed.Add(1)
+	}
+}
+
+// submit queues the task on the worker owning the key, it returns false when the task is rejected
+func (d *dispatcher) submit(key string, t task) bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if d.closed {
+		d.drop(t)
+		return false
+	}
+
+	queue := d.queues[d.shard(key)]
+	switch d.policy {
+	case OverflowPolicyDropNewest:
+		select {
+		case queue <- t:
+			return true
+		default:
+			d.drop(t)
+			return false
+		}
+	case OverflowPolicyDropOldest:
+		for {
+			select {
+			case queue <- t:
+				return true
+			default:
+			}
+			select {
+			case oldest := <-queue:
+				d.drop(oldest)
+			default:
+			}
+		}
+	default:
+		queue <- t
+		return true
+	}
+}
+
+func (d *dispatcher) drop(t task) {
+	d.dropped.Add(1)
+	if t.reject != nil {
+		t.reject()
+	}
+}
+
+func (d *dispatcher) shard(key string) int {
+	hash := fnv.New32a()
+	hash.Write([]byte(key))
+	return int(hash.Sum32() % uint32(len(d.queues)))
+}
+
+// shutdown stops accepting tasks and waits until queued tasks are processed or the context is done
+func (d *dispatcher) shutdown(ctx context.Context) error {
+	d.mu.Lock()
+	if !d.closed {
+		d.closed = true
+		for _, queue := range d.queues {
+			close(queue)
+		}
+	}
+	d.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		d.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (d *dispatcher) stats() DispatcherStats {
+	d.mu.RLock()
+	draining := d.closed
+	d.mu.RUnlock()
+
+	stats := DispatcherStats{
+		Workers:        len(d.queues),
+		QueueCapacity:  d.queueSize,
+		QueueDepths:    make([]int, len(d.queues)),
+		InFlight:       d.inFlight.Load(),
+		Processed:      d.processed.Load(),
+		Dropped:        d.dropped.Load(),
+		OverflowPolicy: d.policy,
+		Draining:       draining,
+	}
+	for i, queue := range d.queues {
+		stats.QueueDepths[i] = len(queue)
+		stats.QueueDepth += stats.QueueDepths[i]
+	}
+	return stats
+}
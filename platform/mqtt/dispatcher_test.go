@@ -0,0 +1,92 @@
+package mqtt
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDispatcherKeepsOrderPerKey(t *testing.T) {
+	d := newDispatcher(4, 10, OverflowPolicyBlock)
+
+	var mu sync.Mutex
+	got := make(map[string][]int)
+	for i := 0; i < 50; i++ {
+		for _, key := range []string{"gate_a", "gate_b", "gate_c"} {
+			key, i := key, i
+			d.submit(key, task{run: func() {
+				mu.Lock()
+				got[key] = append(got[key], i)
+				mu.Unlock()
+			}})
+		}
+	}
+	require.NoError(t, d.shutdown(context.Background()))
+
+	for key, sequence := range got {
+		require.Len(t, sequence, 50, key)
+		for i := range sequence {
+			require.Equal(t, i, sequence[i], key)
+		}
+	}
+	require.EqualValues(t, 150, d.stats().Processed)
+}
+
+func TestDispatcherOverflow(t *testing.T) {
+	testCases := []struct {
+		policy   OverflowPolicy
+		rejected []int
+	}{
+		{policy: OverflowPolicyDropNewest, rejected: []int{3}},
+		{policy: OverflowPolicyDropOldest, rejected: []int{1}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.policy), func(t *testing.T) {
+			d := newDispatcher(1, 2, tc.policy)
+			release := make(chan struct{})
+			started := make(chan struct{})
+			d.submit("gate", task{run: func() {
+				close(started)
+				<-release
+			}})
+			<-started
+
+			var rejected []int
+			for i := 1; i <= 3; i++ {
+				i := i
+				d.submit("gate", task{run: func() {}, reject: func() { rejected = append(rejected, i) }})
+			}
+
+			require.Equal(t, tc.rejected, rejected)
+			stats := d.stats()
+			require.EqualValues(t, 1, stats.Dropped)
+			require.Equal(t, 2, stats.QueueDepth)
+			require.EqualValues(t, 1, stats.InFlight)
+
+			close(release)
+			require.NoError(t, d.shutdown(context.Background()))
+		})
+	}
+}
+
+func TestDispatcherShutdown(t *testing.T) {
+	d := newDispatcher(1, 1, OverflowPolicyBlock)
+	release := make(chan struct{})
+	d.submit("gate", task{run: func() { <-release }})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, d.shutdown(ctx), context.DeadlineExceeded)
+
+	rejected := false
+	require.False(t, d.submit("gate", task{run: func() {}, reject: func() { rejected = true }}))
+	require.True(t, rejected)
+	require.True(t, d.stats().Draining)
+
+	close(release)
+	require.NoError(t, d.shutdown(context.Background()))
+}
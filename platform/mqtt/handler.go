@@ -3,108 +3,291 @@ package mqtt
 import (
 	"context"
 	"encoding/json"
+	"net/http"
+	"time"
 
 	"github.com/adiubaidah/syafiiyah-main/internal/constant/exception"
 	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
 	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
 )
 
-func (h *MQTTBroker) handleRecord(acknowledgmentTopic string, request *model.SmartCardRequest) {
+func (h *MQTTBroker) handleRecord(event *tapEvent, acknowledgmentTopic string, request *model.SmartCardRequest) {
+	// during an enrollment session the card goes straight to the next owner of the session
+	enrolled, err := h.smartCardUseCase.Enroll(context.Background(), event.deviceName, request.Uid)
+	if err != nil {
+		h.logger.Errorf("Error enrolling smart card: %v\n", err)
+		h.replyTapError(event, acknowledgmentTopic, err)
+		return
+	}
+	if enrolled != nil {
+		event.owner = enrolled.Owner
+		h.replyTapData(event, acknowledgmentTopic, "Kartu "+enrolled.Owner.Name+" terdaftar", enrolled)
+		return
+	}
+
 	recordedSmartCard, err := h.smartCardUseCase.Create(context.Background(), request)
 	if err != nil {
 		h.logger.Errorf("Error creating smart card: %v\n", err)
+		h.replyTapError(event, acknowledgmentTopic, err)
+		return
 	}
 
-	var response any
+	h.replyTapData(event, acknowledgmentTopic, "Smart card tercatat", *recordedSmartCard)
+}
+
+func (h *MQTTBroker) handlePresence(event *tapEvent, acknowledgmentTopic string) {
+	result, err := h.recordPresence(event)
 	if err != nil {
-		h.logger.Errorf("Error creating smart card: %v\n", err)
-		if appError, ok := err.(*exception.AppError); ok {
-			response = model.ResponseMessage{
-				Code:    appError.Code,
-				Status:  "error",
-				Message: appError.Message,
-			}
+		h.replyTapError(event, acknowledgmentTopic, err)
+		return
+	}
+
+	h.replyTapData(event, acknowledgmentTopic, "Presensi tercatat", result)
+}
+
+// recordPresence resolve the owner of the smart card and create the presence.
+// A nil tappedAt means live tap, evaluated against the currently active schedule.
+func (h *MQTTBroker) recordPresence(event *tapEvent) (any, error) {
+	uid, tappedAt := event.uid, event.tappedAt
+	owner, err := h.getTapOwner(event)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.checkHoliday(event); err != nil {
+		return nil, err
+	}
+
+	// the device may only accept cards of some owners, e.g. santri putri on the female dormitory
+	access, err := h.deviceUseCase.GetAccessByTopic(context.Background(), event.topic)
+	if err != nil {
+		h.logger.Errorf("Error getting device access: %v\n", err)
+		return nil, err
+	}
+
+	switch owner.Role {
+	case repo.RoleTypeSantri:
+		var presence *model.SantriPresenceResponse
+		if tappedAt != nil {
+			presence, err = h.SantriHandler.PresenceAt(uid, owner.ID, *tappedAt, access)
 		} else {
-			response = model.ResponseMessage{
-				Code:    500,
-				Status:  "error",
-				Message: err.Error(),
-			}
+			presence, err = h.SantriHandler.Presence(uid, owner.ID, access)
 		}
-	} else {
+		if err != nil {
+			return nil, presenceError(event, err)
+		}
+		event.presence, event.scheduleID = presence, presence.Schedule.Id
+		return presence, nil
+	case repo.RoleTypeEmployee:
+		var presence *model.EmployeePresenceResponse
+		if tappedAt != nil {
+			presence, err = h.EmployeeHandler.PresenceAt(uid, owner.ID, *tappedAt, access)
+		} else {
+			presence, err = h.EmployeeHandler.Presence(uid, owner.ID, access)
+		}
+		if err != nil {
+			return nil, presenceError(event, err)
+		}
+		event.presence, event.scheduleID = presence, presence.Schedule.Id
+		return presence, nil
+	default:
+		h.logger.Warnf("Smart card %s has no owner\n", uid)
+		event.result = model.TapResultNoOwner
+		return nil, exception.NewNotFoundError("Smart card belum memiliki pemilik")
+	}
+}
 
-		response = model.ResponseData[model.SmartCard]{
-			Code:   200,
-			Status: "success",
-			Data:   *recordedSmartCard,
+// getTapOwner resolves the owner of the tapped card, refusing a card that can not be used.
+// The owner of a QR credential is already known from the verified token.
+func (h *MQTTBroker) getTapOwner(event *tapEvent) (*model.OwenerDetails, error) {
+	if event.qr != nil {
+		if err := h.checkTapQROwner(event); err != nil {
+			return nil, err
 		}
+		return &event.owner, nil
 	}
 
-	payload, err := json.Marshal(response)
+	getSmartCard, err := h.getTapSmartCard(event)
 	if err != nil {
-		h.logger.Errorf("Error marshaling response: %v\n", err)
-		return
+		return nil, err
 	}
-
-	token := h.Client.Publish(acknowledgmentTopic, 0, false, payload)
-
-	if token.Wait() && token.Error() != nil {
-		h.logger.Errorf("Error sending acknowledgment: %v\n", token.Error())
+	if err := h.checkTapSmartCard(event, getSmartCard); err != nil {
+		return nil, err
 	}
+	return &getSmartCard.Owner, nil
 }
 
-func (h *MQTTBroker) handlePresence(acknowledgmentTopic string, request *model.SmartCardRequest) {
-
-	getSmartCard, err := h.smartCardUseCase.Get(context.Background(), &model.SmartCardRequest{Uid: request.Uid})
-	var response any
+// getTapSmartCard gets the smart card of the tap and remembers its owner.
+// An unknown card is put in the pending queue.
+func (h *MQTTBroker) getTapSmartCard(event *tapEvent) (*model.SmartCardComplete, error) {
+	getSmartCard, err := h.smartCardUseCase.Get(context.Background(), &model.SmartCardRequest{Uid: event.uid})
 	if err != nil {
 		h.logger.Errorf("Error getting smart card: %v\n", err)
+		if isNotFound(err) {
+			event.result = model.TapResultCardUnknown
+			// keep the UID, so an admin can claim the card without tapping it again in record mode
+			if err := h.smartCardUseCase.QueuePending(context.Background(), event.uid, event.deviceName); err != nil {
+				h.logger.Errorf("Error queueing pending smart card: %v\n", err)
+			}
+		}
+		return nil, err
+	}
+	event.owner = getSmartCard.Owner
+	return getSmartCard, nil
+}
 
-		response = createErrorResponse(err)
-		h.publishResponse(acknowledgmentTopic, response)
-		return
+// checkTapSmartCard refuses a card that is not active or used outside its validity window.
+// A buffered tap is checked at the time it was tapped, a card suspended by the expiry job after that
+// is still accepted for a tap before its valid_until. A card suspended for another reason is refused.
+func (h *MQTTBroker) checkTapSmartCard(event *tapEvent, smartCard *model.SmartCardComplete) error {
+	at := time.Now()
+	if event.tappedAt != nil {
+		at = *event.tappedAt
 	}
 
-	if !getSmartCard.IsActive {
+	expiredSinceTap := event.tappedAt != nil && smartCard.SuspendedByExpiryAfter(at)
+	if !smartCard.IsActive && !expiredSinceTap {
 		h.logger.Warn("Smart card is not active")
+		event.result = model.TapResultCardInactive
+		return exception.NewForbiddenError("Smart card tidak aktif")
+	}
 
-		response = model.ResponseMessage{
-			Code:    403,
-			Status:  "error",
-			Message: "Smart card tidak aktif",
+	if !smartCard.ValidAt(at) {
+		h.logger.Warnf("Smart card %s is used outside its validity\n", event.uid)
+		event.result = model.TapResultCardExpired
+		return exception.NewForbiddenError("Smart card tidak berlaku")
+	}
+	return nil
+}
+
+// checkTapQROwner refuses the QR credential of an owner who could not tap a card anymore, a santri who is
+// no longer active or an owner whose card is refused by checkTapSmartCard. An owner without a card may use it.
+func (h *MQTTBroker) checkTapQROwner(event *tapEvent) error {
+	active, err := h.smartCardUseCase.IsOwnerActive(context.Background(), event.owner)
+	if err != nil {
+		h.logger.Errorf("Error getting owner of QR credential: %v\n", err)
+		return err
+	}
+	if !active {
+		h.logger.Warnf("QR credential of inactive %s %d\n", event.owner.Role, event.owner.ID)
+		event.result = model.TapResultCardInactive
+		return exception.NewForbiddenError("Pemilik QR tidak aktif")
+	}
+
+	ownerSmartCard, err := h.smartCardUseCase.GetOwnerSmartCard(context.Background(), event.owner)
+	if err != nil {
+		if isNotFound(err) {
+			return nil
 		}
-		h.publishResponse(acknowledgmentTopic, response)
-		return
+		h.logger.Errorf("Error getting smart card of QR credential owner: %v\n", err)
+		return err
 	}
+	return h.checkTapSmartCard(event, ownerSmartCard)
+}
 
-	switch getSmartCard.Owner.Role {
-	case repo.RoleTypeSantri:
-		result, err := h.SantriHandler.Presence(request.Uid, getSmartCard.Owner.ID)
-		if err != nil {
-			if appErr, ok := err.(*exception.AppError); ok {
-				response = model.ResponseMessage{
-					Code:    appErr.Code,
-					Status:  "error",
-					Message: appErr.Message,
-				}
-			} else {
-				response = model.ResponseMessage{
-					Code:    500,
-					Status:  "error",
-					Message: err.Error(),
-				}
-			}
-		} else {
-			response = model.ResponseData[*model.SantriPresenceResponse]{
-				Code:   200,
-				Status: "success",
-				Data:   result,
-			}
+// checkHoliday refuses a presence on a holiday, a buffered tap is checked on the day it was tapped
+func (h *MQTTBroker) checkHoliday(event *tapEvent) error {
+	if h.holidayUseCase == nil {
+		return nil
+	}
+
+	at := time.Now()
+	if event.tappedAt != nil {
+		at = *event.tappedAt
+	}
+	holiday, err := h.holidayUseCase.GetHolidayAt(context.Background(), at)
+	if err != nil {
+		// presence stays usable when the holidays can not be checked
+		h.logger.Errorf("Error getting holiday: %v\n", err)
+		return nil
+	}
+	if holiday == nil {
+		return nil
+	}
+
+	event.result = model.TapResultHoliday
+	return exception.NewForbiddenError("Hari libur " + holiday.Name + ", presensi tidak dicatat")
+}
+
+// presenceError marks a presence failing because the owner has no schedule at the tap time
+func presenceError(event *tapEvent, err error) error {
+	if isNotFound(err) {
+		event.result = model.TapResultNoSchedule
+	}
+	return err
+}
+
+func (h *MQTTBroker) handleBatch(acknowledgmentTopic string, inputTopic string, request *model.BatchTapRequest) {
+	results := make([]model.BatchTapResult, 0, len(request.Taps))
+	for i := range request.Taps {
+		results = append(results, h.processBatchTap(inputTopic, &request.Taps[i]))
+	}
+
+	h.publishResponse(acknowledgmentTopic, model.ResponseData[[]model.BatchTapResult]{
+		Code:   200,
+		Status: "success",
+		Data:   results,
+	})
+}
+
+func (h *MQTTBroker) processBatchTap(inputTopic string, tap *model.BatchTap) model.BatchTapResult {
+	ctx := context.Background()
+
+	recorded, err := h.deviceUseCase.GetBatchTap(ctx, inputTopic, tap.TapID)
+	if err == nil {
+		recorded.Duplicate = true
+		return *recorded
+	}
+	if !isNotFound(err) {
+		h.logger.Errorf("Error getting batch tap: %v\n", err)
+		return batchTapResult(tap.TapID, err)
+	}
+
+	tappedAt := time.Unix(tap.TappedAt, 0)
+	event := newTapEvent(inputTopic)
+	event.uid = tap.Uid
+	event.tappedAt = &tappedAt
+
+	var result model.BatchTapResult
+	if tappedAt.After(time.Now().Add(maxBatchTapClockSkew)) {
+		result = batchTapResult(tap.TapID, exception.NewValidationError("Waktu tap tidak valid"))
+	} else {
+		_, err = h.recordPresence(event)
+		result = batchTapResult(tap.TapID, err)
+	}
+	h.saveTapEvent(event, result.Code, result.Message)
+
+	// server errors are not saved, so the device can retry the tap on the next upload
+	if result.Code >= 500 {
+		return result
+	}
+	if err := h.deviceUseCase.SaveBatchTap(ctx, inputTopic, tap, &result); err != nil {
+		h.logger.Errorf("Error saving batch tap: %v\n", err)
+	}
+	return result
+}
+
+func batchTapResult(tapID string, err error) model.BatchTapResult {
+	if err != nil {
+		response := createErrorResponse(err)
+		return model.BatchTapResult{
+			TapID:   tapID,
+			Code:    response.Code,
+			Status:  response.Status,
+			Message: response.Message,
 		}
-		h.publishResponse(acknowledgmentTopic, response)
-		return
 	}
 
+	return model.BatchTapResult{
+		TapID:   tapID,
+		Code:    200,
+		Status:  "success",
+		Message: "Presensi tercatat",
+	}
+}
+
+func isNotFound(err error) bool {
+	appErr, ok := err.(*exception.AppError)
+	return ok && appErr.Code == http.StatusNotFound
 }
 
 func createErrorResponse(err error) model.ResponseMessage {
@@ -130,16 +313,49 @@ func (h *MQTTBroker) publishResponse(topic string, response any) {
 		return
 	}
 
-	token := h.Client.Publish(topic, 0, false, payload)
+	token := h.Client.Publish(topic, h.ackQoS, false, payload)
 	if token.Wait() && token.Error() != nil {
 		h.logger.Errorf("Error sending acknowledgment: %v\n", token.Error())
 	}
 }
 
-func (h *MQTTBroker) handlePermission(acknowledgmentTopic string, request *model.SmartCardRequest) {
-	// Handle 'permission' topic
+func (h *MQTTBroker) handlePermission(event *tapEvent, acknowledgmentTopic string) {
+	owner, err := h.getTapOwner(event)
+	if err != nil {
+		h.replyTapError(event, acknowledgmentTopic, err)
+		return
+	}
+
+	if owner.Role != repo.RoleTypeSantri {
+		h.replyTap(event, acknowledgmentTopic, model.ResponseMessage{
+			Code:    403,
+			Status:  "error",
+			Message: "Mode izin hanya untuk santri",
+		})
+		return
+	}
+
+	result, err := h.SantriHandler.Permission(owner.ID)
+	if err != nil {
+		h.logger.Errorf("Error handling santri permission: %v\n", err)
+		h.replyTapError(event, acknowledgmentTopic, err)
+		return
+	}
+
+	h.replyTapData(event, acknowledgmentTopic, "Izin tercatat", result)
 }
 
-func (h *MQTTBroker) handlePing(acknowledgmentTopic string) {
-	// Handle 'ping' topic
+func (h *MQTTBroker) handlePing(acknowledgmentTopic string, inputTopic string, request *model.DevicePingRequest) {
+	heartbeat, err := h.deviceUseCase.RecordHeartbeat(context.Background(), inputTopic, request)
+	if err != nil {
+		h.logger.Errorf("Error recording device heartbeat: %v\n", err)
+		h.publishResponse(acknowledgmentTopic, createErrorResponse(err))
+		return
+	}
+
+	h.publishResponse(acknowledgmentTopic, model.ResponseData[*model.DevicePingResponse]{
+		Code:   200,
+		Status: "success",
+		Data:   heartbeat,
+	})
 }
@@ -0,0 +1,124 @@
+package mqtt
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+	mocks "github.com/adiubaidah/syafiiyah-main/internal/repository/mocks"
+	"github.com/adiubaidah/syafiiyah-main/internal/usecase"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckTapSmartCard(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	broker := &MQTTBroker{logger: logger}
+
+	validUntil := time.Now().Add(-time.Hour)
+	expired := &model.SmartCardComplete{SmartCard: model.SmartCard{
+		State:          repo.SmartCardStateSuspended,
+		ValidUntil:     validUntil.Format("2006-01-02 15:04:05"),
+		ValidUntilTime: validUntil,
+		ExpiredAtTime:  validUntil.Add(30 * time.Minute),
+	}}
+
+	t.Run("buffered tap before valid_until is accepted", func(t *testing.T) {
+		tappedAt := validUntil.Add(-time.Hour)
+		event := &tapEvent{uid: "04A1B2C3", tappedAt: &tappedAt}
+		require.NoError(t, broker.checkTapSmartCard(event, expired))
+	})
+
+	t.Run("buffered tap after valid_until is expired", func(t *testing.T) {
+		tappedAt := validUntil.Add(time.Minute)
+		event := &tapEvent{uid: "04A1B2C3", tappedAt: &tappedAt}
+		require.Error(t, broker.checkTapSmartCard(event, expired))
+		require.Equal(t, model.TapResultCardExpired, event.result)
+	})
+
+	t.Run("live tap on an expired card is inactive", func(t *testing.T) {
+		event := &tapEvent{uid: "04A1B2C3"}
+		require.Error(t, broker.checkTapSmartCard(event, expired))
+		require.Equal(t, model.TapResultCardInactive, event.result)
+	})
+
+	t.Run("buffered tap on a card suspended by an admin after valid_until is inactive", func(t *testing.T) {
+		suspended := &model.SmartCardComplete{SmartCard: model.SmartCard{
+			State:          repo.SmartCardStateSuspended,
+			ValidUntilTime: validUntil,
+		}}
+		tappedAt := validUntil.Add(-time.Hour)
+		event := &tapEvent{uid: "04A1B2C3", tappedAt: &tappedAt}
+		require.Error(t, broker.checkTapSmartCard(event, suspended))
+		require.Equal(t, model.TapResultCardInactive, event.result)
+	})
+
+	t.Run("buffered tap on a card suspended before expiry is inactive", func(t *testing.T) {
+		suspended := &model.SmartCardComplete{SmartCard: model.SmartCard{
+			State:          repo.SmartCardStateSuspended,
+			ValidUntilTime: time.Now().Add(time.Hour),
+		}}
+		tappedAt := time.Now().Add(-time.Hour)
+		event := &tapEvent{uid: "04A1B2C3", tappedAt: &tappedAt}
+		require.Error(t, broker.checkTapSmartCard(event, suspended))
+		require.Equal(t, model.TapResultCardInactive, event.result)
+	})
+}
+
+func TestCheckTapQROwner(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	ctx := context.Background()
+	santriOwner := model.OwenerDetails{ID: 3, Role: repo.RoleTypeSantri}
+	newBroker := func(mockStore *mocks.MockStore) *MQTTBroker {
+		return &MQTTBroker{logger: logger, smartCardUseCase: usecase.NewSmartCardUseCase(mockStore)}
+	}
+
+	t.Run("inactive santri is refused", func(t *testing.T) {
+		mockStore := new(mocks.MockStore)
+		mockStore.On("GetSantri", ctx, int32(3)).Return(repo.GetSantriRow{ID: 3, IsActive: pgtype.Bool{Bool: false, Valid: true}}, nil)
+
+		event := &tapEvent{uid: qrUid(santriOwner.Role, santriOwner.ID), owner: santriOwner}
+		require.Error(t, newBroker(mockStore).checkTapQROwner(event))
+		require.Equal(t, model.TapResultCardInactive, event.result)
+		mockStore.AssertNotCalled(t, "GetOwnerSmartCard", mock.Anything, mock.Anything)
+	})
+
+	t.Run("owner with a suspended card is refused", func(t *testing.T) {
+		mockStore := new(mocks.MockStore)
+		mockStore.On("GetSantri", ctx, int32(3)).Return(repo.GetSantriRow{ID: 3, IsActive: pgtype.Bool{Bool: true, Valid: true}}, nil)
+		mockStore.On("GetOwnerSmartCard", ctx, repo.GetOwnerSmartCardParams{SantriID: pgtype.Int4{Int32: 3, Valid: true}}).
+			Return(repo.SmartCard{ID: 1, State: repo.SmartCardStateSuspended}, nil)
+
+		event := &tapEvent{uid: qrUid(santriOwner.Role, santriOwner.ID), owner: santriOwner}
+		require.Error(t, newBroker(mockStore).checkTapQROwner(event))
+		require.Equal(t, model.TapResultCardInactive, event.result)
+	})
+
+	t.Run("owner without a card is accepted", func(t *testing.T) {
+		mockStore := new(mocks.MockStore)
+		mockStore.On("GetSantri", ctx, int32(3)).Return(repo.GetSantriRow{ID: 3, IsActive: pgtype.Bool{Bool: true, Valid: true}}, nil)
+		mockStore.On("GetOwnerSmartCard", ctx, mock.Anything).Return(repo.SmartCard{}, pgx.ErrNoRows)
+
+		event := &tapEvent{uid: qrUid(santriOwner.Role, santriOwner.ID), owner: santriOwner}
+		require.NoError(t, newBroker(mockStore).checkTapQROwner(event))
+	})
+
+	t.Run("employee with an active card is accepted", func(t *testing.T) {
+		mockStore := new(mocks.MockStore)
+		employeeOwner := model.OwenerDetails{ID: 5, Role: repo.RoleTypeEmployee}
+		mockStore.On("GetEmployeeByID", ctx, int32(5)).Return(repo.GetEmployeeByIDRow{ID: 5}, nil)
+		mockStore.On("GetOwnerSmartCard", ctx, repo.GetOwnerSmartCardParams{EmployeeID: pgtype.Int4{Int32: 5, Valid: true}}).
+			Return(repo.SmartCard{ID: 2, State: repo.SmartCardStateActive, IsActive: true}, nil)
+
+		event := &tapEvent{uid: qrUid(employeeOwner.Role, employeeOwner.ID), owner: employeeOwner}
+		require.NoError(t, newBroker(mockStore).checkTapQROwner(event))
+	})
+}
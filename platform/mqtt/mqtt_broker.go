@@ -3,6 +3,7 @@ package mqtt
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"sync"
 	"time"
 
@@ -10,130 +11,296 @@ import (
 	mqttHandler "github.com/adiubaidah/syafiiyah-main/internal/mqtt"
 	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
 	"github.com/adiubaidah/syafiiyah-main/internal/usecase"
+	"github.com/adiubaidah/syafiiyah-main/pkg/token"
 	"github.com/adiubaidah/syafiiyah-main/pkg/util"
+	"github.com/adiubaidah/syafiiyah-main/platform/live"
 	mqtt "github.com/eclipse/paho.mqtt.golang"
 	"github.com/go-playground/validator/v10"
 	"github.com/sirupsen/logrus"
 )
 
+// maxBatchTapClockSkew is how far in the future a buffered tap timestamp may be, to tolerate device clock drift
+const maxBatchTapClockSkew = time.Minute
+
+const defaultClientID = "syafiiyah-main"
+
 type MQTTBroker struct {
-	logger           *logrus.Logger
-	validator        *validator.Validate
-	Client           mqtt.Client
-	Topics           map[string]struct{}
-	deviceUseCase    *usecase.DeviceUseCase
-	smartCardUseCase *usecase.SmartCardUseCase
-	SantriHandler    *mqttHandler.SantriMQTTHandler
-	mu               sync.Mutex
-	MessageHandler   mqtt.MessageHandler
+	logger            *logrus.Logger
+	validator         *validator.Validate
+	Client            mqtt.Client
+	Topics            map[string]struct{}
+	deviceUseCase     *usecase.DeviceUseCase
+	smartCardUseCase  *usecase.SmartCardUseCase
+	tapEventUseCase   *usecase.TapEventUseCase
+	holidayUseCase    *usecase.HolidayUseCase
+	SantriHandler     *mqttHandler.SantriMQTTHandler
+	EmployeeHandler   *mqttHandler.EmployeeMQTTHandler
+	mu                sync.Mutex
+	MessageHandler    mqtt.MessageHandler
+	inputQoS          byte
+	ackQoS            byte
+	state             connectionState
+	signatureMaxAge   time.Duration
+	nonces            *nonceCache
+	dispatcher        *dispatcher
+	debouncer         *tapDebouncer
+	tapDebounceWindow time.Duration
+	newClient         func(options *mqtt.ClientOptions) mqtt.Client
+	liveFeed          *live.Feed
+	qrMaker           *token.QRMaker
+	// modeSwitchTimer refreshes the topics when the earliest temporary mode expires
+	modeSwitchTimer *time.Timer
+	timerMu         sync.Mutex
 }
 
 type MQTTBrokerConfig struct {
 	Logger           *logrus.Logger
 	DeviceUseCase    *usecase.DeviceUseCase
 	SmartCardUseCase *usecase.SmartCardUseCase
+	TapEventUseCase  *usecase.TapEventUseCase
 	SantriHandler    *mqttHandler.SantriMQTTHandler
+	EmployeeHandler  *mqttHandler.EmployeeMQTTHandler
 	BrokerURL        string
-	IsDevelopment    bool
+	ClientID         string
+	InputQoS         byte
+	AckQoS           byte
+	SignatureMaxAge  time.Duration
+	Workers          int
+	QueueSize        int
+	OverflowPolicy   OverflowPolicy
+	// TapDebounceWindow is the default window for repeated taps, a device may override it
+	TapDebounceWindow time.Duration
+	// NewClient creates the MQTT client, mqtt.NewClient is used when it is nil.
+	// Tests pass the client factory of an in-process broker, see pkg/devicesim.
+	NewClient func(options *mqtt.ClientOptions) mqtt.Client
+	// LiveFeed receives every saved tap, nil disables streaming
+	LiveFeed      *live.Feed
+	IsDevelopment bool
+	// QRMaker verifies QR credentials sent instead of a card, nil refuses them
+	QRMaker *token.QRMaker
+	// HolidayUseCase refuses presence on holidays, nil records presence every day
+	HolidayUseCase *usecase.HolidayUseCase
 }
 
-func NewMQTTBroker(config *MQTTBrokerConfig) *MQTTBroker {
+// NewMQTTBroker connects to the broker and subscribes to the device topics.
+// When the topics cannot be fetched the broker is shut down again and the error is returned.
+func NewMQTTBroker(config *MQTTBrokerConfig) (*MQTTBroker, error) {
 	handler := &MQTTBroker{
-		logger:           config.Logger,
-		validator:        validator.New(),
-		Topics:           make(map[string]struct{}),
-		deviceUseCase:    config.DeviceUseCase,
-		smartCardUseCase: config.SmartCardUseCase,
-		SantriHandler:    config.SantriHandler,
+		logger:            config.Logger,
+		validator:         validator.New(),
+		Topics:            make(map[string]struct{}),
+		deviceUseCase:     config.DeviceUseCase,
+		smartCardUseCase:  config.SmartCardUseCase,
+		tapEventUseCase:   config.TapEventUseCase,
+		holidayUseCase:    config.HolidayUseCase,
+		SantriHandler:     config.SantriHandler,
+		EmployeeHandler:   config.EmployeeHandler,
+		inputQoS:          config.InputQoS,
+		ackQoS:            config.AckQoS,
+		signatureMaxAge:   config.SignatureMaxAge,
+		nonces:            newNonceCache(),
+		dispatcher:        newDispatcher(config.Workers, config.QueueSize, config.OverflowPolicy),
+		debouncer:         newTapDebouncer(),
+		tapDebounceWindow: config.TapDebounceWindow,
+		newClient:         config.NewClient,
+		liveFeed:          config.LiveFeed,
+		qrMaker:           config.QRMaker,
+	}
+	if handler.signatureMaxAge <= 0 {
+		handler.signatureMaxAge = defaultSignatureMaxAge
+	}
+	if handler.tapDebounceWindow <= 0 {
+		handler.tapDebounceWindow = defaultTapDebounceWindow
+	}
+	if handler.newClient == nil {
+		handler.newClient = mqtt.NewClient
+	}
+	handler.Init(config.BrokerURL, config.ClientID)
+	if err := handler.RefreshTopics(); err != nil {
+		handler.Shutdown(context.Background())
+		return nil, fmt.Errorf("error fetching device topics: %w", err)
 	}
-	handler.Init(config.BrokerURL)
-	handler.RefreshTopics()
 
-	return handler
+	return handler, nil
 }
 
-func (h *MQTTBroker) Init(brokerURL string) {
+// Init connects to the broker with persistent session and automatic reconnect.
+// A broker that is unreachable at startup is not fatal, the client keeps retrying in background.
+func (h *MQTTBroker) Init(brokerURL string, clientID string) {
 	h.logger.Println("Initializing MQTT client...")
+	if clientID == "" {
+		clientID = defaultClientID
+	}
 	opts := mqtt.NewClientOptions().AddBroker(brokerURL)
-	opts.SetClientID("syafiiyah-main")
+	opts.SetClientID(clientID)
+	opts.SetCleanSession(false)
+	opts.SetAutoReconnect(true)
+	opts.SetConnectRetry(true)
+	opts.SetConnectRetryInterval(5 * time.Second)
+	opts.SetMaxReconnectInterval(time.Minute)
 	opts.SetDefaultPublishHandler(h.defaultMessageHandler())
+	opts.SetOnConnectHandler(h.onConnect)
+	opts.SetConnectionLostHandler(h.onConnectionLost)
+	opts.SetReconnectingHandler(h.onReconnecting)
+
+	client := h.newClient(opts)
+	h.Client = client
 
-	client := mqtt.NewClient(opts)
 	token := client.Connect()
 	if !token.WaitTimeout(3 * time.Second) {
-		h.logger.Fatalf("MQTT broker connection timeout: %v", token.Error())
+		h.logger.Warn("MQTT broker is not reachable yet, retrying in background")
+		return
 	}
 	if err := token.Error(); err != nil {
-		h.logger.Fatalf("Error connecting to MQTT broker: %v", err)
+		h.logger.Errorf("Error connecting to MQTT broker: %v", err)
+		h.state.update(func(state *ConnectionState) {
+			state.LastError = err.Error()
+		})
 	}
-
-	h.Client = client
-	h.logger.Println("Connected to MQTT broker")
 }
 
+// defaultMessageHandler only queues the message, so a slow device does not stall the MQTT client.
+// Messages of the same device are processed in order by the same worker.
 func (h *MQTTBroker) defaultMessageHandler() mqtt.MessageHandler {
 	return func(client mqtt.Client, msg mqtt.Message) {
 		h.logger.Printf("Received message: %s from topic: %s\n", msg.Payload(), msg.Topic())
-		if _, exists := h.Topics[msg.Topic()]; !exists {
+		h.mu.Lock()
+		_, exists := h.Topics[msg.Topic()]
+		h.mu.Unlock()
+		if !exists {
 			h.logger.Warnf("Topic %s is not registered\n", msg.Topic())
 			return
 		}
-		deviceMode := util.GetDeviceMode(msg.Topic())
-		deviceName := util.GetDeviceName(msg.Topic())
-		acknowledgmentTopic := deviceName + "/acknowledgment/" + deviceMode
 
-		var request model.SmartCardRequest
-		err := json.Unmarshal(msg.Payload(), &request)
-		if err != nil {
-			h.logger.Errorf("Error unmarshaling payload: %v\n", err)
+		h.dispatcher.submit(util.GetDeviceName(msg.Topic()), task{
+			run:    func() { h.processMessage(msg) },
+			reject: func() { h.rejectMessage(msg) },
+		})
+	}
+}
 
-			token := client.Publish(acknowledgmentTopic, 0, false, model.ResponseMessage{
-				Code:    400,
-				Status:  "error",
-				Message: err.Error(),
-			})
-			if token.Wait() && token.Error() != nil {
-				h.logger.Errorf("Error sending acknowledgment: %v\n", token.Error())
-			}
+// rejectMessage tells the device the message is not processed, so it keeps the tap in its offline buffer.
+// It runs on the MQTT client goroutine, hence the publish is not awaited.
+func (h *MQTTBroker) rejectMessage(msg mqtt.Message) {
+	h.logger.Warnf("Dropped message from topic %s, worker queue is full or shutting down\n", msg.Topic())
+	if isCommandAckTopic(msg.Topic()) {
+		return
+	}
 
+	var response any = model.ResponseMessage{Code: 503, Status: "error", Message: "Server sibuk, coba lagi"}
+	if version := ackVersionOf(msg.Payload()); version > 0 && isTapTopic(msg.Topic()) {
+		response = newDeviceAck(&tapEvent{ackVersion: version}, 503, nil)
+	}
+	payload, err := json.Marshal(response)
+	if err != nil {
+		h.logger.Errorf("Error marshaling response: %v\n", err)
+		return
+	}
+	h.Client.Publish(acknowledgmentTopicOf(msg.Topic()), h.ackQoS, false, payload)
+}
+
+func acknowledgmentTopicOf(inputTopic string) string {
+	return util.GetDeviceName(inputTopic) + "/acknowledgment/" + util.GetDeviceMode(inputTopic)
+}
+
+func (h *MQTTBroker) processMessage(msg mqtt.Message) {
+	if isCommandAckTopic(msg.Topic()) {
+		h.handleCommandAck(msg.Topic(), msg.Payload())
+		return
+	}
+	acknowledgmentTopic := acknowledgmentTopicOf(msg.Topic())
+	deviceMode := util.GetDeviceMode(msg.Topic())
+	event := newTapEvent(msg.Topic())
+	if isTapTopic(msg.Topic()) {
+		event.ackVersion = ackVersionOf(msg.Payload())
+	}
+
+	payload, err := h.verifyMessage(msg.Topic(), msg.Payload())
+	if err != nil {
+		h.logger.Warnf("Rejected message from topic %s: %v\n", msg.Topic(), err)
+		h.replyTapError(event, acknowledgmentTopic, err)
+		return
+	}
+
+	if repo.DeviceModeType(deviceMode) == repo.DeviceModeTypePing {
+		var request model.DevicePingRequest
+		if len(payload) > 0 {
+			if err := json.Unmarshal(payload, &request); err != nil {
+				h.logger.Errorf("Error unmarshaling payload: %v\n", err)
+				h.publishResponse(acknowledgmentTopic, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
+				return
+			}
+		}
+		if err := h.validator.Struct(request); err != nil {
+			h.logger.Errorf("Error validating request: %v\n", err)
+			h.publishResponse(acknowledgmentTopic, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
 			return
 		}
 
-		//validate request
+		h.handlePing(acknowledgmentTopic, msg.Topic(), &request)
+		return
+	}
+
+	if repo.DeviceModeType(deviceMode) == repo.DeviceModeTypeBatch {
+		var request model.BatchTapRequest
+		if err := json.Unmarshal(payload, &request); err != nil {
+			h.logger.Errorf("Error unmarshaling payload: %v\n", err)
+			h.replyTap(event, acknowledgmentTopic, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
+			return
+		}
 		if err := h.validator.Struct(request); err != nil {
 			h.logger.Errorf("Error validating request: %v\n", err)
-			token := client.Publish(acknowledgmentTopic, 0, false, model.ResponseMessage{
-				Code:    400,
-				Status:  "error",
-				Message: err.Error(),
-			})
-			if token.Wait() && token.Error() != nil {
-				h.logger.Errorf("Error sending acknowledgment: %v\n", token.Error())
-			}
+			h.replyTap(event, acknowledgmentTopic, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
 			return
 		}
 
-		switch repo.DeviceModeType(deviceMode) {
-		case repo.DeviceModeTypeRecord:
-			h.handleRecord(acknowledgmentTopic, &request)
-		case repo.DeviceModeTypePresence:
-			h.handlePresence(acknowledgmentTopic, &request)
-		case repo.DeviceModeTypePermission:
-			h.handlePermission(acknowledgmentTopic, &request)
-		case repo.DeviceModeTypePing:
-			h.handlePing(acknowledgmentTopic)
-		default:
-			h.logger.Warnf("Unhandled topic: %s\n", msg.Topic())
+		h.handleBatch(acknowledgmentTopic, msg.Topic(), &request)
+		return
+	}
+
+	var request model.SmartCardRequest
+	if err := json.Unmarshal(payload, &request); err != nil {
+		h.logger.Errorf("Error unmarshaling payload: %v\n", err)
+		h.replyTap(event, acknowledgmentTopic, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
+		return
+	}
+
+	//validate request
+	if err := h.validator.Struct(request); err != nil {
+		h.logger.Errorf("Error validating request: %v\n", err)
+		h.replyTap(event, acknowledgmentTopic, model.ResponseMessage{Code: 400, Status: "error", Message: err.Error()})
+		return
+	}
+
+	event.uid = request.Uid
+	if request.QrToken != "" {
+		if !h.applyQRCredential(event, acknowledgmentTopic, request.QrToken) {
+			return
 		}
+	}
+	if !h.applyTapRule(event, acknowledgmentTopic) {
+		return
+	}
 
+	switch repo.DeviceModeType(deviceMode) {
+	case repo.DeviceModeTypeRecord:
+		h.handleRecord(event, acknowledgmentTopic, &request)
+	case repo.DeviceModeTypePresence:
+		h.handlePresence(event, acknowledgmentTopic)
+	case repo.DeviceModeTypePermission:
+		h.handlePermission(event, acknowledgmentTopic)
+	default:
+		h.logger.Warnf("Unhandled topic: %s\n", msg.Topic())
 	}
 }
 
-func (h *MQTTBroker) RefreshTopics() {
+// RefreshTopics subscribes to the input topics of every device and of the temporary modes that have not expired,
+// and schedules the next refresh for when the earliest temporary mode expires
+func (h *MQTTBroker) RefreshTopics() error {
 	h.logger.Println("Fetching initial topics...")
 	device, err := h.deviceUseCase.ListDevices(context.Background())
 	if err != nil {
-		h.logger.Fatalf("Error fetching device topics: %v", err)
+		return err
 	}
 
 	var newTopics []string
@@ -141,10 +308,39 @@ func (h *MQTTBroker) RefreshTopics() {
 		for _, mode := range device.Modes {
 			newTopics = append(newTopics, mode.InputTopic)
 		}
+		newTopics = append(newTopics, commandAckTopic(device.Name))
 	}
 
+	temporaryTopics, nextExpiry, err := h.deviceUseCase.ListTemporaryModeTopics(context.Background())
+	if err != nil {
+		h.logger.Errorf("Error fetching temporary mode topics: %v", err)
+	}
+	newTopics = append(newTopics, temporaryTopics...)
+	h.scheduleModeSwitchExpiry(nextExpiry)
+
 	// Langganan topic
 	h.UpdateSubscriptions(newTopics)
+	return nil
+}
+
+// scheduleModeSwitchExpiry replaces the pending refresh, so timers are rebuilt from the database after a restart
+func (h *MQTTBroker) scheduleModeSwitchExpiry(expiresAt time.Time) {
+	h.timerMu.Lock()
+	defer h.timerMu.Unlock()
+
+	if h.modeSwitchTimer != nil {
+		h.modeSwitchTimer.Stop()
+		h.modeSwitchTimer = nil
+	}
+	if expiresAt.IsZero() {
+		return
+	}
+
+	h.modeSwitchTimer = time.AfterFunc(time.Until(expiresAt)+time.Second, func() {
+		if err := h.RefreshTopics(); err != nil {
+			h.logger.Errorf("Error refreshing topics after a temporary mode expired: %v", err)
+		}
+	})
 }
 
 func (h *MQTTBroker) UpdateSubscriptions(newTopics []string) {
@@ -155,7 +351,9 @@ func (h *MQTTBroker) UpdateSubscriptions(newTopics []string) {
 	for topic, _ := range h.Topics {
 		if !util.Contains(newTopics, topic) {
 			h.logger.Printf("Unsubscribing and stopping topic: %s\n", topic)
-			h.Client.Unsubscribe(topic)
+			if h.Client.IsConnectionOpen() {
+				h.Client.Unsubscribe(topic)
+			}
 			delete(h.Topics, topic)
 		}
 	}
@@ -163,9 +361,35 @@ func (h *MQTTBroker) UpdateSubscriptions(newTopics []string) {
 	// Tambah topic anyar
 	for _, topic := range newTopics {
 		if _, exists := h.Topics[topic]; !exists {
-			h.logger.Printf("Subscribing and starting topic: %s\n", topic)
-			h.Client.Subscribe(topic, 0, h.defaultMessageHandler())
 			h.Topics[topic] = struct{}{}
+			// saat terputus, topic disubscribe ulang oleh onConnect
+			if !h.Client.IsConnectionOpen() {
+				continue
+			}
+			h.logger.Printf("Subscribing and starting topic: %s\n", topic)
+			h.subscribe(h.Client, topic)
 		}
 	}
+
+	h.state.update(func(state *ConnectionState) {
+		state.SubscribedTopics = len(h.Topics)
+	})
+}
+
+// DispatcherStats returns queue depth and counters of the message worker pool
+func (h *MQTTBroker) DispatcherStats() DispatcherStats {
+	return h.dispatcher.stats()
+}
+
+// Shutdown stops taking new messages, waits for queued messages to be processed and then disconnects.
+// Messages arriving while draining are rejected so the device can send them again later.
+func (h *MQTTBroker) Shutdown(ctx context.Context) error {
+	h.scheduleModeSwitchExpiry(time.Time{})
+	h.logger.Println("Draining MQTT message queue...")
+	err := h.dispatcher.shutdown(ctx)
+	if err != nil {
+		h.logger.Errorf("MQTT message queue is not drained: %v", err)
+	}
+	h.Client.Disconnect(250)
+	return err
 }
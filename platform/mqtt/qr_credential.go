@@ -0,0 +1,47 @@
+package mqtt
+
+import (
+	"fmt"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+)
+
+// applyQRCredential verifies the QR credential sent instead of a card and takes its owner as the owner of the tap.
+// It returns false when the tap is already answered.
+func (h *MQTTBroker) applyQRCredential(event *tapEvent, acknowledgmentTopic string, qrToken string) bool {
+	if event.mode != repo.DeviceModeTypePresence && event.mode != repo.DeviceModeTypePermission {
+		h.replyTap(event, acknowledgmentTopic, model.ResponseMessage{
+			Code:    400,
+			Status:  "error",
+			Message: "QR hanya untuk mode presensi dan izin",
+		})
+		return false
+	}
+
+	if h.qrMaker == nil {
+		h.logger.Warnf("QR credential sent on %s, but QR credentials are not configured\n", event.topic)
+		event.result = model.TapResultQRInvalid
+		h.replyTap(event, acknowledgmentTopic, model.ResponseMessage{Code: 403, Status: "error", Message: "QR tidak berlaku"})
+		return false
+	}
+
+	payload, err := h.qrMaker.VerifyToken(qrToken)
+	if err != nil {
+		h.logger.Warnf("Rejected QR credential from topic %s: %v\n", event.topic, err)
+		event.result = model.TapResultQRInvalid
+		h.replyTap(event, acknowledgmentTopic, model.ResponseMessage{Code: 403, Status: "error", Message: "QR tidak berlaku"})
+		return false
+	}
+
+	event.qr = payload
+	event.owner = model.OwenerDetails{ID: payload.OwnerID, Role: payload.OwnerRole}
+	// debounce and anti-passback follow the owner, whichever QR code of the owner is shown
+	event.uid = qrUid(payload.OwnerRole, payload.OwnerID)
+	return true
+}
+
+// qrUid identifies the owner of a QR credential in the tap events, where a card is identified by its UID
+func qrUid(ownerRole repo.RoleType, ownerID int32) string {
+	return fmt.Sprintf("qr:%s:%d", ownerRole, ownerID)
+}
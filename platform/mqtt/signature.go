@@ -0,0 +1,85 @@
+package mqtt
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/exception"
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
+	"github.com/adiubaidah/syafiiyah-main/pkg/util"
+)
+
+// defaultSignatureMaxAge is used when MQTT_SIGNATURE_MAX_AGE is not configured
+const defaultSignatureMaxAge = 5 * time.Minute
+
+// nonceSweepInterval is how often expired nonces are removed, a sweep walks the whole cache
+const nonceSweepInterval = time.Minute
+
+// nonceCache remembers nonces of accepted messages until they are too old to be accepted anyway
+type nonceCache struct {
+	mu        sync.Mutex
+	seen      map[string]time.Time
+	nextSweep time.Time
+}
+
+func newNonceCache() *nonceCache {
+	return &nonceCache{seen: make(map[string]time.Time)}
+}
+
+// add returns false when the nonce is already used. Expired nonces are swept at most once per nonceSweepInterval,
+// one that is not swept yet does not count as used.
+func (c *nonceCache) add(key string, expiresAt time.Time, now time.Time) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !now.Before(c.nextSweep) {
+		for nonce, expiry := range c.seen {
+			if now.After(expiry) {
+				delete(c.seen, nonce)
+			}
+		}
+		c.nextSweep = now.Add(nonceSweepInterval)
+	}
+
+	if expiry, exists := c.seen[key]; exists && !now.After(expiry) {
+		return false
+	}
+	c.seen[key] = expiresAt
+	return true
+}
+
+// verifyMessage checks the signature envelope of a device message and returns the signed payload.
+// Unsigned, stale and replayed messages are rejected with unauthorized error.
+func (h *MQTTBroker) verifyMessage(topic string, raw []byte) ([]byte, error) {
+	var message model.SignedDeviceMessage
+	if err := json.Unmarshal(raw, &message); err != nil {
+		return nil, exception.NewUnauthorizedError("Pesan tidak ditandatangani")
+	}
+	if err := h.validator.Struct(message); err != nil {
+		return nil, exception.NewUnauthorizedError("Pesan tidak ditandatangani")
+	}
+
+	now := time.Now()
+	sentAt := time.Unix(message.Timestamp, 0)
+	if sentAt.Before(now.Add(-h.signatureMaxAge)) || sentAt.After(now.Add(h.signatureMaxAge)) {
+		return nil, exception.NewUnauthorizedError("Pesan kedaluwarsa")
+	}
+
+	secret, err := h.deviceUseCase.GetSecretByTopic(context.Background(), topic)
+	if err != nil {
+		h.logger.Errorf("Error getting device secret: %v\n", err)
+		return nil, exception.NewUnauthorizedError("Device tidak dikenal")
+	}
+
+	if !util.VerifyDevicePayload(secret, topic, message.Timestamp, message.Nonce, message.Payload, message.Signature) {
+		return nil, exception.NewUnauthorizedError("Signature tidak valid")
+	}
+
+	if !h.nonces.add(util.GetDeviceName(topic)+":"+message.Nonce, sentAt.Add(h.signatureMaxAge), now) {
+		return nil, exception.NewUnauthorizedError("Pesan sudah pernah diterima")
+	}
+
+	return message.Payload, nil
+}
@@ -0,0 +1,24 @@
+package mqtt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNonceCache(t *testing.T) {
+	cache := newNonceCache()
+	now := time.Now()
+
+	require.True(t, cache.add("gate_a:nonce", now.Add(time.Minute), now))
+	require.False(t, cache.add("gate_a:nonce", now.Add(time.Minute), now.Add(time.Second)))
+	require.True(t, cache.add("gate_b:nonce", now.Add(5*time.Second), now.Add(time.Second)))
+
+	// expired before the next sweep, the nonce is not used anymore
+	require.True(t, cache.add("gate_b:nonce", now.Add(15*time.Second), now.Add(10*time.Second)))
+
+	// the sweep removes every expired nonce at once
+	require.True(t, cache.add("gate_c:nonce", now.Add(3*time.Minute), now.Add(2*time.Minute)))
+	require.Len(t, cache.seen, 1)
+}
@@ -0,0 +1,129 @@
+package mqtt
+
+import (
+	"context"
+	"time"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+	"github.com/adiubaidah/syafiiyah-main/pkg/token"
+	"github.com/adiubaidah/syafiiyah-main/pkg/util"
+)
+
+// tapEvent collects what is known about a tap while it is handled, it is saved once the device is answered
+type tapEvent struct {
+	topic          string
+	deviceName     string
+	mode           repo.DeviceModeType
+	uid            string
+	owner          model.OwenerDetails
+	tappedAt       *time.Time
+	startedAt      time.Time
+	gateDirection  repo.GateDirectionType
+	debounceWindow time.Duration
+	// presence and scheduleID are set when the tap recorded a presence
+	presence   any
+	scheduleID int32
+	// ackVersion is the acknowledgment format asked by the device, 0 is ResponseMessage and ResponseData
+	ackVersion int
+	// result is set by handlers knowing more than the response code tells, e.g. an inactive card
+	result model.TapResult
+	// qr is the verified credential when the owner showed a QR code instead of a card
+	qr *token.QRPayload
+}
+
+func newTapEvent(topic string) *tapEvent {
+	return &tapEvent{
+		topic:      topic,
+		deviceName: util.GetDeviceName(topic),
+		mode:       repo.DeviceModeType(util.GetDeviceMode(topic)),
+		startedAt:  time.Now(),
+	}
+}
+
+// saveTapEvent records the outcome of the tap, including rejected ones. Ping is not a tap and is skipped.
+func (h *MQTTBroker) saveTapEvent(event *tapEvent, code int, message string) {
+	if event.mode == repo.DeviceModeTypePing || h.tapEventUseCase == nil {
+		return
+	}
+
+	request := &model.CreateTapEventRequest{
+		DeviceName: event.deviceName,
+		Mode:       event.mode,
+		Uid:        event.uid,
+		Owner:      event.owner,
+		Code:       code,
+		Message:    message,
+		Latency:    time.Since(event.startedAt),
+		TappedAt:   event.tappedAt,
+		// a gate passage only counts for anti-passback when the tap is accepted
+		GateDirection: event.gateDirection,
+	}
+
+	tapEventResponse, err := h.tapEventUseCase.CreateTapEvent(context.Background(), request)
+	if err != nil {
+		h.logger.Errorf("Error saving tap event: %v\n", err)
+		return
+	}
+	h.publishLiveEvent(event, tapEventResponse)
+}
+
+// publishLiveEvent streams the saved tap to the browsers watching the live feed
+func (h *MQTTBroker) publishLiveEvent(event *tapEvent, tapEventResponse *model.TapEventResponse) {
+	if h.liveFeed == nil {
+		return
+	}
+
+	liveEvent := &model.LiveEvent{
+		Type:       model.LiveEventTypeTap,
+		ScheduleID: event.scheduleID,
+		TapEvent:   tapEventResponse,
+		Presence:   event.presence,
+	}
+	if tapEventResponse.Device != nil {
+		liveEvent.DeviceID = tapEventResponse.Device.Id
+	}
+	if event.owner.Role != "" {
+		owner := event.owner
+		liveEvent.Owner = &owner
+		liveEvent.OccupationID = owner.OccupationID
+	}
+	if event.presence != nil {
+		liveEvent.Type = model.LiveEventTypePresence
+	}
+	h.liveFeed.Publish(liveEvent)
+}
+
+// replyTap answers the device with an error or plain message and records the tap
+func (h *MQTTBroker) replyTap(event *tapEvent, acknowledgmentTopic string, response model.ResponseMessage) {
+	if event.ackVersion > 0 {
+		h.publishResponse(acknowledgmentTopic, newDeviceAck(event, response.Code, nil))
+	} else {
+		h.publishResponse(acknowledgmentTopic, response)
+	}
+	h.saveTapEvent(event, response.Code, response.Message)
+}
+
+func (h *MQTTBroker) replyTapError(event *tapEvent, acknowledgmentTopic string, err error) {
+	h.replyTap(event, acknowledgmentTopic, createErrorResponse(err))
+}
+
+// replyTapData answers the device with the result of a successful tap and records the tap.
+// Repeats of the tap are debounced from now on.
+func (h *MQTTBroker) replyTapData(event *tapEvent, acknowledgmentTopic string, message string, data any) {
+	if event.ackVersion > 0 {
+		h.publishResponse(acknowledgmentTopic, newDeviceAck(event, 200, data))
+	} else {
+		h.publishResponse(acknowledgmentTopic, model.ResponseData[any]{
+			Code:   200,
+			Status: "success",
+			Data:   data,
+		})
+	}
+	h.saveTapEvent(event, 200, message)
+
+	if event.debounceWindow > 0 {
+		now := time.Now()
+		h.debouncer.mark(debounceKey(event), now.Add(event.debounceWindow), now)
+	}
+}
@@ -0,0 +1,97 @@
+package mqtt
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/adiubaidah/syafiiyah-main/internal/constant/model"
+	repo "github.com/adiubaidah/syafiiyah-main/internal/repository"
+)
+
+// defaultTapDebounceWindow is used when TAP_DEBOUNCE_WINDOW is not configured
+const defaultTapDebounceWindow = 10 * time.Second
+
+// tapDebouncer remembers cards recently accepted by a device, so a card held on the reader is only handled once
+type tapDebouncer struct {
+	mu    sync.Mutex
+	until map[string]time.Time
+}
+
+func newTapDebouncer() *tapDebouncer {
+	return &tapDebouncer{until: make(map[string]time.Time)}
+}
+
+func (d *tapDebouncer) recent(key string, now time.Time) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	until, exists := d.until[key]
+	return exists && now.Before(until)
+}
+
+func (d *tapDebouncer) mark(key string, until time.Time, now time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for card, expiry := range d.until {
+		if now.After(expiry) {
+			delete(d.until, card)
+		}
+	}
+	d.until[key] = until
+}
+
+func debounceKey(event *tapEvent) string {
+	return event.deviceName + ":" + event.uid
+}
+
+// applyTapRule answers a repeated tap within the debounce window and a tap breaking anti-passback of a gate,
+// without handling them. It returns false when the tap is already answered.
+func (h *MQTTBroker) applyTapRule(event *tapEvent, acknowledgmentTopic string) bool {
+	ctx := context.Background()
+	rule, err := h.deviceUseCase.GetTapRule(ctx, event.topic)
+	if err != nil {
+		h.logger.Errorf("Error getting device tap rule: %v\n", err)
+		rule = &model.DeviceTapRule{}
+	}
+
+	event.debounceWindow = h.tapDebounceWindow
+	if rule.DebounceSeconds != nil {
+		event.debounceWindow = time.Duration(*rule.DebounceSeconds) * time.Second
+	}
+	if event.debounceWindow > 0 && h.debouncer.recent(debounceKey(event), time.Now()) {
+		h.replyTap(event, acknowledgmentTopic, model.ResponseMessage{
+			Code:    208,
+			Status:  "success",
+			Message: "Tap sudah tercatat",
+		})
+		return false
+	}
+
+	event.gateDirection = rule.GateDirection
+	if !rule.AntiPassback || rule.GateDirection == "" || event.mode == repo.DeviceModeTypeRecord {
+		return true
+	}
+
+	lastDirection, err := h.tapEventUseCase.GetLastGateDirection(ctx, event.uid)
+	if err != nil {
+		// the gate stays usable when the last passage can not be checked
+		h.logger.Errorf("Error getting last gate direction: %v\n", err)
+		return true
+	}
+	if lastDirection != rule.GateDirection {
+		return true
+	}
+
+	event.result = model.TapResultPassback
+	message := "Kartu sudah tercatat masuk, tap keluar terlebih dahulu"
+	if rule.GateDirection == repo.GateDirectionTypeExit {
+		message = "Kartu sudah tercatat keluar, tap masuk terlebih dahulu"
+	}
+	h.replyTap(event, acknowledgmentTopic, model.ResponseMessage{
+		Code:    403,
+		Status:  "error",
+		Message: message,
+	})
+	return false
+}
@@ -0,0 +1,23 @@
+package mqtt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTapDebouncer(t *testing.T) {
+	debouncer := newTapDebouncer()
+	now := time.Now()
+
+	require.False(t, debouncer.recent("gate_a:card", now))
+
+	debouncer.mark("gate_a:card", now.Add(10*time.Second), now)
+	require.True(t, debouncer.recent("gate_a:card", now.Add(5*time.Second)))
+	require.False(t, debouncer.recent("gate_b:card", now.Add(5*time.Second)))
+	require.False(t, debouncer.recent("gate_a:card", now.Add(11*time.Second)))
+
+	debouncer.mark("gate_b:card", now.Add(20*time.Second), now.Add(11*time.Second))
+	require.NotContains(t, debouncer.until, "gate_a:card")
+}
@@ -1,7 +1,8 @@
 package routers
 
 import (
-	"fmt"
+	"context"
+	"errors"
 	"net/http"
 	"runtime"
 
@@ -12,6 +13,8 @@ import (
 // Router contains the functions of http handler to clean payloads and pass it the service
 type Router interface {
 	Serve()
+	// Shutdown stops accepting requests and waits for the running ones until ctx is done
+	Shutdown(ctx context.Context) error
 }
 
 // Route data will be registered to http listener
@@ -22,26 +25,47 @@ type Route struct {
 	MiddleWares gin.HandlersChain
 }
 
+// HealthCheck returns state of a dependency shown on the health endpoint
+type HealthCheck func() any
+
 type routing struct {
-	address string
-	routers []Route
+	routers      []Route
+	healthChecks map[string]HealthCheck
+	server       *http.Server
 }
 
 // NewRouting is for creating new routing
-func NewRouting(address string, routers []Route) Router {
-	return &routing{
-		address,
-		routers,
+func NewRouting(address string, routers []Route, healthChecks map[string]HealthCheck) Router {
+	r := &routing{
+		routers:      routers,
+		healthChecks: healthChecks,
+	}
+	r.server = &http.Server{
+		Addr:    address,
+		Handler: r.handler(),
 	}
+	return r
 }
 
+// Serve listens until Shutdown is called
 func (r *routing) Serve() {
+	err := r.server.ListenAndServe()
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		panic(err)
+	}
+}
+
+func (r *routing) Shutdown(ctx context.Context) error {
+	return r.server.Shutdown(ctx)
+}
+
+func (r *routing) handler() http.Handler {
 	ginRouter := gin.New()
 	ginRouter.Use(gin.Logger())
 	ginRouter.Use(gin.Recovery())
 	ginRouter.Use(CORSHandler)
 	ginRouter.Static("/photo", config.PathPhoto)
-	ginRouter.Handle(http.MethodGet, "/ping", HealthCheck)
+	ginRouter.Handle(http.MethodGet, "/ping", r.healthCheck)
 
 	for _, router := range r.routers {
 		if router.MiddleWares == nil {
@@ -57,12 +81,7 @@ func (r *routing) Serve() {
 		}
 	}
 
-	err := ginRouter.Run(r.address)
-	if err != nil {
-		panic(err)
-	}
-	fmt.Printf("started at %s", r.address)
-	ginRouter.Run(r.address)
+	return ginRouter
 }
 
 // CORSHandler handles requests with unsupported HTTP methods.
@@ -82,9 +101,13 @@ func CORSHandler(c *gin.Context) {
 	c.Next()
 }
 
-// HealthCheck handles the HTTP request for health checking the service.
-func HealthCheck(c *gin.Context) {
-	c.JSON(http.StatusOK, gin.H{
+// healthCheck handles the HTTP request for health checking the service.
+func (r *routing) healthCheck(c *gin.Context) {
+	response := gin.H{
 		"description": runtime.NumGoroutine(),
-	})
+	}
+	for name, check := range r.healthChecks {
+		response[name] = check()
+	}
+	c.JSON(http.StatusOK, response)
 }